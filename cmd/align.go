@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/align"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+)
+
+var alignThreads int
+var alignReference string
+var alignFasta string
+var alignOutfile string
+var alignInsertions string
+var alignScoring = align.DefaultScoring()
+
+func init() {
+	rootCmd.AddCommand(alignCmd)
+
+	alignCmd.Flags().IntVarP(&alignThreads, "threads", "t", 0, "Number of CPUs to use (Default: all available CPUs)")
+	alignCmd.Flags().StringVarP(&alignReference, "reference", "r", "", "Reference sequence, in fasta format")
+	alignCmd.Flags().StringVarP(&alignFasta, "fasta", "f", "stdin", "Unaligned sequences to align, in fasta format")
+	alignCmd.Flags().StringVarP(&alignOutfile, "outfile", "o", "stdout", "Alignment to write, in fasta format")
+	alignCmd.Flags().StringVarP(&alignInsertions, "insertions", "", "", "(Optional) CSV file to write insertions relative to the reference to")
+	alignCmd.Flags().Int32VarP(&alignScoring.Match, "match", "", alignScoring.Match, "Score for a match")
+	alignCmd.Flags().Int32VarP(&alignScoring.Mismatch, "mismatch", "", alignScoring.Mismatch, "Penalty for a mismatch")
+	alignCmd.Flags().Int32VarP(&alignScoring.GapOpen, "gap-open", "", alignScoring.GapOpen, "Penalty for opening a gap")
+	alignCmd.Flags().Int32VarP(&alignScoring.GapExtend, "gap-extend", "", alignScoring.GapExtend, "Penalty for each base in a gap")
+	alignCmd.Flags().IntVarP(&alignScoring.Band, "band", "", alignScoring.Band, "Number of extra diagonals either side of the band suggested by k-mer matches")
+
+	alignCmd.Flags().SortFlags = false
+}
+
+var alignCmd = &cobra.Command{
+	Use:   "align",
+	Short: "Align sequences to a reference",
+	Long: `Align sequences to a reference
+
+Example usage:
+	gofasta align -r reference.fasta -f sequences.fasta -o alignment.fasta
+	gofasta align -r reference.fasta -f sequences.fasta --insertions insertions.csv -o alignment.fasta
+
+Each sequence is aligned to the reference on its own, and the output is the same width as the reference, like the
+output of minimap2 followed by gofasta sam toMultiAlign. Insertions relative to the reference are omitted from the
+alignment; --insertions is a CSV file with the columns query,ref_position,insertion, where ref_position is the
+(1-based) reference position that each insertion comes after. Deletions, and parts of the reference that a
+sequence doesn't cover, are gaps.
+
+Alignment is global with affine gap penalties and free end gaps, restricted to a band of diagonals placed using
+exact 15-mer matches with the reference. This is intended for closely related sequences, such as assembled genomes
+of the same virus; with divergent sequences, or indels longer than the band allows for, use a dedicated aligner.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		ref, err := gfio.OpenIn(*cmd.Flag("reference"))
+		if err != nil {
+			return err
+		}
+		defer ref.Close()
+
+		in, err := gfio.OpenIn(*cmd.Flag("fasta"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		var insOut io.Writer
+		if alignInsertions != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("insertions"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			insOut = f
+		}
+
+		err = align.AlignToReference(cmd.Context(), ref, in, out, insOut, alignScoring, alignThreads)
+
+		return
+	},
+}
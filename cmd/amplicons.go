@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/amplicons"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/primers"
+	"github.com/virus-evolution/gofasta/pkg/split"
+)
+
+var ampliconsMSA string
+var ampliconsScheme string
+var ampliconsReference string
+var ampliconsMinCompleteness float64
+var ampliconsOutfile string
+var ampliconsSummary string
+var ampliconsTemplate string
+
+func init() {
+	rootCmd.AddCommand(ampliconsCmd)
+
+	ampliconsCmd.Flags().StringVarP(&ampliconsMSA, "msa", "", "stdin", "Alignment in fasta format, in the scheme's coordinates")
+	ampliconsCmd.Flags().StringVarP(&ampliconsScheme, "scheme", "p", "", "Primer scheme BED file")
+	ampliconsCmd.Flags().StringVarP(&ampliconsReference, "reference", "r", "", "(Optional) Reference sequence in fasta format, to measure divergence from")
+	ampliconsCmd.Flags().Float64VarP(&ampliconsMinCompleteness, "min-completeness", "", 0.5, "A record drops out of an amplicon if less than this proportion of its insert is unambiguous")
+	ampliconsCmd.Flags().StringVarP(&ampliconsOutfile, "outfile", "o", "stdout", "CSV file of each record's statistics in each amplicon to write")
+	ampliconsCmd.Flags().StringVarP(&ampliconsSummary, "summary", "s", "", "(Optional) CSV file of statistics for each amplicon to write")
+	ampliconsCmd.Flags().StringVarP(&ampliconsTemplate, "template", "t", "", "(Optional) Template for filenames to write each amplicon's sub-alignment to. {value} is the\n"+
+		"amplicon's name and {n} its number, e.g. amplicons/{value}.fasta")
+
+	ampliconsCmd.Flags().SortFlags = false
+}
+
+var ampliconsCmd = &cobra.Command{
+	Use:   "amplicons",
+	Short: "Report the completeness and divergence of each record in each amplicon of a primer scheme",
+	Long: `Report the completeness and divergence of each record in each amplicon of a primer scheme
+
+Example usage:
+	gofasta amplicons --msa aligned.fasta -p SARS-CoV-2.primer.bed -r MN908947.fasta -s amplicons_summary.csv -o amplicons.csv
+	gofasta amplicons --msa aligned.fasta -p SARS-CoV-2.primer.bed -s amplicons_summary.csv -t amplicons/{value}.fasta -o amplicons.csv
+
+--scheme is a primer scheme BED file with the columns chrom, start, end, name, pool and strand, like those of the
+ARTIC network. Primers are paired into amplicons by their names, which must end in _LEFT or _RIGHT, optionally
+followed by a suffix such as _alt1 or _1 for alternative primers. Each amplicon's insert is the part between its
+innermost primers, and only inserts are measured, because primer sites reflect the primers rather than the genome.
+
+The alignment must be in the scheme's coordinates, e.g. the output of gofasta sam toMultiAlign (so SAM files should
+be converted with it first) or gofasta align. --outfile has the columns
+record,amplicon,start,end,completeness,compared_sites,differences,divergence, where start and end are the insert's
+1-based, inclusive coordinates and completeness the proportion of it that is A, C, G or T. The last three columns
+compare those bases with --reference, and are empty if no --reference is given.
+
+--summary has the columns amplicon,start,end,records,mean_completeness,dropouts,divergence, where dropouts is the
+number of records with less than --min-completeness, to find amplicons that are performing poorly. With --template,
+every record's insert of each amplicon is written to a file of its own. Output directories in the template must
+already exist. Existing files are overwritten.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if ampliconsScheme == "" {
+			return errors.New("amplicons needs a primer --scheme")
+		}
+		if ampliconsTemplate != "" && !strings.Contains(ampliconsTemplate, "{value}") && !strings.Contains(ampliconsTemplate, "{n}") {
+			return errors.New("--template must include {value} or {n}")
+		}
+
+		schemeIn, err := gfio.OpenIn(*cmd.Flag("scheme"))
+		if err != nil {
+			return err
+		}
+		defer schemeIn.Close()
+		ps, err := primers.ReadBED(schemeIn)
+		if err != nil {
+			return err
+		}
+		as, err := amplicons.FromPrimers(ps)
+		if err != nil {
+			return err
+		}
+
+		var ref string
+		if ampliconsReference != "" {
+			refIn, err := gfio.OpenIn(*cmd.Flag("reference"))
+			if err != nil {
+				return err
+			}
+			defer refIn.Close()
+			refs, err := fastaio.ReadFastaToListUncounted(refIn)
+			if err != nil {
+				return err
+			}
+			if len(refs) != 1 {
+				return errors.New("there must be exactly one record in --reference")
+			}
+			ref = refs[0].Seq
+		}
+
+		msa, err := gfio.OpenIn(*cmd.Flag("msa"))
+		if err != nil {
+			return err
+		}
+		defer msa.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		var summaryOut io.Writer
+		if ampliconsSummary != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("summary"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			summaryOut = f
+		}
+
+		var splitOut func(amplicons.Amplicon) (io.Writer, error)
+		if ampliconsTemplate != "" {
+			files := make([]*gfio.Output, 0, len(as))
+			defer func() {
+				for _, f := range files {
+					if cerr := f.Close(); cerr != nil && err == nil {
+						err = cerr
+					}
+				}
+			}()
+			n := 0
+			splitOut = func(a amplicons.Amplicon) (io.Writer, error) {
+				n++
+				f, err := gfio.OpenOutPath(split.Template(ampliconsTemplate).Name(n, a.Name))
+				if err != nil {
+					return nil, err
+				}
+				files = append(files, f)
+				return f, nil
+			}
+		}
+
+		err = amplicons.Amplicons(cmd.Context(), msa, ref, as, out, summaryOut, splitOut, amplicons.Options{MinCompleteness: ampliconsMinCompleteness})
+
+		return
+	},
+}
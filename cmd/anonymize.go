@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/rename"
+)
+
+var anonymizeFasta string
+var anonymizeOutfile string
+var anonymizeMapping string
+var anonymizeKeyFile string
+var anonymizeOptions = rename.DefaultAnonymizeOptions()
+
+func init() {
+	rootCmd.AddCommand(anonymizeCmd)
+
+	anonymizeCmd.Flags().StringVarP(&anonymizeFasta, "fasta", "f", "stdin", "Fasta file of records to anonymize")
+	anonymizeCmd.Flags().StringVarP(&anonymizeOutfile, "outfile", "o", "stdout", "Fasta file of anonymized records to write")
+	anonymizeCmd.Flags().StringVarP(&anonymizeMapping, "mapping", "m", "", "CSV file of new name, old name to write. Only readable by you")
+	anonymizeCmd.Flags().StringVarP(&anonymizeOptions.Prefix, "prefix", "", anonymizeOptions.Prefix, "Prefix for the new names")
+	anonymizeCmd.Flags().StringVarP(&anonymizeKeyFile, "key-file", "", "", "(Optional) File with a secret key, to name records by a keyed hash of their old names instead of a number")
+	anonymizeCmd.Flags().IntVarP(&anonymizeOptions.HashLength, "hash-length", "", anonymizeOptions.HashLength, "With --key-file, number of hex digits of the hash to use")
+	anonymizeCmd.Flags().BoolVarP(&anonymizeOptions.KeepDescription, "keep-description", "", false, "Keep the description after each record's ID")
+	anonymizeCmd.Flags().BoolVarP(&anonymizeOptions.StripDates, "strip-dates", "", false, "With --keep-description, remove anything that looks like a date from descriptions")
+
+	anonymizeCmd.Flags().Lookup("keep-description").NoOptDefVal = "true"
+	anonymizeCmd.Flags().Lookup("strip-dates").NoOptDefVal = "true"
+
+	anonymizeCmd.Flags().SortFlags = false
+}
+
+var anonymizeCmd = &cobra.Command{
+	Use:     "anonymize",
+	Aliases: []string{"anonymise"},
+	Short:   "Replace record names with opaque identifiers",
+	Long: `Replace record names with opaque identifiers
+
+Example usage:
+	gofasta anonymize -f sequences.fasta -m private_mapping.csv -o shareable.fasta
+	gofasta anonymize -f sequences.fasta -m private_mapping.csv --key-file secret.key --prefix s -o shareable.fasta
+	gofasta anonymize -f sequences.fasta -m private_mapping.csv --keep-description --strip-dates -o shareable.fasta
+
+Each record is renamed to --prefix followed by its number in the file (seq1, seq2, ...). With --key-file, it
+is instead named by the first --hash-length hex digits of the HMAC-SHA256 of its old ID, keyed with the contents
+of the file, so that the same record gets the same name in every file anonymized with the same key, but names
+can't be reversed, or guessed from a list of candidate IDs, without it. Keep the key as private as the mapping.
+
+--mapping is a CSV file of new name, old name pairs, with the header new,old, which gofasta rename -m can use to
+reverse the renaming. It is created so that only you can read it. Descriptions after record IDs are dropped,
+because they often identify samples; --keep-description keeps them, and --strip-dates removes date-like tokens
+such as 2021-01-31, 31/01/2021 and 20210131 from them. Anything else identifying in descriptions is kept.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if anonymizeMapping == "" {
+			return errors.New("gofasta anonymize needs a --mapping file to write")
+		}
+		if anonymizeOptions.StripDates && !anonymizeOptions.KeepDescription {
+			return errors.New("--strip-dates needs --keep-description")
+		}
+
+		if anonymizeKeyFile != "" {
+			key, err := os.ReadFile(anonymizeKeyFile)
+			if err != nil {
+				return err
+			}
+			anonymizeOptions.Key = strings.TrimSpace(string(key))
+			if anonymizeOptions.Key == "" {
+				return errors.New("--key-file is empty")
+			}
+		}
+
+		in, err := gfio.OpenIn(*cmd.Flag("fasta"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		mappingOut, err := gfio.OpenOutPrivate(*cmd.Flag("mapping"))
+		if err != nil {
+			return err
+		}
+		defer mappingOut.Close()
+
+		err = rename.Anonymize(cmd.Context(), in, out, mappingOut, anonymizeOptions)
+
+		return
+	},
+}
@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/closest"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/metadata"
+)
+
+var assignThreads int
+var assignQuery string
+var assignRepresentatives string
+var assignMeasure string
+var assignMetadata string
+var assignNameColumn string
+var assignColumn string
+var assignMinMargin float64
+var assignOutfile string
+
+func init() {
+	rootCmd.AddCommand(assignCmd)
+
+	assignCmd.Flags().IntVarP(&assignThreads, "threads", "t", 0, "Number of CPUs to use (Default: all available CPUs)")
+	assignCmd.Flags().StringVarP(&assignQuery, "query", "", "stdin", "Alignment of sequences to assign labels to, in fasta format")
+	assignCmd.Flags().StringVarP(&assignRepresentatives, "representatives", "r", "", "Alignment of labelled representative sequences, in fasta format")
+	assignCmd.Flags().StringVarP(&assignMeasure, "measure", "m", "snp", "Which distance measure to use (raw, snp or tn93)")
+	assignCmd.Flags().StringVarP(&assignMetadata, "metadata", "", "", "(Optional) CSV/TSV file with the label of each representative. Default is to use their names")
+	assignCmd.Flags().StringVarP(&assignNameColumn, "name-column", "", "name", "Column in --metadata with the sequence names")
+	assignCmd.Flags().StringVarP(&assignColumn, "column", "c", "", "Column in --metadata with the labels")
+	assignCmd.Flags().Float64VarP(&assignMinMargin, "min-margin", "", 0, "Leave a query unlabelled if its next-nearest label is less than this much further away")
+	assignCmd.Flags().StringVarP(&assignOutfile, "outfile", "o", "stdout", "CSV file of assignments to write")
+
+	assignCmd.Flags().SortFlags = false
+}
+
+var assignCmd = &cobra.Command{
+	Use:   "assign",
+	Short: "Label sequences with the label of their nearest representative",
+	Long: `Label sequences with the label of their nearest representative
+
+Example usage:
+	gofasta groupconsensus --msa reference_set.fasta -m metadata.csv -c lineage -o lineages.fasta
+	gofasta assign --query queries.fasta -r lineages.fasta --min-margin 2 -o assignments.csv
+
+	gofasta assign --query queries.fasta -r representatives.fasta --metadata representatives.csv -c lineage -m tn93 -o assignments.csv
+
+Each query is given the label of the representative it is nearest to, using the distance measures of gofasta
+closest. The label of each representative is its name, e.g. for per-label consensus sequences made by gofasta
+groupconsensus, or if --metadata is given, its value in --column there (representatives not in --metadata are
+left out). Ties between representatives of one label are broken by completeness, and ties between labels by the
+order of the representatives.
+
+--outfile has the columns query,label,representative,distance,second_label,second_distance,margin, in the order of
+--query, where second_label is the nearest other label and margin is how much further away it is. A small margin
+means the assignment is uncertain: with --min-margin, queries whose margin is smaller than that are left without a
+label. The representatives are held in memory and the queries are streamed, so --query can be arbitrarily large.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if assignRepresentatives == "" {
+			return errors.New("gofasta assign needs --representatives")
+		}
+
+		labelOf := func(name string) (string, bool) {
+			return name, true
+		}
+		if assignMetadata != "" {
+			if assignColumn == "" {
+				return errors.New("--metadata needs a --column of labels")
+			}
+			f, err := gfio.OpenIn(*cmd.Flag("metadata"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			table, err := metadata.Read(f, metadata.SepFromPath(assignMetadata), assignNameColumn)
+			if err != nil {
+				return err
+			}
+			if !table.HasColumn(assignColumn) {
+				return errors.New("no column called " + assignColumn + " in metadata")
+			}
+			labelOf = func(name string) (string, bool) {
+				l, ok := table.Get(name, assignColumn)
+				return l, ok && l != ""
+			}
+		}
+
+		reps, err := gfio.OpenIn(*cmd.Flag("representatives"))
+		if err != nil {
+			return err
+		}
+		defer reps.Close()
+
+		query, err := gfio.OpenIn(*cmd.Flag("query"))
+		if err != nil {
+			return err
+		}
+		defer query.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		err = closest.Assign(cmd.Context(), query, reps, labelOf, assignMeasure, assignMinMargin, out, assignThreads)
+
+		return
+	},
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/backtranslate"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+)
+
+var backtranslateProteins string
+var backtranslateNucleotides string
+var backtranslateOutfile string
+var backtranslateCode int
+
+func init() {
+	rootCmd.AddCommand(backtranslateCmd)
+
+	backtranslateCmd.Flags().StringVarP(&backtranslateProteins, "proteins", "p", "stdin", "Protein alignment in fasta format")
+	backtranslateCmd.Flags().StringVarP(&backtranslateNucleotides, "nucleotides", "n", "", "Nucleotide sequences that code for the proteins, in fasta format")
+	backtranslateCmd.Flags().StringVarP(&backtranslateOutfile, "outfile", "o", "stdout", "Codon alignment to write, in fasta format")
+	backtranslateCmd.Flags().IntVarP(&backtranslateCode, "code", "", 1, "NCBI translation table the proteins were translated with (1, 2, 3, 4, 5, 6, 9, 10, 11, 12, 13 or 14)")
+
+	backtranslateCmd.Flags().SortFlags = false
+}
+
+var backtranslateCmd = &cobra.Command{
+	Use:   "backtranslate",
+	Short: "Make a codon alignment from a protein alignment",
+	Long: `Make a codon alignment from a protein alignment
+
+Example usage:
+	gofasta backtranslate -p proteins_aligned.fasta -n genes.fasta -o codons_aligned.fasta
+
+Each record in --proteins is matched by name to a record in --nucleotides, and each amino acid is replaced by the
+next codon of the nucleotide sequence (ignoring any gaps in it), and each gap by three gaps. The result is a
+nucleotide alignment that keeps codons together, as is needed for e.g. dN/dS analyses.
+
+Every codon must code for its amino acid with the given --code, unless either is X, and the nucleotide sequence
+must be exactly as long as the protein, except that it can end with an extra stop codon, which is dropped.
+
+--nucleotides is read into memory.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if backtranslateNucleotides == "" {
+			return errors.New("backtranslate needs --nucleotides")
+		}
+
+		proteins, err := gfio.OpenIn(*cmd.Flag("proteins"))
+		if err != nil {
+			return err
+		}
+		defer proteins.Close()
+
+		nucs, err := gfio.OpenIn(*cmd.Flag("nucleotides"))
+		if err != nil {
+			return err
+		}
+		defer nucs.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		err = backtranslate.BackTranslate(cmd.Context(), proteins, nucs, out, backtranslateCode)
+
+		return
+	},
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/bootstrap"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+)
+
+var bootstrapMSA string
+var bootstrapOutfile string
+var bootstrapOptions bootstrap.Options
+
+func init() {
+	rootCmd.AddCommand(bootstrapCmd)
+
+	bootstrapCmd.Flags().StringVarP(&bootstrapMSA, "msa", "", "stdin", "Alignment in fasta format")
+	bootstrapCmd.Flags().StringVarP(&bootstrapOutfile, "outfile", "o", "stdout", "File to write every replicate to, one after another")
+	bootstrapCmd.Flags().StringVarP(&bootstrapOptions.Prefix, "prefix", "p", "", "(Optional) write each replicate to its own file, called <prefix>_N.<suffix>, instead of to --outfile")
+	bootstrapCmd.Flags().IntVarP(&bootstrapOptions.Replicates, "replicates", "n", 100, "Number of replicates")
+	bootstrapCmd.Flags().StringVarP(&bootstrapOptions.Format, "format", "", "fasta", "Output format: fasta, phylip, nexus, clustal or stockholm (see gofasta convert)")
+
+	bootstrapCmd.Flags().SortFlags = false
+}
+
+var bootstrapCmd = &cobra.Command{
+	Use:   "bootstrap",
+	Short: "Make bootstrap pseudo-replicates of an alignment",
+	Long: `Make bootstrap pseudo-replicates of an alignment
+
+Example usage:
+	gofasta bootstrap --msa alignment.fasta -n 100 --format phylip -o replicates.phy
+	gofasta bootstrap --msa alignment.fasta -n 10 --seed 42 -p replicates/boot
+
+Each replicate is made by drawing as many columns as there are in the alignment, uniformly with replacement.
+The replicates are written one after another to --outfile (e.g. for the multiple data sets option of the PHYLIP
+programs), or with --prefix, each to its own file: <prefix>_1.fasta, <prefix>_2.fasta, etc., with the suffix
+for --format. The same --seed with the same alignment always gives the same replicates.
+
+The alignment is read into memory.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		bootstrapOptions.Seed = randomSeed(cmd)
+
+		msa, err := gfio.OpenIn(*cmd.Flag("msa"))
+		if err != nil {
+			return err
+		}
+		defer msa.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		err = bootstrap.Bootstrap(msa, out, bootstrapOptions)
+
+		return
+	},
+}
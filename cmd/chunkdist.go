@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/chunkdist"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+)
+
+var chunkdistThreads int
+var chunkdistMSA string
+var chunkdistDir string
+var chunkdistBlockSize int
+var chunkdistMaxDist int
+var chunkdistOutfile string
+
+func init() {
+	rootCmd.AddCommand(chunkdistCmd)
+
+	chunkdistCmd.Flags().IntVarP(&chunkdistThreads, "threads", "t", 0, "Number of CPUs to use (Default: all available CPUs)")
+	chunkdistCmd.Flags().StringVarP(&chunkdistMSA, "msa", "", "stdin", "Alignment in fasta format")
+	chunkdistCmd.Flags().StringVarP(&chunkdistDir, "dir", "d", "", "Working directory for the blocks and finished tiles. Rerun with the same directory to resume")
+	chunkdistCmd.Flags().IntVarP(&chunkdistBlockSize, "block-size", "b", chunkdist.DefaultOptions().BlockSize, "Number of records in each block")
+	chunkdistCmd.Flags().IntVarP(&chunkdistMaxDist, "max-dist", "", -1, "Only write pairs of records whose distance is at most this (Default: write every pair)")
+	chunkdistCmd.Flags().StringVarP(&chunkdistOutfile, "outfile", "o", "stdout", "CSV file to write the distances to")
+
+	chunkdistCmd.Flags().SortFlags = false
+}
+
+var chunkdistCmd = &cobra.Command{
+	Use:   "chunkdist",
+	Short: "Calculate the pairwise SNP-distances of an alignment too large for gofasta matrix, in resumable blocks",
+	Long: `Calculate the pairwise SNP-distances of an alignment too large for gofasta matrix, in resumable blocks
+
+Example usage:
+	gofasta chunkdist --msa alignment.fasta -d chunkdist_work -o distances.csv
+	gofasta chunkdist --msa alignment.fasta -d chunkdist_work -b 20000 --max-dist 5 -t 16 -o close_pairs.csv
+
+Distances are calculated as by gofasta matrix, but the alignment is first split into blocks of --block-size records
+in --dir, and the matrix is calculated one tile (pair of blocks) at a time, so that only two blocks are ever held in
+memory. Each tile is written to its own file in --dir as soon as it is finished. If a run is interrupted, running the
+same command again with the same --dir skips the split (the alignment isn't read again) and any tiles that are
+already finished. To calculate a matrix for a different alignment, or with a different --max-dist, use a new --dir.
+
+Once every tile is finished they are written to --outfile in long format, with the columns
+sequence1,sequence2,distance and one line for each pair of records. The pairs are in tile order, not in the order
+of gofasta matrix --format long. --max-dist leaves out pairs further apart than that, which for large alignments is
+usually the only way to keep the output to a manageable size.
+
+--dir is left in place afterwards, and can be deleted once --outfile is written.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if chunkdistDir == "" {
+			return errors.New("chunkdist needs a working --dir")
+		}
+
+		msa, err := gfio.OpenIn(*cmd.Flag("msa"))
+		if err != nil {
+			return err
+		}
+		defer msa.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		o := chunkdist.Options{
+			BlockSize: chunkdistBlockSize,
+			MaxDist:   chunkdistMaxDist,
+			Threads:   chunkdistThreads,
+		}
+
+		err = chunkdist.ChunkDist(cmd.Context(), msa, chunkdistDir, out, o, func(done, total int) {
+			fmt.Fprintf(os.Stderr, "finished tile %d of %d\n", done, total)
+		})
+
+		return
+	},
+}
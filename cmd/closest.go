@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/virus-evolution/gofasta/pkg/closest"
+	"github.com/virus-evolution/gofasta/pkg/distance"
 	"github.com/virus-evolution/gofasta/pkg/gfio"
 )
 
@@ -19,6 +20,7 @@ var closestN int
 var closestDist string
 var closestMeasure string
 var closestTable bool
+var closestStreamQueries bool
 
 func init() {
 	rootCmd.AddCommand(closestCmd)
@@ -31,6 +33,9 @@ func init() {
 	closestCmd.Flags().StringVarP(&closestDist, "max-dist", "d", "", "(Optional) return all sequences less than or equal to this distance away")
 	closestCmd.Flags().StringVarP(&closestOutfile, "outfile", "o", "stdout", "The output file to write")
 	closestCmd.Flags().BoolVarP(&closestTable, "table", "", false, "Write a long-form table of the output")
+	closestCmd.Flags().BoolVarP(&closestStreamQueries, "stream-queries", "", false, "Read the targets into memory and stream the queries instead, for many more queries than targets")
+
+	closestCmd.Flags().Lookup("stream-queries").NoOptDefVal = "true"
 
 	closestCmd.Flags().SortFlags = false
 }
@@ -45,6 +50,11 @@ by genetic distance need to be found amongst a large number of target sequences.
 alignment is read into memory and the target alignment is streamed from disk and iterated 
 over once, so it can be arbitrarily large.
 
+If instead there are many queries (hundreds of thousands of genomes, say) and fewer targets,
+use --stream-queries: the target alignment is read into memory, and the queries are streamed
+and each one's line is written as soon as it has been compared with every target, so memory
+use depends on the number of targets rather than of queries.
+
 You can find the single closest neighbour like:
 
 	gofasta closest -t 2 --query query.fasta --target target.fasta -o closest.csv
@@ -84,16 +94,9 @@ between every pair.
 		}
 		defer targetIn.Close()
 
-		var measure string
-		switch strings.ToLower(closestMeasure) {
-		case "raw":
-			measure = "raw"
-		case "snp":
-			measure = "snp"
-		case "tn93":
-			measure = "tn93"
-		default:
-			return errors.New("Couldn't tell which distance --measure / -m to use (choose one of \"raw\", \"snp\" or \"tn93\")")
+		measure, _, err := lookupMeasure(closestMeasure)
+		if err != nil {
+			return errors.New("Couldn't tell which distance --measure / -m to use (choose one of \"" + strings.Join(distance.Measures(), "\", \"") + "\")")
 		}
 
 		dist := -1.0
@@ -111,9 +114,11 @@ between every pair.
 		defer closestOut.Close()
 
 		if closestN > 0 || dist != -1.0 {
-			err = closest.ClosestN(closestN, dist, queryIn, targetIn, measure, closestOut, closestTable, closestThreads)
+			err = closest.ClosestN(cmd.Context(), queryIn, targetIn, closestOut, closest.NOptions{N: closestN, MaxDist: dist, HasMaxDist: closestDist != "", Measure: measure, Table: closestTable, Threads: closestThreads, StreamQueries: closestStreamQueries})
+		} else if closestStreamQueries {
+			err = closest.StreamClosest(cmd.Context(), queryIn, targetIn, measure, closestOut, closestThreads)
 		} else {
-			err = closest.Closest(queryIn, targetIn, measure, closestOut, closestThreads)
+			err = closest.Closest(cmd.Context(), queryIn, targetIn, measure, closestOut, closestThreads)
 		}
 
 		return err
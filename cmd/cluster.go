@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/cluster"
+	"github.com/virus-evolution/gofasta/pkg/distance"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+)
+
+var clusterThreads int
+var clusterMSA string
+var clusterOutfile string
+var clusterThreshold int
+var clusterRepresentatives string
+var clusterMinSize int
+
+func init() {
+	rootCmd.AddCommand(clusterCmd)
+
+	clusterCmd.Flags().IntVarP(&clusterThreads, "threads", "t", 0, "Number of CPUs to use (Default: all available CPUs)")
+	clusterCmd.Flags().StringVarP(&clusterMSA, "msa", "", "stdin", "Alignment in fasta format")
+	clusterCmd.Flags().StringVarP(&clusterOutfile, "outfile", "o", "stdout", "CSV file of cluster assignments to write")
+	clusterCmd.Flags().IntVarP(&clusterThreshold, "threshold", "d", 0, "Maximum SNP-distance between linked sequences")
+	clusterCmd.Flags().StringVarP(&clusterRepresentatives, "representatives", "", "", "(Optional) fasta file to write one representative sequence per cluster to")
+	clusterCmd.Flags().IntVarP(&clusterMinSize, "min-size", "", 1, "Only write representatives of clusters with at least this many members")
+
+	clusterCmd.Flags().SortFlags = false
+}
+
+var clusterCmd = &cobra.Command{
+	Use:   "cluster",
+	Short: "Single-linkage clustering of sequences by SNP-distance",
+	Long: `Single-linkage clustering of sequences by SNP-distance
+
+Example usage:
+	gofasta cluster --msa alignment.fasta -d 2 -o clusters.csv
+	gofasta cluster --msa alignment.fasta -d 2 --representatives reps.fasta --min-size 5 -o clusters.csv
+
+Two sequences are in the same cluster if they are linked by a chain of sequences, each at most --threshold SNPs
+from the next. The output has the columns sequence,cluster,cluster_size,representative, with one line per sequence
+in input order. Clusters are numbered from 1, in the order of their first member in the alignment, and sequences
+that aren't linked to any other are clusters of size 1.
+
+The representative of each cluster is its medoid: the member with the smallest total SNP-distance to the other
+members.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		msa, err := gfio.OpenIn(*cmd.Flag("msa"))
+		if err != nil {
+			return err
+		}
+		defer msa.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		records, err := fastaio.ReadEncodeAlignmentToList(msa, false)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(os.Stderr, "number of sequences in alignment: %d\n", len(records))
+
+		names := make([]string, len(records))
+		for i := range records {
+			names[i] = records[i].ID
+		}
+
+		clusters, err := cluster.SingleLinkage(cmd.Context(), distance.PackRecords(records), clusterThreshold, clusterThreads)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(os.Stderr, "number of clusters: %d\n", len(clusters))
+
+		err = cluster.WriteAssignments(out, clusters, names)
+		if err != nil {
+			return err
+		}
+
+		if clusterRepresentatives != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("representatives"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			err = cluster.WriteRepresentatives(f, clusters, records, clusterMinSize)
+			if err != nil {
+				return err
+			}
+		}
+
+		return
+	},
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"errors"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/codonalign"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+var codonalignMSA string
+var codonalignAnnotation string
+var codonalignGenes []string
+var codonalignMaxCost int
+var codonalignOutfile string
+var codonalignReport string
+
+func init() {
+	rootCmd.AddCommand(codonalignCmd)
+
+	codonalignCmd.Flags().StringVarP(&codonalignMSA, "msa", "", "stdin", "Alignment to the annotation's reference, in fasta format")
+	codonalignCmd.Flags().StringVarP(&codonalignAnnotation, "annotation", "a", "", "Genbank or GFF3 format annotation file. Must have suffix .gb or .gff")
+	codonalignCmd.Flags().StringSliceVarP(&codonalignGenes, "genes", "", []string{}, "Only adjust deletions in these CDS (comma-separated)")
+	codonalignCmd.Flags().IntVarP(&codonalignMaxCost, "max-mismatches", "", 0, "Largest number of extra mismatches to the reference that moving a deletion can cost")
+	codonalignCmd.Flags().StringVarP(&codonalignOutfile, "outfile", "o", "stdout", "Alignment to write, in fasta format")
+	codonalignCmd.Flags().StringVarP(&codonalignReport, "report", "", "", "(Optional) CSV file of the deletions that were moved")
+
+	codonalignCmd.Flags().SortFlags = false
+}
+
+var codonalignCmd = &cobra.Command{
+	Use:   "codonalign",
+	Short: "Move in-frame deletions in protein-coding regions to codon boundaries",
+	Long: `Move in-frame deletions in protein-coding regions to codon boundaries
+
+Example usage:
+	gofasta codonalign --msa aligned.fasta -a MN908947.gb -o codon_aligned.fasta
+	gofasta codonalign --msa aligned.fasta -a MN908947.gb --max-mismatches 1 --report moved.csv -o codon_aligned.fasta
+
+The alignment must be in the annotation's coordinates, e.g. the output of gofasta sam toMultiAlign or gofasta align.
+Nucleotide aligners place a deletion of whole codons wherever it scores best, which is often part-way through a
+codon when the deleted bases are repeated nearby, so that it breaks two codons instead of removing one. Each run
+of gaps whose length is a multiple of three, and which is inside one or more CDS but doesn't remove whole codons
+from all of them, is moved by up to two columns to the placement that does, choosing the one with the fewest
+mismatches to the reference (and then the shortest move). It is only moved if that costs at most
+--max-mismatches more mismatches than its original placement, so by default only equivalent placements are used.
+
+Frameshifting deletions, gaps at the ends of sequences, and gaps outside CDS are left alone. --report has the
+columns query,position,length,new_position,mismatches_before,mismatches_after, with 1-based positions of the first
+gap of each deletion that was moved.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if codonalignAnnotation == "" {
+			return errors.New("codonalign needs an --annotation")
+		}
+		annoSuffix, err := variants.AnnotationSuffix(codonalignAnnotation)
+		if err != nil {
+			return err
+		}
+		anno, err := gfio.OpenIn(*cmd.Flag("annotation"))
+		if err != nil {
+			return err
+		}
+		defer anno.Close()
+		regions, refSeq, err := variants.ReadAnnotation(anno, annoSuffix, "")
+		if err != nil {
+			return err
+		}
+		if len(codonalignGenes) > 0 {
+			regions, err = variants.SelectRegions(regions, codonalignGenes)
+			if err != nil {
+				return err
+			}
+		}
+
+		msa, err := gfio.OpenIn(*cmd.Flag("msa"))
+		if err != nil {
+			return err
+		}
+		defer msa.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		var reportOut io.Writer
+		if codonalignReport != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("report"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			reportOut = f
+		}
+
+		err = codonalign.CodonAlign(cmd.Context(), msa, refSeq, regions, out, reportOut, codonalignMaxCost)
+
+		return
+	},
+}
@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/codonusage"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+var codonUsageFasta string
+var codonUsageOutfile string
+var codonUsagePerRecord string
+var codonUsageAnnotation string
+var codonUsageGenes []string
+var codonUsageCode int
+
+func init() {
+	rootCmd.AddCommand(codonUsageCmd)
+
+	codonUsageCmd.Flags().StringVarP(&codonUsageFasta, "fasta", "f", "stdin", "Nucleotide sequences in fasta format")
+	codonUsageCmd.Flags().StringVarP(&codonUsageOutfile, "outfile", "o", "stdout", "CSV file of codon usage over all records to write")
+	codonUsageCmd.Flags().StringVarP(&codonUsagePerRecord, "per-record", "", "", "(Optional) CSV file of codon usage in each record to write")
+	codonUsageCmd.Flags().StringVarP(&codonUsageAnnotation, "annotation", "a", "", "Genbank or GFF3 format annotation file. Must have suffix .gb or .gff")
+	codonUsageCmd.Flags().StringSliceVarP(&codonUsageGenes, "genes", "", []string{}, "With --annotation, only count codons in these CDS (comma-separated)")
+	codonUsageCmd.Flags().IntVarP(&codonUsageCode, "code", "", 1, "NCBI translation table to use (1, 2, 3, 4, 5, 6, 9, 10, 11, 12, 13 or 14)")
+
+	codonUsageCmd.Flags().SortFlags = false
+}
+
+var codonUsageCmd = &cobra.Command{
+	Use:   "codon-usage",
+	Short: "Count the codons used by nucleotide sequences",
+	Long: `Count the codons used by nucleotide sequences
+
+Example usage:
+	gofasta codon-usage -f genes.fasta -o codon_usage.csv
+	gofasta codon-usage -f aligned.fasta -a MN908947.gb --genes S --per-record per_record.csv -o codon_usage.csv
+
+Without --annotation, every record is read in frame 1, after removing gaps. With --annotation, the codons in every
+CDS (or those in --genes) are counted, and the records must be in the annotation's coordinates, e.g. the output
+of gofasta sam toMultiAlign. Codons containing gaps or ambiguity codes, and trailing partial codons, are ignored.
+
+--outfile has the columns codon,amino_acid,count,per_thousand,rscu, with one row for each of the 64 codons, summed
+over all the records. --per-record has the same columns for each record, with an extra first column, record.
+RSCU (relative synonymous codon usage) is the number of times a codon is used divided by the mean for all the
+codons for the same amino acid (or stop), so is 1 when synonymous codons are used equally.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		var regions []variants.Region
+		if codonUsageAnnotation != "" {
+			annoSuffix, err := variants.AnnotationSuffix(codonUsageAnnotation)
+			if err != nil {
+				return err
+			}
+			anno, err := gfio.OpenIn(*cmd.Flag("annotation"))
+			if err != nil {
+				return err
+			}
+			defer anno.Close()
+			regions, _, err = variants.ReadAnnotation(anno, annoSuffix, "")
+			if err != nil {
+				return err
+			}
+			if len(codonUsageGenes) > 0 {
+				regions, err = variants.SelectRegions(regions, codonUsageGenes)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		in, err := gfio.OpenIn(*cmd.Flag("fasta"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		var perRecord io.Writer
+		if codonUsagePerRecord != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("per-record"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			perRecord = f
+		}
+
+		err = codonusage.CodonUsage(cmd.Context(), in, regions, out, perRecord, codonUsageCode)
+
+		return
+	},
+}
@@ -0,0 +1,158 @@
+package cmd
+
+import (
+	"errors"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/collapse"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/mask"
+)
+
+var collapseMSA string
+var collapseOutfile string
+var collapseTable string
+var collapseSites string
+var collapseVCFFilter string
+var collapseAmbiguous bool
+var collapsePrefix string
+var collapsePrescreen bool
+
+func init() {
+	rootCmd.AddCommand(collapseCmd)
+
+	collapseCmd.Flags().StringVarP(&collapseMSA, "msa", "", "stdin", "Multiple sequence alignment in fasta format")
+	collapseCmd.Flags().StringVarP(&collapseOutfile, "outfile", "o", "stdout", "Alignment of one representative per haplotype to write")
+	collapseCmd.Flags().StringVarP(&collapseTable, "table", "t", "", "(Optional) CSV file of the members of each haplotype to write")
+	collapseCmd.Flags().StringVarP(&collapseSites, "sites", "s", "", "(Optional) sites to mask before comparing sequences. Must have suffix .bed, .vcf or .csv (see gofasta mask)")
+	collapseCmd.Flags().StringVarP(&collapseVCFFilter, "vcf-filter", "", "", "If --sites is a VCF, only mask records with this value in the FILTER column")
+	collapseCmd.Flags().BoolVarP(&collapseAmbiguous, "ambiguous", "", false, "Also collapse sequences that only differ where one of them is ambiguous (SNP-distance zero)")
+	collapseCmd.Flags().StringVarP(&collapsePrefix, "prefix", "", "hap", "Prefix for the names of haplotypes")
+	collapseCmd.Flags().BoolVarP(&collapsePrescreen, "prescreen", "", false, "Find the possibly repeated sequences in a first low-memory pass, for very large alignments (reads --msa twice)")
+
+	collapseCmd.Flags().Lookup("ambiguous").NoOptDefVal = "true"
+	collapseCmd.Flags().Lookup("prescreen").NoOptDefVal = "true"
+
+	collapseCmd.Flags().SortFlags = false
+}
+
+var collapseCmd = &cobra.Command{
+	Use:   "collapse",
+	Short: "Collapse an alignment into haplotypes",
+	Long: `Collapse an alignment into haplotypes
+
+Example usage:
+	gofasta collapse --msa aligned.fasta -t haplotypes.csv -o haplotypes.fasta
+	gofasta collapse --msa aligned.fasta -s problematic_sites_sarsCov2.vcf --vcf-filter mask --ambiguous -t haplotypes.csv -o haplotypes.fasta
+
+Identical sequences are collapsed into one haplotype, and one representative sequence per haplotype is written
+to --outfile, named --prefix followed by a number, in the order in which the haplotypes first appear. This is
+the usual first step in making a haplotype network.
+
+With --sites, the sites are masked with N before sequences are compared, and are also masked in the output.
+With --ambiguous, a sequence joins the first haplotype whose representative it has an SNP-distance of zero to,
+so that e.g. a sequence with Ns is collapsed into a haplotype without them. The most complete member of each
+haplotype is then its representative. This is greedy, so the haplotypes can depend on the order of the input.
+
+--table is a CSV file with the columns haplotype,representative,count,members, where members is a
+";"-delimited list of every record in the haplotype.
+
+--prescreen is for alignments with tens of millions of records, where keeping every haplotype in memory is too
+much. A first pass puts every sequence into a Bloom filter, in a couple of bytes per record, to find the few that
+might be repeated, and a second pass writes the other haplotypes as it goes and only keeps track of those. --msa
+is read twice, so it can't be stdin, and --ambiguous can't be used. --outfile is the same, but in --table the
+haplotypes with one member come first, and the rest at the end.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if collapsePrescreen && collapseMSA == "stdin" {
+			return errors.New("--prescreen reads --msa twice, so it can't be stdin")
+		}
+		if collapsePrescreen && collapseAmbiguous {
+			return errors.New("--prescreen can't be used with --ambiguous")
+		}
+
+		o := collapse.Options{Ambiguous: collapseAmbiguous, Prefix: collapsePrefix}
+
+		if collapseSites != "" {
+			sitesIn, err := gfio.OpenIn(*cmd.Flag("sites"))
+			if err != nil {
+				return err
+			}
+			defer sitesIn.Close()
+			masks, err := mask.Read(sitesIn, collapseSites, collapseVCFFilter)
+			if err != nil {
+				return err
+			}
+			o.Masks = &masks
+		}
+
+		if collapsePrescreen {
+			return collapsePrescreened(cmd, o)
+		}
+
+		msa, err := gfio.OpenIn(*cmd.Flag("msa"))
+		if err != nil {
+			return err
+		}
+		defer msa.Close()
+
+		haplotypes, err := collapse.Collapse(cmd.Context(), msa, o)
+		if err != nil {
+			return err
+		}
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		err = collapse.WriteAlignment(out, haplotypes)
+		if err != nil {
+			return err
+		}
+
+		if collapseTable != "" {
+			tableOut, err := gfio.OpenOut(*cmd.Flag("table"))
+			if err != nil {
+				return err
+			}
+			defer tableOut.Close()
+			err = collapse.WriteTable(tableOut, haplotypes)
+			if err != nil {
+				return err
+			}
+		}
+
+		return
+	},
+}
+
+// collapsePrescreened runs collapse with --prescreen, which writes --outfile and --table as it goes
+func collapsePrescreened(cmd *cobra.Command, o collapse.Options) (err error) {
+	out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	var tableOut io.Writer
+	if collapseTable != "" {
+		f, err := gfio.OpenOut(*cmd.Flag("table"))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		tableOut = f
+	}
+
+	open := func() (io.ReadCloser, error) {
+		return gfio.OpenIn(*cmd.Flag("msa"))
+	}
+	err = collapse.Prescreen(cmd.Context(), open, out, tableOut, o)
+
+	return
+}
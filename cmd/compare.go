@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"errors"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/compare"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+)
+
+var compareA string
+var compareB string
+var comparePairs string
+var compareOutfile string
+var compareSummary string
+
+func init() {
+	rootCmd.AddCommand(compareCmd)
+
+	compareCmd.Flags().StringVarP(&compareA, "a", "a", "", "First alignment, in fasta format")
+	compareCmd.Flags().StringVarP(&compareB, "b", "b", "", "Second alignment, in fasta format")
+	compareCmd.Flags().StringVarP(&comparePairs, "pairs", "p", "", "(Optional) CSV file with a header and two columns: names in --a and the names of their partners in --b (default: pair records with the same name)")
+	compareCmd.Flags().StringVarP(&compareOutfile, "outfile", "o", "stdout", "CSV file of per-pair differences to write")
+	compareCmd.Flags().StringVarP(&compareSummary, "summary", "s", "", "(Optional) CSV file of totals over all pairs to write")
+
+	compareCmd.Flags().SortFlags = false
+}
+
+var compareCmd = &cobra.Command{
+	Use:   "compare",
+	Short: "Compare two alignments record by record",
+	Long: `Compare two alignments record by record
+
+Example usage:
+	gofasta compare -a old_pipeline.fasta -b new_pipeline.fasta -s summary.csv -o differences.csv
+	gofasta compare -a old.fasta -b new.fasta -p renamed.csv -o differences.csv
+
+Each record in --a is paired with the record of the same name in --b (or with the partner named in --pairs),
+and the pair is compared site by site. Paired records must be the same length, e.g. both aligned to the same
+reference. A gap in only one record is an indel, an N (or ?) in only one is an N change, two different
+unambiguous nucleotides are an SNP, and any other difference is an ambiguity change.
+
+--outfile has the columns a,b,snps,indels,n_changes,ambiguity_changes,concordant,differences, in the order of
+--a, where differences is a ";"-delimited list of the differing sites as position:a>b. --summary has the totals
+over all pairs, the proportion of pairs that are identical (concordance), and how many records in each
+alignment had no partner.
+
+--b is read into memory.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if compareA == "" || compareB == "" {
+			return errors.New("compare needs two alignments, --a and --b")
+		}
+
+		var mapping map[string]string
+		if comparePairs != "" {
+			pairsIn, err := gfio.OpenIn(*cmd.Flag("pairs"))
+			if err != nil {
+				return err
+			}
+			defer pairsIn.Close()
+			mapping, err = compare.ReadMapping(pairsIn)
+			if err != nil {
+				return err
+			}
+		}
+
+		aIn, err := gfio.OpenIn(*cmd.Flag("a"))
+		if err != nil {
+			return err
+		}
+		defer aIn.Close()
+
+		bIn, err := gfio.OpenIn(*cmd.Flag("b"))
+		if err != nil {
+			return err
+		}
+		defer bIn.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		var summaryOut io.Writer
+		if compareSummary != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("summary"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			summaryOut = f
+		}
+
+		err = compare.Write(cmd.Context(), aIn, bIn, mapping, out, summaryOut)
+
+		return
+	},
+}
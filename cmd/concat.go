@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"errors"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/concat"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+)
+
+var concatFastas []string
+var concatOutfile string
+var concatPartitions string
+var concatFormat string
+var concatFill string
+
+func init() {
+	rootCmd.AddCommand(concatCmd)
+
+	concatCmd.Flags().StringSliceVarP(&concatFastas, "fasta", "f", []string{}, "Alignments to concatenate, in order. Can be given more than once, or as a comma-separated list. Positional arguments are added to these")
+	concatCmd.Flags().StringVarP(&concatOutfile, "outfile", "o", "stdout", "Concatenated alignment to write, in fasta format")
+	concatCmd.Flags().StringVarP(&concatPartitions, "partitions", "p", "", "(Optional) partitions file to write")
+	concatCmd.Flags().StringVarP(&concatFormat, "format", "", "raxml", "Format of --partitions: raxml or nexus")
+	concatCmd.Flags().StringVarP(&concatFill, "fill", "", "-", "Character to fill genes that a record is missing from with")
+
+	concatCmd.Flags().SortFlags = false
+}
+
+var concatCmd = &cobra.Command{
+	Use:   "concat [fasta ...]",
+	Short: "Concatenate alignments of different genes into a supermatrix",
+	Long: `Concatenate alignments of different genes into a supermatrix
+
+Example usage:
+	gofasta concat S.fasta ORF1ab.fasta N.fasta -p partitions.txt -o supermatrix.fasta
+	gofasta concat -f genes/*.fasta --fill N --format nexus -p partitions.nex -o supermatrix.fasta
+
+Records are matched by name across the alignments, and are written in the order that they are first seen. A
+record that is missing from an alignment is filled with --fill (a gap, by default) for that alignment's
+columns, with a warning. Each alignment is a partition named after its file, without the directory or suffix.
+
+--partitions is in RAxML's format (e.g. "DNA, S = 1-3822"), or with --format nexus, a NEXUS sets block of
+charsets. Every alignment is read into memory.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		paths := append(concatFastas, args...)
+		if len(paths) == 0 {
+			return errors.New("no alignments to concatenate")
+		}
+		if len(concatFill) != 1 {
+			return errors.New("--fill must be a single character")
+		}
+
+		ins := make([]concat.Input, 0, len(paths))
+		for _, path := range paths {
+			f, err := gfio.OpenInPath(path, "fasta")
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			name := filepath.Base(path)
+			name = strings.TrimSuffix(name, filepath.Ext(name))
+			ins = append(ins, concat.Input{R: f, Name: name})
+		}
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		var partitionsOut io.Writer
+		if concatPartitions != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("partitions"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			partitionsOut = f
+		}
+
+		err = concat.Concat(cmd.Context(), ins, out, partitionsOut, concatFill[0], concatFormat)
+
+		return
+	},
+}
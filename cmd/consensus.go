@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/consensus"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+)
+
+var consensusMSA string
+var consensusOutfile string
+var consensusName string
+var consensusThreshold float64
+var consensusIUPAC bool
+var consensusCountGaps bool
+var consensusMinFraction float64
+
+func init() {
+	rootCmd.AddCommand(consensusCmd)
+
+	consensusCmd.Flags().StringVarP(&consensusMSA, "msa", "", "stdin", "Multiple sequence alignment in fasta format")
+	consensusCmd.Flags().StringVarP(&consensusOutfile, "outfile", "o", "stdout", "Fasta file of the consensus sequence to write")
+	consensusCmd.Flags().StringVarP(&consensusName, "name", "", "consensus", "Name of the consensus record in the output")
+	consensusCmd.Flags().Float64VarP(&consensusThreshold, "threshold", "", 0.0, "Minimum proportion of sequences a base must make up to be called. Default is simple majority")
+	consensusCmd.Flags().BoolVarP(&consensusIUPAC, "iupac", "", false, "Call an IUPAC ambiguity code if no single base reaches --threshold, instead of N")
+	consensusCmd.Flags().BoolVarP(&consensusCountGaps, "count-gaps", "", false, "Count gaps as a character that can be the consensus, instead of as missing data")
+	consensusCmd.Flags().Float64VarP(&consensusMinFraction, "min-fraction", "", 0.0, "Call N at columns where less than this proportion of sequences are not N")
+
+	consensusCmd.Flags().Lookup("iupac").NoOptDefVal = "true"
+	consensusCmd.Flags().Lookup("count-gaps").NoOptDefVal = "true"
+
+	consensusCmd.Flags().SortFlags = false
+}
+
+var consensusCmd = &cobra.Command{
+	Use:   "consensus",
+	Short: "Make a consensus sequence from an alignment",
+	Long: `Make a consensus sequence from an alignment
+
+Example usage:
+	gofasta consensus --msa alignment.fasta -o consensus.fasta
+
+By default the most common base at each column is called, and ties are called as N. Ambiguity codes
+count towards each of the nucleotides they represent (so an R adds half to A and half to G), and Ns
+and gaps are treated as missing data.
+
+Use --threshold to require the consensus base to make up at least that proportion of the sequences with
+data at a column, and --iupac to call the IUPAC code for the smallest set of bases that reaches --threshold
+when no single base does:
+	gofasta consensus --msa alignment.fasta --threshold 0.75 --iupac -o consensus.fasta
+
+--count-gaps treats gaps as a character in their own right, so the consensus can contain gaps. --min-fraction
+calls N at any column where fewer than that proportion of all sequences have information.
+
+If input and output files are not specified, the behaviour is to read the alignment from stdin and write
+the consensus to stdout.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		msa, err := gfio.OpenIn(*cmd.Flag("msa"))
+		if err != nil {
+			return err
+		}
+		defer msa.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		err = consensus.Consensus(cmd.Context(), msa, out, consensus.Options{
+			Name:        consensusName,
+			Threshold:   consensusThreshold,
+			IUPAC:       consensusIUPAC,
+			CountGaps:   consensusCountGaps,
+			MinFraction: consensusMinFraction,
+		})
+		switch {
+		case errors.Is(err, consensus.ErrThreshold):
+			err = errors.New("--threshold must be between 0 and 1")
+		case errors.Is(err, consensus.ErrMinFraction):
+			err = errors.New("--min-fraction must be between 0 and 1")
+		}
+
+		return
+	},
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/convert"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+)
+
+var convertInfile string
+var convertOutfile string
+var convertOptions convert.Options
+
+func init() {
+	rootCmd.AddCommand(convertCmd)
+
+	convertCmd.Flags().StringVarP(&convertInfile, "infile", "i", "stdin", "Alignment to convert")
+	convertCmd.Flags().StringVarP(&convertOutfile, "outfile", "o", "stdout", "Alignment to write")
+	convertCmd.Flags().StringVarP(&convertOptions.From, "from", "", "", "Format of --infile: fasta, phylip, nexus, clustal or stockholm (default: from its suffix, or fasta)")
+	convertCmd.Flags().StringVarP(&convertOptions.To, "to", "", "", "Format of --outfile: fasta, phylip, nexus, clustal or stockholm (default: from its suffix)")
+	convertCmd.Flags().BoolVarP(&convertOptions.Strict, "strict", "", false, "Read and write PHYLIP with names of exactly ten characters")
+	convertCmd.Flags().BoolVarP(&convertOptions.Interleaved, "interleaved", "", false, "Write PHYLIP or NEXUS in interleaved blocks")
+
+	convertCmd.Flags().Lookup("strict").NoOptDefVal = "true"
+	convertCmd.Flags().Lookup("interleaved").NoOptDefVal = "true"
+
+	convertCmd.Flags().SortFlags = false
+}
+
+// formatFromSuffix guesses an alignment format from the suffix of a filename
+func formatFromSuffix(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".fasta", ".fa", ".fas", ".fna", ".mfa":
+		return convert.Fasta
+	case ".phy", ".phylip":
+		return convert.Phylip
+	case ".nex", ".nexus", ".nxs":
+		return convert.Nexus
+	case ".aln", ".clustal":
+		return convert.Clustal
+	case ".sto", ".stk", ".stockholm":
+		return convert.Stockholm
+	}
+	return ""
+}
+
+var convertCmd = &cobra.Command{
+	Use:   "convert",
+	Short: "Convert an alignment between file formats",
+	Long: `Convert an alignment between file formats
+
+Example usage:
+	gofasta convert -i alignment.fasta -o alignment.phy
+	gofasta convert -i alignment.nex --to fasta -o alignment.fasta
+	gofasta convert -i alignment.fasta --to phylip --strict --interleaved -o alignment.phy
+
+The formats are fasta, phylip, nexus, clustal and stockholm. If --from or --to isn't given, it is guessed from
+the suffix of the file (.fasta/.fa/.fas, .phy/.phylip, .nex/.nexus, .aln/.clustal, .sto/.stockholm); stdin is
+read as fasta.
+
+PHYLIP is read sequential (with each sequence on one line) or interleaved, whichever the first line suggests,
+and is written sequential unless --interleaved is given. Names are separated from the sequence by whitespace,
+or with --strict, are exactly the first ten characters of the line. NEXUS is read from the matrix of the first
+DATA or CHARACTERS block. Stockholm markup is dropped and its "." gaps are written as "-".
+
+Only record names are kept (not descriptions), and sequences are uppercased. Every format except fasta is read
+into memory, as is any input written as something other than fasta.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		o := convertOptions
+		if o.From == "" {
+			o.From = formatFromSuffix(convertInfile)
+			if o.From == "" {
+				o.From = convert.Fasta
+			}
+		}
+		if o.To == "" {
+			o.To = formatFromSuffix(convertOutfile)
+			if o.To == "" {
+				return errors.New("couldn't tell the output format from --outfile (use --to)")
+			}
+		}
+
+		in, err := gfio.OpenIn(*cmd.Flag("infile"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		err = convert.Convert(cmd.Context(), in, out, o)
+
+		return
+	},
+}
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"errors"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/dedup"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+)
+
+var dedupFasta string
+var dedupOutfile string
+var dedupMap string
+var dedupIgnoreEnds bool
+var dedupDegap bool
+var dedupPrescreen bool
+
+func init() {
+	rootCmd.AddCommand(dedupCmd)
+
+	dedupCmd.Flags().StringVarP(&dedupFasta, "fasta", "f", "stdin", "Sequences to deduplicate, in fasta format")
+	dedupCmd.Flags().StringVarP(&dedupOutfile, "outfile", "o", "stdout", "Deduplicated fasta file to write")
+	dedupCmd.Flags().StringVarP(&dedupMap, "map", "", "", "(Optional) CSV file of representatives and their duplicates to write")
+	dedupCmd.Flags().BoolVarP(&dedupIgnoreEnds, "ignore-ends", "", false, "Ignore leading and trailing Ns and gaps when comparing sequences")
+	dedupCmd.Flags().BoolVarP(&dedupDegap, "degap", "", false, "Remove all gaps before comparing sequences")
+	dedupCmd.Flags().BoolVarP(&dedupPrescreen, "prescreen", "", false, "Find the possible duplicates in a first low-memory pass, for very large files (reads --fasta twice)")
+
+	dedupCmd.Flags().Lookup("ignore-ends").NoOptDefVal = "true"
+	dedupCmd.Flags().Lookup("degap").NoOptDefVal = "true"
+	dedupCmd.Flags().Lookup("prescreen").NoOptDefVal = "true"
+
+	dedupCmd.Flags().SortFlags = false
+}
+
+var dedupCmd = &cobra.Command{
+	Use:   "dedup",
+	Short: "Remove duplicate sequences",
+	Long: `Remove duplicate sequences
+
+Example usage:
+	gofasta dedup -f sequences.fasta -o unique.fasta --map duplicates.csv
+
+Sequences are compared case-insensitively, and the first record with each distinct sequence is kept as
+the representative. The sequences don't have to be aligned.
+
+--ignore-ends ignores leading and trailing Ns and gaps, and --degap ignores all gaps, so that sequences
+which differ only in those are also treated as duplicates.
+
+--map is a CSV file with the columns representative,count,duplicates, where duplicates is a ";"-delimited
+list of the records that were removed in favour of the representative.
+
+--prescreen is for files with tens of millions of records, where keeping the hash and name of every
+distinct sequence in memory is too much. A first pass puts every sequence into a Bloom filter, in a couple
+of bytes per record, to find the few that might be duplicated, and a second pass only keeps track of
+those. --fasta is read twice, so it can't be stdin. The deduplicated fasta is the same, but in --map the
+sequences that weren't duplicated come first, and the rest at the end.
+
+If input and output files are not specified, the behaviour is to read the sequences from stdin and write
+the deduplicated sequences to stdout.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if dedupPrescreen && dedupFasta == "stdin" {
+			return errors.New("--prescreen reads --fasta twice, so it can't be stdin")
+		}
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		var mapOut io.Writer
+		if dedupMap != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("map"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			mapOut = f
+		}
+
+		if dedupPrescreen {
+			open := func() (io.ReadCloser, error) {
+				return gfio.OpenIn(*cmd.Flag("fasta"))
+			}
+			err = dedup.Prescreen(cmd.Context(), open, out, mapOut, dedupIgnoreEnds, dedupDegap)
+			return
+		}
+
+		in, err := gfio.OpenIn(*cmd.Flag("fasta"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		err = dedup.Dedup(cmd.Context(), in, out, mapOut, dedupIgnoreEnds, dedupDegap)
+
+		return
+	},
+}
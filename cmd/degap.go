@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"errors"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/degap"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+)
+
+var degapFasta string
+var degapOutfile string
+var degapColumns bool
+var degapThreshold float64
+var degapDropped string
+
+func init() {
+	rootCmd.AddCommand(degapCmd)
+
+	degapCmd.Flags().StringVarP(&degapFasta, "fasta", "f", "stdin", "Sequences or alignment to remove gaps from, in fasta format")
+	degapCmd.Flags().StringVarP(&degapOutfile, "outfile", "o", "stdout", "Fasta file to write")
+	degapCmd.Flags().BoolVarP(&degapColumns, "columns", "", false, "Remove gap columns from an alignment, instead of every gap from every record")
+	degapCmd.Flags().Float64VarP(&degapThreshold, "threshold", "", 1.0, "With --columns, remove columns where at least this proportion of records have a gap")
+	degapCmd.Flags().StringVarP(&degapDropped, "dropped", "", "", "(Optional) with --columns, CSV file of the positions that were removed")
+
+	degapCmd.Flags().Lookup("columns").NoOptDefVal = "true"
+
+	degapCmd.Flags().SortFlags = false
+}
+
+var degapCmd = &cobra.Command{
+	Use:   "degap",
+	Short: "Remove gaps from sequences or alignment columns",
+	Long: `Remove gaps from sequences or alignment columns
+
+Example usage:
+	gofasta degap -f alignment.fasta -o unaligned.fasta
+	gofasta degap -f alignment.fasta --columns --threshold 0.9 --dropped dropped.csv -o trimmed.fasta
+
+By default, every gap is removed from every record, so the output is no longer aligned.
+
+With --columns, whole columns are removed from the alignment instead: by default only those that are gaps in
+every record, or with --threshold, those where at least that proportion of the records have a gap. --dropped is a
+CSV file with the columns position,gap_proportion listing the (1-based) columns that were removed.
+
+--columns needs two passes over the alignment, so if it is read from stdin it is held in memory.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if !degapColumns && degapDropped != "" {
+			return errors.New("--dropped only makes sense with --columns")
+		}
+
+		in, err := gfio.OpenIn(*cmd.Flag("fasta"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		if !degapColumns {
+			return degap.Unalign(cmd.Context(), in, out)
+		}
+
+		var dropped io.Writer
+		if degapDropped != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("dropped"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			dropped = f
+		}
+
+		err = degap.DropColumns(cmd.Context(), in, out, dropped, degapThreshold)
+
+		return
+	},
+}
@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/disambiguate"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+)
+
+var disambiguateFasta string
+var disambiguateOutfile string
+var disambiguateMethods []string
+var disambiguateReference string
+var disambiguateIncludeN bool
+
+func init() {
+	rootCmd.AddCommand(disambiguateCmd)
+
+	disambiguateCmd.Flags().StringVarP(&disambiguateFasta, "fasta", "f", "stdin", "Sequences to disambiguate, in fasta format")
+	disambiguateCmd.Flags().StringVarP(&disambiguateOutfile, "outfile", "o", "stdout", "Fasta file to write")
+	disambiguateCmd.Flags().StringSliceVarP(&disambiguateMethods, "method", "m", []string{"frequency"}, "How to resolve ambiguity codes: reference, frequency or random. Several, comma-separated, are tried in order")
+	disambiguateCmd.Flags().StringVarP(&disambiguateReference, "reference", "r", "", "With --method reference, reference sequence in fasta format, aligned to --fasta")
+	disambiguateCmd.Flags().BoolVarP(&disambiguateIncludeN, "include-n", "", false, "Also resolve Ns")
+
+	disambiguateCmd.Flags().Lookup("include-n").NoOptDefVal = "true"
+
+	disambiguateCmd.Flags().SortFlags = false
+}
+
+var disambiguateCmd = &cobra.Command{
+	Use:   "disambiguate",
+	Short: "Resolve IUPAC ambiguity codes",
+	Long: `Resolve IUPAC ambiguity codes
+
+Example usage:
+	gofasta disambiguate -f aligned.fasta -o resolved.fasta
+	gofasta disambiguate -f aligned.fasta -m reference,frequency -r MN908947.fasta -o resolved.fasta
+	gofasta disambiguate -f sequences.fasta -m random --seed 42 -o resolved.fasta
+
+Each ambiguity code (e.g. R, which is A or G) is replaced with one of the nucleotides it represents, using the
+first of the --method(s) that can resolve it:
+	reference   the nucleotide in --reference at the same position, if the code is compatible with it
+	frequency   the most common compatible nucleotide in the same alignment column (ties go to the first
+	            alphabetically), if there is one
+	random      a compatible nucleotide chosen at random
+Codes that no method resolves are left as they are, so end with random to resolve every code. Ns are only
+resolved with --include-n, and gaps are never changed.
+
+With reference or frequency, --fasta must be an alignment, and with frequency it is read into memory. The same
+--seed with the same input always gives the same output.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		o := disambiguate.Options{Methods: disambiguateMethods, IncludeN: disambiguateIncludeN}
+
+		for _, m := range disambiguateMethods {
+			if m == disambiguate.Random {
+				o.Seed = randomSeed(cmd)
+			}
+		}
+
+		if disambiguateReference != "" {
+			refIn, err := gfio.OpenIn(*cmd.Flag("reference"))
+			if err != nil {
+				return err
+			}
+			defer refIn.Close()
+			refs, err := fastaio.ReadFastaToListUncounted(refIn)
+			if err != nil {
+				return err
+			}
+			if len(refs) != 1 {
+				return errors.New("there must be exactly one record in --reference")
+			}
+			o.Reference = refs[0].Seq
+		}
+
+		in, err := gfio.OpenIn(*cmd.Flag("fasta"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		err = disambiguate.Disambiguate(cmd.Context(), in, out, o)
+
+		return
+	},
+}
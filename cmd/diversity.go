@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/diversity"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/metadata"
+)
+
+var diversityMSA string
+var diversityMetadata string
+var diversityNameColumn string
+var diversityColumn string
+var diversityOutfile string
+var diversityOptions diversity.Options
+
+func init() {
+	rootCmd.AddCommand(diversityCmd)
+
+	diversityCmd.Flags().IntVarP(&diversityOptions.Threads, "threads", "t", 0, "Number of CPUs to use (Default: all available CPUs)")
+	diversityCmd.Flags().StringVarP(&diversityMSA, "msa", "", "stdin", "Alignment in fasta format")
+	diversityCmd.Flags().StringVarP(&diversityMetadata, "metadata", "m", "", "(Optional) CSV/TSV metadata file, to calculate the statistics for each group in --column")
+	diversityCmd.Flags().StringVarP(&diversityNameColumn, "name-column", "", "name", "Column in --metadata with the sequence names")
+	diversityCmd.Flags().StringVarP(&diversityColumn, "column", "c", "", "Column in --metadata with the groups")
+	diversityCmd.Flags().IntVarP(&diversityOptions.Window, "window", "w", 0, "Width of sliding windows, in columns (Default: the whole alignment)")
+	diversityCmd.Flags().IntVarP(&diversityOptions.Step, "step", "", 0, "Distance between the starts of consecutive windows, in columns (Default: --window)")
+	diversityCmd.Flags().StringVarP(&diversityOutfile, "outfile", "o", "stdout", "CSV file to write")
+
+	diversityCmd.Flags().SortFlags = false
+}
+
+var diversityCmd = &cobra.Command{
+	Use:   "diversity",
+	Short: "Calculate nucleotide diversity, segregating sites and Watterson's theta",
+	Long: `Calculate nucleotide diversity, segregating sites and Watterson's theta
+
+Example usage:
+	gofasta diversity --msa aligned.fasta -o diversity.csv
+	gofasta diversity --msa aligned.fasta -m metadata.csv -c lineage -o diversity_by_lineage.csv
+	gofasta diversity --msa aligned.fasta -w 500 --step 250 -o diversity_windows.csv
+
+The output has the columns group,start,end,records,segregating_sites,pi,theta_w, with one row for each group (or
+one group called all, without --metadata) and window (or the whole alignment, without --window). start and end
+are 1-based and inclusive. Records that aren't in --metadata, or have no value in --column, are skipped.
+
+Only unambiguous nucleotides are counted. pi is the mean, over every pair of records, of the proportion of the
+sites where both have one that differ: so missing data in one record doesn't affect its comparisons at other
+sites. A site is segregating if at least two different nucleotides are found there, and theta_w is Watterson's
+estimator per site, the number of segregating sites divided by a_n = 1 + 1/2 + ... + 1/(n-1) and by the width of
+the window, where n is the number of records. Both are empty for groups of one record.
+
+Every pair of records in each group is compared, using the same packed representation as gofasta matrix, so the
+time taken grows with the square of the group sizes.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if diversityOptions.Window > 0 && diversityOptions.Step == 0 {
+			diversityOptions.Step = diversityOptions.Window
+		}
+
+		var groupOf func(string) (string, bool)
+		if diversityMetadata != "" {
+			if diversityColumn == "" {
+				return errors.New("--metadata needs a --column")
+			}
+			f, err := gfio.OpenIn(*cmd.Flag("metadata"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			table, err := metadata.Read(f, metadata.SepFromPath(diversityMetadata), diversityNameColumn)
+			if err != nil {
+				return err
+			}
+			if !table.HasColumn(diversityColumn) {
+				return errors.New("no column called " + diversityColumn + " in metadata")
+			}
+			groupOf = func(name string) (string, bool) {
+				g, ok := table.Get(name, diversityColumn)
+				return g, ok && g != ""
+			}
+		}
+
+		msa, err := gfio.OpenIn(*cmd.Flag("msa"))
+		if err != nil {
+			return err
+		}
+		defer msa.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		err = diversity.Diversity(msa, groupOf, out, diversityOptions)
+
+		return
+	},
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"errors"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/dnds"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+var dndsMSA string
+var dndsAnnotation string
+var dndsGenes []string
+var dndsOutfile string
+var dndsAggregate string
+
+func init() {
+	rootCmd.AddCommand(dndsCmd)
+
+	dndsCmd.Flags().StringVarP(&dndsMSA, "msa", "", "stdin", "Alignment to the annotation's reference, in fasta format")
+	dndsCmd.Flags().StringVarP(&dndsAnnotation, "annotation", "a", "", "Genbank or GFF3 format annotation file. Must have suffix .gb or .gff")
+	dndsCmd.Flags().StringSliceVarP(&dndsGenes, "genes", "", []string{}, "Only count these CDS (comma-separated)")
+	dndsCmd.Flags().StringVarP(&dndsOutfile, "outfile", "o", "stdout", "CSV file of counts per record per CDS to write")
+	dndsCmd.Flags().StringVarP(&dndsAggregate, "aggregate", "", "", "(Optional) CSV file of counts per CDS, summed over every record, to write")
+
+	dndsCmd.Flags().SortFlags = false
+}
+
+var dndsCmd = &cobra.Command{
+	Use:   "dnds",
+	Short: "Count synonymous and non-synonymous differences from the reference in each CDS",
+	Long: `Count synonymous and non-synonymous differences from the reference in each CDS
+
+Example usage:
+	gofasta dnds --msa aligned.fasta -a MN908947.gb -o dnds.csv
+	gofasta dnds --msa aligned.fasta -a MN908947.gb --genes S,N --aggregate genes.csv -o dnds.csv
+
+The alignment must be in the annotation's coordinates, e.g. the output of gofasta sam toMultiAlign or gofasta align.
+Each codon of each CDS is compared to the reference's, as in Nei and Gojobori (1986): the reference codon has
+some number of synonymous and non-synonymous sites (changes to a stop codon count as neither), and where codons
+differ at more than one site, the differences are averaged over the orders the changes could have happened in,
+leaving out orders through a stop codon. Codons with a gap or an ambiguous nucleotide, and reference stop codons,
+are skipped.
+
+--outfile has the columns record,gene,codons,syn_sites,nonsyn_sites,syn_diffs,nonsyn_diffs,pn,ps,pn_ps, with one
+line per record per CDS, where codons is the number compared, pn and ps are the proportions of non-synonymous and
+synonymous sites that differ, and pn_ps is their ratio (empty if ps is zero). --aggregate has the same counts
+summed over every record, with the columns gene,records,codons,syn_sites,nonsyn_sites,syn_diffs,nonsyn_diffs,pn,ps,pn_ps.
+These are uncorrected proportions from a star-like comparison to the reference, so are a screen, not a test.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if dndsAnnotation == "" {
+			return errors.New("dnds needs an --annotation")
+		}
+		annoSuffix, err := variants.AnnotationSuffix(dndsAnnotation)
+		if err != nil {
+			return err
+		}
+		anno, err := gfio.OpenIn(*cmd.Flag("annotation"))
+		if err != nil {
+			return err
+		}
+		defer anno.Close()
+		regions, refSeq, err := variants.ReadAnnotation(anno, annoSuffix, "")
+		if err != nil {
+			return err
+		}
+		if len(dndsGenes) > 0 {
+			regions, err = variants.SelectRegions(regions, dndsGenes)
+			if err != nil {
+				return err
+			}
+		}
+
+		msa, err := gfio.OpenIn(*cmd.Flag("msa"))
+		if err != nil {
+			return err
+		}
+		defer msa.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		var aggregateOut io.Writer
+		if dndsAggregate != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("aggregate"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			aggregateOut = f
+		}
+
+		err = dnds.DNDS(cmd.Context(), msa, refSeq, regions, out, aggregateOut)
+
+		return
+	},
+}
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/dotplot"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+)
+
+var dotplotA string
+var dotplotB string
+var dotplotOutfile string
+var dotplotOptions = dotplot.Options{K: 15, MaxOccurrences: 10}
+
+func init() {
+	rootCmd.AddCommand(dotplotCmd)
+
+	dotplotCmd.Flags().StringVarP(&dotplotA, "a", "a", "stdin", "First sequence, in fasta format, or both sequences if --b isn't given")
+	dotplotCmd.Flags().StringVarP(&dotplotB, "b", "b", "", "(Optional) Second sequence, in fasta format")
+	dotplotCmd.Flags().IntVarP(&dotplotOptions.K, "kmer", "k", dotplotOptions.K, "Length of the k-mers to match (at most 32)")
+	dotplotCmd.Flags().IntVarP(&dotplotOptions.MinLength, "min-length", "", 0, "Only report segments at least this long (Default: the k-mer length)")
+	dotplotCmd.Flags().IntVarP(&dotplotOptions.MaxOccurrences, "max-occurrences", "", dotplotOptions.MaxOccurrences, "Ignore k-mers that occur more than this many times in a (0 for no limit)")
+	dotplotCmd.Flags().BoolVarP(&dotplotOptions.Forward, "forward", "", false, "Only find matches on the forward strand of b")
+	dotplotCmd.Flags().Lookup("forward").NoOptDefVal = "true"
+	dotplotCmd.Flags().StringVarP(&dotplotOutfile, "outfile", "o", "stdout", "CSV file of matching segments to write")
+
+	dotplotCmd.Flags().SortFlags = false
+}
+
+var dotplotCmd = &cobra.Command{
+	Use:   "dotplot",
+	Short: "List the matching segments between two sequences, for a dotplot",
+	Long: `List the matching segments between two sequences, for a dotplot
+
+Example usage:
+	gofasta dotplot -a two_sequences.fasta -o dotplot.csv
+	gofasta dotplot -a old_assembly.fasta -b new_assembly.fasta -k 21 --min-length 50 -o dotplot.csv
+
+Either --a has exactly two records, or --a and --b have exactly one each. Gaps in the input are ignored, so
+coordinates are in the ungapped sequences. Every k-mer of b (and, unless --forward is set, of its reverse
+complement) that is also in a is a match, and runs of consecutive matches along the same diagonal are merged into
+segments. K-mers with anything other than A, C, G or T in them don't match.
+
+The output has the columns a_start,a_end,b_start,b_end,strand,length, with one row per segment, sorted by
+a_start. Coordinates are 1-based and inclusive, and each segment is a line from (a_start, b_start) to
+(a_end, b_end), so on the reverse strand b_start is greater than b_end. Plotting them shows large indels as breaks
+between diagonal lines, inversions as lines at right angles to the rest, and duplications as repeated lines.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		aIn, err := gfio.OpenIn(*cmd.Flag("a"))
+		if err != nil {
+			return err
+		}
+		defer aIn.Close()
+
+		records, err := fastaio.ReadFastaToList(aIn)
+		if err != nil {
+			return err
+		}
+
+		if dotplotB != "" {
+			if len(records) != 1 {
+				return errors.New("there must be exactly one record in --a when --b is given")
+			}
+			bIn, err := gfio.OpenIn(*cmd.Flag("b"))
+			if err != nil {
+				return err
+			}
+			defer bIn.Close()
+			bRecords, err := fastaio.ReadFastaToList(bIn)
+			if err != nil {
+				return err
+			}
+			if len(bRecords) != 1 {
+				return errors.New("there must be exactly one record in --b")
+			}
+			records = append(records, bRecords[0])
+		} else if len(records) != 2 {
+			return errors.New("there must be exactly two records in --a when --b isn't given")
+		}
+
+		if dotplotOptions.MinLength == 0 {
+			dotplotOptions.MinLength = dotplotOptions.K
+		}
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		err = dotplot.Plot(records, out, dotplotOptions)
+
+		return
+	},
+}
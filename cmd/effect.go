@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/effect"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+var effectInput string
+var effectFormat string
+var effectAnnotation string
+var effectOutfile string
+
+func init() {
+	rootCmd.AddCommand(effectCmd)
+
+	effectCmd.Flags().StringVarP(&effectInput, "snps", "s", "stdin", "SNPs to annotate: the output of gofasta snps, or a VCF file")
+	effectCmd.Flags().StringVarP(&effectFormat, "format", "", "", "Format of --snps: snps or vcf (default: vcf if its suffix is .vcf, otherwise snps)")
+	effectCmd.Flags().StringVarP(&effectAnnotation, "annotation", "a", "", "Genbank or GFF3 format annotation file. Must have suffix .gb or .gff")
+	effectCmd.Flags().StringVarP(&effectOutfile, "outfile", "o", "stdout", "CSV file to write")
+
+	effectCmd.Flags().SortFlags = false
+}
+
+var effectCmd = &cobra.Command{
+	Use:   "effect",
+	Short: "Annotate a list of SNPs with their effects on protein-coding regions",
+	Long: `Annotate a list of SNPs with their effects on protein-coding regions
+
+Example usage:
+	gofasta snps -r MN908947.fasta -q alignment.fasta -o snps.csv
+	gofasta effect -s snps.csv -a MN908947.gb -o effects.csv
+	gofasta effect -s calls.vcf -a MN908947.gff -o effects.csv
+
+--snps is either the output of gofasta snps (with the columns query,SNPs, or SNP,frequency with --aggregate), or a
+VCF file, whose single-nucleotide ALT alleles are annotated (other alleles are skipped). Positions must be in the
+annotation's coordinates, and every SNP's reference allele must match the annotation's reference sequence.
+
+--outfile has the columns query,snp,gene,codon,ref_codon,alt_codon,aa_change,effect, with one line for every SNP
+in every CDS that it is in, or one line with no gene if it is in none. Codons are on the gene's strand, and
+a query's SNPs that fall in the same codon are translated together. effect is one of synonymous, nonsynonymous,
+stop_gained, stop_lost, ambiguous (the new codon has an ambiguity code) or intergenic. The query column is empty
+for VCF input and aggregate SNPs files.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if effectAnnotation == "" {
+			return errors.New("effect needs an --annotation")
+		}
+		annoSuffix, err := variants.AnnotationSuffix(effectAnnotation)
+		if err != nil {
+			return err
+		}
+		anno, err := gfio.OpenIn(*cmd.Flag("annotation"))
+		if err != nil {
+			return err
+		}
+		defer anno.Close()
+		regions, refSeq, err := variants.ReadAnnotation(anno, annoSuffix, "")
+		if err != nil {
+			return err
+		}
+
+		format := effectFormat
+		if format == "" {
+			format = effect.FormatSNPs
+			if strings.ToLower(filepath.Ext(effectInput)) == ".vcf" {
+				format = effect.FormatVCF
+			}
+		}
+
+		in, err := gfio.OpenIn(*cmd.Flag("snps"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		var sets []effect.Set
+		switch format {
+		case effect.FormatSNPs:
+			sets, err = effect.ReadSNPs(in)
+		case effect.FormatVCF:
+			sets, err = effect.ReadVCF(in)
+		default:
+			return errors.New("unknown --format: " + format + " (choose one of snps or vcf)")
+		}
+		if err != nil {
+			return err
+		}
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		err = effect.Annotate(sets, refSeq, regions, out)
+
+		return
+	},
+}
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/entropy"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+)
+
+var entropyMSA string
+var entropyOutfile string
+var entropyGapsAsState bool
+var entropyBED string
+var entropyChrom string
+var entropyMaskEntropy float64
+var entropyMaskGaps float64
+var entropyMaskN float64
+
+func init() {
+	rootCmd.AddCommand(entropyCmd)
+
+	entropyCmd.Flags().StringVarP(&entropyMSA, "msa", "", "stdin", "Alignment in fasta format")
+	entropyCmd.Flags().StringVarP(&entropyOutfile, "outfile", "o", "stdout", "CSV file of per-column statistics to write")
+	entropyCmd.Flags().BoolVarP(&entropyGapsAsState, "gaps-as-state", "", false, "Count gaps as a fifth character state when calculating entropy")
+	entropyCmd.Flags().StringVarP(&entropyBED, "bed", "", "", "(Optional) BED file of columns to mask to write, chosen by --mask-entropy, --mask-gaps and --mask-n")
+	entropyCmd.Flags().StringVarP(&entropyChrom, "chrom", "", "alignment", "Name to write in the first column of --bed")
+	entropyCmd.Flags().Float64VarP(&entropyMaskEntropy, "mask-entropy", "", 0, "With --bed, mask columns with at least this entropy (bits)")
+	entropyCmd.Flags().Float64VarP(&entropyMaskGaps, "mask-gaps", "", 0, "With --bed, mask columns with more than this fraction of gaps")
+	entropyCmd.Flags().Float64VarP(&entropyMaskN, "mask-n", "", 0, "With --bed, mask columns with more than this fraction of Ns")
+
+	entropyCmd.Flags().Lookup("gaps-as-state").NoOptDefVal = "true"
+
+	entropyCmd.Flags().SortFlags = false
+}
+
+var entropyCmd = &cobra.Command{
+	Use:   "entropy",
+	Short: "Calculate the variability of each column of an alignment",
+	Long: `Calculate the variability of each column of an alignment
+
+Example usage:
+	gofasta entropy --msa aligned.fasta -o entropy.csv
+	gofasta entropy --msa aligned.fasta --bed mask.bed --mask-entropy 1.0 --mask-n 0.5 -o entropy.csv
+
+--outfile is a CSV file with the columns position,A,C,G,T,gaps,N,ambiguous,entropy,gap_fraction,n_fraction,
+with one row per alignment column. Position is 1-based, N counts N and ?, and ambiguous counts the other IUPAC
+ambiguity codes. Entropy is the Shannon entropy, in bits, of the counts of A, C, G and T (plus gaps, with
+--gaps-as-state), so ranges from 0 for an invariant column to 2 (or log2(5)) bits.
+
+--bed is a BED file of the columns with at least --mask-entropy, or more than --mask-gaps gaps or --mask-n Ns (as
+fractions of the records), with adjacent columns merged. It can be given to gofasta mask --sites. Thresholds
+that are 0 aren't used.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		msa, err := gfio.OpenIn(*cmd.Flag("msa"))
+		if err != nil {
+			return err
+		}
+		defer msa.Close()
+
+		cols, err := entropy.Columns(cmd.Context(), msa, entropyGapsAsState)
+		if err != nil {
+			return err
+		}
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		err = entropy.WriteTable(out, cols)
+		if err != nil {
+			return err
+		}
+
+		if entropyBED != "" {
+			bedOut, err := gfio.OpenOut(*cmd.Flag("bed"))
+			if err != nil {
+				return err
+			}
+			defer bedOut.Close()
+			sites := entropy.Sites(cols, entropy.Criteria{MinEntropy: entropyMaskEntropy, MaxGapFraction: entropyMaskGaps, MaxNFraction: entropyMaskN})
+			err = entropy.WriteBED(bedOut, sites, entropyChrom)
+			if err != nil {
+				return err
+			}
+		}
+
+		return
+	},
+}
@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"regexp"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/extract"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+)
+
+var extractFasta string
+var extractOutfile string
+var extractNames []string
+var extractNamesFile string
+var extractRegex string
+var extractNamesOrder bool
+var extractNoIndex bool
+
+func init() {
+	rootCmd.AddCommand(extractCmd)
+
+	extractCmd.Flags().StringVarP(&extractFasta, "fasta", "f", "stdin", "Fasta file to extract records from")
+	extractCmd.Flags().StringVarP(&extractOutfile, "outfile", "o", "stdout", "Fasta file of extracted records to write")
+	extractCmd.Flags().StringSliceVarP(&extractNames, "name", "n", []string{}, "Name of a record to extract. Can be given more than once, or as a comma-separated list")
+	extractCmd.Flags().StringVarP(&extractNamesFile, "names", "", "", "Plain text file of names of records to extract, one per line")
+	extractCmd.Flags().StringVarP(&extractRegex, "regex", "", "", "Extract records whose header line matches this regular expression")
+	extractCmd.Flags().BoolVarP(&extractNamesOrder, "names-order", "", false, "Write records in the order they are named, rather than the order they are in --fasta")
+	extractCmd.Flags().BoolVarP(&extractNoIndex, "no-index", "", false, "Don't use a .fai index, even if there is one")
+
+	extractCmd.Flags().Lookup("names-order").NoOptDefVal = "true"
+	extractCmd.Flags().Lookup("no-index").NoOptDefVal = "true"
+
+	extractCmd.Flags().SortFlags = false
+}
+
+var extractCmd = &cobra.Command{
+	Use:   "extract",
+	Short: "Extract records by name or regular expression",
+	Long: `Extract records by name or regular expression
+
+Example usage:
+	gofasta extract -f sequences.fasta --names names.txt -o extracted.fasta
+	gofasta extract -f sequences.fasta -n seq1,seq2 --names-order
+	gofasta extract -f sequences.fasta --regex "^England/" -o england.fasta
+
+Names are matched exactly against record IDs (the header up to the first whitespace), while --regex is matched
+against the whole header line. By default records are written in the order they are in --fasta; --names-order
+writes them in the order in which they were named instead.
+
+If --fasta is a file and there is an index for it (--fasta plus ".fai", as made by samtools faidx), and records are
+only selected by name, the index is used to read just the named records. Use --no-index to stream through the
+whole file instead.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		names := extractNames
+		if extractNamesFile != "" {
+			namesIn, err := gfio.OpenIn(*cmd.Flag("names"))
+			if err != nil {
+				return err
+			}
+			defer namesIn.Close()
+			fromFile, err := extract.ReadNames(namesIn)
+			if err != nil {
+				return err
+			}
+			names = append(names, fromFile...)
+		}
+
+		var re *regexp.Regexp
+		if extractRegex != "" {
+			re, err = regexp.Compile(extractRegex)
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(names) == 0 && re == nil {
+			return errors.New("nothing to extract: use --name, --names or --regex")
+		}
+
+		in, err := gfio.OpenIn(*cmd.Flag("fasta"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		if !extractNoIndex && re == nil && extractFasta != "stdin" && !gfio.IsRemote(extractFasta) {
+			if faiIn, ferr := os.Open(extractFasta + ".fai"); ferr == nil {
+				defer faiIn.Close()
+				index, err := extract.ReadFai(faiIn)
+				if err != nil {
+					return err
+				}
+				return extract.ExtractIndexed(in, index, names, extractNamesOrder, out)
+			}
+		}
+
+		err = extract.Extract(cmd.Context(), in, names, re, extractNamesOrder, out)
+
+		return
+	},
+}
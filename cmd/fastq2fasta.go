@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"compress/gzip"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/fastq"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+)
+
+var fastq2fastaInfile string
+var fastq2fastaOutfile string
+var fastq2fastaOptions fastq.Options
+
+func init() {
+	rootCmd.AddCommand(fastq2fastaCmd)
+
+	fastq2fastaCmd.Flags().StringVarP(&fastq2fastaInfile, "fastq", "f", "stdin", "FASTQ file to convert, which may be gzipped")
+	fastq2fastaCmd.Flags().StringVarP(&fastq2fastaOutfile, "outfile", "o", "stdout", "Fasta file to write. It is gzipped if its name ends in .gz")
+	fastq2fastaCmd.Flags().IntVarP(&fastq2fastaOptions.MinLength, "min-length", "", 0, "Drop records shorter than this")
+	fastq2fastaCmd.Flags().IntVarP(&fastq2fastaOptions.MaxLength, "max-length", "", 0, "Drop records longer than this (Default: no limit)")
+	fastq2fastaCmd.Flags().Float64VarP(&fastq2fastaOptions.MinQuality, "min-quality", "", 0, "Drop records whose mean quality is below this")
+	fastq2fastaCmd.Flags().IntVarP(&fastq2fastaOptions.MaskQuality, "mask-quality", "", 0, "Write bases whose quality is below this as N")
+
+	fastq2fastaCmd.Flags().SortFlags = false
+}
+
+var fastq2fastaCmd = &cobra.Command{
+	Use:   "fastq2fasta",
+	Short: "Convert a FASTQ file to fasta format, filtering and masking by quality",
+	Long: `Convert a FASTQ file to fasta format, filtering and masking by quality
+
+Example usage:
+	gofasta fastq2fasta -f reads.fastq.gz -o reads.fasta
+	gofasta fastq2fasta -f consensus.fastq --min-length 25000 --min-quality 20 --mask-quality 10 -o consensus.fasta.gz
+
+Gzipped input is detected from its contents, and the output is gzipped if --outfile ends in .gz. Records must be
+four lines each, with Phred+33 qualities, as written by every current sequencer and basecaller. Record headers are
+kept as they are.
+
+The mean quality of a record is the Phred score of its mean error probability, as used by most long-read filtering
+tools, so that a few very low-quality bases pull it down more than they would an arithmetic mean of the scores.
+Length and quality filters are applied before --mask-quality, and the numbers of records dropped by each are in the
+--summary-out.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in, err := gfio.OpenIn(*cmd.Flag("fastq"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		if !strings.HasSuffix(strings.ToLower(fastq2fastaOutfile), ".gz") {
+			err = fastq.ToFasta(in, out, fastq2fastaOptions)
+			return
+		}
+
+		gz := gzip.NewWriter(out)
+		if err = fastq.ToFasta(in, gz, fastq2fastaOptions); err != nil {
+			return err
+		}
+		err = gz.Close()
+
+		return
+	},
+}
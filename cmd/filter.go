@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"io"
+	"regexp"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/filter"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+)
+
+var filterFasta string
+var filterOutfile string
+var filterRejected string
+var filterReasons string
+var filterMinLength int
+var filterMaxLength int
+var filterMaxN float64
+var filterMaxAmbiguity float64
+var filterMaxGap float64
+var filterHeader string
+var filterInvert bool
+
+func init() {
+	rootCmd.AddCommand(filterCmd)
+
+	filterCmd.Flags().StringVarP(&filterFasta, "fasta", "f", "stdin", "Sequences to filter, in fasta format")
+	filterCmd.Flags().StringVarP(&filterOutfile, "outfile", "o", "stdout", "Fasta file of the records that are kept")
+	filterCmd.Flags().StringVarP(&filterRejected, "rejected", "", "", "(Optional) fasta file of the records that are not kept")
+	filterCmd.Flags().StringVarP(&filterReasons, "reasons", "", "", "(Optional) CSV file of which records were kept, and why the others weren't")
+	filterCmd.Flags().IntVarP(&filterMinLength, "min-length", "", 0, "Minimum sequence length, not counting gaps")
+	filterCmd.Flags().IntVarP(&filterMaxLength, "max-length", "", 0, "Maximum sequence length, not counting gaps")
+	filterCmd.Flags().Float64VarP(&filterMaxN, "max-n", "", 1.0, "Maximum proportion of the sequence that is N")
+	filterCmd.Flags().Float64VarP(&filterMaxAmbiguity, "max-ambiguity", "", 1.0, "Maximum proportion of the sequence that is not A, T, G, C or a gap (including N)")
+	filterCmd.Flags().Float64VarP(&filterMaxGap, "max-gap", "", 1.0, "Maximum proportion of the sequence that is a gap")
+	filterCmd.Flags().StringVarP(&filterHeader, "header", "", "", "Regular expression that the header line must match")
+	filterCmd.Flags().BoolVarP(&filterInvert, "invert", "", false, "Keep the records that fail the criteria instead of those that pass")
+
+	filterCmd.Flags().Lookup("invert").NoOptDefVal = "true"
+
+	filterCmd.Flags().SortFlags = false
+}
+
+var filterCmd = &cobra.Command{
+	Use:   "filter",
+	Short: "Select records by length, composition or header",
+	Long: `Select records by length, composition or header
+
+Example usage:
+	gofasta filter -f sequences.fasta --min-length 29000 --max-n 0.05 -o kept.fasta --rejected rejected.fasta --reasons reasons.csv
+
+A record is kept if it passes all the criteria that are set. With --invert, a record is kept if it fails any of them.
+--header is matched against the whole header line, not just the record's ID. The sequences don't have to be aligned.
+
+--reasons is a CSV file with the columns query,kept,reasons, where reasons is a ";"-delimited list of the criteria
+the record failed.
+
+If input and output files are not specified, the behaviour is to read the sequences from stdin and write
+the kept sequences to stdout.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		c := filter.DefaultCriteria()
+		c.MinLength = filterMinLength
+		c.MaxLength = filterMaxLength
+		c.MaxN = filterMaxN
+		c.MaxAmbiguity = filterMaxAmbiguity
+		c.MaxGap = filterMaxGap
+		c.Invert = filterInvert
+		if filterHeader != "" {
+			c.Header, err = regexp.Compile(filterHeader)
+			if err != nil {
+				return err
+			}
+		}
+
+		in, err := gfio.OpenIn(*cmd.Flag("fasta"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		var rejected, reasons io.Writer
+		if filterRejected != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("rejected"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			rejected = f
+		}
+		if filterReasons != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("reasons"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			reasons = f
+		}
+
+		err = filter.Filter(cmd.Context(), in, c, out, rejected, reasons)
+
+		return
+	},
+}
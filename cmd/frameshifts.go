@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/frameshift"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+var frameshiftsMSA string
+var frameshiftsAnnotation string
+var frameshiftsInsertions string
+var frameshiftsGenes []string
+var frameshiftsOutfile string
+
+func init() {
+	rootCmd.AddCommand(frameshiftsCmd)
+
+	frameshiftsCmd.Flags().StringVarP(&frameshiftsMSA, "msa", "", "stdin", "Alignment to the annotation's reference, in fasta format")
+	frameshiftsCmd.Flags().StringVarP(&frameshiftsAnnotation, "annotation", "a", "", "Genbank or GFF3 format annotation file. Must have suffix .gb or .gff")
+	frameshiftsCmd.Flags().StringVarP(&frameshiftsInsertions, "insertions", "", "", "(Optional) CSV file of insertions written by gofasta align --insertions")
+	frameshiftsCmd.Flags().StringSliceVarP(&frameshiftsGenes, "genes", "", []string{}, "Only check these CDS (comma-separated)")
+	frameshiftsCmd.Flags().StringVarP(&frameshiftsOutfile, "outfile", "o", "stdout", "CSV file of frameshifts to write")
+
+	frameshiftsCmd.Flags().SortFlags = false
+}
+
+var frameshiftsCmd = &cobra.Command{
+	Use:   "frameshifts",
+	Short: "Find frameshifting indels in protein-coding regions",
+	Long: `Find frameshifting indels in protein-coding regions
+
+Example usage:
+	gofasta frameshifts --msa aligned.fasta -a MN908947.gb -o frameshifts.csv
+	gofasta align -r MN908947.fasta -f assemblies.fasta --insertions insertions.csv -o aligned.fasta
+	gofasta frameshifts --msa aligned.fasta -a MN908947.gb --insertions insertions.csv -o frameshifts.csv
+
+The alignment must be in the annotation's coordinates, e.g. the output of gofasta sam toMultiAlign or gofasta align.
+A run of gaps is a frameshifting deletion in a CDS if the number of its bases inside the CDS isn't a multiple of
+three. Gaps at the ends of a sequence are treated as missing data, not deletions.
+
+Insertions relative to the reference aren't in the alignment, so they are only found if --insertions is given.
+An insertion of a length that isn't a multiple of three is a frameshift in every CDS that it falls inside.
+
+--outfile has the columns query,gene,type,position,length,codon,frame_shift. type is del or ins, position is the
+first deleted base, or the base after which bases were inserted, codon is the codon of the gene that the indel
+starts in, and frame_shift is the change in reading frame (-1 or -2 for deletions, +1 or +2 for insertions).`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if frameshiftsAnnotation == "" {
+			return errors.New("frameshifts needs an --annotation")
+		}
+		annoSuffix, err := variants.AnnotationSuffix(frameshiftsAnnotation)
+		if err != nil {
+			return err
+		}
+		anno, err := gfio.OpenIn(*cmd.Flag("annotation"))
+		if err != nil {
+			return err
+		}
+		defer anno.Close()
+		regions, refSeq, err := variants.ReadAnnotation(anno, annoSuffix, "")
+		if err != nil {
+			return err
+		}
+		if len(frameshiftsGenes) > 0 {
+			regions, err = variants.SelectRegions(regions, frameshiftsGenes)
+			if err != nil {
+				return err
+			}
+		}
+
+		var ins map[string][]variants.Variant
+		if frameshiftsInsertions != "" {
+			insIn, err := gfio.OpenIn(*cmd.Flag("insertions"))
+			if err != nil {
+				return err
+			}
+			defer insIn.Close()
+			ins, err = frameshift.ReadInsertions(insIn)
+			if err != nil {
+				return err
+			}
+		}
+
+		msa, err := gfio.OpenIn(*cmd.Flag("msa"))
+		if err != nil {
+			return err
+		}
+		defer msa.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		err = frameshift.Frameshifts(cmd.Context(), msa, regions, len(refSeq), ins, out)
+
+		return
+	},
+}
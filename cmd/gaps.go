@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/gaps"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+)
+
+var gapsMSA string
+var gapsOutfile string
+var gapsFormat string
+var gapsMinLength int
+var gapsMissingness string
+
+func init() {
+	rootCmd.AddCommand(gapsCmd)
+
+	gapsCmd.Flags().StringVarP(&gapsMSA, "msa", "", "stdin", "Alignment in fasta format")
+	gapsCmd.Flags().StringVarP(&gapsOutfile, "outfile", "o", "stdout", "File to write the intervals to")
+	gapsCmd.Flags().StringVarP(&gapsFormat, "format", "", "", "Format of --outfile: csv or bed (default: bed if its suffix is .bed, otherwise csv)")
+	gapsCmd.Flags().IntVarP(&gapsMinLength, "min-length", "", 1, "Only write intervals at least this long")
+	gapsCmd.Flags().StringVarP(&gapsMissingness, "missingness", "", "", "(Optional) CSV file to write the number of records with a gap or N at each position to")
+
+	gapsCmd.Flags().SortFlags = false
+}
+
+var gapsCmd = &cobra.Command{
+	Use:   "gaps",
+	Short: "List the runs of gaps and N in each record",
+	Long: `List the runs of gaps and N in each record
+
+Example usage:
+	gofasta gaps --msa alignment.fasta -o gaps.csv
+	gofasta gaps --msa alignment.fasta --min-length 100 -o dropouts.bed
+	gofasta gaps --msa alignment.fasta --missingness missing.csv -o gaps.csv
+
+Each run of gaps ('-') or of N in each record is an interval of type gap or N. As CSV, --outfile has the columns
+record,start,end,length,type, with 1-based, inclusive positions; as BED, it has the record name, the 0-based,
+half-open interval, and the type as the name. Intervals shorter than --min-length are left out.
+
+--missingness has the columns position,gaps,n,missing_fraction: the number of records with a gap and with an N at
+each position, and the proportion of records with either. It counts every interval, whatever --min-length is.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		format := gapsFormat
+		if format == "" {
+			format = gaps.FormatCSV
+			if strings.ToLower(filepath.Ext(gapsOutfile)) == ".bed" {
+				format = gaps.FormatBED
+			}
+		}
+
+		msa, err := gfio.OpenIn(*cmd.Flag("msa"))
+		if err != nil {
+			return err
+		}
+		defer msa.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		var missingOut io.Writer
+		if gapsMissingness != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("missingness"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			missingOut = f
+		}
+
+		err = gaps.Gaps(cmd.Context(), msa, out, missingOut, format, gapsMinLength)
+
+		return
+	},
+}
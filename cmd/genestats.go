@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/genestats"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+var genestatsMSA string
+var genestatsAnnotation string
+var genestatsGenes []string
+var genestatsGenome bool
+var genestatsOutfile string
+
+func init() {
+	rootCmd.AddCommand(genestatsCmd)
+
+	genestatsCmd.Flags().StringVarP(&genestatsMSA, "msa", "", "stdin", "Alignment to the annotation's reference, in fasta format")
+	genestatsCmd.Flags().StringVarP(&genestatsAnnotation, "annotation", "a", "", "Genbank or GFF3 format annotation file. Must have suffix .gb or .gff")
+	genestatsCmd.Flags().StringSliceVarP(&genestatsGenes, "genes", "", []string{}, "Only report these CDS (comma-separated)")
+	genestatsCmd.Flags().BoolVarP(&genestatsGenome, "genome", "", false, "Also report the whole alignment, as a first line called genome")
+	genestatsCmd.Flags().Lookup("genome").NoOptDefVal = "true"
+	genestatsCmd.Flags().StringVarP(&genestatsOutfile, "outfile", "o", "stdout", "CSV file to write")
+
+	genestatsCmd.Flags().SortFlags = false
+}
+
+var genestatsCmd = &cobra.Command{
+	Use:   "genestats",
+	Short: "Summarise the variation in each annotated gene across an alignment",
+	Long: `Summarise the variation in each annotated gene across an alignment
+
+Example usage:
+	gofasta genestats --msa aligned.fasta -a MN908947.gb -o genestats.csv
+	gofasta genestats --msa aligned.fasta -a MN908947.gb --genes S,N --genome
+
+The alignment must be in the annotation's coordinates, e.g. the output of gofasta sam toMultiAlign or gofasta align.
+For each CDS, the output has the columns
+gene,length,variable_sites,informative_sites,mean_pairwise_identity,gap_fraction,missing_fraction, to help choose
+loci for typing schemes. Only A, C, G and T are counted: a site is variable if it has more than one of them, and
+parsimony-informative if at least two of them are each in at least two records. mean_pairwise_identity is the
+proportion of identical sites between pairs of records, over the sites where both have one of them.
+missing_fraction is the proportion of N, ? and other ambiguity codes.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if genestatsAnnotation == "" {
+			return errors.New("genestats needs an --annotation")
+		}
+		annoSuffix, err := variants.AnnotationSuffix(genestatsAnnotation)
+		if err != nil {
+			return err
+		}
+		anno, err := gfio.OpenIn(*cmd.Flag("annotation"))
+		if err != nil {
+			return err
+		}
+		defer anno.Close()
+		regions, _, err := variants.ReadAnnotation(anno, annoSuffix, "")
+		if err != nil {
+			return err
+		}
+		if len(genestatsGenes) > 0 {
+			regions, err = variants.SelectRegions(regions, genestatsGenes)
+			if err != nil {
+				return err
+			}
+		}
+
+		msa, err := gfio.OpenIn(*cmd.Flag("msa"))
+		if err != nil {
+			return err
+		}
+		defer msa.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		err = genestats.GeneStats(cmd.Context(), msa, regions, out, genestatsGenome)
+
+		return
+	},
+}
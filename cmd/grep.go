@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"errors"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/grep"
+)
+
+var grepFasta string
+var grepMotifs []string
+var grepMotifsFile string
+var grepOutfile string
+var grepExtract string
+var grepOptions grep.Options
+
+func init() {
+	rootCmd.AddCommand(grepCmd)
+
+	grepCmd.Flags().StringVarP(&grepFasta, "fasta", "f", "stdin", "Fasta file of sequences to search")
+	grepCmd.Flags().StringSliceVarP(&grepMotifs, "motif", "m", []string{}, "Motif to search for, which may contain IUPAC ambiguity codes. Can be given more than once, or as a comma-separated list")
+	grepCmd.Flags().StringVarP(&grepMotifsFile, "motifs", "", "", "Fasta file of motifs to search for")
+	grepCmd.Flags().StringVarP(&grepOutfile, "outfile", "o", "stdout", "CSV file of matches to write")
+	grepCmd.Flags().StringVarP(&grepExtract, "extract", "", "", "(Optional) fasta file to write the sequence of each match to")
+	grepCmd.Flags().IntVarP(&grepOptions.Flank, "flank", "", 0, "With --extract, also write this many bases either side of each match")
+	grepCmd.Flags().BoolVarP(&grepOptions.Forward, "forward", "", false, "Only search the forward strand of each record")
+	grepCmd.Flags().BoolVarP(&grepOptions.Ambiguous, "ambiguous", "", false, "Let ambiguity codes in the records match the motif bases that they could be")
+
+	grepCmd.Flags().Lookup("forward").NoOptDefVal = "true"
+	grepCmd.Flags().Lookup("ambiguous").NoOptDefVal = "true"
+
+	grepCmd.Flags().SortFlags = false
+}
+
+var grepCmd = &cobra.Command{
+	Use:   "grep",
+	Short: "Find motifs in sequences",
+	Long: `Find motifs in sequences
+
+Example usage:
+	gofasta grep -f sequences.fasta -m GAATTC -o sites.csv
+	gofasta grep -f sequences.fasta -m GGATCC,GANTC --forward
+	gofasta grep -f sequences.fasta --motifs probes.fasta --extract matches.fasta --flank 20
+
+Every record is searched for every motif given with --motif (which is also its name) or in --motifs, and for
+its reverse complement unless --forward is used. Motifs may contain IUPAC ambiguity codes, which match any
+of the nucleotides that they stand for. By default only unambiguous nucleotides in the records can match, so N
+never matches; with --ambiguous, an ambiguity code in a record matches a motif base that it could be. Gaps
+never match, so for aligned records use gofasta degap first to find motifs that span gaps.
+
+--outfile has the columns record,motif,strand,start,end,match. Positions are 1-based and inclusive on the
+record's forward strand, and match is the record's sequence in the motif's orientation. Overlapping matches are
+all reported, and a motif that is its own reverse complement is only reported on the + strand.
+
+--extract writes each match, with --flank bases either side (fewer at the ends of a record), in the motif's
+orientation, named record:start-end(strand) followed by the motif's name.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		motifs := make([]grep.Motif, 0)
+		for _, m := range grepMotifs {
+			motifs = append(motifs, grep.Motif{Name: m, Seq: m})
+		}
+		if grepMotifsFile != "" {
+			motifsIn, err := gfio.OpenIn(*cmd.Flag("motifs"))
+			if err != nil {
+				return err
+			}
+			defer motifsIn.Close()
+			records, err := fastaio.ReadFastaToListUncounted(motifsIn)
+			if err != nil {
+				return err
+			}
+			for _, FR := range records {
+				motifs = append(motifs, grep.Motif{Name: FR.ID, Seq: FR.Seq})
+			}
+		}
+		if len(motifs) == 0 {
+			return errors.New("grep needs --motif or --motifs")
+		}
+
+		in, err := gfio.OpenIn(*cmd.Flag("fasta"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		var extractOut io.Writer
+		if grepExtract != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("extract"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			extractOut = f
+		}
+
+		err = grep.Grep(cmd.Context(), in, motifs, out, extractOut, grepOptions)
+
+		return
+	},
+}
@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"errors"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/consensus"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/metadata"
+)
+
+var groupConsensusMSA string
+var groupConsensusMetadata string
+var groupConsensusNameColumn string
+var groupConsensusColumn string
+var groupConsensusOutfile string
+var groupConsensusMembers string
+var groupConsensusOptions consensus.GroupOptions
+
+func init() {
+	rootCmd.AddCommand(groupConsensusCmd)
+
+	groupConsensusCmd.Flags().StringVarP(&groupConsensusMSA, "msa", "", "stdin", "Multiple sequence alignment in fasta format")
+	groupConsensusCmd.Flags().StringVarP(&groupConsensusMetadata, "metadata", "m", "", "CSV/TSV file with a group for each record, e.g. metadata or the output of gofasta cluster")
+	groupConsensusCmd.Flags().StringVarP(&groupConsensusNameColumn, "name-column", "", "name", "Column in --metadata with the sequence names")
+	groupConsensusCmd.Flags().StringVarP(&groupConsensusColumn, "column", "c", "", "Column in --metadata with the groups")
+	groupConsensusCmd.Flags().StringVarP(&groupConsensusOutfile, "outfile", "o", "stdout", "Fasta file of one consensus sequence per group to write")
+	groupConsensusCmd.Flags().StringVarP(&groupConsensusMembers, "members", "", "", "(Optional) CSV file of the members of each group to write")
+	groupConsensusCmd.Flags().Float64VarP(&groupConsensusOptions.Threshold, "threshold", "", 0.0, "Minimum proportion of a group's sequences a base must make up to be called. Default is simple majority")
+	groupConsensusCmd.Flags().BoolVarP(&groupConsensusOptions.IUPAC, "iupac", "", false, "Call an IUPAC ambiguity code if no single base reaches --threshold, instead of N")
+	groupConsensusCmd.Flags().Float64VarP(&groupConsensusOptions.MinAllele, "min-allele", "", 0.0, "Ignore alleles that make up less than this proportion of a column's bases within a group")
+	groupConsensusCmd.Flags().BoolVarP(&groupConsensusOptions.CountGaps, "count-gaps", "", false, "Count gaps as a character that can be the consensus, instead of as missing data")
+	groupConsensusCmd.Flags().Float64VarP(&groupConsensusOptions.MinFraction, "min-fraction", "", 0.0, "Call N at columns where less than this proportion of a group's sequences are not N")
+	groupConsensusCmd.Flags().IntVarP(&groupConsensusOptions.MinSize, "min-size", "", 1, "Only write groups with at least this many members")
+
+	groupConsensusCmd.Flags().Lookup("iupac").NoOptDefVal = "true"
+	groupConsensusCmd.Flags().Lookup("count-gaps").NoOptDefVal = "true"
+
+	groupConsensusCmd.Flags().SortFlags = false
+}
+
+var groupConsensusCmd = &cobra.Command{
+	Use:   "groupconsensus",
+	Short: "Make one consensus sequence per group of records in an alignment",
+	Long: `Make one consensus sequence per group of records in an alignment
+
+Example usage:
+	gofasta groupconsensus --msa alignment.fasta -m metadata.csv -c lineage --iupac -o lineages.fasta
+	gofasta groupconsensus --msa alignment.fasta -m clusters.csv --name-column sequence -c cluster --threshold 1 --iupac --min-allele 0.1 -o clusters.fasta
+
+Records are grouped by the value of --column in --metadata, which can be a metadata table or the assignments
+written by gofasta cluster. Records that aren't in --metadata, or have no value in --column, are skipped. One
+consensus sequence per group is written to --outfile, named after the group, in the order the groups first
+appear in the alignment. This shrinks a large alignment into a representative of each group.
+
+Each consensus is called as by gofasta consensus, with --threshold, --iupac, --count-gaps and --min-fraction
+taken within the group. --min-allele drops alleles that are rarer than that proportion of a column's bases
+before calling, so that with e.g. --threshold 1 --iupac --min-allele 0.1, each column is the IUPAC code of the
+alleles that make up at least 10% of the group.
+
+--members is a CSV file with the columns group,size,members, where members is a ";"-delimited list of every
+record in the group.
+
+Every group's column counts are kept in memory until the whole alignment is read, so memory use grows with the
+number of groups times the alignment length.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if groupConsensusMetadata == "" || groupConsensusColumn == "" {
+			return errors.New("gofasta groupconsensus needs --metadata and --column")
+		}
+
+		f, err := gfio.OpenIn(*cmd.Flag("metadata"))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		table, err := metadata.Read(f, metadata.SepFromPath(groupConsensusMetadata), groupConsensusNameColumn)
+		if err != nil {
+			return err
+		}
+		if !table.HasColumn(groupConsensusColumn) {
+			return errors.New("no column called " + groupConsensusColumn + " in metadata")
+		}
+		groupOf := func(name string) (string, bool) {
+			g, ok := table.Get(name, groupConsensusColumn)
+			return g, ok && g != ""
+		}
+
+		msa, err := gfio.OpenIn(*cmd.Flag("msa"))
+		if err != nil {
+			return err
+		}
+		defer msa.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		var members io.Writer
+		if groupConsensusMembers != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("members"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			members = f
+		}
+
+		err = consensus.Groups(cmd.Context(), msa, out, members, groupOf, groupConsensusOptions)
+
+		return
+	},
+}
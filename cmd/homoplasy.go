@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/homoplasy"
+)
+
+var homoplasyMSA string
+var homoplasyReference string
+var homoplasyOutfile string
+var homoplasyOptions = homoplasy.DefaultOptions()
+
+func init() {
+	rootCmd.AddCommand(homoplasyCmd)
+
+	homoplasyCmd.Flags().IntVarP(&homoplasyOptions.Threads, "threads", "t", 0, "Number of CPUs to use (Default: all available CPUs)")
+	homoplasyCmd.Flags().StringVarP(&homoplasyMSA, "msa", "", "stdin", "Multiple sequence alignment in fasta format")
+	homoplasyCmd.Flags().StringVarP(&homoplasyReference, "reference", "r", "", "Reference sequence in fasta format, aligned to --msa")
+	homoplasyCmd.Flags().IntVarP(&homoplasyOptions.Threshold, "threshold", "", homoplasyOptions.Threshold, "SNP-distance at which to link sequences into clusters")
+	homoplasyCmd.Flags().IntVarP(&homoplasyOptions.MinClusters, "min-clusters", "", homoplasyOptions.MinClusters, "Report alleles carried by members of at least this many clusters")
+	homoplasyCmd.Flags().Float64VarP(&homoplasyOptions.MaxFrequency, "max-frequency", "", homoplasyOptions.MaxFrequency, "Only report alleles carried by at most this proportion of the sequences called at the site")
+	homoplasyCmd.Flags().StringVarP(&homoplasyOutfile, "outfile", "o", "stdout", "CSV file of candidate sites to write")
+
+	homoplasyCmd.Flags().SortFlags = false
+}
+
+var homoplasyCmd = &cobra.Command{
+	Use:   "homoplasy",
+	Short: "Find alleles shared by sequences that are otherwise distantly related",
+	Long: `Find alleles shared by sequences that are otherwise distantly related
+
+Example usage:
+	gofasta homoplasy --msa aligned.fasta -r MN908947.fasta -o candidates.csv
+	gofasta mask --msa aligned.fasta -s candidates.csv -o masked.fasta
+
+The sequences are clustered by single linkage at --threshold SNPs, as by gofasta cluster, and for every site,
+each allele that differs from --reference is counted by the number of clusters it is found in. An allele found
+in at least --min-clusters clusters has arisen more than once, or is an artefact that recurs across samples
+(e.g. from a primer or a difficult stretch of the genome); either way it can mislead tree building. Alleles at
+more than --max-frequency of the sequences called at a site are left out, so that only minor alleles are reported.
+--threshold should be large enough that each lineage is one or a few clusters, otherwise alleles that define a
+lineage are counted many times.
+
+--outfile has the columns position,ref,alt,carriers,clusters,frequency, with 1-based positions, and can be passed
+to gofasta mask (or --sites of gofasta collapse) as it is. These are candidates to review, not a mask to apply
+blindly. The alignment is read into memory, and clustering compares every pair of sequences.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if homoplasyReference == "" {
+			return errors.New("gofasta homoplasy needs a --reference")
+		}
+		refIn, err := gfio.OpenIn(*cmd.Flag("reference"))
+		if err != nil {
+			return err
+		}
+		defer refIn.Close()
+		refs, err := fastaio.ReadEncodeAlignmentToListUncounted(refIn, false)
+		if err != nil {
+			return err
+		}
+		if len(refs) != 1 {
+			return errors.New("there must be exactly one record in --reference")
+		}
+
+		msa, err := gfio.OpenIn(*cmd.Flag("msa"))
+		if err != nil {
+			return err
+		}
+		defer msa.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		err = homoplasy.Homoplasy(cmd.Context(), msa, refs[0].Seq, out, homoplasyOptions)
+
+		return
+	},
+}
@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/extract"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+)
+
+var intersectFasta string
+var intersectOther string
+var intersectNames string
+var intersectNoIndex bool
+var intersectOutfile string
+
+func init() {
+	rootCmd.AddCommand(intersectCmd)
+
+	intersectCmd.Flags().StringVarP(&intersectFasta, "fasta", "f", "stdin", "Fasta file to keep records from")
+	intersectCmd.Flags().StringVarP(&intersectOther, "other", "", "", "Fasta file of records to keep")
+	intersectCmd.Flags().StringVarP(&intersectNames, "names", "", "", "Plain text file of names of records to keep, one per line")
+	intersectCmd.Flags().BoolVarP(&intersectNoIndex, "no-index", "", false, "Don't use .fai indexes, even if there are any")
+	intersectCmd.Flags().StringVarP(&intersectOutfile, "outfile", "o", "stdout", "Fasta file to write")
+
+	intersectCmd.Flags().Lookup("no-index").NoOptDefVal = "true"
+
+	intersectCmd.Flags().SortFlags = false
+}
+
+var intersectCmd = &cobra.Command{
+	Use:   "intersect",
+	Short: "Keep only the records that are in another fasta file or list of names",
+	Long: `Keep only the records that are in another fasta file or list of names
+
+Example usage:
+	gofasta intersect -f alignment.fasta --other passed_qc.fasta -o shared.fasta
+	gofasta intersect -f alignment.fasta --names included.txt -o included.fasta
+
+Records of --fasta whose IDs (the header up to the first whitespace) are in --other or in --names are written in
+the order that they are in --fasta. Names that aren't in --fasta are ignored. Only the IDs of --other are read,
+from its .fai index if it has one. If --fasta is a file with an index (--fasta plus ".fai", as made by samtools
+faidx), it is used to read just the shared records. Use --no-index to stream through the files instead. See also
+gofasta subtract.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		names, err := setNames(cmd, intersectOther, intersectNames, intersectNoIndex)
+		if err != nil {
+			return err
+		}
+
+		in, err := gfio.OpenIn(*cmd.Flag("fasta"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		if !intersectNoIndex && intersectFasta != "stdin" && !gfio.IsRemote(intersectFasta) {
+			if faiIn, ferr := os.Open(intersectFasta + ".fai"); ferr == nil {
+				defer faiIn.Close()
+				index, err := extract.ReadFai(faiIn)
+				if err != nil {
+					return err
+				}
+				return extract.IntersectIndexed(in, index, names, out)
+			}
+		}
+
+		err = extract.Intersect(cmd.Context(), in, names, out)
+
+		return
+	},
+}
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/join"
+)
+
+var joinA string
+var joinB string
+var joinReferenceName string
+var joinOutfile string
+
+func init() {
+	rootCmd.AddCommand(joinCmd)
+
+	joinCmd.Flags().StringVarP(&joinA, "a", "a", "stdin", "First alignment, in fasta format")
+	joinCmd.Flags().StringVarP(&joinB, "b", "b", "", "Second alignment, in fasta format")
+	joinCmd.Flags().StringVarP(&joinReferenceName, "reference-name", "r", "", "Name of the reference record that is in both alignments")
+	joinCmd.Flags().StringVarP(&joinOutfile, "outfile", "o", "stdout", "Alignment to write, in fasta format")
+
+	joinCmd.Flags().SortFlags = false
+}
+
+var joinCmd = &cobra.Command{
+	Use:   "join",
+	Short: "Merge two alignments that share a reference record",
+	Long: `Merge two alignments that share a reference record
+
+Example usage:
+	gofasta join -a alignment1.fasta -b alignment2.fasta -r MN908947.3 -o joined.fasta
+
+Both alignments must contain a record called --reference-name, with the same sequence once gaps are removed.
+Their columns are lined up through it: columns where the reference has a base in both are merged, and columns
+where it has a gap in one alignment (insertions relative to the reference) are kept, as gaps in the other
+alignment's records. Nothing is re-aligned, so the alignment of each input to the reference is unchanged.
+
+The output has the records of --a, then those of --b apart from its copy of the reference. A warning is given for
+any other name that is in both. Both alignments are read into memory.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if joinB == "" {
+			return errors.New("join needs --b")
+		}
+		if joinReferenceName == "" {
+			return errors.New("join needs --reference-name")
+		}
+
+		a, err := gfio.OpenIn(*cmd.Flag("a"))
+		if err != nil {
+			return err
+		}
+		defer a.Close()
+
+		b, err := gfio.OpenIn(*cmd.Flag("b"))
+		if err != nil {
+			return err
+		}
+		defer b.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		err = join.Join(a, b, joinReferenceName, out)
+
+		return
+	},
+}
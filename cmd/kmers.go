@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/kmers"
+)
+
+var kmersFasta string
+var kmersOutfile string
+var kmersDistances string
+var kmersStranded bool
+var kmersOptions kmers.Options
+
+func init() {
+	rootCmd.AddCommand(kmersCmd)
+
+	kmersCmd.Flags().IntVarP(&kmersOptions.Threads, "threads", "t", 0, "Number of CPUs to use for --distances (Default: all available CPUs)")
+	kmersCmd.Flags().StringVarP(&kmersFasta, "fasta", "f", "stdin", "Fasta file of sequences to count k-mers in")
+	kmersCmd.Flags().IntVarP(&kmersOptions.K, "kmer", "k", 21, "K-mer length (at most 32)")
+	kmersCmd.Flags().StringVarP(&kmersOutfile, "outfile", "o", "stdout", "CSV file to write the k-mer counts to")
+	kmersCmd.Flags().BoolVarP(&kmersOptions.PerRecord, "per-record", "", false, "Count k-mers in each record separately, instead of over the whole file")
+	kmersCmd.Flags().BoolVarP(&kmersStranded, "stranded", "", false, "Count k-mers on the records' strand only, instead of canonically")
+	kmersCmd.Flags().StringVarP(&kmersDistances, "distances", "", "", "(Optional) CSV file to write the pairwise k-mer distances between records to")
+	kmersCmd.Flags().StringVarP(&kmersOptions.Metric, "metric", "", kmers.Mash, "With --distances, the distance to use: jaccard, mash or bray-curtis")
+	kmersCmd.Flags().StringVarP(&kmersOptions.Format, "format", "", "square", "With --distances, the matrix format: square, lower or long")
+	kmersCmd.Flags().BoolVarP(&kmersOptions.Float32, "float32", "", false, "With --distances, hold the distances as 32-bit floats, in half the memory")
+
+	kmersCmd.Flags().Lookup("per-record").NoOptDefVal = "true"
+	kmersCmd.Flags().Lookup("stranded").NoOptDefVal = "true"
+	kmersCmd.Flags().Lookup("float32").NoOptDefVal = "true"
+
+	kmersCmd.Flags().SortFlags = false
+}
+
+var kmersCmd = &cobra.Command{
+	Use:   "kmers",
+	Short: "Count k-mers, and calculate alignment-free distances between sequences",
+	Long: `Count k-mers, and calculate alignment-free distances between sequences
+
+Example usage:
+	gofasta kmers -f sequences.fasta -k 8 -o counts.csv
+	gofasta kmers -f sequences.fasta -k 5 --per-record --stranded -o counts.csv
+	gofasta kmers -f sequences.fasta --distances distances.csv --metric jaccard --format long
+
+The records don't need to be aligned. K-mers with anything other than A, C, G or T in them (including gaps) are
+skipped. By default k-mers are counted canonically: each one together with its reverse complement, as whichever
+of the two comes first alphabetically. With --stranded they are counted as they are.
+
+--outfile has the columns kmer,count with the counts summed over every record, or with --per-record the columns
+record,kmer,count. K-mers are in alphabetical order, and those that are never seen are left out.
+
+--distances writes the matrix of distances between every pair of records, in the same formats as gofasta matrix.
+jaccard is one minus the Jaccard index of two records' sets of k-mers; mash is the Mash estimate of the
+per-base mutation distance from that index (1 if no k-mers are shared); and bray-curtis is the Bray-Curtis
+dissimilarity of the two records' k-mer counts. Every record's k-mer counts are held in memory, and so is one
+triangle of the matrix, as 64-bit floats or with --float32 as 32-bit floats, which are precise enough for the six
+decimal places the distances are written with, but for the odd last digit. If --distances is given but --outfile
+isn't, no counts are written.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		kmersOptions.Canonical = !kmersStranded
+
+		in, err := gfio.OpenIn(*cmd.Flag("fasta"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		var out io.Writer
+		if kmersDistances == "" || cmd.Flags().Changed("outfile") {
+			f, err := gfio.OpenOut(*cmd.Flag("outfile"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			out = f
+		}
+
+		var distOut io.Writer
+		if kmersDistances != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("distances"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			distOut = f
+		}
+
+		err = kmers.Kmers(cmd.Context(), in, out, distOut, kmersOptions)
+
+		return
+	},
+}
@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/liftover"
+)
+
+var liftoverInput string
+var liftoverFormat string
+var liftoverFromMSA string
+var liftoverToMSA string
+var liftoverRefName string
+var liftoverColumns []string
+var liftoverOutfile string
+
+func init() {
+	rootCmd.AddCommand(liftoverCmd)
+
+	liftoverCmd.Flags().StringVarP(&liftoverInput, "input", "i", "stdin", "BED or CSV file of coordinates to convert")
+	liftoverCmd.Flags().StringVarP(&liftoverFormat, "format", "", "", "Format of --input: bed or csv (default: from the suffix of --input)")
+	liftoverCmd.Flags().StringVarP(&liftoverFromMSA, "from-msa", "", "", "Alignment, in fasta format, whose columns the coordinates are in (default: the ungapped reference)")
+	liftoverCmd.Flags().StringVarP(&liftoverToMSA, "to-msa", "", "", "Alignment, in fasta format, to convert the coordinates to the columns of (default: the ungapped reference)")
+	liftoverCmd.Flags().StringVarP(&liftoverRefName, "reference-name", "", "", "Name of the reference record in the alignments (default: the first record)")
+	liftoverCmd.Flags().StringSliceVarP(&liftoverColumns, "column", "c", []string{"position"}, "For CSV input, the column(s) of 1-based positions to convert")
+	liftoverCmd.Flags().StringVarP(&liftoverOutfile, "outfile", "o", "stdout", "File to write the converted coordinates to, in the same format as --input")
+
+	liftoverCmd.Flags().SortFlags = false
+}
+
+var liftoverCmd = &cobra.Command{
+	Use:   "liftover",
+	Short: "Convert coordinates between alignment columns and reference positions",
+	Long: `Convert coordinates between alignment columns and reference positions
+
+Example usage:
+	gofasta liftover --from-msa alignment.fasta -i sites.bed -o sites.reference.bed
+	gofasta liftover --to-msa alignment.fasta -i mutations.csv -c position -o mutations.columns.csv
+	gofasta liftover --from-msa old.fasta --to-msa new.fasta --reference-name MN908947.3 -i mask.bed -o mask.new.bed
+
+Coordinates in the columns of --from-msa (or in the ungapped reference, if it isn't given) are converted to the
+columns of --to-msa (or to the ungapped reference, if it isn't given). Both alignments must contain the
+reference, called --reference-name (or, if that isn't given, as their first record). This is needed when the
+reference has gaps in an alignment, e.g. in a multiple alignment that keeps insertion columns.
+
+BED files are 0-based and half-open, and any columns after the third are copied as they are. Insertion columns
+at the ends of an interval are trimmed off, and an interval that only covers insertion columns is left out. CSV
+files must have a header, and the positions in the --column column(s) are 1-based; a position in an insertion
+column relative to the reference is left empty. Either is reported as a warning.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if liftoverFromMSA == "" && liftoverToMSA == "" {
+			return errors.New("liftover needs at least one of --from-msa and --to-msa")
+		}
+
+		format := liftoverFormat
+		if format == "" {
+			format = strings.TrimPrefix(strings.ToLower(filepath.Ext(liftoverInput)), ".")
+		}
+		if format != "bed" && format != "csv" {
+			return errors.New("couldn't tell if --input was a bed or csv file (use --format)")
+		}
+
+		var from, to *liftover.Map
+		if liftoverFromMSA != "" {
+			f, err := gfio.OpenIn(*cmd.Flag("from-msa"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			m, err := liftover.ReadMap(cmd.Context(), f, liftoverRefName)
+			if err != nil {
+				return err
+			}
+			from = &m
+		}
+		if liftoverToMSA != "" {
+			f, err := gfio.OpenIn(*cmd.Flag("to-msa"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			m, err := liftover.ReadMap(cmd.Context(), f, liftoverRefName)
+			if err != nil {
+				return err
+			}
+			to = &m
+		}
+
+		c, err := liftover.NewConverter(from, to)
+		if err != nil {
+			return err
+		}
+
+		in, err := gfio.OpenIn(*cmd.Flag("input"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		if format == "bed" {
+			err = liftover.LiftBED(in, out, c)
+		} else {
+			err = liftover.LiftCSV(in, out, c, liftoverColumns)
+		}
+
+		return
+	},
+}
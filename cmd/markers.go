@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/markers"
+	"github.com/virus-evolution/gofasta/pkg/metadata"
+)
+
+var markersMSA string
+var markersReference string
+var markersMetadata string
+var markersNameColumn string
+var markersColumn string
+var markersOutfile string
+var markersOptions = markers.DefaultOptions()
+
+func init() {
+	rootCmd.AddCommand(markersCmd)
+
+	markersCmd.Flags().StringVarP(&markersMSA, "msa", "", "stdin", "Alignment in fasta format")
+	markersCmd.Flags().StringVarP(&markersReference, "reference", "r", "", "Reference sequence in fasta format, aligned to --msa")
+	markersCmd.Flags().StringVarP(&markersMetadata, "metadata", "m", "", "CSV/TSV metadata file with the group of each record")
+	markersCmd.Flags().StringVarP(&markersNameColumn, "name-column", "", "name", "Column in --metadata with the sequence names")
+	markersCmd.Flags().StringVarP(&markersColumn, "column", "c", "", "Column in --metadata with the groups")
+	markersCmd.Flags().Float64VarP(&markersOptions.MinFrequency, "min-frequency", "", markersOptions.MinFrequency, "Only report mutations in at least this proportion of a group's records")
+	markersCmd.Flags().Float64VarP(&markersOptions.MaxOtherFrequency, "max-other-frequency", "", markersOptions.MaxOtherFrequency, "Only report mutations in at most this proportion of the other records")
+	markersCmd.Flags().IntVarP(&markersOptions.MinSize, "min-size", "", markersOptions.MinSize, "Only report groups with at least this many records")
+	markersCmd.Flags().StringVarP(&markersOutfile, "outfile", "o", "stdout", "CSV file of each group's mutations to write")
+
+	markersCmd.Flags().SortFlags = false
+}
+
+var markersCmd = &cobra.Command{
+	Use:     "unique-mutations",
+	Aliases: []string{"markers"},
+	Short:   "Find the mutations that distinguish each group of records from the others",
+	Long: `Find the mutations that distinguish each group of records from the others
+
+Example usage:
+	gofasta unique-mutations --msa aligned.fasta -r MN908947.fasta -m metadata.csv -c lineage -o lineage_mutations.csv
+	gofasta unique-mutations --msa aligned.fasta -r MN908947.fasta -m metadata.csv -c lineage --min-frequency 0.95 --max-other-frequency 0
+
+Every mutation relative to --reference in each group, as given by --column of --metadata, is compared with the
+records in all the other groups. It is reported if it is in at least --min-frequency of the group's records and at
+most --max-other-frequency of the others, so by default mutations enriched in a group are reported as well as
+those fixed in it and found nowhere else. Only records with an unambiguous nucleotide at a site are counted there,
+so missing data doesn't lower the frequencies. Records that aren't in --metadata, or have no value in --column,
+are skipped.
+
+The output has the columns
+group,mutation,position,records,called,carriers,frequency,other_called,other_carriers,other_frequency, where
+records is the size of the group, called and other_called the number of the group's and of the other records with
+a call at the site, and carriers and other_carriers the number of those with the mutation. Groups are in the
+order that they are first seen in the alignment. The counts of every group's nucleotides at every site are kept
+in memory, so memory use grows with the number of groups times the alignment length.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if markersReference == "" {
+			return errors.New("gofasta unique-mutations needs a --reference")
+		}
+		if markersMetadata == "" || markersColumn == "" {
+			return errors.New("gofasta unique-mutations needs --metadata and --column")
+		}
+
+		refIn, err := gfio.OpenIn(*cmd.Flag("reference"))
+		if err != nil {
+			return err
+		}
+		defer refIn.Close()
+		refs, err := fastaio.ReadEncodeAlignmentToListUncounted(refIn, false)
+		if err != nil {
+			return err
+		}
+		if len(refs) != 1 {
+			return errors.New("there must be exactly one record in --reference")
+		}
+
+		f, err := gfio.OpenIn(*cmd.Flag("metadata"))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		table, err := metadata.Read(f, metadata.SepFromPath(markersMetadata), markersNameColumn)
+		if err != nil {
+			return err
+		}
+		if !table.HasColumn(markersColumn) {
+			return errors.New("no column called " + markersColumn + " in metadata")
+		}
+		groupOf := func(name string) (string, bool) {
+			g, ok := table.Get(name, markersColumn)
+			return g, ok && g != ""
+		}
+
+		msa, err := gfio.OpenIn(*cmd.Flag("msa"))
+		if err != nil {
+			return err
+		}
+		defer msa.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		err = markers.Markers(cmd.Context(), msa, refs[0].Seq, groupOf, out, markersOptions)
+
+		return
+	},
+}
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/mask"
+)
+
+var maskMSA string
+var maskSites string
+var maskVCFFilter string
+var maskChar string
+var maskOutfile string
+
+func init() {
+	rootCmd.AddCommand(maskCmd)
+
+	maskCmd.Flags().StringVarP(&maskMSA, "msa", "", "stdin", "Multiple sequence alignment in fasta format")
+	maskCmd.Flags().StringVarP(&maskSites, "sites", "s", "", "Sites to mask. Must have suffix .bed, .vcf or .csv")
+	maskCmd.Flags().StringVarP(&maskVCFFilter, "vcf-filter", "", "", "If --sites is a VCF, only mask records with this value in the FILTER column (e.g. mask)")
+	maskCmd.Flags().StringVarP(&maskChar, "char", "", "N", "Character to replace masked sites with")
+	maskCmd.Flags().StringVarP(&maskOutfile, "outfile", "o", "stdout", "Masked alignment to write, in fasta format")
+
+	maskCmd.Flags().SortFlags = false
+}
+
+var maskCmd = &cobra.Command{
+	Use:   "mask",
+	Short: "Mask sites in an alignment",
+	Long: `Mask sites in an alignment
+
+Example usage:
+	gofasta mask --msa alignment.fasta --sites problematic_sites_sarsCov2.vcf --vcf-filter mask -o masked.fasta
+
+Sites are alignment columns, and can be given in one of three formats, chosen by the suffix of --sites:
+
+	.bed - tab-separated intervals, 0-based and half-open. The first column is ignored, and every interval is
+	       masked in every record
+	.vcf - every site (the span of the REF allele starting at POS) is masked in every record. Use --vcf-filter
+	       to only mask records with a particular value in the FILTER column
+	.csv - a header and the columns start and (optionally) end and record, 1-based and inclusive. Rows with a
+	       record name are only masked in that record; rows without one are masked in every record
+
+If input and output files are not specified, the behaviour is to read the alignment from stdin and write
+the masked alignment to stdout.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if len(maskChar) != 1 {
+			return errors.New("--char must be a single character")
+		}
+
+		sitesIn, err := gfio.OpenIn(*cmd.Flag("sites"))
+		if err != nil {
+			return err
+		}
+		defer sitesIn.Close()
+
+		masks, err := mask.Read(sitesIn, maskSites, maskVCFFilter)
+		if err != nil {
+			return err
+		}
+
+		msa, err := gfio.OpenIn(*cmd.Flag("msa"))
+		if err != nil {
+			return err
+		}
+		defer msa.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		err = mask.Mask(cmd.Context(), msa, masks, maskChar[0], out)
+
+		return
+	},
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/merge"
+)
+
+var mergeFastas []string
+var mergeOutfile string
+var mergeDuplicates string
+var mergeAlignment bool
+
+func init() {
+	rootCmd.AddCommand(mergeCmd)
+
+	mergeCmd.Flags().StringSliceVarP(&mergeFastas, "fasta", "f", []string{}, "Fasta files to merge, in order. Can be given more than once, or as a comma-separated list. Positional arguments are added to these")
+	mergeCmd.Flags().StringVarP(&mergeOutfile, "outfile", "o", "stdout", "Fasta file to write")
+	mergeCmd.Flags().StringVarP(&mergeDuplicates, "duplicates", "d", "error", "What to do with records whose name has been seen before: error, skip or suffix")
+	mergeCmd.Flags().BoolVarP(&mergeAlignment, "alignment", "a", false, "Check that every record in every input is the same length")
+
+	mergeCmd.Flags().Lookup("alignment").NoOptDefVal = "true"
+
+	mergeCmd.Flags().SortFlags = false
+}
+
+var mergeCmd = &cobra.Command{
+	Use:   "merge [fasta ...]",
+	Short: "Concatenate fasta files, dealing with duplicate names",
+	Long: `Concatenate fasta files, dealing with duplicate names
+
+Example usage:
+	gofasta merge a.fasta b.fasta c.fasta -o merged.fasta
+	gofasta merge -f a.fasta,b.fasta --duplicates suffix --alignment -o merged.fasta
+
+Records are written in the order of the inputs. A record whose ID has been seen before is handled according to
+--duplicates: "error" stops, "skip" keeps only the first one, and "suffix" renames later ones to ID_2, ID_3, etc.
+
+With --alignment, every record in every input must be the same length.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		paths := append(mergeFastas, args...)
+		if len(paths) == 0 {
+			return errors.New("no fasta files to merge")
+		}
+
+		ins := make([]merge.Input, 0, len(paths))
+		for _, path := range paths {
+			f, err := gfio.OpenInPath(path, "fasta")
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			ins = append(ins, merge.Input{R: f, Label: path})
+		}
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		err = merge.Merge(cmd.Context(), ins, out, mergeDuplicates, mergeAlignment)
+
+		return
+	},
+}
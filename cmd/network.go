@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/collapse"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/mask"
+	"github.com/virus-evolution/gofasta/pkg/network"
+)
+
+var networkThreads int
+var networkMSA string
+var networkOutfile string
+var networkFormat string
+var networkNodes string
+var networkMethod string
+var networkSites string
+var networkVCFFilter string
+var networkAmbiguous bool
+
+func init() {
+	rootCmd.AddCommand(networkCmd)
+
+	networkCmd.Flags().IntVarP(&networkThreads, "threads", "t", 0, "Number of CPUs to use (Default: all available CPUs)")
+	networkCmd.Flags().StringVarP(&networkMSA, "msa", "", "stdin", "Alignment in fasta format")
+	networkCmd.Flags().StringVarP(&networkOutfile, "outfile", "o", "stdout", "File to write the network to (the edges, with --format csv)")
+	networkCmd.Flags().StringVarP(&networkFormat, "format", "", "csv", "Output format: csv or graphml")
+	networkCmd.Flags().StringVarP(&networkNodes, "nodes", "", "", "With --format csv, (optional) CSV file of haplotypes to write")
+	networkCmd.Flags().StringVarP(&networkMethod, "method", "", "msn", "Network to build: msn (minimum spanning network) or mst (minimum spanning tree)")
+	networkCmd.Flags().StringVarP(&networkSites, "sites", "s", "", "(Optional) sites to mask before comparing sequences. Must have suffix .bed, .vcf or .csv (see gofasta mask)")
+	networkCmd.Flags().StringVarP(&networkVCFFilter, "vcf-filter", "", "", "If --sites is a VCF, only mask records with this value in the FILTER column")
+	networkCmd.Flags().BoolVarP(&networkAmbiguous, "ambiguous", "", false, "Also collapse sequences that only differ where one of them is ambiguous (see gofasta collapse)")
+
+	networkCmd.Flags().Lookup("ambiguous").NoOptDefVal = "true"
+
+	networkCmd.Flags().SortFlags = false
+}
+
+var networkCmd = &cobra.Command{
+	Use:   "network",
+	Short: "Build a haplotype network from an alignment",
+	Long: `Build a haplotype network from an alignment
+
+Example usage:
+	gofasta network --msa aligned.fasta --nodes nodes.csv -o edges.csv
+	gofasta network --msa aligned.fasta --method mst --format graphml -o network.graphml
+
+The alignment is first collapsed into haplotypes as gofasta collapse does (with --sites and --ambiguous having
+the same meaning), and the haplotypes are then joined by edges weighted by the SNP-distance between their
+representatives.
+
+With --method msn, the network is a minimum spanning network: every edge that is in some minimum spanning tree,
+so that equally short alternative connections are all shown. With --method mst, it is a single minimum spanning
+tree, with ties broken by the order of the input. Median vectors (unsampled intermediate haplotypes) are not
+inferred.
+
+With --format csv, --outfile has the columns source,target,weight, and --nodes has the columns
+haplotype,representative,count,members. With --format graphml, the whole network is written to --outfile as
+GraphML, which can be opened in e.g. Cytoscape or Gephi.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if networkFormat != "csv" && networkFormat != "graphml" {
+			return errors.New("unknown --format: " + networkFormat + " (choose one of csv or graphml)")
+		}
+		if networkFormat == "graphml" && networkNodes != "" {
+			return errors.New("--nodes can only be used with --format csv")
+		}
+
+		o := collapse.Options{Ambiguous: networkAmbiguous, Prefix: "hap"}
+
+		if networkSites != "" {
+			sitesIn, err := gfio.OpenIn(*cmd.Flag("sites"))
+			if err != nil {
+				return err
+			}
+			defer sitesIn.Close()
+			masks, err := mask.Read(sitesIn, networkSites, networkVCFFilter)
+			if err != nil {
+				return err
+			}
+			o.Masks = &masks
+		}
+
+		msa, err := gfio.OpenIn(*cmd.Flag("msa"))
+		if err != nil {
+			return err
+		}
+		defer msa.Close()
+
+		haplotypes, err := collapse.Collapse(cmd.Context(), msa, o)
+		if err != nil {
+			return err
+		}
+
+		nw, err := network.Build(cmd.Context(), haplotypes, networkMethod, networkThreads)
+		if err != nil {
+			return err
+		}
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		if networkFormat == "graphml" {
+			return nw.WriteGraphML(out)
+		}
+
+		err = nw.WriteEdges(out)
+		if err != nil {
+			return err
+		}
+
+		if networkNodes != "" {
+			nodesOut, err := gfio.OpenOut(*cmd.Flag("nodes"))
+			if err != nil {
+				return err
+			}
+			defer nodesOut.Close()
+			err = nw.WriteNodes(nodesOut)
+			if err != nil {
+				return err
+			}
+		}
+
+		return
+	},
+}
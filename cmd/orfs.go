@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/orfs"
+)
+
+var orfsFasta string
+var orfsOutfile string
+var orfsProteins string
+var orfsMinLength int
+var orfsStartCodons []string
+var orfsStopToStop bool
+var orfsPartial bool
+var orfsCode int
+
+func init() {
+	rootCmd.AddCommand(orfsCmd)
+
+	orfsCmd.Flags().StringVarP(&orfsFasta, "fasta", "f", "stdin", "Nucleotide sequences in fasta format")
+	orfsCmd.Flags().StringVarP(&orfsOutfile, "outfile", "o", "stdout", "CSV file of ORF coordinates to write")
+	orfsCmd.Flags().StringVarP(&orfsProteins, "proteins", "p", "", "(Optional) fasta file of the translation of each ORF to write")
+	orfsCmd.Flags().IntVarP(&orfsMinLength, "min-length", "l", 100, "Minimum length of ORFs to report, in amino acids (not counting the stop codon)")
+	orfsCmd.Flags().StringSliceVarP(&orfsStartCodons, "start-codons", "", []string{"ATG"}, "Codons that can start an ORF (comma-separated)")
+	orfsCmd.Flags().BoolVarP(&orfsStopToStop, "stop-to-stop", "", false, "Report ORFs from stop codon to stop codon, ignoring --start-codons")
+	orfsCmd.Flags().BoolVarP(&orfsPartial, "partial", "", false, "Also report ORFs that run off the 3' end of the sequence without a stop codon")
+	orfsCmd.Flags().IntVarP(&orfsCode, "code", "", 1, "NCBI translation table to use (1, 2, 3, 4, 5, 6, 9, 10, 11, 12, 13 or 14)")
+
+	orfsCmd.Flags().Lookup("stop-to-stop").NoOptDefVal = "true"
+	orfsCmd.Flags().Lookup("partial").NoOptDefVal = "true"
+
+	orfsCmd.Flags().SortFlags = false
+}
+
+var orfsCmd = &cobra.Command{
+	Use:   "orfs",
+	Short: "Find open reading frames",
+	Long: `Find open reading frames
+
+Example usage:
+	gofasta orfs -f assemblies.fasta -l 50 -p orfs.fasta -o orfs.csv
+	gofasta orfs -f assemblies.fasta --start-codons ATG,CTG,TTG --code 11 -o orfs.csv
+
+Every record is searched on both strands and in all three frames, after removing gaps, for ORFs of at least
+--min-length amino acids. An ORF starts at the first start codon after the previous stop codon (so nested ORFs
+in the same frame aren't reported separately) and ends at the next stop codon in the same frame.
+
+--outfile has the columns record,orf,strand,frame,start,end,length_nt,length_aa,complete, with one row per ORF,
+sorted by start position. start and end are 1-based positions on the forward strand, and include the stop codon.
+frame is counted from the 5' end of the strand that the ORF is on. complete is false for ORFs (found with
+--partial) that have no stop codon.
+
+--proteins has the translation of each ORF, without the stop codon, named after the orf column of --outfile.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		o := orfs.Options{MinLength: orfsMinLength, StartCodons: orfsStartCodons, Partial: orfsPartial, Table: orfsCode}
+		if orfsStopToStop {
+			o.StartCodons = nil
+		}
+
+		in, err := gfio.OpenIn(*cmd.Flag("fasta"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		var proteins io.Writer
+		if orfsProteins != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("proteins"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			proteins = f
+		}
+
+		err = orfs.FindAll(cmd.Context(), in, out, proteins, o)
+
+		return
+	},
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/outliers"
+)
+
+var outliersMSA string
+var outliersReference string
+var outliersThreshold float64
+var outliersOutfile string
+
+func init() {
+	rootCmd.AddCommand(outliersCmd)
+
+	outliersCmd.Flags().StringVarP(&outliersMSA, "msa", "", "stdin", "Alignment in fasta format")
+	outliersCmd.Flags().StringVarP(&outliersReference, "reference", "r", "", "(Optional) reference sequence in fasta format, aligned to --msa, to compare records to instead of the consensus")
+	outliersCmd.Flags().Float64VarP(&outliersThreshold, "threshold", "", 5, "Flag records with a robust z-score of their distance greater than this")
+	outliersCmd.Flags().StringVarP(&outliersOutfile, "outfile", "o", "stdout", "CSV file to write")
+
+	outliersCmd.Flags().SortFlags = false
+}
+
+var outliersCmd = &cobra.Command{
+	Use:   "outliers",
+	Short: "Flag records that are unusually far from the consensus",
+	Long: `Flag records that are unusually far from the consensus
+
+Example usage:
+	gofasta outliers --msa alignment.fasta -o outliers.csv
+	gofasta outliers --msa alignment.fasta -r MN908947.fasta --threshold 3 -o outliers.csv
+
+Each record is compared to the majority-rule consensus of the alignment (or to --reference) at the sites where
+both have an unambiguous nucleotide, and its distance is the proportion of those sites that differ. Records
+whose distance is more than --threshold times the scaled median absolute deviation above the median distance
+are flagged. The scale is never less than one SNP, at the median number of compared sites, so that in an
+alignment of near-identical sequences one or two differences aren't enough to be an outlier.
+
+--outfile has the columns query,snps,compared_sites,distance,z,outlier. A record with no sites to compare has a
+distance of NaN and is always flagged. The alignment is read into memory.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		var ref string
+		if outliersReference != "" {
+			refIn, err := gfio.OpenIn(*cmd.Flag("reference"))
+			if err != nil {
+				return err
+			}
+			defer refIn.Close()
+			refs, err := fastaio.ReadFastaToListUncounted(refIn)
+			if err != nil {
+				return err
+			}
+			if len(refs) != 1 {
+				return errors.New("there must be exactly one record in --reference")
+			}
+			ref = refs[0].Seq
+		}
+
+		msa, err := gfio.OpenIn(*cmd.Flag("msa"))
+		if err != nil {
+			return err
+		}
+		defer msa.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		err = outliers.Outliers(msa, ref, outliersThreshold, out)
+
+		return
+	},
+}
@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"errors"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/pad"
+)
+
+var padFasta string
+var padReference string
+var padAnchor string
+var padChar string
+var padReport string
+var padOutfile string
+var padOptions = pad.DefaultOptions()
+
+func init() {
+	rootCmd.AddCommand(padCmd)
+
+	padCmd.Flags().StringVarP(&padFasta, "fasta", "f", "stdin", "Fasta file to pad")
+	padCmd.Flags().StringVarP(&padReference, "reference", "r", "", "Reference sequence in fasta format, whose length to pad to")
+	padCmd.Flags().IntVarP(&padOptions.Length, "length", "l", 0, "Length to pad to, instead of the length of --reference")
+	padCmd.Flags().StringVarP(&padAnchor, "anchor", "", "start", "Which end of each record stays in place: start (pad or trim at the end) or end (pad or trim at the start)")
+	padCmd.Flags().StringVarP(&padChar, "char", "", "N", "Character to pad with")
+	padCmd.Flags().StringVarP(&padOptions.Short, "short", "", padOptions.Short, "What to do with short records: pad, skip or error")
+	padCmd.Flags().StringVarP(&padOptions.Long, "long", "", padOptions.Long, "What to do with long records: trim, skip or error")
+	padCmd.Flags().StringVarP(&padReport, "report", "", "", "(Optional) CSV file of the records that were padded, trimmed or skipped")
+	padCmd.Flags().StringVarP(&padOutfile, "outfile", "o", "stdout", "Fasta file to write")
+
+	padCmd.Flags().SortFlags = false
+}
+
+var padCmd = &cobra.Command{
+	Use:     "pad",
+	Aliases: []string{"normalize"},
+	Short:   "Pad or trim every record to the length of the reference",
+	Long: `Pad or trim every record to the length of the reference
+
+Example usage:
+	gofasta pad -f almost_aligned.fasta -r MN908947.fasta --report padded.csv -o aligned.fasta
+	gofasta pad -f almost_aligned.fasta -l 29903 --long skip --report wrong_length.csv -o aligned.fasta
+	gofasta pad -f almost_aligned.fasta -r MN908947.fasta --short error --long error -o aligned.fasta
+
+For files that are nearly alignments, e.g. consensus sequences in reference coordinates whose 3' ends have been
+cut short, so that commands that need an alignment fail with records that are not the same width. Each record is
+coerced to the length of --reference (or --length): short records are padded with --char, and long records are
+trimmed. --anchor start keeps each record's start in place, so that padding and trimming happen at its end;
+--anchor end keeps its end in place.
+
+Nothing is realigned, so this is only right if the records are already in the reference's coordinates and only
+differ at their ends: use gofasta align or gofasta pseudoalign for anything else. --short and --long can instead
+skip records of the wrong length, or stop with an error. --report has the columns record,length,action, with one
+line for each record that wasn't already the right length, where length is the original length and action is
+padded, trimmed or skipped.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if (padReference == "") == (padOptions.Length == 0) {
+			return errors.New("choose one of --reference or --length")
+		}
+		if len(padChar) != 1 {
+			return errors.New("--char must be a single character")
+		}
+		padOptions.Char = padChar[0]
+		switch padAnchor {
+		case "start":
+			padOptions.AnchorEnd = false
+		case "end":
+			padOptions.AnchorEnd = true
+		default:
+			return errors.New("--anchor must be start or end")
+		}
+
+		if padReference != "" {
+			refIn, err := gfio.OpenIn(*cmd.Flag("reference"))
+			if err != nil {
+				return err
+			}
+			defer refIn.Close()
+			refs, err := fastaio.ReadFastaToListUncounted(refIn)
+			if err != nil {
+				return err
+			}
+			if len(refs) != 1 {
+				return errors.New("there must be exactly one record in --reference")
+			}
+			padOptions.Length = len(refs[0].Seq)
+		}
+
+		in, err := gfio.OpenIn(*cmd.Flag("fasta"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		var reportOut io.Writer
+		if padReport != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("report"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			reportOut = f
+		}
+
+		err = pad.PadAll(cmd.Context(), in, out, reportOut, padOptions)
+
+		return
+	},
+}
@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"errors"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/align"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+)
+
+var pairalignA string
+var pairalignB string
+var pairalignOutfile string
+var pairalignVariants string
+var pairalignAlignment string
+var pairalignScoring = align.DefaultScoring()
+
+func init() {
+	rootCmd.AddCommand(pairalignCmd)
+
+	pairalignCmd.Flags().StringVarP(&pairalignA, "a", "a", "stdin", "First sequence, in fasta format, or both sequences if --b isn't given")
+	pairalignCmd.Flags().StringVarP(&pairalignB, "b", "b", "", "(Optional) Second sequence, in fasta format")
+	pairalignCmd.Flags().StringVarP(&pairalignOutfile, "outfile", "o", "stdout", "CSV file to write a summary of the alignment to")
+	pairalignCmd.Flags().StringVarP(&pairalignVariants, "variants", "", "", "(Optional) CSV file to write the SNPs and indels to")
+	pairalignCmd.Flags().StringVarP(&pairalignAlignment, "alignment", "", "", "(Optional) File to write the pairwise alignment to, in fasta format")
+	pairalignCmd.Flags().Int32VarP(&pairalignScoring.Match, "match", "", pairalignScoring.Match, "Score for a match")
+	pairalignCmd.Flags().Int32VarP(&pairalignScoring.Mismatch, "mismatch", "", pairalignScoring.Mismatch, "Penalty for a mismatch")
+	pairalignCmd.Flags().Int32VarP(&pairalignScoring.GapOpen, "gap-open", "", pairalignScoring.GapOpen, "Penalty for opening a gap")
+	pairalignCmd.Flags().Int32VarP(&pairalignScoring.GapExtend, "gap-extend", "", pairalignScoring.GapExtend, "Penalty for each base in a gap")
+	pairalignCmd.Flags().IntVarP(&pairalignScoring.Band, "band", "", pairalignScoring.Band, "Number of extra diagonals either side of the band suggested by k-mer matches")
+
+	pairalignCmd.Flags().SortFlags = false
+}
+
+var pairalignCmd = &cobra.Command{
+	Use:   "pairalign",
+	Short: "Align two sequences and list their differences",
+	Long: `Align two sequences and list their differences
+
+Example usage:
+	gofasta pairalign -a two_sequences.fasta
+	gofasta pairalign -a old_assembly.fasta -b new_assembly.fasta --variants differences.csv
+	cat a.fasta b.fasta | gofasta pairalign --alignment pair.fasta
+
+Either --a has exactly two records, or --a and --b have exactly one each. Gaps in the input are ignored. The
+second sequence (b) is aligned to the first (a), in the same way as gofasta align: globally with affine gap
+penalties and free end gaps, in a band of diagonals placed using exact 15-mer matches.
+
+--outfile is a two-column CSV (stat,value) with the names and lengths of the sequences, the parts of each that
+are aligned (1-based and inclusive, since either can overhang the other), the CIGAR string of b relative to a
+(=, X, I, D, and S for parts of b that overhang a), the percent identity over the aligned columns, counts of
+SNPs, insertions and deletions, and a ";"-delimited list of the differences as position:a>b in a's coordinates.
+Only pairs of different unambiguous nucleotides are SNPs.
+
+--variants has the columns type,a_position,b_position,a,b. For an indel, the position in the sequence without
+the bases is the one that the indel comes after.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		aIn, err := gfio.OpenIn(*cmd.Flag("a"))
+		if err != nil {
+			return err
+		}
+		defer aIn.Close()
+
+		records, err := fastaio.ReadFastaToList(aIn)
+		if err != nil {
+			return err
+		}
+
+		if pairalignB != "" {
+			if len(records) != 1 {
+				return errors.New("there must be exactly one record in --a when --b is given")
+			}
+			bIn, err := gfio.OpenIn(*cmd.Flag("b"))
+			if err != nil {
+				return err
+			}
+			defer bIn.Close()
+			bRecords, err := fastaio.ReadFastaToList(bIn)
+			if err != nil {
+				return err
+			}
+			if len(bRecords) != 1 {
+				return errors.New("there must be exactly one record in --b")
+			}
+			records = append(records, bRecords[0])
+		} else if len(records) != 2 {
+			return errors.New("there must be exactly two records in --a when --b isn't given")
+		}
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		var variantsOut io.Writer
+		if pairalignVariants != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("variants"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			variantsOut = f
+		}
+
+		var alignmentOut io.Writer
+		if pairalignAlignment != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("alignment"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			alignmentOut = f
+		}
+
+		err = align.PairAlign(records, out, variantsOut, alignmentOut, pairalignScoring)
+
+		return
+	},
+}
@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"errors"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/primers"
+)
+
+var primersMSA string
+var primersPrimers string
+var primersReference string
+var primersMaxMismatches int
+var primersThreePrime int
+var primersAll bool
+var primersOutfile string
+var primersSummary string
+
+func init() {
+	rootCmd.AddCommand(primersCmd)
+
+	primersCmd.Flags().StringVarP(&primersMSA, "msa", "", "stdin", "Alignment in fasta format, in the reference's coordinates")
+	primersCmd.Flags().StringVarP(&primersPrimers, "primers", "p", "", "Primer and probe sequences. Must have suffix .fasta, .bed, .tsv or .csv")
+	primersCmd.Flags().StringVarP(&primersReference, "reference", "r", "", "Reference sequence in fasta format, for finding the binding sites of primers without positions")
+	primersCmd.Flags().IntVarP(&primersMaxMismatches, "max-mismatches", "", 3, "Most mismatches to the reference allowed when finding binding sites")
+	primersCmd.Flags().IntVarP(&primersThreePrime, "three-prime", "", 5, "Number of bases at the 3' end of each primer in which differences are counted separately")
+	primersCmd.Flags().BoolVarP(&primersAll, "all", "", false, "Report every record and primer, not only those with differences")
+	primersCmd.Flags().StringVarP(&primersOutfile, "outfile", "o", "stdout", "CSV file of differences at primer binding sites to write")
+	primersCmd.Flags().StringVarP(&primersSummary, "summary", "s", "", "(Optional) CSV file of the number of records with differences at each primer to write")
+
+	primersCmd.Flags().Lookup("all").NoOptDefVal = "true"
+
+	primersCmd.Flags().SortFlags = false
+}
+
+var primersCmd = &cobra.Command{
+	Use:   "primers",
+	Short: "Check primer and probe binding sites in an alignment",
+	Long: `Check primer and probe binding sites in an alignment
+
+Example usage:
+	gofasta primers --msa aligned.fasta -p primers.tsv -r MN908947.fasta -s summary.csv -o primer_sites.csv
+	gofasta primers --msa aligned.fasta -p SARS-CoV-2.primer.bed -r MN908947.fasta --three-prime 3 -o primer_sites.csv
+
+--primers can be a fasta file (.fasta, .fa, .fna), a table with a header and the columns name and seq (.tsv or .csv),
+or a primer scheme BED file (.bed) with the columns chrom, start, end, name, pool and strand, and optionally the
+primer sequence. Primers from fasta files and tables are placed at their best match to --reference, on either strand,
+allowing up to --max-mismatches mismatches. Primers in BED files without sequences take them from --reference.
+
+The alignment must be in the reference's coordinates, e.g. the output of gofasta sam toMultiAlign or gofasta align.
+At each binding site, a mismatch is an unambiguous nucleotide that can't pair with the primer (which may contain
+degenerate bases), an ambiguity is an N or other ambiguity code, and a gap is a deletion. Mismatches and gaps in
+the --three-prime bases at the primer's 3' end, which matter most for amplification, are also counted separately.
+
+--outfile has the columns query,primer,start,end,strand,mismatches,three_prime,ambiguities,gaps,differences, with
+one row for each record and primer with a difference (or every one, with --all). start and end are 1-based and
+inclusive, and differences lists each mismatch and gap as position:expected>observed, where expected is the primer
+on the forward strand. --summary has the columns primer,records,with_mismatches,with_three_prime,with_ambiguities,
+with_gaps.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if primersPrimers == "" {
+			return errors.New("primers needs a file of --primers")
+		}
+
+		pIn, err := gfio.OpenIn(*cmd.Flag("primers"))
+		if err != nil {
+			return err
+		}
+		defer pIn.Close()
+		ps, err := primers.Read(pIn, primersPrimers)
+		if err != nil {
+			return err
+		}
+
+		var ref string
+		if primersReference != "" {
+			refIn, err := gfio.OpenIn(*cmd.Flag("reference"))
+			if err != nil {
+				return err
+			}
+			defer refIn.Close()
+			refs, err := fastaio.ReadFastaToListUncounted(refIn)
+			if err != nil {
+				return err
+			}
+			if len(refs) != 1 {
+				return errors.New("there must be exactly one record in --reference")
+			}
+			ref = refs[0].Seq
+		}
+
+		err = primers.Locate(ps, ref, primersMaxMismatches)
+		if err != nil {
+			return err
+		}
+
+		msa, err := gfio.OpenIn(*cmd.Flag("msa"))
+		if err != nil {
+			return err
+		}
+		defer msa.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		var summaryOut io.Writer
+		if primersSummary != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("summary"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			summaryOut = f
+		}
+
+		err = primers.Primers(cmd.Context(), msa, ps, out, summaryOut, primers.Options{ThreePrime: primersThreePrime, All: primersAll})
+
+		return
+	},
+}
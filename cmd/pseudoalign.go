@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"errors"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/align"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+)
+
+var pseudoalignThreads int
+var pseudoalignReference string
+var pseudoalignFasta string
+var pseudoalignOutfile string
+var pseudoalignReport string
+var pseudoalignMinAnchors int
+var pseudoalignPad string
+var pseudoalignScoring = align.DefaultScoring()
+
+func init() {
+	rootCmd.AddCommand(pseudoalignCmd)
+
+	pseudoalignScoring.Band = 20
+
+	pseudoalignCmd.Flags().IntVarP(&pseudoalignThreads, "threads", "t", 0, "Number of CPUs to use (Default: all available CPUs)")
+	pseudoalignCmd.Flags().StringVarP(&pseudoalignReference, "reference", "r", "", "Reference sequence, in fasta format")
+	pseudoalignCmd.Flags().StringVarP(&pseudoalignFasta, "fasta", "f", "stdin", "Fragments to place, in fasta format")
+	pseudoalignCmd.Flags().StringVarP(&pseudoalignOutfile, "outfile", "o", "stdout", "Alignment to write, in fasta format")
+	pseudoalignCmd.Flags().StringVarP(&pseudoalignReport, "report", "", "", "(Optional) CSV file of where each fragment was placed")
+	pseudoalignCmd.Flags().IntVarP(&pseudoalignMinAnchors, "min-anchors", "", 3, "Only place fragments with at least this many k-mers that occur once in the reference")
+	pseudoalignCmd.Flags().StringVarP(&pseudoalignPad, "pad", "", "N", "Character for the parts of the reference that a fragment doesn't cover")
+	pseudoalignCmd.Flags().IntVarP(&pseudoalignScoring.K, "kmer", "k", pseudoalignScoring.K, "K-mer length for anchoring fragments")
+	pseudoalignCmd.Flags().Int32VarP(&pseudoalignScoring.Match, "match", "", pseudoalignScoring.Match, "Score for a match")
+	pseudoalignCmd.Flags().Int32VarP(&pseudoalignScoring.Mismatch, "mismatch", "", pseudoalignScoring.Mismatch, "Penalty for a mismatch")
+	pseudoalignCmd.Flags().Int32VarP(&pseudoalignScoring.GapOpen, "gap-open", "", pseudoalignScoring.GapOpen, "Penalty for opening a gap")
+	pseudoalignCmd.Flags().Int32VarP(&pseudoalignScoring.GapExtend, "gap-extend", "", pseudoalignScoring.GapExtend, "Penalty for each base in a gap")
+	pseudoalignCmd.Flags().IntVarP(&pseudoalignScoring.Band, "band", "", pseudoalignScoring.Band, "Number of extra diagonals either side of the band suggested by k-mer matches")
+
+	pseudoalignCmd.Flags().SortFlags = false
+}
+
+var pseudoalignCmd = &cobra.Command{
+	Use:   "pseudoalign",
+	Short: "Place fragments, such as genes, reads or Sanger sequences, in reference coordinates",
+	Long: `Place fragments, such as genes, reads or Sanger sequences, in reference coordinates
+
+Example usage:
+	gofasta pseudoalign -r reference.fasta -f sanger.fasta -o placed.fasta
+	gofasta pseudoalign -r reference.fasta -f genes.fasta --report placements.csv -o placed.fasta
+	gofasta pseudoalign -r reference.fasta -f sanger.fasta | cat genomes_aligned.fasta - > combined.fasta
+
+Each fragment is anchored to the reference by its k-mers that occur exactly once there, on whichever strand has
+more of them, and then aligned to the region they point to with a banded alignment, as gofasta align does. The
+output is the same width as the reference, so that it can be combined with whole-genome alignments in reference
+coordinates, with --pad outside the part of the reference each fragment covers. Fragments on the reverse strand
+are reverse-complemented. Insertions relative to the reference are omitted, and deletions are gaps.
+
+Fragments with fewer than --min-anchors anchors aren't written. --report has the columns
+query,strand,ref_start,ref_end,anchors,identity,insertions for every fragment, where ref_start and ref_end are
+1-based and inclusive, identity is the percentage of aligned bases that match the reference, and insertions is the
+number of insertions that were left out. Only anchors is filled in for fragments that weren't placed.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if len(pseudoalignPad) != 1 {
+			return errors.New("--pad must be a single character")
+		}
+
+		ref, err := gfio.OpenIn(*cmd.Flag("reference"))
+		if err != nil {
+			return err
+		}
+		defer ref.Close()
+
+		in, err := gfio.OpenIn(*cmd.Flag("fasta"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		var reportOut io.Writer
+		if pseudoalignReport != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("report"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			reportOut = f
+		}
+
+		o := align.PseudoOptions{MinAnchors: pseudoalignMinAnchors, Pad: pseudoalignPad[0], Threads: pseudoalignThreads}
+		err = align.PseudoAlignToReference(cmd.Context(), ref, in, out, reportOut, pseudoalignScoring, o)
+
+		return
+	},
+}
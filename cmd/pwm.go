@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/pwm"
+)
+
+var pwmBuildMSA string
+var pwmBuildStart int
+var pwmBuildEnd int
+var pwmBuildOutfile string
+
+var pwmScanFasta string
+var pwmScanMatrix string
+var pwmScanPseudocount float64
+var pwmScanMinScore float64
+var pwmScanForward bool
+var pwmScanOutfile string
+
+func init() {
+	rootCmd.AddCommand(pwmCmd)
+	pwmCmd.AddCommand(pwmBuildCmd)
+	pwmCmd.AddCommand(pwmScanCmd)
+
+	pwmBuildCmd.Flags().StringVarP(&pwmBuildMSA, "msa", "", "stdin", "Alignment in fasta format to build the matrix from")
+	pwmBuildCmd.Flags().IntVarP(&pwmBuildStart, "start", "", -1, "1-based first alignment column of the profile. Omit it to start at the beginning of the alignment")
+	pwmBuildCmd.Flags().IntVarP(&pwmBuildEnd, "end", "", -1, "1-based last alignment column of the profile. Omit it to finish at the end of the alignment")
+	pwmBuildCmd.Flags().StringVarP(&pwmBuildOutfile, "outfile", "o", "stdout", "CSV file of nucleotide counts to write")
+	pwmBuildCmd.Flags().SortFlags = false
+
+	pwmScanCmd.Flags().StringVarP(&pwmScanFasta, "fasta", "f", "stdin", "Fasta file of sequences to scan")
+	pwmScanCmd.Flags().StringVarP(&pwmScanMatrix, "matrix", "m", "", "CSV file of nucleotide counts or frequencies, as written by gofasta pwm build")
+	pwmScanCmd.Flags().Float64VarP(&pwmScanPseudocount, "pseudocount", "", 1, "Pseudocount added to each position of the matrix before taking log-odds")
+	pwmScanCmd.Flags().Float64VarP(&pwmScanMinScore, "min-score", "", 0.8, "Smallest relative score (between 0 and 1) of a window to report")
+	pwmScanCmd.Flags().BoolVarP(&pwmScanForward, "forward", "", false, "Only scan the forward strand")
+	pwmScanCmd.Flags().Lookup("forward").NoOptDefVal = "true"
+	pwmScanCmd.Flags().StringVarP(&pwmScanOutfile, "outfile", "o", "stdout", "CSV file of hits to write")
+	pwmScanCmd.Flags().SortFlags = false
+}
+
+var pwmCmd = &cobra.Command{
+	Use:   "pwm",
+	Short: "Build position weight matrices and scan sequences with them",
+	Long:  `Build position weight matrices and scan sequences with them`,
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+
+		return nil
+	},
+}
+
+var pwmBuildCmd = &cobra.Command{
+	Use:   "build",
+	Short: "Count the nucleotides in each column of an alignment",
+	Long: `Count the nucleotides in each column of an alignment
+
+Example usage:
+	gofasta pwm build --msa alignment.fasta --start 21563 --end 21582 -o profile.csv
+
+--outfile has the columns position,A,C,G,T, with one line per alignment column from --start to --end. Only
+unambiguous nucleotides are counted, so a column's counts add up to the number of records with A, C, G or T in
+it. Build a profile from an alignment of the motifs themselves, or from a region of a whole-genome alignment.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in, err := gfio.OpenIn(*cmd.Flag("msa"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		m, err := pwm.Build(cmd.Context(), in, pwmBuildStart, pwmBuildEnd)
+		if err != nil {
+			return err
+		}
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		err = m.Write(out)
+
+		return
+	},
+}
+
+var pwmScanCmd = &cobra.Command{
+	Use:   "scan",
+	Short: "Find the windows of sequences that score highly against a profile",
+	Long: `Find the windows of sequences that score highly against a profile
+
+Example usage:
+	gofasta pwm scan -m profile.csv -f sequences.fasta -o hits.csv
+	gofasta pwm build --msa motifs.fasta | gofasta pwm scan -m stdin -f genomes.fasta --min-score 0.9
+
+The counts in --matrix are turned into log2-odds weights against a uniform background, after adding
+--pseudocount to each position. Every window of every record the width of the matrix is scored on both strands
+(or only the forward strand with --forward), and windows with a gap are skipped. An ambiguity code scores the
+mean of the weights of the nucleotides it can stand for.
+
+--outfile has the columns record,start,end,strand,score,relative_score,match. Start and end are 1-based
+and inclusive, on the forward strand of the record. relative_score is the score scaled between the lowest (0)
+and highest (1) possible scores of the matrix, and windows below --min-score aren't reported. match is the
+record's sequence in the window, reverse-complemented for hits on the - strand.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if pwmScanMatrix == "" {
+			return errors.New("gofasta pwm scan needs --matrix")
+		}
+
+		matrixIn, err := gfio.OpenIn(*cmd.Flag("matrix"))
+		if err != nil {
+			return err
+		}
+		defer matrixIn.Close()
+
+		m, err := pwm.Read(matrixIn)
+		if err != nil {
+			return err
+		}
+
+		in, err := gfio.OpenIn(*cmd.Flag("fasta"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		err = pwm.ScanAll(cmd.Context(), in, pwm.NewProfile(m.Weights(pwmScanPseudocount)), out, pwmScanMinScore, !pwmScanForward)
+
+		return
+	},
+}
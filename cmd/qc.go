@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/frameshift"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/qc"
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+var qcMSA string
+var qcReference string
+var qcAnnotation string
+var qcInsertions string
+var qcOutfile string
+var qcFormat string
+var qcThresholds = qc.DefaultThresholds()
+
+func init() {
+	rootCmd.AddCommand(qcCmd)
+
+	qcCmd.Flags().StringVarP(&qcMSA, "msa", "", "stdin", "Alignment in reference coordinates, in fasta format")
+	qcCmd.Flags().StringVarP(&qcReference, "reference", "r", "", "Reference sequence, in fasta format (default: the sequence in --annotation)")
+	qcCmd.Flags().StringVarP(&qcAnnotation, "annotation", "a", "", "(Optional) Genbank or GFF3 format annotation file, for checking frameshifts. Must have suffix .gb or .gff")
+	qcCmd.Flags().StringVarP(&qcInsertions, "insertions", "", "", "(Optional) CSV file of insertions from gofasta align, for checking frameshifts")
+	qcCmd.Flags().StringVarP(&qcOutfile, "outfile", "o", "stdout", "File to write the report to")
+	qcCmd.Flags().StringVarP(&qcFormat, "format", "", "csv", "Report format: csv or json")
+	qcCmd.Flags().Float64VarP(&qcThresholds.MinCompleteness, "min-completeness", "", qcThresholds.MinCompleteness, "Minimum proportion of reference positions with an unambiguous base")
+	qcCmd.Flags().IntVarP(&qcThresholds.MaxNRun, "max-n-run", "", 0, "Maximum length of a run of Ns (0 means no limit)")
+	qcCmd.Flags().IntVarP(&qcThresholds.MaxSNPs, "max-snps", "", 0, "Maximum number of SNPs relative to the reference (0 means no limit)")
+	qcCmd.Flags().IntVarP(&qcThresholds.MaxFrameshifts, "max-frameshifts", "", 0, "With --annotation, maximum number of frameshifting indels in CDS")
+
+	qcCmd.Flags().SortFlags = false
+}
+
+var qcCmd = &cobra.Command{
+	Use:   "qc",
+	Short: "Quality control report for aligned sequences",
+	Long: `Quality control report for aligned sequences
+
+Example usage:
+	gofasta qc --msa alignment.fasta -r reference.fasta --min-completeness 0.95 -o qc.csv
+	gofasta qc --msa alignment.fasta -a MN908947.gb --insertions insertions.csv --max-snps 50 --max-n-run 3000 -o qc.csv
+
+The alignment must be in reference coordinates, for example the output of gofasta align or gofasta sam toMultiAlign.
+Each sequence passes or fails according to:
+
+	completeness   the proportion of reference positions with an unambiguous base (--min-completeness)
+	longest N-run  the longest run of Ns (--max-n-run)
+	SNPs           the number of unambiguous differences from the reference (--max-snps)
+	frameshifts    deletions, and with --insertions, insertions, of a length that isn't a multiple of three within a
+	               CDS in --annotation (--max-frameshifts). Gaps at the ends of a sequence aren't counted
+
+The CSV report has the columns query,qc,completeness,longest_n_run,snps,frameshifts,reasons, where reasons lists the
+thresholds that a failing sequence didn't meet.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		c := qc.Checker{Thresholds: qcThresholds}
+
+		if qcReference != "" {
+			refIn, err := gfio.OpenIn(*cmd.Flag("reference"))
+			if err != nil {
+				return err
+			}
+			defer refIn.Close()
+			refs, err := fastaio.ReadFastaToListUncounted(refIn)
+			if err != nil {
+				return err
+			}
+			if len(refs) != 1 {
+				return errors.New("there must be exactly one record in --reference")
+			}
+			c.Ref = refs[0].Seq
+		}
+
+		if qcAnnotation != "" {
+			annoSuffix, err := variants.AnnotationSuffix(qcAnnotation)
+			if err != nil {
+				return err
+			}
+			anno, err := gfio.OpenIn(*cmd.Flag("annotation"))
+			if err != nil {
+				return err
+			}
+			defer anno.Close()
+			c.Regions, c.Ref, err = variants.ReadAnnotation(anno, annoSuffix, c.Ref)
+			if err != nil {
+				return err
+			}
+			c.Thresholds.CheckFrameshifts = true
+		}
+
+		if c.Ref == "" {
+			return errors.New("qc needs a --reference or an --annotation with a sequence")
+		}
+
+		if qcInsertions != "" {
+			if qcAnnotation == "" {
+				return errors.New("--insertions are only used for checking frameshifts, which needs an --annotation")
+			}
+			insIn, err := gfio.OpenIn(*cmd.Flag("insertions"))
+			if err != nil {
+				return err
+			}
+			defer insIn.Close()
+			c.Insertions, err = frameshift.ReadInsertions(insIn)
+			if err != nil {
+				return err
+			}
+		}
+
+		msa, err := gfio.OpenIn(*cmd.Flag("msa"))
+		if err != nil {
+			return err
+		}
+		defer msa.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		err = c.QC(cmd.Context(), msa, out, qcFormat)
+
+		return
+	},
+}
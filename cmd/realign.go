@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"errors"
+	"io"
+	"runtime"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/align"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/realign"
+	"github.com/virus-evolution/gofasta/pkg/sam"
+)
+
+var realignThreads int
+var realignMSA string
+var realignReference string
+var realignReferenceName string
+var realignSAM string
+var realignOutfile string
+var realignInsertions string
+var realignScoring = align.DefaultScoring()
+
+func init() {
+	rootCmd.AddCommand(realignCmd)
+
+	realignCmd.Flags().IntVarP(&realignThreads, "threads", "t", 0, "Number of CPUs to use (Default: all available CPUs)")
+	realignCmd.Flags().StringVarP(&realignMSA, "msa", "", "stdin", "Alignment to reproject, in fasta format")
+	realignCmd.Flags().StringVarP(&realignReference, "reference", "r", "", "New reference sequence, in fasta format, to align the records to")
+	realignCmd.Flags().StringVarP(&realignReferenceName, "reference-name", "", "", "Name of the record in --msa to use as the new reference. The alignment is projected onto it without re-aligning")
+	realignCmd.Flags().StringVarP(&realignSAM, "sam", "s", "", "SAM file of the records aligned to the new reference, to convert instead of aligning with the built-in aligner")
+	realignCmd.Flags().StringVarP(&realignOutfile, "outfile", "o", "stdout", "Alignment to write, in fasta format")
+	realignCmd.Flags().StringVarP(&realignInsertions, "insertions", "", "", "(Optional) CSV file to write insertions relative to the new reference to")
+	realignCmd.Flags().Int32VarP(&realignScoring.Match, "match", "", realignScoring.Match, "Score for a match")
+	realignCmd.Flags().Int32VarP(&realignScoring.Mismatch, "mismatch", "", realignScoring.Mismatch, "Penalty for a mismatch")
+	realignCmd.Flags().Int32VarP(&realignScoring.GapOpen, "gap-open", "", realignScoring.GapOpen, "Penalty for opening a gap")
+	realignCmd.Flags().Int32VarP(&realignScoring.GapExtend, "gap-extend", "", realignScoring.GapExtend, "Penalty for each base in a gap")
+	realignCmd.Flags().IntVarP(&realignScoring.Band, "band", "", realignScoring.Band, "Number of extra diagonals either side of the band suggested by k-mer matches")
+
+	realignCmd.Flags().SortFlags = false
+}
+
+var realignCmd = &cobra.Command{
+	Use:   "realign",
+	Short: "Reproject an alignment onto a new reference",
+	Long: `Reproject an alignment onto a new reference
+
+Example usage:
+	gofasta realign --msa alignment.fasta -r new_reference.fasta -o realigned.fasta
+	gofasta realign --msa alignment.fasta --reference-name hCoV-19/Wuhan/WH04/2020 --insertions insertions.csv -o realigned.fasta
+	minimap2 -a -x asm20 --score-N=0 new_reference.fasta sequences.fasta | gofasta realign -s stdin -o realigned.fasta
+
+The output is in the coordinates of the new reference, the same width as it without gaps, so that positions in
+it are positions in the new reference. Insertions relative to the new reference are omitted from the alignment;
+--insertions is a CSV file with the columns query,ref_position,insertion, as written by gofasta align.
+
+There are three ways to reproject, and exactly one of --reference, --reference-name and --sam must be given:
+
+--reference: every record in --msa is degapped and aligned to the new reference with the built-in aligner (see
+gofasta align, whose scoring options are also available here). This works for any new reference, but assumes
+closely related sequences.
+
+--reference-name: the new reference is a record in --msa, and the alignment is projected onto it by removing
+every column where it has a gap, so that the existing alignment is kept as it is. The alignment is read into
+memory.
+
+--sam: the records have already been aligned to the new reference, e.g. by minimap2, and the SAM file is
+converted as gofasta sam toMultiAlign would. --msa isn't used, and --insertions isn't available.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		given := 0
+		for _, f := range []string{realignReference, realignReferenceName, realignSAM} {
+			if f != "" {
+				given++
+			}
+		}
+		if given != 1 {
+			return errors.New("realign needs exactly one of --reference, --reference-name and --sam")
+		}
+		if realignSAM != "" && realignInsertions != "" {
+			return errors.New("--insertions can't be used with --sam")
+		}
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		if realignSAM != "" {
+			samIn, err := gfio.OpenIn(*cmd.Flag("sam"))
+			if err != nil {
+				return err
+			}
+			defer samIn.Close()
+			threads := realignThreads
+			if threads < 1 {
+				threads = runtime.NumCPU()
+			}
+			err = sam.ToMultiAlign(cmd.Context(), samIn, out, sam.MultiAlignOptions{Threads: threads})
+			return err
+		}
+
+		var insOut io.Writer
+		if realignInsertions != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("insertions"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			insOut = f
+		}
+
+		msa, err := gfio.OpenIn(*cmd.Flag("msa"))
+		if err != nil {
+			return err
+		}
+		defer msa.Close()
+
+		if realignReferenceName != "" {
+			err = realign.Project(msa, realignReferenceName, out, insOut)
+			return
+		}
+
+		refIn, err := gfio.OpenIn(*cmd.Flag("reference"))
+		if err != nil {
+			return err
+		}
+		defer refIn.Close()
+		refs, err := fastaio.ReadFastaToListUncounted(refIn)
+		if err != nil {
+			return err
+		}
+		if len(refs) != 1 {
+			return errors.New("there must be exactly one record in --reference")
+		}
+
+		err = realign.Realign(cmd.Context(), msa, refs[0].Seq, out, insOut, realignScoring, realignThreads)
+
+		return
+	},
+}
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"errors"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/distance"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/recombination"
+)
+
+var recombinationQuery string
+var recombinationParents string
+var recombinationOutfile string
+var recombinationWindowsOut string
+var recombinationOptions = recombination.DefaultOptions()
+
+func init() {
+	rootCmd.AddCommand(recombinationCmd)
+
+	recombinationCmd.Flags().IntVarP(&recombinationOptions.Threads, "threads", "t", 0, "Number of CPUs to use (Default: all available CPUs)")
+	recombinationCmd.Flags().StringVarP(&recombinationQuery, "query", "q", "stdin", "Alignment of sequences to screen, in fasta format")
+	recombinationCmd.Flags().StringVarP(&recombinationParents, "parents", "p", "", "Alignment of putative parents, in fasta format")
+	recombinationCmd.Flags().StringVarP(&recombinationOutfile, "outfile", "o", "stdout", "CSV file of switches in the nearest parent to write")
+	recombinationCmd.Flags().StringVarP(&recombinationWindowsOut, "windows", "", "", "(Optional) CSV file of the distance from each query to each parent in each window to write")
+	recombinationCmd.Flags().IntVarP(&recombinationOptions.Window, "window", "w", recombinationOptions.Window, "Window size, in alignment columns")
+	recombinationCmd.Flags().IntVarP(&recombinationOptions.Step, "step", "", recombinationOptions.Step, "Distance between the starts of neighbouring windows")
+	recombinationCmd.Flags().Float64VarP(&recombinationOptions.MaxP, "max-p", "", recombinationOptions.MaxP, "Only report switches with a p-value at most this")
+
+	recombinationCmd.Flags().SortFlags = false
+}
+
+var recombinationCmd = &cobra.Command{
+	Use:   "recombination",
+	Short: "Screen sequences for switches in their nearest parent along the alignment",
+	Long: `Screen sequences for switches in their nearest parent along the alignment
+
+Example usage:
+	gofasta recombination -q queries.fasta -p lineage_representatives.fasta -o switches.csv
+	gofasta recombination -q queries.fasta -p parents.fasta -w 2000 --step 500 --windows windows.csv -o switches.csv
+
+Each query is compared to every sequence in --parents (except one with the same name) in windows along the
+alignment, using the SNP-distance as in gofasta snps, closest, etc. Where the single nearest parent changes
+between neighbouring stretches of windows, the breakpoint is placed, within those windows, at the split of the
+sites that tell the two parents apart (sites where the query matches only one of them) that puts the most
+sites supporting the left parent on the left and the most supporting the right parent on the right. The
+p-value is Fisher's exact test for the left parent's sites being on the left by chance. It isn't corrected for
+the number of parents or queries, and all parents are assumed to be non-recombinant.
+
+--outfile has the columns query,parent_a,parent_b,region_start,region_end,breakpoint_start,breakpoint_end,
+a_sites_left,b_sites_left,a_sites_right,b_sites_right,p_value, where parent_a is nearest to the left of the
+breakpoint, the region is the windows that were compared, and the breakpoint is between the informative sites
+breakpoint_start and breakpoint_end. Positions are 1-based. --windows has one row per query and window, with
+the distance to each parent and the single nearest parent (empty if there was a tie).
+
+--query and --parents must all be aligned to each other, and are read into memory. This is a quick screen, not
+a replacement for dedicated recombination detection methods.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if recombinationParents == "" {
+			return errors.New("recombination needs --parents")
+		}
+
+		parentsIn, err := gfio.OpenIn(*cmd.Flag("parents"))
+		if err != nil {
+			return err
+		}
+		defer parentsIn.Close()
+		parents, err := distance.PackAlignment(parentsIn)
+		if err != nil {
+			return err
+		}
+
+		queryIn, err := gfio.OpenIn(*cmd.Flag("query"))
+		if err != nil {
+			return err
+		}
+		defer queryIn.Close()
+		queries, err := distance.PackAlignment(queryIn)
+		if err != nil {
+			return err
+		}
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		var windowsOut io.Writer
+		if recombinationWindowsOut != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("windows"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			windowsOut = f
+		}
+
+		err = recombination.Recombination(queries, parents, out, windowsOut, recombinationOptions)
+
+		return
+	},
+}
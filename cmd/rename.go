@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"errors"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/rename"
+)
+
+var renameFasta string
+var renameOutfile string
+var renameMapping string
+var renameTemplate string
+var renameDelimiter string
+var renameStrict bool
+var renameBackMap string
+
+func init() {
+	rootCmd.AddCommand(renameCmd)
+
+	renameCmd.Flags().StringVarP(&renameFasta, "fasta", "f", "stdin", "Fasta file of records to rename")
+	renameCmd.Flags().StringVarP(&renameOutfile, "outfile", "o", "stdout", "Fasta file of renamed records to write")
+	renameCmd.Flags().StringVarP(&renameMapping, "mapping", "m", "", "Two-column CSV file of old name, new name")
+	renameCmd.Flags().StringVarP(&renameTemplate, "template", "", "", "Template for new names, e.g. \"{2}_{3}\". See below")
+	renameCmd.Flags().StringVarP(&renameDelimiter, "delimiter", "", "|", "Delimiter to split IDs on to get the fields for --template")
+	renameCmd.Flags().BoolVarP(&renameStrict, "strict", "", false, "Exit with an error if a record can't be renamed, instead of keeping its name")
+	renameCmd.Flags().StringVarP(&renameBackMap, "back-map", "", "", "(Optional) CSV file of new name, old name to write")
+
+	renameCmd.Flags().Lookup("strict").NoOptDefVal = "true"
+
+	renameCmd.Flags().SortFlags = false
+}
+
+var renameCmd = &cobra.Command{
+	Use:   "rename",
+	Short: "Rename records from a mapping file or a template",
+	Long: `Rename records from a mapping file or a template
+
+Example usage:
+	gofasta rename -f sequences.fasta -m old_to_new.csv -o renamed.fasta --back-map new_to_old.csv
+	gofasta rename -f gisaid.fasta --template "{2}" --delimiter "|" -o renamed.fasta
+
+--mapping is a CSV file of old name, new name pairs, optionally with the header old,new. Only the record ID (the
+header up to the first whitespace) is replaced; any description after it is kept.
+
+--template builds new names from the existing ones: {id} is the whole ID, {1}, {2}, ... are the fields of the ID
+split on --delimiter, and {header} is the whole header line with whitespace replaced by underscores (in which case
+the description isn't kept separately).
+
+Records that aren't in --mapping, or that don't have enough fields for --template, keep their names unless --strict
+is set. It is always an error for two records to end up with the same name.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if (renameMapping == "") == (renameTemplate == "") {
+			return errors.New("use exactly one of --mapping or --template")
+		}
+
+		var mapping map[string]string
+		var tmpl *rename.Template
+		if renameMapping != "" {
+			mappingIn, err := gfio.OpenIn(*cmd.Flag("mapping"))
+			if err != nil {
+				return err
+			}
+			defer mappingIn.Close()
+			mapping, err = rename.ReadMapping(mappingIn)
+			if err != nil {
+				return err
+			}
+		} else {
+			t, err := rename.ParseTemplate(renameTemplate, renameDelimiter)
+			if err != nil {
+				return err
+			}
+			tmpl = &t
+		}
+
+		in, err := gfio.OpenIn(*cmd.Flag("fasta"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		var backOut io.Writer
+		if renameBackMap != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("back-map"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			backOut = f
+		}
+
+		err = rename.Rename(cmd.Context(), in, out, backOut, mapping, tmpl, renameStrict)
+
+		return
+	},
+}
@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/restrict"
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+var restrictMSA string
+var restrictAnnotation string
+var restrictOutdir string
+var restrictGenes []string
+var restrictMode string
+var restrictCode int
+
+func init() {
+	rootCmd.AddCommand(restrictCmd)
+
+	restrictCmd.Flags().StringVarP(&restrictMSA, "msa", "", "stdin", "Alignment in the annotation's coordinates, in fasta format")
+	restrictCmd.Flags().StringVarP(&restrictAnnotation, "annotation", "a", "", "Genbank or GFF3 format annotation file. Must have suffix .gb or .gff, and include the reference sequence")
+	restrictCmd.Flags().StringVarP(&restrictOutdir, "outdir", "d", ".", "Directory to write one fasta file per CDS to")
+	restrictCmd.Flags().StringSliceVarP(&restrictGenes, "genes", "", []string{}, "Only write these CDS (comma-separated). Default: all of them")
+	restrictCmd.Flags().StringVarP(&restrictMode, "mode", "", "extent", "What to write for each CDS: extent, codon or protein")
+	restrictCmd.Flags().IntVarP(&restrictCode, "code", "", 1, "With --mode protein, the NCBI translation table to use")
+
+	restrictCmd.Flags().SortFlags = false
+}
+
+var restrictCmd = &cobra.Command{
+	Use:   "restrict",
+	Short: "Write one sub-alignment per gene, using an annotation",
+	Long: `Write one sub-alignment per gene, using an annotation
+
+Example usage:
+	gofasta restrict --msa alignment.fasta -a MN908947.gb -d genes
+	gofasta restrict --msa alignment.fasta -a MN908947.gb --genes S,N --mode codon -d genes
+	gofasta restrict --msa alignment.fasta -a MN908947.gb --mode protein -d proteins
+
+The alignment must be in the annotation's coordinates, for example the output of gofasta sam toMultiAlign. One file,
+named after the CDS, is written to --outdir for each CDS. CDS that share a name are numbered _2, _3, etc.
+
+--mode extent writes the alignment columns from the start to the end of each CDS, as they are. --mode codon writes
+the coding sequence itself: joined across any ribosomal slippage or splicing, and reverse-complemented for CDS on the
+reverse strand, so that every sub-alignment starts with the first codon and is in frame. --mode protein writes its
+translation.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if restrictAnnotation == "" {
+			return errors.New("restrict needs an --annotation")
+		}
+		annoSuffix, err := variants.AnnotationSuffix(restrictAnnotation)
+		if err != nil {
+			return err
+		}
+		anno, err := gfio.OpenIn(*cmd.Flag("annotation"))
+		if err != nil {
+			return err
+		}
+		defer anno.Close()
+
+		regions, refSeq, err := variants.ReadAnnotation(anno, annoSuffix, "")
+		if err != nil {
+			return err
+		}
+
+		if len(restrictGenes) > 0 {
+			regions, err = variants.SelectRegions(regions, restrictGenes)
+			if err != nil {
+				return err
+			}
+		}
+
+		msa, err := gfio.OpenIn(*cmd.Flag("msa"))
+		if err != nil {
+			return err
+		}
+		defer msa.Close()
+
+		outs, closeOuts, err := regionOutputs(restrictOutdir, regions)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if cerr := closeOuts(); cerr != nil && err == nil {
+				err = cerr
+			}
+		}()
+
+		err = restrict.Restrict(cmd.Context(), msa, regions, len(refSeq), outs, restrictMode, restrictCode)
+
+		return
+	},
+}
@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"errors"
+	"io"
+	"regexp"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/extract"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/revcomp"
+)
+
+var revcompFasta string
+var revcompOutfile string
+var revcompNames []string
+var revcompNamesFile string
+var revcompRegex string
+var revcompReference string
+var revcompK int
+var revcompReport string
+
+func init() {
+	rootCmd.AddCommand(revcompCmd)
+
+	revcompCmd.Flags().StringVarP(&revcompFasta, "fasta", "f", "stdin", "Fasta file of sequences to reverse-complement")
+	revcompCmd.Flags().StringVarP(&revcompOutfile, "outfile", "o", "stdout", "Fasta file to write")
+	revcompCmd.Flags().StringSliceVarP(&revcompNames, "name", "n", []string{}, "Name of a record to reverse-complement. Can be given more than once, or as a comma-separated list")
+	revcompCmd.Flags().StringVarP(&revcompNamesFile, "names", "", "", "Plain text file of names of records to reverse-complement, one per line")
+	revcompCmd.Flags().StringVarP(&revcompRegex, "regex", "", "", "Reverse-complement records whose header line matches this regular expression")
+	revcompCmd.Flags().StringVarP(&revcompReference, "reference", "r", "", "Orient every record to the same strand as the sequence in this fasta file")
+	revcompCmd.Flags().IntVarP(&revcompK, "kmer", "k", 15, "With --reference, k-mer size to use for strand detection")
+	revcompCmd.Flags().StringVarP(&revcompReport, "report", "", "", "With --reference, optional CSV file of the strand detected for each record")
+
+	revcompCmd.Flags().SortFlags = false
+}
+
+var revcompCmd = &cobra.Command{
+	Use:   "revcomp",
+	Short: "Reverse-complement sequences",
+	Long: `Reverse-complement sequences
+
+Example usage:
+	gofasta revcomp -f sequences.fasta -o revcomp.fasta
+	gofasta revcomp -f sequences.fasta -n seq1,seq2 -o fixed.fasta
+	gofasta revcomp -f reads.fasta -r MN908947.fasta --report strands.csv -o oriented.fasta
+
+By default every record is reverse-complemented. With --name, --names or --regex only the records that are
+named (matched against record IDs) or whose header line matches the regular expression are, and every other
+record is written unchanged. IUPAC ambiguity codes are complemented (e.g. R becomes Y), and gaps, N and ? are
+left as they are.
+
+With --reference, each record is instead written on whichever strand shares more k-mers with the reference,
+so that a file of sequences in mixed orientations can be made consistent. Records that share no more k-mers
+with the reverse strand than with the forward strand are left as they are.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		names := revcompNames
+		if revcompNamesFile != "" {
+			namesIn, err := gfio.OpenIn(*cmd.Flag("names"))
+			if err != nil {
+				return err
+			}
+			defer namesIn.Close()
+			fromFile, err := extract.ReadNames(namesIn)
+			if err != nil {
+				return err
+			}
+			names = append(names, fromFile...)
+		}
+
+		var re *regexp.Regexp
+		if revcompRegex != "" {
+			re, err = regexp.Compile(revcompRegex)
+			if err != nil {
+				return err
+			}
+		}
+
+		if revcompReference != "" && (len(names) > 0 || re != nil) {
+			return errors.New("--reference can't be used with --name, --names or --regex")
+		}
+
+		in, err := gfio.OpenIn(*cmd.Flag("fasta"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		if revcompReference == "" {
+			nameSet := make(map[string]bool, len(names))
+			for _, n := range names {
+				nameSet[n] = true
+			}
+			return revcomp.RevComp(cmd.Context(), in, out, nameSet, re)
+		}
+
+		if revcompK < 1 {
+			return errors.New("--kmer must be at least 1")
+		}
+
+		refIn, err := gfio.OpenIn(*cmd.Flag("reference"))
+		if err != nil {
+			return err
+		}
+		defer refIn.Close()
+		refs, err := fastaio.ReadFastaToListUncounted(refIn)
+		if err != nil {
+			return err
+		}
+		if len(refs) != 1 {
+			return errors.New("there must be exactly one record in --reference")
+		}
+
+		var report io.Writer
+		if revcompReport != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("report"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			report = f
+		}
+
+		err = revcomp.Orient(cmd.Context(), in, out, refs[0].Seq, revcompK, report)
+
+		return
+	},
+}
@@ -1,10 +1,20 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
+	"os/signal"
+	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/internal/pipeline"
+
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/summary"
 )
 
 var (
@@ -18,13 +28,106 @@ If you use gofasta in your work, please cite:
 Jackson B (2022). gofasta: command-line utilities for genomic epidemiology research. Bioinformatics 38 (16), 4033-4035
 https://doi.org/10.1093/bioinformatics/btac424`,
 		Version: "1.2.1",
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if cmd.Flags().Changed("ordered") && cmd.Flags().Changed("unordered") {
+				return errors.New("--ordered and --unordered can't both be given")
+			}
+			if unordered || (cmd.Flags().Changed("ordered") && !ordered) {
+				cmd.SetContext(pipeline.Unordered(cmd.Context()))
+			}
+			return nil
+		},
 	}
 )
 
-// Execute executes the root command.
+var summaryOut string
+var seed int64
+var ordered, unordered bool
+
+// seedUsed is the seed that randomSeed returned, if a command called it, so that it can be written to --summary-out
+var seedUsed *int64
+
+func init() {
+	rootCmd.PersistentFlags().StringVarP(&summaryOut, "summary-out", "", "", "(Optional) write a JSON summary of the run (records processed and skipped, warnings, time and memory use) to this file")
+	rootCmd.PersistentFlags().Int64VarP(&seed, "seed", "", 0, "Seed for the random number generator of any command that uses one (default: chosen from the time, and reported on stderr)")
+	rootCmd.PersistentFlags().BoolVarP(&ordered, "ordered", "", false, "Write the results of commands that process records concurrently in the order of their input (the default)")
+	rootCmd.PersistentFlags().Lookup("ordered").NoOptDefVal = "true"
+	rootCmd.PersistentFlags().BoolVarP(&unordered, "unordered", "", false, "Write the results of snps, assign, chunkdist, closest --stream-queries, search, variants and sam toma as soon as\n"+
+		"each is ready, in whatever order that is, so that one slow record doesn't hold up the ones after it. Every other\n"+
+		"command writes its results in order regardless")
+	rootCmd.PersistentFlags().Lookup("unordered").NoOptDefVal = "true"
+	rootCmd.PersistentFlags().IntVarP(&gfio.Remote.Retries, "remote-retries", "", gfio.Remote.Retries, "Number of times to retry fetching an input given as an http(s) URL")
+	rootCmd.PersistentFlags().DurationVarP(&gfio.Remote.Timeout, "remote-timeout", "", gfio.Remote.Timeout, "Timeout for each attempt at fetching an input given as an http(s) URL")
+}
+
+// Execute executes the root command. The first interrupt (Ctrl-C, say) cancels the command's context, so that
+// it can stop and close its outputs cleanly. A second interrupt doesn't wait: it flushes the outputs and exits
 func Execute() {
-	if err := rootCmd.Execute(); err != nil {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	go func() {
+		<-ctx.Done()
+		gfio.FlushOnInterrupt()
+		stop()
+	}()
+	c, err := rootCmd.ExecuteContextC(ctx)
+	interrupted := ctx.Err() != nil
+	stop()
+	// the outputs are buffered, so a write can fail as late as the last flush
+	if cerr := gfio.CloseAll(); err == nil {
+		err = cerr
+	}
+	if werr := summary.Collect().WriteWarnings(os.Stderr); werr != nil {
+		fmt.Fprintln(os.Stderr, werr)
+	}
+	if summaryOut != "" {
+		if serr := writeSummary(c, err); serr != nil {
+			fmt.Fprintln(os.Stderr, serr)
+		}
+	}
+	if interrupted && errors.Is(err, context.Canceled) {
+		fmt.Fprintln(os.Stderr, "interrupted")
+		os.Exit(130)
+	}
+	if err != nil {
 		fmt.Println(err)
 		os.Exit(1)
 	}
 }
+
+// randomSeed returns --seed, or if it wasn't given, a seed chosen from the time, which is reported on stderr so
+// that the run can be repeated exactly. Every command that uses randomness takes its seed from here
+func randomSeed(cmd *cobra.Command) int64 {
+	if seedUsed == nil {
+		s := seed
+		if !cmd.Flags().Changed("seed") {
+			s = time.Now().UnixNano()
+			os.Stderr.WriteString("using random seed " + strconv.FormatInt(s, 10) + "\n")
+		}
+		seedUsed = &s
+	}
+	return *seedUsed
+}
+
+// writeSummary writes the machine-readable summary of this run to --summary-out
+func writeSummary(c *cobra.Command, runErr error) error {
+	s := summary.Collect()
+	if c != nil {
+		s.Command = c.CommandPath()
+	}
+	s.Args = os.Args[1:]
+	s.Success = runErr == nil
+	s.Seed = seedUsed
+	if runErr != nil {
+		s.Error = runErr.Error()
+	}
+
+	f, err := os.Create(summaryOut)
+	if err != nil {
+		return err
+	}
+	if err = s.Write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"errors"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/metadata"
+	"github.com/virus-evolution/gofasta/pkg/roottotip"
+)
+
+var rootToTipMSA string
+var rootToTipReference string
+var rootToTipMetadata string
+var rootToTipNameColumn string
+var rootToTipDateColumn string
+var rootToTipOutfile string
+var rootToTipRegression string
+
+func init() {
+	rootCmd.AddCommand(rootToTipCmd)
+
+	rootToTipCmd.Flags().StringVarP(&rootToTipMSA, "msa", "", "stdin", "Multiple sequence alignment in fasta format")
+	rootToTipCmd.Flags().StringVarP(&rootToTipReference, "reference", "r", "", "(Optional) root sequence in fasta format, aligned to --msa. Default is the consensus of --msa")
+	rootToTipCmd.Flags().StringVarP(&rootToTipMetadata, "metadata", "m", "", "CSV/TSV file with the sampling date of each record")
+	rootToTipCmd.Flags().StringVarP(&rootToTipNameColumn, "name-column", "", "name", "Column in --metadata with the sequence names")
+	rootToTipCmd.Flags().StringVarP(&rootToTipDateColumn, "date-column", "", "date", "Column in --metadata with the dates, as YYYY-MM-DD or decimal years")
+	rootToTipCmd.Flags().StringVarP(&rootToTipOutfile, "outfile", "o", "stdout", "CSV file of root-to-tip divergences to write")
+	rootToTipCmd.Flags().StringVarP(&rootToTipRegression, "regression", "", "", "(Optional) CSV file of the regression to write. Otherwise it is summarised on stderr")
+
+	rootToTipCmd.Flags().SortFlags = false
+}
+
+var rootToTipCmd = &cobra.Command{
+	Use:     "root-to-tip",
+	Aliases: []string{"roottotip"},
+	Short:   "Regress divergence from the root on sampling date",
+	Long: `Regress divergence from the root on sampling date
+
+Example usage:
+	gofasta root-to-tip --msa alignment.fasta -r MN908947.fasta -m metadata.csv -o roottotip.csv
+	gofasta root-to-tip --msa alignment.fasta -m metadata.csv --date-column collection_date --regression regression.csv -o roottotip.csv
+
+Each record's divergence from the root is the proportion of sites, where both it and the root have an
+unambiguous nucleotide, at which they differ. The root is --reference, or the majority consensus of --msa if
+it isn't given (in which case the alignment is read into memory). Divergence is regressed on sampling date by
+least squares: the slope is a rough substitution rate (substitutions per site per year), and the root date is
+where the fitted line meets zero divergence. This doesn't account for the shared ancestry of the sequences, so
+is a check for clock-like signal and for outliers, not an estimate to report.
+
+--outfile has the columns record,date,decimal_date,distance,compared_sites,divergence,residual, where distance
+is the number of differences from the root at compared_sites, and residual is divergence minus its fitted value.
+Records without a date in --metadata are skipped. --regression has the columns n,rate,intercept,root_date,r_squared.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if rootToTipMetadata == "" {
+			return errors.New("gofasta root-to-tip needs --metadata")
+		}
+
+		f, err := gfio.OpenIn(*cmd.Flag("metadata"))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		table, err := metadata.Read(f, metadata.SepFromPath(rootToTipMetadata), rootToTipNameColumn)
+		if err != nil {
+			return err
+		}
+		if !table.HasColumn(rootToTipDateColumn) {
+			return errors.New("no column called " + rootToTipDateColumn + " in metadata")
+		}
+		dateOf := func(name string) (string, bool) {
+			return table.Get(name, rootToTipDateColumn)
+		}
+
+		var root []byte
+		if rootToTipReference != "" {
+			refIn, err := gfio.OpenIn(*cmd.Flag("reference"))
+			if err != nil {
+				return err
+			}
+			defer refIn.Close()
+			refs, err := fastaio.ReadEncodeAlignmentToListUncounted(refIn, false)
+			if err != nil {
+				return err
+			}
+			if len(refs) != 1 {
+				return errors.New("there must be exactly one record in --reference")
+			}
+			root = refs[0].Seq
+		}
+
+		msa, err := gfio.OpenIn(*cmd.Flag("msa"))
+		if err != nil {
+			return err
+		}
+		defer msa.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		var regressionOut io.Writer
+		if rootToTipRegression != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("regression"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			regressionOut = f
+		}
+
+		r, err := roottotip.RootToTip(cmd.Context(), msa, root, dateOf, out, regressionOut)
+		if err != nil {
+			return err
+		}
+
+		if regressionOut == nil {
+			os.Stderr.WriteString("n = " + strconv.Itoa(r.N) + ", rate = " + strconv.FormatFloat(r.Slope, 'g', 4, 64) +
+				" substitutions/site/year, root date = " + strconv.FormatFloat(r.RootDate, 'f', 2, 64) +
+				", R squared = " + strconv.FormatFloat(r.R2, 'f', 4, 64) + "\n")
+		}
+
+		return
+	},
+}
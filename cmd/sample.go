@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/metadata"
+	"github.com/virus-evolution/gofasta/pkg/sample"
+)
+
+var sampleFasta string
+var sampleOutfile string
+var sampleCount int
+var sampleFraction float64
+var sampleMetadata string
+var sampleNameColumn string
+var sampleGroupBy []string
+var sampleEpiWeek string
+
+func init() {
+	rootCmd.AddCommand(sampleCmd)
+
+	sampleCmd.Flags().StringVarP(&sampleFasta, "fasta", "f", "stdin", "Fasta file to sample from")
+	sampleCmd.Flags().StringVarP(&sampleOutfile, "outfile", "o", "stdout", "Fasta file to write")
+	sampleCmd.Flags().IntVarP(&sampleCount, "number", "n", 0, "Number of records to keep (per group, with --group-by or --epi-week)")
+	sampleCmd.Flags().Float64VarP(&sampleFraction, "fraction", "p", 0, "Proportion of records to keep")
+	sampleCmd.Flags().StringVarP(&sampleMetadata, "metadata", "m", "", "CSV/TSV metadata file, for sampling by group")
+	sampleCmd.Flags().StringVarP(&sampleNameColumn, "name-column", "", "name", "Column in --metadata with the sequence names")
+	sampleCmd.Flags().StringSliceVarP(&sampleGroupBy, "group-by", "g", []string{}, "Column(s) in --metadata to group records by")
+	sampleCmd.Flags().StringVarP(&sampleEpiWeek, "epi-week", "", "", "Column in --metadata of dates (YYYY-MM-DD). Records are also grouped by their epi-week")
+
+	sampleCmd.Flags().SortFlags = false
+}
+
+var sampleCmd = &cobra.Command{
+	Use:   "sample",
+	Short: "Randomly subsample the records in a fasta file",
+	Long: `Randomly subsample the records in a fasta file
+
+Example usage:
+	gofasta sample -f sequences.fasta -n 1000 --seed 42 -o sampled.fasta
+	gofasta sample -f sequences.fasta -p 0.1 -o sampled.fasta
+	gofasta sample -f sequences.fasta -m metadata.csv -g lineage --epi-week date -n 5 -o sampled.fasta
+
+With --number, exactly that many records are kept (or all of them, if there are fewer). With --fraction, each
+record is kept independently with that probability, so the number kept varies. Records are written in their
+input order, and the input is only read once.
+
+With --group-by and/or --epi-week, --number is the most records to keep from each group, e.g. at most 5 per
+lineage per epi-week. Records that aren't in --metadata are skipped. Epi-weeks start on a Sunday, and week 1 of
+a year is the first week with at least four days in that year.
+
+The same --seed with the same input always gives the same sample.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		o := sample.Options{Count: sampleCount, Fraction: sampleFraction, GroupBy: sampleGroupBy, EpiWeekColumn: sampleEpiWeek}
+
+		o.Seed = randomSeed(cmd)
+
+		if sampleMetadata != "" {
+			f, err := gfio.OpenIn(*cmd.Flag("metadata"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			table, err := metadata.Read(f, metadata.SepFromPath(sampleMetadata), sampleNameColumn)
+			if err != nil {
+				return err
+			}
+			o.Table = &table
+		} else if len(sampleGroupBy) > 0 || sampleEpiWeek != "" {
+			return errors.New("--group-by and --epi-week need --metadata")
+		}
+
+		in, err := gfio.OpenIn(*cmd.Flag("fasta"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		err = sample.Sample(cmd.Context(), in, out, o)
+
+		return
+	},
+}
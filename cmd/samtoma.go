@@ -95,7 +95,13 @@ Note the change of coordinate system if moving from old to new flags.
 		}
 		defer out.Close()
 
-		err = sam.ToMultiAlign(samIn, out, toMultiAlignWrap, toMultiAlignStart, toMultiAlignEnd, toMultiAlignPad, samThreads)
+		err = sam.ToMultiAlign(cmd.Context(), samIn, out, sam.MultiAlignOptions{
+			Wrap:    toMultiAlignWrap,
+			Start:   toMultiAlignStart,
+			End:     toMultiAlignEnd,
+			Pad:     toMultiAlignPad,
+			Threads: samThreads,
+		})
 
 		return
 	},
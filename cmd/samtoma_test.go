@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"testing"
 
@@ -43,7 +44,7 @@ Don't combine them (and note the change of coordinate system if using the new op
 		toMultiAlignEnd = toMultiAlignTrimEnd
 	}
 
-	err = sam.ToMultiAlign(samReader, outWriterOld, toMultiAlignWrap, toMultiAlignStart, toMultiAlignEnd, toMultiAlignPad, samThreads)
+	err = sam.ToMultiAlign(context.Background(), samReader, outWriterOld, sam.MultiAlignOptions{Wrap: toMultiAlignWrap, Start: toMultiAlignStart, End: toMultiAlignEnd, Pad: toMultiAlignPad, Threads: samThreads})
 	if err != nil {
 		t.Error(err)
 	}
@@ -79,7 +80,7 @@ Don't combine them (and note the change of coordinate system if using the new op
 		toMultiAlignEnd = toMultiAlignTrimEnd
 	}
 
-	err = sam.ToMultiAlign(samReader, outWriterNew, toMultiAlignWrap, toMultiAlignStart, toMultiAlignEnd, toMultiAlignPad, samThreads)
+	err = sam.ToMultiAlign(context.Background(), samReader, outWriterNew, sam.MultiAlignOptions{Wrap: toMultiAlignWrap, Start: toMultiAlignStart, End: toMultiAlignEnd, Pad: toMultiAlignPad, Threads: samThreads})
 	if err != nil {
 		t.Error(err)
 	}
@@ -124,7 +125,7 @@ Don't combine them (and note the change of coordinate system if using the new op
 		toMultiAlignEnd = toMultiAlignTrimEnd
 	}
 
-	err = sam.ToMultiAlign(samReader, outWriterOld, toMultiAlignWrap, toMultiAlignStart, toMultiAlignEnd, toMultiAlignPad, samThreads)
+	err = sam.ToMultiAlign(context.Background(), samReader, outWriterOld, sam.MultiAlignOptions{Wrap: toMultiAlignWrap, Start: toMultiAlignStart, End: toMultiAlignEnd, Pad: toMultiAlignPad, Threads: samThreads})
 	if err != nil {
 		t.Error(err)
 	}
@@ -160,7 +161,7 @@ Don't combine them (and note the change of coordinate system if using the new op
 		toMultiAlignEnd = toMultiAlignTrimEnd
 	}
 
-	err = sam.ToMultiAlign(samReader, outWriterNew, toMultiAlignWrap, toMultiAlignStart, toMultiAlignEnd, toMultiAlignPad, samThreads)
+	err = sam.ToMultiAlign(context.Background(), samReader, outWriterNew, sam.MultiAlignOptions{Wrap: toMultiAlignWrap, Start: toMultiAlignStart, End: toMultiAlignEnd, Pad: toMultiAlignPad, Threads: samThreads})
 	if err != nil {
 		t.Error(err)
 	}
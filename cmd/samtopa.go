@@ -50,7 +50,14 @@ var toPairAlignCmd = &cobra.Command{
 		}
 		defer ref.Close()
 
-		err = sam.ToPairAlign(samIn, ref, toPairAlignOutpath, toPairAlignWrap, toPairAlignStart, toPairAlignEnd, toPairAlignOmitReference, toPairAlignSkipInsertions, samThreads)
+		err = sam.ToPairAlign(cmd.Context(), samIn, ref, toPairAlignOutpath, sam.PairAlignOptions{
+			Wrap:           toPairAlignWrap,
+			Start:          toPairAlignStart,
+			End:            toPairAlignEnd,
+			OmitReference:  toPairAlignOmitReference,
+			OmitInsertions: toPairAlignSkipInsertions,
+			Threads:        samThreads,
+		})
 
 		return err
 	},
@@ -9,6 +9,7 @@ import (
 
 	"github.com/virus-evolution/gofasta/pkg/gfio"
 	"github.com/virus-evolution/gofasta/pkg/sam"
+	"github.com/virus-evolution/gofasta/pkg/variants"
 )
 
 var samVariantsAnnotation string
@@ -124,7 +125,14 @@ Frame-shifting mutations in coding sequence are reported as indels but are ignor
 		}
 		defer out.Close()
 
-		err = sam.Variants(samIn, ref, refFromFile, anno, annoSuffix, out, samVariantsStart, samVariantsEnd, samVariantsAggregate, samVariantsThreshold, samVariantsAppendSNP, samThreads)
+		err = sam.Variants(cmd.Context(), samIn, ref, refFromFile, anno, annoSuffix, out, variants.Options{
+			Start:     samVariantsStart,
+			End:       samVariantsEnd,
+			Aggregate: samVariantsAggregate,
+			Threshold: samVariantsThreshold,
+			AppendSNP: samVariantsAppendSNP,
+			Threads:   samThreads,
+		})
 
 		return err
 	},
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/schema"
+)
+
+var schemaFormat string
+var schemaJSONSchema bool
+var schemaJSON bool
+var schemaOutfile string
+
+func init() {
+	rootCmd.AddCommand(schemaCmd)
+
+	schemaCmd.Flags().StringVarP(&schemaFormat, "format", "f", "", "Output format to describe the columns of (default: list every format)")
+	schemaCmd.Flags().BoolVarP(&schemaJSONSchema, "json-schema", "", false, "With --format, write a JSON Schema for one line of the format instead")
+	schemaCmd.Flags().BoolVarP(&schemaJSON, "json", "", false, "Write every format and its columns as JSON")
+	schemaCmd.Flags().StringVarP(&schemaOutfile, "outfile", "o", "stdout", "File to write to")
+
+	schemaCmd.Flags().Lookup("json-schema").NoOptDefVal = "true"
+	schemaCmd.Flags().Lookup("json").NoOptDefVal = "true"
+
+	schemaCmd.Flags().SortFlags = false
+}
+
+var schemaCmd = &cobra.Command{
+	Use:   "schema",
+	Short: "Describe the columns of gofasta's CSV outputs",
+	Long: `Describe the columns of gofasta's CSV outputs
+
+Example usage:
+	gofasta schema
+	gofasta schema -f closest
+	gofasta schema -f snps --json-schema -o snps.schema.json
+	gofasta schema --json
+
+Without --format, the formats that are described are listed. With --format, the columns of that format are
+written as CSV with the columns column,type,nullable,description, where type is one of string, integer, number
+or boolean, and a nullable column can be empty. With --json-schema, a JSON Schema for one line of the format
+(parsed into an object keyed by column name) is written instead. --json writes every format at once.
+
+Within a schema version (given by --json, and in each JSON Schema's title), columns may be added to the end of
+a format, but none is renamed, removed, reordered or changes type.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		switch {
+		case schemaJSON:
+			return schema.WriteJSON(out)
+		case schemaFormat == "":
+			return schema.WriteList(out)
+		}
+
+		f, err := schema.Lookup(schemaFormat)
+		if err != nil {
+			return err
+		}
+		if schemaJSONSchema {
+			b, err := f.JSONSchema()
+			if err != nil {
+				return err
+			}
+			_, err = out.Write(append(b, '\n'))
+			return err
+		}
+		err = f.WriteColumns(out)
+
+		return
+	},
+}
@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"errors"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/search"
+)
+
+var searchQuery string
+var searchDatabase string
+var searchOutfile string
+var searchPrescreen bool
+var searchOptions = search.DefaultOptions()
+
+func init() {
+	rootCmd.AddCommand(searchCmd)
+
+	searchCmd.Flags().IntVarP(&searchOptions.Threads, "threads", "t", 0, "Number of CPUs to use (Default: all available CPUs)")
+	searchCmd.Flags().StringVarP(&searchQuery, "query", "q", "", "Fasta file of sequences to search for")
+	searchCmd.Flags().StringVarP(&searchDatabase, "database", "d", "stdin", "Fasta file of sequences to search in")
+	searchCmd.Flags().StringVarP(&searchOutfile, "outfile", "o", "stdout", "CSV file to write the hits to")
+	searchCmd.Flags().Float64VarP(&searchOptions.MinIdentity, "min-identity", "", searchOptions.MinIdentity, "Minimum percent identity of a hit over its aligned columns")
+	searchCmd.Flags().Float64VarP(&searchOptions.MinCoverage, "min-coverage", "", searchOptions.MinCoverage, "Minimum proportion of the query's length that a hit must cover")
+	searchCmd.Flags().IntVarP(&searchOptions.K, "kmer", "k", searchOptions.K, "Length of the k-mers to index")
+	searchCmd.Flags().IntVarP(&searchOptions.W, "window", "w", searchOptions.W, "Number of consecutive k-mers from which each minimizer is picked")
+	searchCmd.Flags().IntVarP(&searchOptions.MinHits, "min-hits", "", searchOptions.MinHits, "Minimum number of shared minimizers before a query is aligned to a database sequence")
+	searchCmd.Flags().BoolVarP(&searchPrescreen, "prescreen", "", false, "Find the possibly repeated database sequences in a first low-memory pass, and only search each of them once (reads --database twice)")
+
+	searchCmd.Flags().Lookup("prescreen").NoOptDefVal = "true"
+
+	searchCmd.Flags().SortFlags = false
+}
+
+var searchCmd = &cobra.Command{
+	Use:   "search",
+	Short: "Find database sequences that contain a query fragment",
+	Long: `Find database sequences that contain a query fragment
+
+Example usage:
+	gofasta search -q fragments.fasta -d database.fasta -o hits.csv
+	gofasta search -q amplicon.fasta -d database.fasta --min-identity 99 --min-coverage 1 -o hits.csv
+
+Every sequence in --database is checked for each query in --query, on both strands. Neither needs to be
+aligned, and gaps are ignored. The queries are indexed by their minimizers (the k-mer with the smallest hash in
+each window of --window consecutive k-mers), and where a database sequence shares at least --min-hits of them
+with a query on about the same diagonal, the query is aligned to that part of it, in the same way as gofasta
+pairalign. A hit is reported if it has at least --min-identity percent identity over at least --min-coverage of
+the query's length. Only the best hit of each query in each database sequence is reported.
+
+--outfile has the columns query,target,strand,target_start,target_end,query_start,query_end,identity,coverage,cigar.
+Coordinates are 1-based and inclusive, and the query's are on its own forward strand even when its reverse
+complement was found (strand -). The queries are read into memory but the database is streamed.
+
+--prescreen is for databases in which many sequences are repeated, such as a large collection of genomes from
+one outbreak. A first pass puts every database sequence into a Bloom filter, in a couple of bytes per record, to
+find the few that might be repeated, and in a second pass each of those is only searched once, and its hits
+reused for the records that repeat it. --database is read twice, so it can't be stdin. The hits are the same.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if searchQuery == "" {
+			return errors.New("search needs --query")
+		}
+		if searchPrescreen && searchDatabase == "stdin" {
+			return errors.New("--prescreen reads --database twice, so it can't be stdin")
+		}
+
+		queryIn, err := gfio.OpenIn(*cmd.Flag("query"))
+		if err != nil {
+			return err
+		}
+		defer queryIn.Close()
+
+		queries, err := fastaio.ReadFastaToListUncounted(queryIn)
+		if err != nil {
+			return err
+		}
+		if len(queries) == 0 {
+			return errors.New("no records in --query")
+		}
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		if searchPrescreen {
+			open := func() (io.ReadCloser, error) {
+				return gfio.OpenIn(*cmd.Flag("database"))
+			}
+			err = search.Prescreen(cmd.Context(), queries, open, out, searchOptions)
+			return
+		}
+
+		db, err := gfio.OpenIn(*cmd.Flag("database"))
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+
+		err = search.Search(cmd.Context(), queries, db, out, searchOptions)
+
+		return
+	},
+}
@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"errors"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/metadata"
+	"github.com/virus-evolution/gofasta/pkg/selection"
+)
+
+var selectFasta string
+var selectOutfile string
+var selectMetadata string
+var selectNameColumn string
+var selectWhere string
+var selectMetadataOut string
+var selectAnnotate []string
+var selectAnnotateSep string
+
+func init() {
+	rootCmd.AddCommand(selectCmd)
+
+	selectCmd.Flags().StringVarP(&selectFasta, "fasta", "f", "stdin", "Fasta file to select records from")
+	selectCmd.Flags().StringVarP(&selectOutfile, "outfile", "o", "stdout", "Fasta file of selected records to write")
+	selectCmd.Flags().StringVarP(&selectMetadata, "metadata", "m", "", "CSV/TSV metadata file")
+	selectCmd.Flags().StringVarP(&selectNameColumn, "name-column", "", "name", "Column in --metadata with the sequence names")
+	selectCmd.Flags().StringVarP(&selectWhere, "where", "w", "", "Expression over columns in --metadata that selected records must match")
+	selectCmd.Flags().StringVarP(&selectMetadataOut, "metadata-out", "", "", "(Optional) file to write the metadata of the selected records to, in the same format as --metadata")
+	selectCmd.Flags().StringSliceVarP(&selectAnnotate, "annotate", "", []string{}, "Columns in --metadata to add to the header of each selected record (comma-separated)")
+	selectCmd.Flags().StringVarP(&selectAnnotateSep, "annotate-sep", "", "|", "Separator for --annotate")
+
+	selectCmd.Flags().SortFlags = false
+}
+
+var selectCmd = &cobra.Command{
+	Use:   "select",
+	Short: "Select records using their metadata",
+	Long: `Select records using their metadata
+
+Example usage:
+	gofasta select -f sequences.fasta -m metadata.csv -w 'country == England and date >= 2021-01-01' --metadata-out selected.csv -o selected.fasta
+	gofasta select -f sequences.fasta -m metadata.tsv -w 'lineage in (BA.1, BA.2) or lineage ~ "^BA\.2\."' -o omicron.fasta
+	gofasta select -f sequences.fasta -m metadata.csv --annotate lineage,date -o annotated.fasta
+
+--metadata is joined to the records by name (the column given by --name-column), and records are selected if their
+row matches --where. Records that aren't in --metadata are never selected. Without --where, every record that is
+in --metadata is selected.
+
+--where compares columns to values, and the comparisons can be combined with and (or &&), or (or ||), not (or !)
+and parentheses. The operators are:
+	== (or =), !=      equal to, not equal to
+	<, <=, >, >=       less than, greater than, etc.
+	~, !~              matches, doesn't match, a regular expression
+	in (a, b, ...)     equal to one of a list of values
+Values are compared as numbers if both are numbers, and as text otherwise, so dates in YYYY-MM-DD format compare
+correctly. Values (and column names) containing spaces or any of ()<>=!~,&| must be quoted.
+
+Records are written in the order they are in --fasta, and so are the rows in --metadata-out. With --annotate,
+headers are the record ID and the values of the columns, separated by --annotate-sep, e.g. seq1|BA.1|2022-01-03.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if selectMetadata == "" {
+			return errors.New("select needs --metadata")
+		}
+
+		f, err := gfio.OpenIn(*cmd.Flag("metadata"))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		sep := metadata.SepFromPath(selectMetadata)
+		table, err := metadata.Read(f, sep, selectNameColumn)
+		if err != nil {
+			return err
+		}
+
+		in, err := gfio.OpenIn(*cmd.Flag("fasta"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		var metaOut io.Writer
+		if selectMetadataOut != "" {
+			mf, err := gfio.OpenOut(*cmd.Flag("metadata-out"))
+			if err != nil {
+				return err
+			}
+			defer mf.Close()
+			metaOut = mf
+		}
+
+		o := selection.Options{Where: selectWhere, Annotate: selectAnnotate, AnnotateSep: selectAnnotateSep}
+
+		err = selection.Select(cmd.Context(), in, out, metaOut, &table, sep, o)
+
+		return
+	},
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/seqhash"
+)
+
+var seqhashFasta string
+var seqhashOutfile string
+var seqhashTable string
+var seqhashAlgorithm string
+var seqhashLength int
+var seqhashPrefix string
+var seqhashAppend bool
+
+func init() {
+	rootCmd.AddCommand(seqhashCmd)
+
+	seqhashCmd.Flags().StringVarP(&seqhashFasta, "fasta", "f", "stdin", "Fasta file of sequences to hash")
+	seqhashCmd.Flags().StringVarP(&seqhashOutfile, "outfile", "o", "stdout", "Fasta file to write")
+	seqhashCmd.Flags().StringVarP(&seqhashTable, "table", "t", "", "(Optional) CSV file of record names and their hashes to write")
+	seqhashCmd.Flags().StringVarP(&seqhashAlgorithm, "algorithm", "", "sha256", "Hash algorithm: sha256, sha512, sha1 or md5")
+	seqhashCmd.Flags().IntVarP(&seqhashLength, "length", "", 0, "Only keep this many hex characters of each hash (default: all of them)")
+	seqhashCmd.Flags().StringVarP(&seqhashPrefix, "prefix", "", "", "Prefix to write before each hash")
+	seqhashCmd.Flags().BoolVarP(&seqhashAppend, "append", "", false, "Append the hash to the header, rather than replacing the header with it")
+
+	seqhashCmd.Flags().Lookup("append").NoOptDefVal = "true"
+
+	seqhashCmd.Flags().SortFlags = false
+}
+
+var seqhashCmd = &cobra.Command{
+	Use:   "seqhash",
+	Short: "Name sequences by a hash of their contents",
+	Long: `Name sequences by a hash of their contents
+
+Example usage:
+	gofasta seqhash -f sequences.fasta -t lookup.csv -o hashed.fasta
+	gofasta seqhash -f sequences.fasta --algorithm md5 --length 12 --prefix seq_ --append -o hashed.fasta
+
+Each sequence is hashed after uppercasing it and removing gaps, so the same sequence always gets the same hash,
+whether or not it is aligned and whatever its original name. By default the header is replaced with the hash;
+with --append, the hash is added to the end of the header after a space, so the record keeps its ID.
+
+--table is a CSV file with the columns name,seqhash, which maps the original record IDs to the new identifiers.
+Identical sequences get identical hashes, so the output can have duplicate names (see gofasta dedup).`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		o := seqhash.Options{Algorithm: seqhashAlgorithm, Length: seqhashLength, Prefix: seqhashPrefix, Mode: seqhash.Replace}
+		if seqhashAppend {
+			o.Mode = seqhash.Append
+		}
+
+		in, err := gfio.OpenIn(*cmd.Flag("fasta"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		var table io.Writer
+		if seqhashTable != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("table"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			table = f
+		}
+
+		err = seqhash.SeqHash(cmd.Context(), in, out, table, o)
+
+		return
+	},
+}
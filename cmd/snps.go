@@ -13,6 +13,8 @@ var snpsOutfile string
 var hardGaps bool
 var aggregate bool
 var thresh float64
+var snpsSuggestMask bool
+var snpsSuggest = snps.DefaultSuggestOptions()
 
 func init() {
 	rootCmd.AddCommand(snpCmd)
@@ -23,9 +25,16 @@ func init() {
 	snpCmd.Flags().BoolVarP(&hardGaps, "hard-gaps", "", false, "Don't treat alignment gaps as missing data")
 	snpCmd.Flags().BoolVarP(&aggregate, "aggregate", "", false, "Report the proportions of each change")
 	snpCmd.Flags().Float64VarP(&thresh, "threshold", "", 0.0, "If --aggregate, only report snps with a freq greater than or equal to this value")
+	snpCmd.Flags().BoolVarP(&snpsSuggestMask, "suggest-mask", "", false, "Write the sites whose snps look artefactual, as a mask file, instead of the snps")
+	snpCmd.Flags().IntVarP(&snpsSuggest.Flank, "flank", "", snpsSuggest.Flank, "If --suggest-mask, how many bases either side of an snp to look for Ns")
+	snpCmd.Flags().Float64VarP(&snpsSuggest.NearN, "near-n", "", snpsSuggest.NearN, "If --suggest-mask, flag sites where at least this proportion of snps are near an N")
+	snpCmd.Flags().Float64VarP(&snpsSuggest.MaxAmbiguous, "max-ambiguous", "", snpsSuggest.MaxAmbiguous, "If --suggest-mask, flag sites where at least this proportion of records with data have an ambiguity code")
+	snpCmd.Flags().Float64VarP(&snpsSuggest.MaxMissing, "max-missing", "", snpsSuggest.MaxMissing, "If --suggest-mask, flag sites where at least this proportion of records have N")
+	snpCmd.Flags().IntVarP(&snpsSuggest.MinRecords, "min-records", "", snpsSuggest.MinRecords, "If --suggest-mask, only consider sites where at least this many records have an snp or ambiguity code")
 
 	snpCmd.Flags().Lookup("hard-gaps").NoOptDefVal = "true"
 	snpCmd.Flags().Lookup("aggregate").NoOptDefVal = "true"
+	snpCmd.Flags().Lookup("suggest-mask").NoOptDefVal = "true"
 
 	snpCmd.Flags().SortFlags = false
 }
@@ -48,6 +57,15 @@ If you set --aggregate (and optionally a --threshold) it will return the SNPs pr
 
 Setting --hard-gaps treats alignment gaps as different from {ATGC}.
 
+If you set --suggest-mask, the output is instead a list of the sites whose calls look artefactual, with the columns
+position,ref,carriers,near_n,ambiguous,missing,frequency,reason, which can be passed straight to gofasta mask -s
+(after review). A site is flagged (if at least --min-records records have an snp or an ambiguity code there) when
+at least --near-n of its snps have an N within --flank bases, so are only ever called at the edges of runs of
+missing data; when at least --max-ambiguous of the records with data there have an ambiguity code; or when at least
+--max-missing of all records have N there. frequency is the proportion of records with an unambiguous base at the
+site that have an snp there, and reason lists which of near_n, ambiguous and missing applied, e.g.:
+	gofasta snps -r reference.fasta -q alignment.fasta --suggest-mask -o suggested_mask.csv
+
 If query and outfile are not specified, the behaviour is to read the query alignment
 from stdin and write the snps file to stdout, e.g. you could do this:
 	cat alignment.fasta | gofasta snps -r reference.fasta > snps.csv`,
@@ -72,7 +90,12 @@ from stdin and write the snps file to stdout, e.g. you could do this:
 		}
 		defer out.Close()
 
-		err = snps.SNPs(ref, query, hardGaps, aggregate, thresh, out)
+		if snpsSuggestMask {
+			err = snps.Suggest(cmd.Context(), ref, query, out, snpsSuggest)
+			return
+		}
+
+		err = snps.SNPs(cmd.Context(), ref, query, out, snps.Options{HardGaps: hardGaps, Aggregate: aggregate, Threshold: thresh})
 
 		return
 	},
@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/metadata"
+	"github.com/virus-evolution/gofasta/pkg/seqsort"
+	"github.com/virus-evolution/gofasta/pkg/split"
+)
+
+var sortFasta string
+var sortOutfile string
+var sortBy string
+var sortReverse bool
+var sortMetadata string
+var sortNameColumn string
+var sortColumn string
+var sortNumeric bool
+var sortReference string
+var sortMaxMemory string
+var sortTempDir string
+
+func init() {
+	rootCmd.AddCommand(sortCmd)
+
+	sortCmd.Flags().StringVarP(&sortFasta, "fasta", "f", "stdin", "Fasta file to sort")
+	sortCmd.Flags().StringVarP(&sortOutfile, "outfile", "o", "stdout", "Fasta file to write")
+	sortCmd.Flags().StringVarP(&sortBy, "by", "k", "name", "What to sort by: one of name, length, completeness, metadata or distance")
+	sortCmd.Flags().BoolVarP(&sortReverse, "reverse", "", false, "Sort in descending order")
+	sortCmd.Flags().StringVarP(&sortMetadata, "metadata", "m", "", "With --by metadata, CSV/TSV metadata file")
+	sortCmd.Flags().StringVarP(&sortNameColumn, "name-column", "", "name", "Column in --metadata with the sequence names")
+	sortCmd.Flags().StringVarP(&sortColumn, "column", "c", "", "With --by metadata, the column in --metadata to sort by")
+	sortCmd.Flags().BoolVarP(&sortNumeric, "numeric", "", false, "With --by metadata, compare values in --column as numbers")
+	sortCmd.Flags().StringVarP(&sortReference, "reference", "r", "", "With --by distance, fasta file with the reference sequence, aligned to --fasta")
+	sortCmd.Flags().StringVarP(&sortMaxMemory, "max-memory", "", "1G", "Approximate amount of sequence to sort in memory before using temporary files, e.g. 500M")
+	sortCmd.Flags().StringVarP(&sortTempDir, "temp-dir", "", "", "Directory for temporary files (default: the system's)")
+
+	sortCmd.Flags().Lookup("reverse").NoOptDefVal = "true"
+	sortCmd.Flags().Lookup("numeric").NoOptDefVal = "true"
+
+	sortCmd.Flags().SortFlags = false
+}
+
+var sortCmd = &cobra.Command{
+	Use:   "sort",
+	Short: "Sort the records in a fasta file",
+	Long: `Sort the records in a fasta file
+
+Example usage:
+	gofasta sort -f sequences.fasta -o sorted.fasta
+	gofasta sort -f sequences.fasta -k completeness --reverse -o sorted.fasta
+	gofasta sort -f sequences.fasta -k metadata -m metadata.csv -c date -o sorted.fasta
+	gofasta sort -f aligned.fasta -k distance -r MN908947.fasta -o sorted.fasta
+
+Records can be sorted by:
+	name           record ID
+	length         number of bases, not counting gaps
+	completeness   proportion of the sequence that is A, C, G or T
+	metadata       the value in --column of --metadata (as text, or with --numeric, as numbers)
+	distance       SNP-distance to --reference, which --fasta must be aligned to
+
+Sorting is ascending unless --reverse is used. Records with equal keys stay in their input order, and records
+that aren't in --metadata (or have no value in --column) are written last.
+
+If there is more sequence than --max-memory, sorted chunks are written to temporary files in --temp-dir and
+merged at the end, so files larger than memory can be sorted.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		o := seqsort.Options{By: sortBy, Reverse: sortReverse, Column: sortColumn, Numeric: sortNumeric, TempDir: sortTempDir}
+
+		o.MaxMemory, err = split.ParseSize(sortMaxMemory)
+		if err != nil {
+			return err
+		}
+
+		switch sortBy {
+		case seqsort.ByMetadata:
+			if sortMetadata == "" || sortColumn == "" {
+				return errors.New("--by metadata needs --metadata and --column")
+			}
+			f, err := gfio.OpenIn(*cmd.Flag("metadata"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			table, err := metadata.Read(f, metadata.SepFromPath(sortMetadata), sortNameColumn)
+			if err != nil {
+				return err
+			}
+			o.Table = &table
+		case seqsort.ByDistance:
+			if sortReference == "" {
+				return errors.New("--by distance needs --reference")
+			}
+			refIn, err := gfio.OpenIn(*cmd.Flag("reference"))
+			if err != nil {
+				return err
+			}
+			defer refIn.Close()
+			refs, err := fastaio.ReadFastaToListUncounted(refIn)
+			if err != nil {
+				return err
+			}
+			if len(refs) != 1 {
+				return errors.New("there must be exactly one record in --reference")
+			}
+			o.Reference = refs[0].Seq
+		}
+
+		in, err := gfio.OpenIn(*cmd.Flag("fasta"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		err = seqsort.Sort(cmd.Context(), in, out, o)
+
+		return
+	},
+}
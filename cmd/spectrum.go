@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"errors"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/spectrum"
+)
+
+var spectrumMSA string
+var spectrumReference string
+var spectrumOutfile string
+var spectrumAggregate string
+var spectrumContext bool
+
+func init() {
+	rootCmd.AddCommand(spectrumCmd)
+
+	spectrumCmd.Flags().StringVarP(&spectrumMSA, "msa", "", "stdin", "Alignment in fasta format")
+	spectrumCmd.Flags().StringVarP(&spectrumReference, "reference", "r", "", "Reference sequence in fasta format, aligned to --msa")
+	spectrumCmd.Flags().StringVarP(&spectrumOutfile, "outfile", "o", "stdout", "CSV file to write each record's spectrum to")
+	spectrumCmd.Flags().StringVarP(&spectrumAggregate, "aggregate", "", "", "(Optional) CSV file to write the spectrum summed over every record to")
+	spectrumCmd.Flags().BoolVarP(&spectrumContext, "context", "", false, "Split substitutions by their flanking reference bases (trinucleotide context)")
+
+	spectrumCmd.Flags().Lookup("context").NoOptDefVal = "true"
+
+	spectrumCmd.Flags().SortFlags = false
+}
+
+var spectrumCmd = &cobra.Command{
+	Use:   "spectrum",
+	Short: "Count the types of substitution relative to a reference",
+	Long: `Count the types of substitution relative to a reference
+
+Example usage:
+	gofasta spectrum --msa alignment.fasta -r MN908947.fasta -o spectra.csv
+	gofasta spectrum --msa alignment.fasta -r MN908947.fasta --context --aggregate spectrum.csv
+
+Every site where a record and the reference both have an unambiguous nucleotide, and they differ, is counted as
+one of the twelve substitution types (A>C, A>G, ... T>G), from the reference to the record. With --context,
+each type is also split by the reference bases either side of the site, written as 5'[ref>alt]3' (e.g. T[C>T]A),
+with N for a flanking base that isn't an unambiguous nucleotide. An excess of C>T (or of C>T in particular
+contexts) can point to APOBEC editing, and of A>G and T>C to ADAR editing.
+
+--outfile is a tidy table with the columns query,substitution,count,proportion, with a line for every type
+(including those with no count) for each record, and proportion the fraction of the record's substitutions of that
+type. --aggregate has the columns substitution,count,proportion, summed over every record. If --aggregate is given
+but --outfile isn't, the per-record table isn't written.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if spectrumReference == "" {
+			return errors.New("spectrum needs a --reference")
+		}
+		refIn, err := gfio.OpenIn(*cmd.Flag("reference"))
+		if err != nil {
+			return err
+		}
+		defer refIn.Close()
+		refs, err := fastaio.ReadFastaToListUncounted(refIn)
+		if err != nil {
+			return err
+		}
+		if len(refs) != 1 {
+			return errors.New("there must be exactly one record in --reference")
+		}
+
+		msa, err := gfio.OpenIn(*cmd.Flag("msa"))
+		if err != nil {
+			return err
+		}
+		defer msa.Close()
+
+		var out io.Writer
+		if spectrumAggregate == "" || cmd.Flags().Changed("outfile") {
+			f, err := gfio.OpenOut(*cmd.Flag("outfile"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			out = f
+		}
+
+		var aggregateOut io.Writer
+		if spectrumAggregate != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("aggregate"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			aggregateOut = f
+		}
+
+		err = spectrum.Spectra(cmd.Context(), msa, refs[0].Seq, out, aggregateOut, spectrumContext)
+
+		return
+	},
+}
@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/metadata"
+	"github.com/virus-evolution/gofasta/pkg/split"
+)
+
+var splitFasta string
+var splitNFiles int
+var splitRecords int
+var splitMaxSize string
+var splitMetadata string
+var splitNameColumn string
+var splitColumn string
+var splitMissing string
+var splitTemplate string
+
+func init() {
+	rootCmd.AddCommand(splitCmd)
+
+	splitCmd.Flags().StringVarP(&splitFasta, "fasta", "f", "stdin", "Fasta file to split")
+	splitCmd.Flags().IntVarP(&splitNFiles, "n-files", "n", 0, "Split into this many files")
+	splitCmd.Flags().IntVarP(&splitRecords, "records", "r", 0, "Split into files of this many records")
+	splitCmd.Flags().StringVarP(&splitMaxSize, "max-size", "", "", "Split into files no larger than this, e.g. 500K, 10M or 2G")
+	splitCmd.Flags().StringVarP(&splitMetadata, "metadata", "m", "", "Split by the values of --column in this CSV/TSV metadata file")
+	splitCmd.Flags().StringVarP(&splitNameColumn, "name-column", "", "name", "Column in --metadata with the sequence names")
+	splitCmd.Flags().StringVarP(&splitColumn, "column", "c", "", "Column in --metadata to split by")
+	splitCmd.Flags().StringVarP(&splitMissing, "missing", "", "", "With --metadata, use this value for records that aren't in the metadata (default: skip them)")
+	splitCmd.Flags().StringVarP(&splitTemplate, "template", "t", "", "Template for output filenames. {n} is the file number and {value} the metadata value\n"+
+		"(default \"split_{n}.fasta\", or \"{value}.fasta\" with --metadata)")
+
+	splitCmd.Flags().SortFlags = false
+}
+
+var splitCmd = &cobra.Command{
+	Use:   "split",
+	Short: "Split a fasta file into several smaller ones",
+	Long: `Split a fasta file into several smaller ones
+
+Example usage:
+	gofasta split -f sequences.fasta -n 10 -t chunks/part_{n}.fasta
+	gofasta split -f sequences.fasta -r 1000
+	gofasta split -f sequences.fasta --max-size 50M
+	gofasta split -f sequences.fasta -m metadata.csv -c lineage -t by_lineage/{value}.fasta
+
+Choose exactly one of --n-files, --records, --max-size or --metadata.
+
+--n-files deals the records out to the files in turn, so that the input only has to be read once. The other
+modes write contiguous chunks of the input. With --metadata, each value in --column gets its own file, and the
+output template must include {value}. Characters that aren't safe in filenames are replaced with underscores.
+
+Output directories in the template must already exist. Existing files are overwritten.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		modes := 0
+		for _, set := range []bool{splitNFiles > 0, splitRecords > 0, splitMaxSize != "", splitMetadata != ""} {
+			if set {
+				modes++
+			}
+		}
+		if modes != 1 {
+			return errors.New("choose exactly one of --n-files, --records, --max-size or --metadata")
+		}
+
+		tmpl := split.Template(splitTemplate)
+		if splitTemplate == "" {
+			tmpl = "split_{n}.fasta"
+			if splitMetadata != "" {
+				tmpl = "{value}.fasta"
+			}
+		}
+
+		in, err := gfio.OpenIn(*cmd.Flag("fasta"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		var names []string
+
+		switch {
+		case splitNFiles > 0:
+			names, err = split.IntoFiles(cmd.Context(), in, splitNFiles, tmpl)
+		case splitRecords > 0:
+			names, err = split.ByRecords(cmd.Context(), in, splitRecords, tmpl)
+		case splitMaxSize != "":
+			var size int64
+			size, err = split.ParseSize(splitMaxSize)
+			if err != nil {
+				return err
+			}
+			names, err = split.BySize(cmd.Context(), in, size, tmpl)
+		default:
+			if splitColumn == "" {
+				return errors.New("--metadata needs a --column to split by")
+			}
+			var f *os.File
+			f, err = gfio.OpenIn(*cmd.Flag("metadata"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			var table metadata.Table
+			table, err = metadata.Read(f, metadata.SepFromPath(splitMetadata), splitNameColumn)
+			if err != nil {
+				return err
+			}
+			names, err = split.ByMetadata(cmd.Context(), in, table, splitColumn, splitMissing, tmpl)
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, name := range names {
+			os.Stderr.WriteString("wrote " + name + "\n")
+		}
+
+		return
+	},
+}
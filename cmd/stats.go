@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/stats"
+)
+
+var statsFasta string
+var statsOutfile string
+var statsFormat string
+var statsAlignmentOut string
+
+func init() {
+	rootCmd.AddCommand(statsCmd)
+
+	statsCmd.Flags().StringVarP(&statsFasta, "fasta", "f", "stdin", "Sequences or alignment in fasta format")
+	statsCmd.Flags().StringVarP(&statsOutfile, "outfile", "o", "stdout", "File to write the statistics to")
+	statsCmd.Flags().StringVarP(&statsFormat, "format", "", "csv", "Output format: csv or json")
+	statsCmd.Flags().StringVarP(&statsAlignmentOut, "alignment-summary", "", "", "(Optional) with --format csv, CSV file to write the file-level statistics to")
+
+	statsCmd.Flags().SortFlags = false
+}
+
+var statsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Report length and base composition statistics",
+	Long: `Report length and base composition statistics
+
+Example usage:
+	gofasta stats -f sequences.fasta -o stats.csv
+	gofasta stats -f alignment.fasta --alignment-summary alignment_stats.csv -o stats.csv
+	gofasta stats -f alignment.fasta --format json -o stats.json
+
+For each record, the output has its length, the number of each of A, C, G, T and N, the number of gaps and of
+other IUPAC ambiguity codes, the GC content as a percentage of the unambiguous bases, and the length of the
+longest run of Ns.
+
+For the file as a whole there is the number of records and their minimum and maximum length. If every record is
+the same length, the input is treated as an alignment, and its width and the number of variable columns (those
+with more than one unambiguous nucleotide) are also given. With --format json these are under the "alignment"
+key of the output; with --format csv they are written to --alignment-summary.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in, err := gfio.OpenIn(*cmd.Flag("fasta"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		var summaryOut io.Writer
+		if statsAlignmentOut != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("alignment-summary"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			summaryOut = f
+		}
+
+		err = stats.Write(cmd.Context(), in, out, summaryOut, statsFormat)
+
+		return
+	},
+}
@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/extract"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+)
+
+var subtractFasta string
+var subtractOther string
+var subtractNames string
+var subtractNoIndex bool
+var subtractOutfile string
+
+func init() {
+	rootCmd.AddCommand(subtractCmd)
+
+	subtractCmd.Flags().StringVarP(&subtractFasta, "fasta", "f", "stdin", "Fasta file to remove records from")
+	subtractCmd.Flags().StringVarP(&subtractOther, "other", "", "", "Fasta file of records to remove")
+	subtractCmd.Flags().StringVarP(&subtractNames, "names", "", "", "Plain text file of names of records to remove, one per line")
+	subtractCmd.Flags().BoolVarP(&subtractNoIndex, "no-index", "", false, "Don't use a .fai index of --other, even if there is one")
+	subtractCmd.Flags().StringVarP(&subtractOutfile, "outfile", "o", "stdout", "Fasta file to write")
+
+	subtractCmd.Flags().Lookup("no-index").NoOptDefVal = "true"
+
+	subtractCmd.Flags().SortFlags = false
+}
+
+// setNames returns the names in the fasta file other (from its .fai index if it has one, unless noIndex) and in
+// the plain text file names, either of which may be empty
+func setNames(cmd *cobra.Command, other, names string, noIndex bool) ([]string, error) {
+	if other == "" && names == "" {
+		return nil, errors.New("give the names to compare to with --other and/or --names")
+	}
+
+	all := make([]string, 0)
+
+	if other != "" {
+		var fromOther []string
+		var faiIn *os.File
+		if !noIndex && other != "stdin" && !gfio.IsRemote(other) {
+			faiIn, _ = os.Open(other + ".fai")
+		}
+		if faiIn != nil {
+			defer faiIn.Close()
+			index, err := extract.ReadFai(faiIn)
+			if err != nil {
+				return nil, err
+			}
+			fromOther = extract.IndexNames(index)
+		} else {
+			otherIn, err := gfio.OpenIn(*cmd.Flag("other"))
+			if err != nil {
+				return nil, err
+			}
+			defer otherIn.Close()
+			fromOther, err = extract.ReadIDs(otherIn)
+			if err != nil {
+				return nil, err
+			}
+		}
+		all = append(all, fromOther...)
+	}
+
+	if names != "" {
+		namesIn, err := gfio.OpenIn(*cmd.Flag("names"))
+		if err != nil {
+			return nil, err
+		}
+		defer namesIn.Close()
+		fromFile, err := extract.ReadNames(namesIn)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, fromFile...)
+	}
+
+	return all, nil
+}
+
+var subtractCmd = &cobra.Command{
+	Use:   "subtract",
+	Short: "Remove the records that are in another fasta file or list of names",
+	Long: `Remove the records that are in another fasta file or list of names
+
+Example usage:
+	gofasta subtract -f all.fasta --other already_analysed.fasta -o new.fasta
+	gofasta subtract -f all.fasta --names excluded.txt -o kept.fasta
+
+Records of --fasta whose IDs (the header up to the first whitespace) are in --other or in --names are left out,
+and the rest are written in the order that they are in --fasta, with their whole headers. --fasta is streamed.
+Only the IDs of --other are read: from its index (--other plus ".fai", as made by samtools faidx) if it has one,
+which is much faster for a large file, or else by scanning its header lines. See also gofasta intersect.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		names, err := setNames(cmd, subtractOther, subtractNames, subtractNoIndex)
+		if err != nil {
+			return err
+		}
+
+		in, err := gfio.OpenIn(*cmd.Flag("fasta"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		err = extract.Subtract(cmd.Context(), in, names, out)
+
+		return
+	},
+}
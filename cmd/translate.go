@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/translate"
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+var translateFasta string
+var translateOutfile string
+var translateFrame int
+var translateReverse bool
+var translateCode int
+var translateAnnotation string
+var translateOutdir string
+var translateGenes []string
+
+func init() {
+	rootCmd.AddCommand(translateCmd)
+
+	translateCmd.Flags().StringVarP(&translateFasta, "fasta", "f", "stdin", "Nucleotide sequences to translate, in fasta format")
+	translateCmd.Flags().StringVarP(&translateOutfile, "outfile", "o", "stdout", "Fasta file of protein sequences to write (without --annotation)")
+	translateCmd.Flags().IntVarP(&translateFrame, "frame", "", 1, "Reading frame to translate in (1, 2 or 3)")
+	translateCmd.Flags().BoolVarP(&translateReverse, "reverse", "", false, "Translate the reverse complement")
+	translateCmd.Flags().IntVarP(&translateCode, "code", "", 1, "NCBI translation table to use (1, 2, 3, 4, 5, 6, 9, 10, 11, 12, 13 or 14)")
+	translateCmd.Flags().StringVarP(&translateAnnotation, "annotation", "a", "", "Genbank or GFF3 format annotation file. Must have suffix .gb or .gff")
+	translateCmd.Flags().StringVarP(&translateOutdir, "outdir", "d", ".", "With --annotation, directory to write one fasta file per CDS to")
+	translateCmd.Flags().StringSliceVarP(&translateGenes, "genes", "", []string{}, "With --annotation, only translate these CDS (comma-separated)")
+
+	translateCmd.Flags().Lookup("reverse").NoOptDefVal = "true"
+
+	translateCmd.Flags().SortFlags = false
+}
+
+var translateCmd = &cobra.Command{
+	Use:   "translate",
+	Short: "Translate nucleotide sequences to protein",
+	Long: `Translate nucleotide sequences to protein
+
+Example usage:
+	gofasta translate -f sequences.fasta -o proteins.fasta
+	gofasta translate -f sequences.fasta --frame 2 --reverse --code 11 -o proteins.fasta
+	gofasta translate -f aligned.fasta -a MN908947.gb -d proteins/ --genes S,N
+
+Without --annotation, every record is translated in --frame on the forward (or, with --reverse, the reverse)
+strand, and a trailing partial codon is ignored.
+
+With --annotation, every CDS in the annotation is translated separately and written to its own file in --outdir,
+named after the CDS. The sequences must be in the annotation's coordinates, for example the output of
+gofasta sam toMultiAlign.
+
+Codons containing ambiguous nucleotides are translated if they can only code for one amino acid, otherwise they
+are X. Codons of gaps are translated as a gap.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in, err := gfio.OpenIn(*cmd.Flag("fasta"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		if translateAnnotation == "" {
+			out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+			if err != nil {
+				return err
+			}
+			defer out.Close()
+
+			return translate.Translate(cmd.Context(), in, out, translateFrame, translateReverse, translateCode)
+		}
+
+		annoSuffix, err := variants.AnnotationSuffix(translateAnnotation)
+		if err != nil {
+			return err
+		}
+		anno, err := gfio.OpenIn(*cmd.Flag("annotation"))
+		if err != nil {
+			return err
+		}
+		defer anno.Close()
+
+		regions, refSeq, err := variants.ReadAnnotation(anno, annoSuffix, "")
+		if err != nil {
+			return err
+		}
+
+		if len(translateGenes) > 0 {
+			regions, err = variants.SelectRegions(regions, translateGenes)
+			if err != nil {
+				return err
+			}
+		}
+
+		outs, closeOuts, err := regionOutputs(translateOutdir, regions)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if cerr := closeOuts(); cerr != nil && err == nil {
+				err = cerr
+			}
+		}()
+
+		err = translate.TranslateRegions(cmd.Context(), in, regions, len(refSeq), outs, translateCode)
+
+		return
+	},
+}
+
+// regionOutputs creates dir if necessary, and one fasta file in it for each region, named after the region.
+// Some CDS share a name (e.g. ORF1ab in SARS-CoV-2, which is in two parts), so repeats are numbered _2, _3, etc.
+// The returned function closes all the files, and returns the first error from closing any of them
+func regionOutputs(dir string, regions []variants.Region) ([]io.Writer, func() error, error) {
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, nil, err
+	}
+
+	files := make([]*gfio.Output, 0, len(regions))
+	closeAll := func() error {
+		var err error
+		for _, f := range files {
+			if cerr := f.Close(); cerr != nil && err == nil {
+				err = cerr
+			}
+		}
+		return err
+	}
+
+	outs := make([]io.Writer, len(regions))
+	seen := make(map[string]int)
+	for i, r := range regions {
+		seen[r.Name]++
+		name := r.Name
+		if seen[r.Name] > 1 {
+			name = r.Name + "_" + strconv.Itoa(seen[r.Name])
+		}
+		f, err := gfio.OpenOutPath(filepath.Join(dir, name+".fasta"))
+		if err != nil {
+			closeAll()
+			return nil, nil, err
+		}
+		files = append(files, f)
+		outs[i] = f
+	}
+
+	return outs, closeAll, nil
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/distance"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/tree"
+)
+
+var treeThreads int
+var treeMSA string
+var treeOutfile string
+var treeMethod string
+
+func init() {
+	rootCmd.AddCommand(treeCmd)
+
+	treeCmd.Flags().IntVarP(&treeThreads, "threads", "t", 0, "Number of CPUs to use for the distance calculations (Default: all available CPUs)")
+	treeCmd.Flags().StringVarP(&treeMSA, "msa", "", "stdin", "Alignment in fasta format")
+	treeCmd.Flags().StringVarP(&treeOutfile, "outfile", "o", "stdout", "Newick file to write")
+	treeCmd.Flags().StringVarP(&treeMethod, "method", "", "bionj", "Tree building method: bionj or nj")
+
+	treeCmd.Flags().SortFlags = false
+}
+
+var treeCmd = &cobra.Command{
+	Use:   "tree",
+	Short: "Build a neighbour-joining tree from an alignment",
+	Long: `Build a neighbour-joining tree from an alignment
+
+Example usage:
+	gofasta tree --msa alignment.fasta -o tree.nwk
+	gofasta tree --msa alignment.fasta --method nj -o tree.nwk
+
+The tree is built from the pairwise SNP-distances between the sequences (as in gofasta matrix), so branch lengths
+are in SNPs. --method bionj (Gascuel 1997) weights the distances to new nodes by their variance, and is usually
+more accurate than plain neighbour-joining (--method nj, Saitou & Nei 1987) at the same cost.
+
+The tree is unrooted, and written with a trifurcation at its base. Negative branch lengths are set to zero.
+Tree building takes time proportional to the cube of the number of sequences, so this is intended for quick looks
+at alignments of up to a few thousand records.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		var bionj bool
+		switch treeMethod {
+		case "bionj":
+			bionj = true
+		case "nj":
+		default:
+			return errors.New("unknown tree building method: " + treeMethod + " (choose one of bionj or nj)")
+		}
+
+		msa, err := gfio.OpenIn(*cmd.Flag("msa"))
+		if err != nil {
+			return err
+		}
+		defer msa.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		packed, err := distance.PackAlignment(msa)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(os.Stderr, "number of sequences in alignment: %d\n", len(packed))
+
+		m, err := distance.SNPMatrix(cmd.Context(), packed, treeThreads)
+		if err != nil {
+			return err
+		}
+
+		t, err := tree.Build(cmd.Context(), m, bionj)
+		if err != nil {
+			return err
+		}
+
+		err = t.WriteNewick(out)
+
+		return
+	},
+}
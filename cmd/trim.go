@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/trim"
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+var trimMSA string
+var trimOutfile string
+var trimStart int
+var trimEnd int
+var trimPad bool
+var trimAnnotation string
+var trimGene string
+
+func init() {
+	rootCmd.AddCommand(trimCmd)
+
+	trimCmd.Flags().StringVarP(&trimMSA, "msa", "", "stdin", "Alignment in fasta format")
+	trimCmd.Flags().StringVarP(&trimOutfile, "outfile", "o", "stdout", "Fasta file to write")
+	trimCmd.Flags().IntVarP(&trimStart, "start", "", 1, "1-based first position to retain in the output. Bases before this position are omitted, or are replaced with N if --pad")
+	trimCmd.Flags().IntVarP(&trimEnd, "end", "", -1, "1-based last position to retain in the output. Bases after this position are omitted, or are replaced with N if --pad (default: the last position)")
+	trimCmd.Flags().BoolVarP(&trimPad, "pad", "", false, "Replace the trimmed-out regions with Ns instead of removing them")
+	trimCmd.Flags().StringVarP(&trimAnnotation, "annotation", "a", "", "Genbank or GFF3 format annotation file, for --gene. Must have suffix .gb or .gff, and include the reference sequence")
+	trimCmd.Flags().StringVarP(&trimGene, "gene", "g", "", "Trim to the extent of this CDS in --annotation, instead of to --start and --end")
+
+	trimCmd.Flags().Lookup("pad").NoOptDefVal = "true"
+
+	trimCmd.Flags().SortFlags = false
+}
+
+var trimCmd = &cobra.Command{
+	Use:   "trim",
+	Short: "Trim an alignment to a range of positions",
+	Long: `Trim an alignment to a range of positions
+
+Example usage:
+	gofasta trim --msa alignment.fasta --start 266 --end 29674 -o trimmed.fasta
+	gofasta trim --msa alignment.fasta --start 266 --end 29674 --pad -o trimmed.fasta
+	gofasta trim --msa alignment.fasta -a MN908947.gb --gene S -o spike.fasta
+
+Positions are 1-based and inclusive, as for sam toMultiAlign --start and --end. With --pad, the alignment keeps
+its width and the positions outside the range are replaced with Ns.
+
+With --gene, the range is the extent of the named CDS in --annotation (from the 5'-most to the 3'-most position of
+all the CDS features with that name), and the alignment must be in the annotation's coordinates, for example the
+output of gofasta sam toMultiAlign.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if trimGene != "" {
+			if cmd.Flags().Changed("start") || cmd.Flags().Changed("end") {
+				return errors.New("choose one of --gene or --start/--end")
+			}
+			if trimAnnotation == "" {
+				return errors.New("--gene needs an --annotation")
+			}
+
+			annoSuffix, err := variants.AnnotationSuffix(trimAnnotation)
+			if err != nil {
+				return err
+			}
+			anno, err := gfio.OpenIn(*cmd.Flag("annotation"))
+			if err != nil {
+				return err
+			}
+			defer anno.Close()
+
+			regions, _, err := variants.ReadAnnotation(anno, annoSuffix, "")
+			if err != nil {
+				return err
+			}
+
+			trimStart, trimEnd = -1, -1
+			for _, r := range regions {
+				if r.Name != trimGene {
+					continue
+				}
+				if trimStart == -1 || r.Start < trimStart {
+					trimStart = r.Start
+				}
+				if r.Stop > trimEnd {
+					trimEnd = r.Stop
+				}
+			}
+			if trimStart == -1 {
+				return errors.New("couldn't find CDS " + trimGene + " in --annotation")
+			}
+		}
+
+		msa, err := gfio.OpenIn(*cmd.Flag("msa"))
+		if err != nil {
+			return err
+		}
+		defer msa.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		err = trim.Trim(cmd.Context(), msa, out, trimStart, trimEnd, trimPad)
+
+		return
+	},
+}
@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/typing"
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+var typeMSA string
+var typeDefinitions []string
+var typeAnnotation string
+var typeOutfile string
+var typeSatisfiedOnly bool
+
+func init() {
+	rootCmd.AddCommand(typeCmd)
+
+	typeCmd.Flags().StringVarP(&typeMSA, "msa", "", "stdin", "Alignment in reference coordinates, in fasta format")
+	typeCmd.Flags().StringSliceVarP(&typeDefinitions, "definitions", "d", []string{}, "JSON file(s) of variant definitions. Can be given more than once, or as a comma-separated list")
+	typeCmd.Flags().StringVarP(&typeAnnotation, "annotation", "a", "", "Genbank or GFF3 format annotation file, needed if any definitions have amino acid sites. Must have suffix .gb or .gff")
+	typeCmd.Flags().StringVarP(&typeOutfile, "outfile", "o", "stdout", "CSV file to write the calls to")
+	typeCmd.Flags().BoolVarP(&typeSatisfiedOnly, "satisfied-only", "", false, "Only write the definitions that each query satisfies")
+
+	typeCmd.Flags().Lookup("satisfied-only").NoOptDefVal = "true"
+
+	typeCmd.Flags().SortFlags = false
+}
+
+var typeCmd = &cobra.Command{
+	Use:   "type",
+	Short: "Genotype sequences against variant definitions",
+	Long: `Genotype sequences against variant definitions
+
+Example usage:
+	gofasta type --msa alignment.fasta -d B.1.1.7.json,B.1.351.json -a MN908947.gb -o calls.csv
+
+A definition file holds one JSON object, or an array of them, like:
+
+	{
+	  "name": "B.1.1.7",
+	  "sites": ["nuc:C3267T", "aa:S:N501Y", "del:21765:6"],
+	  "rules": {"min_alt": 2, "max_ref": 1}
+	}
+
+Sites are written as in the output of gofasta variants. Amino acid sites need an --annotation, and the alignment must
+be in its coordinates. At each site a query has the alternative allele, the reference allele, another allele, or is
+missing data (an ambiguity code, or N).
+
+A definition is satisfied if at least min_alt sites have the alternative allele (default: all of them) and at most
+max_ref have the reference (default: none). It is conflicted if more than max_ref sites have the reference, and
+otherwise partial. The output has the columns query,definition,call,alt,ref,other,missing,proportion, where
+proportion is the proportion of the definition's sites with the alternative allele.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if len(typeDefinitions) == 0 {
+			return errors.New("no --definitions to type against")
+		}
+
+		defs := make([]typing.Definition, 0)
+		for _, path := range typeDefinitions {
+			f, err := gfio.OpenInPath(path, "-d / --definitions")
+			if err != nil {
+				return err
+			}
+			d, err := typing.ReadDefinitions(f)
+			f.Close()
+			if err != nil {
+				return errors.New(path + ": " + err.Error())
+			}
+			defs = append(defs, d...)
+		}
+
+		var regions []variants.Region
+		refLen := 0
+		if typeAnnotation != "" {
+			annoSuffix, err := variants.AnnotationSuffix(typeAnnotation)
+			if err != nil {
+				return err
+			}
+			anno, err := gfio.OpenIn(*cmd.Flag("annotation"))
+			if err != nil {
+				return err
+			}
+			defer anno.Close()
+
+			var refSeq string
+			regions, refSeq, err = variants.ReadAnnotation(anno, annoSuffix, "")
+			if err != nil {
+				return err
+			}
+			refLen = len(refSeq)
+		}
+
+		typer, err := typing.NewTyper(defs, regions, refLen)
+		if err != nil {
+			return err
+		}
+
+		msa, err := gfio.OpenIn(*cmd.Flag("msa"))
+		if err != nil {
+			return err
+		}
+		defer msa.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		err = typer.Type(cmd.Context(), msa, out, typeSatisfiedOnly)
+
+		return
+	},
+}
@@ -177,11 +177,27 @@ you haven't also invoked --dist-push).
 		}
 		defer out.Close()
 
-		err = updown.TopRanking(query, target, ref, out, TRtable,
-			qtype, ttype, ignoreArray,
-			TRsizetotal, TRsizeup, TRsizedown, TRsizeside, TRsizesame,
-			TRdistall, TRdistup, TRdistdown, TRdistside,
-			TRthresholdpair, TRthresholdtarget, TRnofill, TRdistpush)
+		err = updown.TopRanking(query, target, ref, out, updown.TopRankingOptions{
+			Table:              TRtable,
+			QueryType:          qtype,
+			TargetType:         ttype,
+			Ignore:             ignoreArray,
+			SizeTotal:          TRsizetotal,
+			SizeUp:             TRsizeup,
+			SizeDown:           TRsizedown,
+			SizeSide:           TRsizeside,
+			SizeSame:           TRsizesame,
+			DistAll:            TRdistall,
+			DistUp:             TRdistup,
+			DistDown:           TRdistdown,
+			DistSide:           TRdistside,
+			ThresholdPair:      TRthresholdpair,
+			HasThresholdPair:   true,
+			ThresholdTarget:    TRthresholdtarget,
+			HasThresholdTarget: true,
+			NoFill:             TRnofill,
+			DistPush:           TRdistpush,
+		})
 
 		return
 	},
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/variablesites"
+)
+
+var variableSitesMSA string
+var variableSitesOutfile string
+var variableSitesMap string
+var variableSitesASC string
+var variableSitesOptions variablesites.Options
+
+func init() {
+	rootCmd.AddCommand(variableSitesCmd)
+
+	variableSitesCmd.Flags().StringVarP(&variableSitesMSA, "msa", "", "stdin", "Alignment in fasta format")
+	variableSitesCmd.Flags().StringVarP(&variableSitesOutfile, "outfile", "o", "stdout", "Alignment of the variable columns to write, in fasta format")
+	variableSitesCmd.Flags().StringVarP(&variableSitesMap, "map", "", "", "(Optional) CSV file of the original position of each kept column to write")
+	variableSitesCmd.Flags().StringVarP(&variableSitesASC, "asc", "", "", "(Optional) file of the number of invariant A, C, G and T columns to write")
+	variableSitesCmd.Flags().BoolVarP(&variableSitesOptions.AmbiguousAsMissing, "ambiguous-as-missing", "", false, "Treat ambiguity codes, N and ? as missing data, rather than as states")
+	variableSitesCmd.Flags().BoolVarP(&variableSitesOptions.GapsAsMissing, "gaps-as-missing", "", false, "Treat gaps as missing data, rather than as a state")
+
+	variableSitesCmd.Flags().Lookup("ambiguous-as-missing").NoOptDefVal = "true"
+	variableSitesCmd.Flags().Lookup("gaps-as-missing").NoOptDefVal = "true"
+
+	variableSitesCmd.Flags().SortFlags = false
+}
+
+var variableSitesCmd = &cobra.Command{
+	Use:   "variable-sites",
+	Short: "Remove the invariant columns from an alignment",
+	Long: `Remove the invariant columns from an alignment
+
+Example usage:
+	gofasta variable-sites --msa alignment.fasta -o variable.fasta
+	gofasta variable-sites --msa alignment.fasta --ambiguous-as-missing --gaps-as-missing --map columns.csv --asc invariant.txt -o variable.fasta
+
+A column is kept if it has more than one state. By default every character is a state of its own (ignoring
+case); with --ambiguous-as-missing and --gaps-as-missing, those characters are ignored, so a column of As and
+Ns is invariant.
+
+--map has the columns column,original_column (both 1-based). --asc has one line with the number of invariant
+columns of each of A, C, G and T, separated by spaces, e.g. for the weights of RAxML-NG's stamatakis
+ascertainment bias correction (+ASC_STAM). Invariant columns with no A, C, G or T aren't counted.
+
+The alignment is read into memory.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		msa, err := gfio.OpenIn(*cmd.Flag("msa"))
+		if err != nil {
+			return err
+		}
+		defer msa.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		var mapOut io.Writer
+		if variableSitesMap != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("map"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			mapOut = f
+		}
+
+		var ascOut io.Writer
+		if variableSitesASC != "" {
+			f, err := gfio.OpenOut(*cmd.Flag("asc"))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			ascOut = f
+		}
+
+		err = variablesites.VariableSites(msa, out, mapOut, ascOut, variableSitesOptions)
+
+		return
+	},
+}
@@ -128,7 +128,14 @@ Frame-shifting mutations in coding sequence are reported as indels but are ignor
 		}
 		defer out.Close()
 
-		err = variants.Variants(msa, stdin, variantsReference, anno, annoSuffix, out, variantsStart, variantsEnd, variantsAggregate, variantsThreshold, variantsAppendSNP, variantsThreads)
+		err = variants.Variants(cmd.Context(), msa, stdin, variantsReference, anno, annoSuffix, out, variants.Options{
+			Start:     variantsStart,
+			End:       variantsEnd,
+			Aggregate: variantsAggregate,
+			Threshold: variantsThreshold,
+			AppendSNP: variantsAppendSNP,
+			Threads:   variantsThreads,
+		})
 
 		return
 	},
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/vcf2fasta"
+)
+
+var vcf2fastaVCF string
+var vcf2fastaReference string
+var vcf2fastaOutfile string
+var vcf2fastaOptions vcf2fasta.Options
+
+func init() {
+	rootCmd.AddCommand(vcf2fastaCmd)
+
+	vcf2fastaCmd.Flags().StringVarP(&vcf2fastaVCF, "vcf", "v", "stdin", "VCF file of genotypes")
+	vcf2fastaCmd.Flags().StringVarP(&vcf2fastaReference, "reference", "r", "", "Reference sequence that the variants were called against, in fasta format")
+	vcf2fastaCmd.Flags().StringVarP(&vcf2fastaOutfile, "outfile", "o", "stdout", "Alignment to write, in fasta format")
+	vcf2fastaCmd.Flags().StringSliceVarP(&vcf2fastaOptions.Samples, "samples", "s", []string{}, "Samples to write, comma-separated (default: all of them)")
+	vcf2fastaCmd.Flags().Float64VarP(&vcf2fastaOptions.MinQual, "min-qual", "", 0, "Write N at sites in records with a QUAL below this")
+	vcf2fastaCmd.Flags().Float64VarP(&vcf2fastaOptions.MinGQ, "min-gq", "", 0, "Write N at sites where a sample's GQ is below this")
+	vcf2fastaCmd.Flags().IntVarP(&vcf2fastaOptions.MinDP, "min-dp", "", 0, "Write N at sites where a sample's DP is below this")
+	vcf2fastaCmd.Flags().BoolVarP(&vcf2fastaOptions.Pass, "pass", "", false, "Write N at sites in records whose FILTER isn't PASS or .")
+	vcf2fastaCmd.Flags().BoolVarP(&vcf2fastaOptions.AbsentAsN, "absent-as-n", "", false, "Write N, rather than the reference, at sites with no VCF record")
+
+	vcf2fastaCmd.Flags().Lookup("pass").NoOptDefVal = "true"
+	vcf2fastaCmd.Flags().Lookup("absent-as-n").NoOptDefVal = "true"
+
+	vcf2fastaCmd.Flags().SortFlags = false
+}
+
+var vcf2fastaCmd = &cobra.Command{
+	Use:   "vcf2fasta",
+	Short: "Make an alignment from the genotypes in a VCF file",
+	Long: `Make an alignment from the genotypes in a VCF file
+
+Example usage:
+	gofasta vcf2fasta -r reference.fasta -v calls.vcf -o alignment.fasta
+	gofasta vcf2fasta -r reference.fasta -v calls.vcf --pass --min-dp 10 --min-gq 20 -o alignment.fasta
+	bcftools view calls.vcf.gz | gofasta vcf2fasta -r reference.fasta -s sample1,sample2 -o alignment.fasta
+
+Each sample's genotypes are applied to --reference, to make one sequence per sample that is the same length as
+the reference, so the output can be used with gofasta snps, closest, etc. The VCF must describe only the one
+reference sequence, and each REF must match it.
+
+A homozygous (or haploid) call replaces the reference allele, left-aligned: a deletion is written as gaps, and
+the extra bases of an insertion are dropped. A heterozygous call between single bases is written as the IUPAC
+code for them, and any other heterozygous call as Ns. Missing genotypes, symbolic alleles, and calls that fail
+--min-qual, --min-gq, --min-dp or --pass are written as Ns over the reference allele. Sites that aren't in the
+VCF are the reference base, or N with --absent-as-n (e.g. for a VCF with a record at every callable site).`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if vcf2fastaReference == "" {
+			return errors.New("vcf2fasta needs --reference")
+		}
+
+		refIn, err := gfio.OpenIn(*cmd.Flag("reference"))
+		if err != nil {
+			return err
+		}
+		defer refIn.Close()
+		refs, err := fastaio.ReadFastaToListUncounted(refIn)
+		if err != nil {
+			return err
+		}
+		if len(refs) != 1 {
+			return errors.New("there must be exactly one record in --reference")
+		}
+
+		vcf, err := gfio.OpenIn(*cmd.Flag("vcf"))
+		if err != nil {
+			return err
+		}
+		defer vcf.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		err = vcf2fasta.VCF2Fasta(vcf, refs[0], out, vcf2fastaOptions)
+
+		return
+	},
+}
@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/watch"
+)
+
+var watchState string
+var watchOutfile string
+
+func init() {
+	rootCmd.AddCommand(watchCmd)
+
+	watchCmd.PersistentFlags().StringVarP(&watchState, "state", "", "", "CSV file of the records that have already been processed. Created if it doesn't exist")
+	watchCmd.PersistentFlags().StringVarP(&watchOutfile, "outfile", "o", "", "Output file to append the new records' results to. Created if it doesn't exist")
+}
+
+var watchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Process only the records that are new since the last run",
+	Long: `Process only the records that are new since the last run
+
+Example usage:
+	gofasta watch snps --state snps_state.csv -r reference.fasta -q daily_alignment.fasta -o snps.csv
+	gofasta watch closest --state closest_state.csv --query daily_alignment.fasta --target reference_set.fasta -n 5 -o closest.csv
+
+For datasets that grow every day. --state records the name and sequence hash of every record that has been
+processed, with the columns name,seqhash. Each run passes only the records of the growing alignment that aren't in
+it to the command, appends their results to --outfile (without repeating its header), and then adds them to
+--state, so an interrupted run can simply be repeated. A record whose sequence has changed since it was processed
+is not processed again, because its old results are already in --outfile, but a warning is given: remove it from
+both files to process it again.
+
+Only results that depend on each record on its own can be appended like this, so the other inputs (the
+reference, or the target set of gofasta closest) should stay the same between runs; if they change, start again
+with a new state file.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+
+		return nil
+	},
+}
+
+// watchRun passes the records in the fasta file given by inFlag that aren't in --state to process, with --outfile
+// to append to, and then adds them to --state
+func watchRun(cmd *cobra.Command, inFlag string, process func(in io.Reader, out io.Writer) error) error {
+
+	if watchState == "" || watchOutfile == "" {
+		return errors.New("gofasta watch needs a --state file and an --outfile")
+	}
+
+	state := make(watch.State)
+	stateSize := int64(0)
+	if info, err := os.Stat(watchState); err == nil {
+		stateSize = info.Size()
+	}
+	if stateSize > 0 {
+		f, err := os.Open(watchState)
+		if err != nil {
+			return err
+		}
+		state, err = watch.ReadState(f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	in, err := gfio.OpenIn(*cmd.Flag(inFlag))
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	var newRecords bytes.Buffer
+	entries, err := watch.New(cmd.Context(), in, state, &newRecords)
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+
+	out, err := os.OpenFile(watchOutfile, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	info, err := out.Stat()
+	if err != nil {
+		return err
+	}
+	var w io.Writer = out
+	if info.Size() > 0 {
+		w = watch.SkipHeader(out)
+	}
+
+	if err = process(&newRecords, w); err != nil {
+		return err
+	}
+
+	stateOut, err := os.OpenFile(watchState, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	defer stateOut.Close()
+
+	return watch.WriteState(stateOut, entries, stateSize == 0)
+}
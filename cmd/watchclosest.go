@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/closest"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+)
+
+var watchClosestThreads int
+var watchClosestQuery string
+var watchClosestTarget string
+var watchClosestMeasure string
+var watchClosestN int
+var watchClosestDist string
+var watchClosestTable bool
+
+func init() {
+	watchCmd.AddCommand(watchClosestCmd)
+
+	watchClosestCmd.Flags().IntVarP(&watchClosestThreads, "threads", "t", 0, "Number of CPUs to use (Default: all available CPUs)")
+	watchClosestCmd.Flags().StringVarP(&watchClosestQuery, "query", "", "stdin", "Growing alignment of sequences to find neighbours for, in fasta format")
+	watchClosestCmd.Flags().StringVarP(&watchClosestTarget, "target", "", "", "Alignment of sequences to search for neighbours in, in fasta format")
+	watchClosestCmd.Flags().StringVarP(&watchClosestMeasure, "measure", "m", "raw", "Which distance measure to use (raw, snp or tn93)")
+	watchClosestCmd.Flags().IntVarP(&watchClosestN, "number", "n", 0, "(Optional) the closest n sequences to each query will be returned")
+	watchClosestCmd.Flags().StringVarP(&watchClosestDist, "max-dist", "d", "", "(Optional) return all sequences less than or equal to this distance away")
+	watchClosestCmd.Flags().BoolVarP(&watchClosestTable, "table", "", false, "Write a long-form table of the output")
+
+	watchClosestCmd.Flags().Lookup("table").NoOptDefVal = "true"
+
+	watchClosestCmd.Flags().SortFlags = false
+}
+
+var watchClosestCmd = &cobra.Command{
+	Use:   "closest",
+	Short: "Find the closest sequences to the new records of a growing alignment",
+	Long: `Find the closest sequences to the new records of a growing alignment
+
+Example usage:
+	gofasta watch closest --state closest_state.csv --query daily_alignment.fasta --target reference_set.fasta -n 5 -o closest.csv
+
+As gofasta closest, but only for the queries that aren't in --state, whose lines are appended to --outfile.
+--target is searched in full every time, and should stay the same between runs.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		if watchClosestTarget == "" {
+			return errors.New("gofasta watch closest needs a --target")
+		}
+
+		var measure string
+		switch strings.ToLower(watchClosestMeasure) {
+		case "raw", "snp", "tn93":
+			measure = strings.ToLower(watchClosestMeasure)
+		default:
+			return errors.New("Couldn't tell which distance --measure / -m to use (choose one of \"raw\", \"snp\" or \"tn93\")")
+		}
+
+		dist := -1.0
+		if watchClosestDist != "" {
+			dist, err = strconv.ParseFloat(watchClosestDist, 64)
+			if err != nil {
+				return err
+			}
+		}
+
+		return watchRun(cmd, "query", func(in io.Reader, out io.Writer) error {
+			targetIn, err := gfio.OpenIn(*cmd.Flag("target"))
+			if err != nil {
+				return err
+			}
+			defer targetIn.Close()
+
+			if watchClosestN > 0 || dist != -1.0 {
+				return closest.ClosestN(cmd.Context(), in, targetIn, out, closest.NOptions{N: watchClosestN, MaxDist: dist, HasMaxDist: watchClosestDist != "", Measure: measure, Table: watchClosestTable, Threads: watchClosestThreads})
+			}
+			return closest.Closest(cmd.Context(), in, targetIn, measure, out, watchClosestThreads)
+		})
+	},
+}
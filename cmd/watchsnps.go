@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/snps"
+)
+
+var watchSNPsReference string
+var watchSNPsQuery string
+var watchSNPsHardGaps bool
+
+func init() {
+	watchCmd.AddCommand(watchSNPsCmd)
+
+	watchSNPsCmd.Flags().StringVarP(&watchSNPsReference, "reference", "r", "", "Reference sequence, in fasta format")
+	watchSNPsCmd.Flags().StringVarP(&watchSNPsQuery, "query", "q", "stdin", "Growing alignment of sequences to find snps in, in fasta format")
+	watchSNPsCmd.Flags().BoolVarP(&watchSNPsHardGaps, "hard-gaps", "", false, "Don't treat alignment gaps as missing data")
+
+	watchSNPsCmd.Flags().Lookup("hard-gaps").NoOptDefVal = "true"
+
+	watchSNPsCmd.Flags().SortFlags = false
+}
+
+var watchSNPsCmd = &cobra.Command{
+	Use:   "snps",
+	Short: "Find snps relative to a reference in the new records of a growing alignment",
+	Long: `Find snps relative to a reference in the new records of a growing alignment
+
+Example usage:
+	gofasta watch snps --state snps_state.csv -r reference.fasta -q daily_alignment.fasta -o snps.csv
+
+As gofasta snps (without --aggregate), but only for the records that aren't in --state, whose lines are appended
+to --outfile.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		return watchRun(cmd, "query", func(in io.Reader, out io.Writer) error {
+			ref, err := gfio.OpenIn(*cmd.Flag("reference"))
+			if err != nil {
+				return err
+			}
+			defer ref.Close()
+
+			return snps.SNPs(cmd.Context(), ref, in, out, snps.Options{HardGaps: watchSNPsHardGaps})
+		})
+	},
+}
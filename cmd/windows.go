@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/windows"
+)
+
+var windowsFasta string
+var windowsReference string
+var windowsOutfile string
+var windowsOptions = windows.DefaultOptions()
+
+func init() {
+	rootCmd.AddCommand(windowsCmd)
+
+	windowsCmd.Flags().StringVarP(&windowsFasta, "fasta", "f", "stdin", "Fasta file of sequences, aligned to --reference if it is given")
+	windowsCmd.Flags().StringVarP(&windowsReference, "reference", "r", "", "(Optional) reference sequence in fasta format, to calculate divergence from")
+	windowsCmd.Flags().StringVarP(&windowsOutfile, "outfile", "o", "stdout", "CSV file to write")
+	windowsCmd.Flags().IntVarP(&windowsOptions.Window, "window", "w", windowsOptions.Window, "Window size, in columns")
+	windowsCmd.Flags().IntVarP(&windowsOptions.Step, "step", "", windowsOptions.Step, "Distance between the starts of consecutive windows, in columns")
+
+	windowsCmd.Flags().SortFlags = false
+}
+
+var windowsCmd = &cobra.Command{
+	Use:   "windows",
+	Short: "Summarise composition and divergence in sliding windows",
+	Long: `Summarise composition and divergence in sliding windows
+
+Example usage:
+	gofasta windows -f sequences.fasta -o windows.csv
+	gofasta windows -f alignment.fasta -r MN908947.fasta -w 1000 --step 100 -o windows.csv
+
+Each record is split into windows of --window columns, starting every --step columns, with the last window
+ending at the end of the record. --outfile is a tidy table, with one line per window of each record, and the
+columns record,start,end,bases,gc,n_fraction,gap_fraction,compared_sites,divergence. Start and end are 1-based
+and inclusive. bases is the number of unambiguous nucleotides in the window and gc the proportion of them that
+are G or C; n_fraction and gap_fraction are the proportions of the window's columns that are N and gaps.
+
+With --reference, which must be aligned to the records, compared_sites is the number of sites in the window where
+both the record and the reference have an unambiguous nucleotide, and divergence is the proportion of them that
+differ. Without it, these columns are empty. Records are streamed, so the file can be of any size.`,
+
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		var ref string
+		if windowsReference != "" {
+			refIn, err := gfio.OpenIn(*cmd.Flag("reference"))
+			if err != nil {
+				return err
+			}
+			defer refIn.Close()
+			refs, err := fastaio.ReadFastaToListUncounted(refIn)
+			if err != nil {
+				return err
+			}
+			if len(refs) != 1 {
+				return errors.New("there must be exactly one record in --reference")
+			}
+			ref = refs[0].Seq
+		}
+
+		in, err := gfio.OpenIn(*cmd.Flag("fasta"))
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		out, err := gfio.OpenOut(*cmd.Flag("outfile"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		err = windows.Windows(cmd.Context(), in, ref, out, windowsOptions)
+
+		return
+	},
+}
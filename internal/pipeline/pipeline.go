@@ -0,0 +1,274 @@
+/*
+Package pipeline implements the reader, workers and ordered writer that most of
+gofasta's commands are built from.
+
+A source emits items one at a time, a number of goroutines transform them, and
+a sink receives the results in the same order as the source emitted the items,
+unless the context was made Unordered, in which case the sink receives each
+result as soon as it is ready, so that one slow item doesn't hold up the ones
+after it. Either way the sink is only ever called from one goroutine at a time.
+The number of items between the source and the sink is bounded, so a slow sink
+holds up the source rather than letting results pile up in memory. The first
+error from any stage cancels the others, and is the error that Run returns.
+*/
+package pipeline
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// orderKey is the key of the context value Unordered sets
+type orderKey struct{}
+
+// Unordered returns a copy of ctx under which Run passes results to its sink as soon as they are ready, rather than
+// in the order the source emitted the items. It is what gofasta's --unordered sets
+func Unordered(ctx context.Context) context.Context {
+	return context.WithValue(ctx, orderKey{}, true)
+}
+
+// Ordered reports whether Run keeps results in order under ctx, which it does unless ctx came from Unordered
+func Ordered(ctx context.Context) bool {
+	unordered, _ := ctx.Value(orderKey{}).(bool)
+	return !unordered
+}
+
+// Source emits items by calling emit, in order, until it runs out or emit returns an error, which it should
+// return. It should also stop if ctx is cancelled
+type Source[In any] func(ctx context.Context, emit func(In) error) error
+
+// job is one item and the slot its result is delivered to. The slot is buffered so that a worker never waits
+// for the sink
+type job[In, Out any] struct {
+	item In
+	slot chan result[Out]
+}
+
+type result[Out any] struct {
+	value Out
+	err   error
+}
+
+// Run passes every item from source to work on workers goroutines (0 means runtime.NumCPU()), and the results
+// to sink in the order source emitted the items, or unless Ordered(ctx), in the order they are ready. At most
+// queue items (0 means twice the number of workers) are in flight at once. It returns the first error from
+// source, work, sink or ctx
+func Run[In, Out any](ctx context.Context, workers, queue int, source Source[In], work func(In) (Out, error), sink func(Out) error) error {
+
+	if workers < 1 {
+		workers = runtime.NumCPU()
+	}
+	if queue < 1 {
+		queue = 2 * workers
+	}
+
+	if !Ordered(ctx) {
+		return runUnordered(ctx, workers, queue, source, work, sink)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var once sync.Once
+	var firstErr error
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	cJobs := make(chan job[In, Out], workers)
+	cOrder := make(chan chan result[Out], queue)
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(cJobs)
+		defer close(cOrder)
+		err := source(ctx, func(item In) error {
+			j := job[In, Out]{item: item, slot: make(chan result[Out], 1)}
+			select {
+			case cOrder <- j.slot:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			select {
+			case cJobs <- j:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			return nil
+		})
+		if err != nil {
+			fail(err)
+		}
+	}()
+
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for j := range cJobs {
+				if ctx.Err() != nil {
+					j.slot <- result[Out]{err: ctx.Err()}
+					continue
+				}
+				v, err := work(j.item)
+				j.slot <- result[Out]{value: v, err: err}
+			}
+		}()
+	}
+
+	for slot := range cOrder {
+		var r result[Out]
+		select {
+		case r = <-slot:
+		case <-ctx.Done():
+			fail(ctx.Err())
+		}
+		if ctx.Err() != nil {
+			continue
+		}
+		if r.err != nil {
+			fail(r.err)
+			continue
+		}
+		if err := sink(r.value); err != nil {
+			fail(err)
+		}
+	}
+
+	wg.Wait()
+
+	return firstErr
+}
+
+// runUnordered is Run for results that can be passed to sink in any order
+func runUnordered[In, Out any](ctx context.Context, workers, queue int, source Source[In], work func(In) (Out, error), sink func(Out) error) error {
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var once sync.Once
+	var firstErr error
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	// a token is taken when an item is emitted, and given back once its result has been through the sink
+	tokens := make(chan struct{}, queue)
+	cJobs := make(chan In, workers)
+	cResults := make(chan result[Out], queue)
+
+	go func() {
+		defer close(cJobs)
+		err := source(ctx, func(item In) error {
+			select {
+			case tokens <- struct{}{}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			select {
+			case cJobs <- item:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			return nil
+		})
+		if err != nil {
+			fail(err)
+		}
+	}()
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for item := range cJobs {
+				if ctx.Err() != nil {
+					cResults <- result[Out]{err: ctx.Err()}
+					continue
+				}
+				v, err := work(item)
+				cResults <- result[Out]{value: v, err: err}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(cResults)
+	}()
+
+	// cResults holds as many results as there are tokens, so the workers never wait for the sink
+	for r := range cResults {
+		<-tokens
+		switch {
+		case ctx.Err() != nil:
+			fail(ctx.Err())
+		case r.err != nil:
+			fail(r.err)
+		default:
+			if err := sink(r.value); err != nil {
+				fail(err)
+			}
+		}
+	}
+
+	return firstErr
+}
+
+// FromChannels returns a Source that emits the values fastaio's readers send on c, until they send on cDone
+// (having sent every value) or cErr. If the pipeline stops first, the rest of c is drained in the background
+// so that the reader isn't left blocked
+func FromChannels[T any](c chan T, cErr chan error, cDone chan bool) Source[T] {
+	return func(ctx context.Context, emit func(T) error) error {
+		for {
+			select {
+			case v := <-c:
+				if err := emit(v); err != nil {
+					go Drain(c, cErr, cDone)
+					return err
+				}
+			case err := <-cErr:
+				return err
+			case <-cDone:
+				// the reader has finished, but c may still be holding some values
+				for {
+					select {
+					case v := <-c:
+						if err := emit(v); err != nil {
+							return err
+						}
+					default:
+						return nil
+					}
+				}
+			case <-ctx.Done():
+				go Drain(c, cErr, cDone)
+				return ctx.Err()
+			}
+		}
+	}
+}
+
+// Drain receives from a reader's channels until it sends on cErr or cDone, so that a reader whose values are no
+// longer wanted isn't left blocked on a send
+func Drain[T any](c chan T, cErr chan error, cDone chan bool) {
+	for {
+		select {
+		case <-c:
+		case <-cErr:
+			return
+		case <-cDone:
+			return
+		}
+	}
+}
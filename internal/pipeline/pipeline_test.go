@@ -0,0 +1,156 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func count(n int) Source[int] {
+	return func(ctx context.Context, emit func(int) error) error {
+		for i := 0; i < n; i++ {
+			if err := emit(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func TestRun(t *testing.T) {
+	got := make([]int, 0)
+	err := Run(context.Background(), 4, 3, count(1000), func(i int) (int, error) {
+		// later items finish first, so they have to be put back in order
+		if i%7 == 0 {
+			time.Sleep(time.Microsecond * 50)
+		}
+		return i * 2, nil
+	}, func(v int) error {
+		got = append(got, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1000 {
+		t.Fatalf("problem in TestRun(): expected 1000 results, got %d", len(got))
+	}
+	for i, v := range got {
+		if v != i*2 {
+			t.Fatalf("problem in TestRun(): result %d is %d", i, v)
+		}
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	bad := errors.New("bad record")
+
+	err := Run(context.Background(), 3, 0, count(1000), func(i int) (int, error) {
+		if i == 500 {
+			return 0, bad
+		}
+		return i, nil
+	}, func(v int) error {
+		if v >= 500 {
+			t.Errorf("problem in TestRunErrors(): %d reached the sink after the error", v)
+		}
+		return nil
+	})
+	if err != bad {
+		t.Errorf("problem in TestRunErrors(): expected the worker's error, got %v", err)
+	}
+
+	err = Run(context.Background(), 3, 0, count(1000), func(i int) (int, error) { return i, nil }, func(v int) error {
+		if v == 10 {
+			return bad
+		}
+		return nil
+	})
+	if err != bad {
+		t.Errorf("problem in TestRunErrors(): expected the sink's error, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	err = Run(ctx, 3, 0, count(1000000), func(i int) (int, error) { return i, nil }, func(v int) error {
+		if v == 10 {
+			cancel()
+		}
+		return nil
+	})
+	if err != context.Canceled {
+		t.Errorf("problem in TestRunErrors(): expected the pipeline to be cancelled, got %v", err)
+	}
+}
+
+func TestFromChannels(t *testing.T) {
+	c := make(chan int, 10)
+	cErr := make(chan error)
+	cDone := make(chan bool)
+	go func() {
+		for i := 0; i < 100; i++ {
+			c <- i
+		}
+		cDone <- true
+	}()
+
+	n := 0
+	err := Run(context.Background(), 2, 0, FromChannels(c, cErr, cDone), func(i int) (int, error) { return i, nil }, func(v int) error {
+		if v != n {
+			t.Errorf("problem in TestFromChannels(): expected %d, got %d", n, v)
+		}
+		n++
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 100 {
+		t.Errorf("problem in TestFromChannels(): expected 100 values, got %d", n)
+	}
+}
+
+func TestRunUnordered(t *testing.T) {
+	// item 0 isn't finished until item 5 has reached the sink, which it can only do if the results aren't kept in
+	// order
+	release := make(chan struct{})
+	got := make([]int, 0)
+	seen := make(map[int]bool)
+	err := Run(Unordered(context.Background()), 4, 8, count(1000), func(i int) (int, error) {
+		if i == 0 {
+			<-release
+		}
+		return i, nil
+	}, func(v int) error {
+		if v == 5 {
+			close(release)
+		}
+		got = append(got, v)
+		seen[v] = true
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1000 || len(seen) != 1000 {
+		t.Fatalf("problem in TestRunUnordered(): expected 1000 different results, got %d (%d different)", len(got), len(seen))
+	}
+	if got[0] == 0 {
+		t.Errorf("problem in TestRunUnordered(): item 0 reached the sink first")
+	}
+
+	if !Ordered(context.Background()) || Ordered(Unordered(context.Background())) {
+		t.Errorf("problem in TestRunUnordered(): Ordered is wrong")
+	}
+
+	bad := errors.New("bad record")
+	err = Run(Unordered(context.Background()), 3, 0, count(1000), func(i int) (int, error) {
+		if i == 500 {
+			return 0, bad
+		}
+		return i, nil
+	}, func(v int) error { return nil })
+	if err != bad {
+		t.Errorf("problem in TestRunUnordered(): expected the worker's error, got %v", err)
+	}
+}
@@ -0,0 +1,343 @@
+/*
+Package align implements pairwise alignment of unaligned sequences to a
+reference, to make multiple alignments in reference coordinates without
+an external aligner.
+
+Alignment is global with affine gap penalties (Gotoh, 1982), with free end
+gaps so that queries that don't cover the whole reference (or overhang it)
+aren't penalised. The dynamic programming is restricted to a band of
+diagonals, which is placed using exact k-mer matches between the query and
+the reference, so that memory and time are proportional to the length of the
+query times the width of the band.
+*/
+package align
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+// Scoring holds the alignment parameters. Mismatch, GapOpen and GapExtend are penalties, so should
+// be positive. A gap of length l costs GapOpen + l*GapExtend
+type Scoring struct {
+	Match     int32
+	Mismatch  int32
+	GapOpen   int32
+	GapExtend int32
+	Band      int // extra diagonals either side of those suggested by the k-mer matches
+	K         int // k-mer length for placing the band
+}
+
+// DefaultScoring returns parameters suited to aligning assembled viral genomes to a closely related reference
+func DefaultScoring() Scoring {
+	return Scoring{Match: 2, Mismatch: 4, GapOpen: 6, GapExtend: 1, Band: 100, K: 15}
+}
+
+// Insertion is a run of query bases that isn't in the reference. Position is the 1-based reference position
+// that the insertion comes after
+type Insertion struct {
+	Position int
+	Seq      string
+}
+
+// Result is a query aligned to the reference: Seq is the same length as the reference, with '-' for
+// deletions and for reference positions that the query doesn't cover
+type Result struct {
+	Seq        string
+	Insertions []Insertion
+}
+
+// nucBits returns the set of nucleotides that an IUPAC code stands for, as bits: A=1, C=2, G=4, T=8
+var nucBits = func() [256]byte {
+	var a [256]byte
+	set := func(c byte, b byte) {
+		a[c] = b
+		if c >= 'A' && c <= 'Z' {
+			a[c+32] = b
+		}
+	}
+	set('A', 1)
+	set('C', 2)
+	set('G', 4)
+	set('T', 8)
+	set('U', 8)
+	set('R', 1|4)
+	set('Y', 2|8)
+	set('S', 2|4)
+	set('W', 1|8)
+	set('K', 4|8)
+	set('M', 1|2)
+	set('B', 2|4|8)
+	set('D', 1|4|8)
+	set('H', 1|2|8)
+	set('V', 1|2|4)
+	set('N', 15)
+	set('?', 15)
+	return a
+}()
+
+// score returns the score for aligning reference base r to query base q. Identical unambiguous bases score
+// Match, incompatible bases score -Mismatch, and anything involving an ambiguity code that is compatible scores 0
+func (s *Scoring) score(r, q byte) int32 {
+	br, bq := nucBits[r], nucBits[q]
+	switch {
+	case br&bq == 0:
+		return -s.Mismatch
+	case br == bq && br&(br-1) == 0:
+		return s.Match
+	}
+	return 0
+}
+
+// kmerIndex maps each k-mer in a reference to its position, or to -1 if it occurs more than once
+type kmerIndex struct {
+	k         int
+	positions map[string]int
+}
+
+func newKmerIndex(ref string, k int) kmerIndex {
+	idx := kmerIndex{k: k, positions: make(map[string]int, len(ref))}
+	for i := 0; i+k <= len(ref); i++ {
+		kmer := ref[i : i+k]
+		if _, ok := idx.positions[kmer]; ok {
+			idx.positions[kmer] = -1
+		} else {
+			idx.positions[kmer] = i
+		}
+	}
+	return idx
+}
+
+// diagonals returns the range of diagonals (reference position - query position) that the band should span,
+// from the k-mers that occur exactly once in the reference and are found in the query. The 5th to 95th
+// percentiles are used, so that a few spurious matches don't widen the band too much
+func (idx kmerIndex) diagonals(query string) (int, int, bool) {
+	k := idx.k
+	diags := make([]int, 0)
+	for j := 0; j+k <= len(query); j++ {
+		if i, ok := idx.positions[query[j:j+k]]; ok && i >= 0 {
+			diags = append(diags, i-j)
+		}
+	}
+	if len(diags) == 0 {
+		return 0, 0, false
+	}
+	sort.Ints(diags)
+	return diags[len(diags)*5/100], diags[(len(diags)-1)*95/100], true
+}
+
+// the three states of the affine-gap dynamic programming
+const (
+	stM     = iota // reference base aligned to query base
+	stD            // reference base aligned to a gap (a deletion in the query)
+	stI            // query base aligned to a gap (an insertion in the query)
+	stStart        // the start of the alignment
+)
+
+const negInf = math.MinInt32 / 2
+
+// alignment operations, as in a CIGAR string
+const (
+	opMatch = 'M'
+	opDel   = 'D'
+	opIns   = 'I'
+)
+
+// path is an alignment of part of a query to part of a reference: ops aligns ref[refStart:refEnd] to
+// query[queryStart:queryEnd]. Because end gaps are free, at least one of refStart and queryStart is 0, and
+// at least one of refEnd and queryEnd is the end of its sequence
+type path struct {
+	ops        []byte
+	refStart   int
+	refEnd     int
+	queryStart int
+	queryEnd   int
+}
+
+// Align aligns query to ref. Both should be uppercase and without gaps
+func (s *Scoring) Align(ref, query string) (Result, error) {
+	return s.align(ref, newKmerIndex(ref, s.K), query)
+}
+
+func (s *Scoring) align(ref string, idx kmerIndex, query string) (Result, error) {
+	p, err := s.path(ref, idx, query)
+	if err != nil {
+		return Result{}, err
+	}
+	return p.result(ref, query), nil
+}
+
+// path finds the best-scoring alignment of query to ref within the band
+func (s *Scoring) path(ref string, idx kmerIndex, query string) (path, error) {
+
+	n, m := len(ref), len(query)
+	if n == 0 {
+		return path{}, errors.New("empty reference sequence")
+	}
+
+	dlo, dhi, ok := idx.diagonals(query)
+	if !ok {
+		// no k-mer matches: fall back to a band around the main diagonal that allows for the difference in length
+		dlo, dhi = 0, n-m
+		if dhi < dlo {
+			dlo, dhi = dhi, dlo
+		}
+	}
+	dlo -= s.Band
+	dhi += s.Band
+	B := dhi - dlo + 1
+
+	// cell (j, i) of the band, where j is the query position and i the reference position, is at
+	// index i - j - dlo of row j
+	gapOpen := -(s.GapOpen + s.GapExtend)
+	gapExtend := -s.GapExtend
+
+	prevM, prevD, prevI := make([]int32, B), make([]int32, B), make([]int32, B)
+	curM, curD, curI := make([]int32, B), make([]int32, B), make([]int32, B)
+
+	// traceback: bits 0-1 are where M came from, 2-3 where D came from, 4-5 where I came from
+	tb := make([]byte, (m+1)*B)
+
+	best := int32(negInf)
+	bestJ, bestI, bestState := 0, 0, stStart
+
+	consider := func(j, i int, v int32, state int) {
+		if v > best {
+			best, bestJ, bestI, bestState = v, j, i, state
+		}
+	}
+
+	max3 := func(a, b, c int32) (int32, byte) {
+		if a >= b && a >= c {
+			return a, stM
+		}
+		if b >= c {
+			return b, stD
+		}
+		return c, stI
+	}
+
+	for j := 0; j <= m; j++ {
+		for k := 0; k < B; k++ {
+			i := j + dlo + k
+			curM[k], curD[k], curI[k] = negInf, negInf, negInf
+			if i < 0 || i > n {
+				continue
+			}
+			row := tb[j*B:]
+
+			if j == 0 || i == 0 {
+				// free leading gaps: the alignment can start anywhere along the top row or left column
+				curM[k] = 0
+				row[k] = stStart
+			} else {
+				// diagonal predecessor (j-1, i-1) is at index k in the previous row
+				v, from := max3(prevM[k], prevD[k], prevI[k])
+				curM[k] = v + s.score(ref[i-1], query[j-1])
+				row[k] = from
+			}
+
+			// deletion: from (j, i-1), index k-1 in this row
+			if i > 0 && k > 0 {
+				v, from := max3(curM[k-1]+gapOpen, curD[k-1]+gapExtend, curI[k-1]+gapOpen)
+				if from == stM && curM[k-1] == negInf {
+					v = negInf
+				}
+				curD[k] = v
+				row[k] |= from << 2
+			}
+
+			// insertion: from (j-1, i), index k+1 in the previous row
+			if j > 0 && k+1 < B {
+				v, from := max3(prevM[k+1]+gapOpen, prevD[k+1]+gapOpen, prevI[k+1]+gapExtend)
+				curI[k] = v
+				row[k] |= from << 4
+			}
+
+			// free trailing gaps: the alignment can end anywhere along the bottom row or right column
+			if j == m || i == n {
+				consider(j, i, curM[k], stM)
+				consider(j, i, curD[k], stD)
+				consider(j, i, curI[k], stI)
+			}
+		}
+		prevM, curM = curM, prevM
+		prevD, curD = curD, prevD
+		prevI, curI = curI, prevI
+	}
+
+	if best <= negInf/2 {
+		return path{}, errors.New("couldn't align sequence within the band")
+	}
+
+	p := path{refEnd: bestI, queryEnd: bestJ}
+
+	j, i, state := bestJ, bestI, bestState
+	for state != stStart {
+		k := i - j - dlo
+		cell := tb[j*B+k]
+		switch state {
+		case stM:
+			from := int(cell & 3)
+			if from == stStart {
+				state = stStart
+				continue
+			}
+			p.ops = append(p.ops, opMatch)
+			i--
+			j--
+			state = from
+		case stD:
+			p.ops = append(p.ops, opDel)
+			state = int((cell >> 2) & 3)
+			i--
+		case stI:
+			p.ops = append(p.ops, opIns)
+			state = int((cell >> 4) & 3)
+			j--
+		}
+	}
+	p.refStart, p.queryStart = i, j
+
+	// the traceback runs from the 3' end backwards
+	for a, b := 0, len(p.ops)-1; a < b; a, b = a+1, b-1 {
+		p.ops[a], p.ops[b] = p.ops[b], p.ops[a]
+	}
+
+	return p, nil
+}
+
+// result converts an alignment path to ref coordinates. Query bases that overhang either end of the
+// reference are dropped
+func (p path) result(ref, query string) Result {
+
+	aligned := make([]byte, len(ref))
+	for i := range aligned {
+		aligned[i] = '-'
+	}
+
+	insertions := make([]Insertion, 0)
+	i, j := p.refStart, p.queryStart
+	var prev byte
+	for _, op := range p.ops {
+		switch op {
+		case opMatch:
+			aligned[i] = query[j]
+			i++
+			j++
+		case opDel:
+			i++
+		case opIns:
+			if prev == opIns {
+				insertions[len(insertions)-1].Seq += query[j : j+1]
+			} else {
+				insertions = append(insertions, Insertion{Position: i, Seq: query[j : j+1]})
+			}
+			j++
+		}
+		prev = op
+	}
+
+	return Result{Seq: string(aligned), Insertions: insertions}
+}
@@ -0,0 +1,119 @@
+package align
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func randomSeq(r *rand.Rand, n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = "ACGT"[r.Intn(4)]
+	}
+	return string(b)
+}
+
+func TestAlignIdentical(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	ref := randomSeq(r, 500)
+	s := DefaultScoring()
+	res, err := s.Align(ref, ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Seq != ref || len(res.Insertions) != 0 {
+		t.Errorf("problem in TestAlignIdentical()")
+	}
+}
+
+func TestAlignIndels(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	ref := randomSeq(r, 1000)
+
+	// the query is missing the first 50 and last 30 bases, has a SNP at 200 (0-based), a 6-base deletion
+	// at 400-405, and a 3-base insertion after position 700 (1-based)
+	snp := "A"
+	if ref[200] == 'A' {
+		snp = "C"
+	}
+	query := ref[50:200] + snp + ref[201:400] + ref[406:700] + "GGG" + ref[700:970]
+
+	want := strings.Repeat("-", 50) + ref[50:200] + snp + ref[201:400] + "------" + ref[406:970] + strings.Repeat("-", 30)
+
+	s := DefaultScoring()
+	res, err := s.Align(ref, query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Seq != want {
+		t.Errorf("problem in TestAlignIndels(): got\n%s\nwant\n%s", res.Seq, want)
+	}
+	if len(res.Insertions) != 1 || res.Insertions[0].Seq != "GGG" {
+		t.Fatalf("problem in TestAlignIndels() insertions: %v", res.Insertions)
+	}
+	// the insertion might be placed anywhere in a run of Gs in the reference
+	p := res.Insertions[0].Position
+	if p < 697 || p > 703 {
+		t.Errorf("problem in TestAlignIndels() insertion position: %d", p)
+	}
+}
+
+func TestAlignLargeDeletion(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	ref := randomSeq(r, 3000)
+	query := ref[:1000] + ref[1400:]
+
+	s := DefaultScoring()
+	res, err := s.Align(ref, query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the deletion can be placed anywhere that gives the same sequence, and is left-aligned
+	start := 1000
+	for start > 0 && ref[start-1] == ref[start+399] {
+		start--
+	}
+	want := ref[:start] + strings.Repeat("-", 400) + ref[start+400:]
+	if res.Seq != want {
+		t.Errorf("problem in TestAlignLargeDeletion()")
+	}
+}
+
+func TestAlignAmbiguous(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+	ref := randomSeq(r, 300)
+	query := ref[:100] + "NNNNN" + ref[105:]
+	s := DefaultScoring()
+	res, err := s.Align(ref, query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Seq != query {
+		t.Errorf("problem in TestAlignAmbiguous()")
+	}
+}
+
+func TestAlignAll(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+	ref := randomSeq(r, 400)
+	in := ">q1\n" + ref[10:] + "\n>q2\n" + ref[:200] + "TTTTTTTTTT" + ref[200:] + "\n>q3 description\n" + ref[:100] + ref[110:] + "\n"
+
+	var out, insOut strings.Builder
+	s := DefaultScoring()
+	err := s.AlignAll(context.Background(), ref, strings.NewReader(in), &out, &insOut, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(out.String(), "\n")
+	if len(lines) != 7 || lines[0] != ">q1" || lines[2] != ">q2" || lines[4] != ">q3" {
+		t.Fatalf("problem in TestAlignAll(): %v", lines)
+	}
+	if lines[1] != strings.Repeat("-", 10)+ref[10:] || lines[3] != ref || len(lines[5]) != 400 {
+		t.Errorf("problem in TestAlignAll() sequences")
+	}
+	if !strings.HasPrefix(insOut.String(), "query,ref_position,insertion\nq2,") {
+		t.Errorf("problem in TestAlignAll() insertions: %s", insOut.String())
+	}
+}
@@ -0,0 +1,76 @@
+package align
+
+import (
+	"context"
+	"errors"
+	"io"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/internal/pipeline"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+// aligned is one query's alignment
+type aligned struct {
+	ID  string
+	res Result
+}
+
+// AlignAll aligns every record in the fasta file in to ref, using threads goroutines, and writes the results to out
+// as an alignment in reference coordinates. Insertions relative to the reference are omitted from the alignment;
+// if insOut is not nil they are written to it as CSV with the columns query,ref_position,insertion
+func (s *Scoring) AlignAll(ctx context.Context, ref string, in io.Reader, out, insOut io.Writer, threads int) error {
+
+	if threads < 1 {
+		threads = runtime.NumCPU()
+	}
+
+	ref = strings.ReplaceAll(strings.ToUpper(ref), "-", "")
+	idx := newKmerIndex(ref, s.K)
+
+	if insOut != nil {
+		if _, err := insOut.Write([]byte("query,ref_position,insertion\n")); err != nil {
+			return err
+		}
+	}
+
+	source := func(ctx context.Context, emit func(fastaio.FastaRecord) error) error {
+		return fastaio.EachRecord(ctx, in, emit)
+	}
+
+	work := func(FR fastaio.FastaRecord) (aligned, error) {
+		res, err := s.align(ref, idx, strings.ReplaceAll(FR.Seq, "-", ""))
+		if err != nil {
+			return aligned{}, errors.New(FR.ID + ": " + err.Error())
+		}
+		return aligned{ID: FR.ID, res: res}, nil
+	}
+
+	return pipeline.Run(ctx, threads, 0, source, work, func(a aligned) error {
+		if _, err := out.Write([]byte(">" + a.ID + "\n" + a.res.Seq + "\n")); err != nil {
+			return err
+		}
+		if insOut != nil {
+			for _, ins := range a.res.Insertions {
+				if _, err := insOut.Write([]byte(a.ID + "," + strconv.Itoa(ins.Position) + "," + ins.Seq + "\n")); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// AlignToReference reads the reference sequence from refIn and aligns every record in in to it, as AlignAll
+func AlignToReference(ctx context.Context, refIn, in io.Reader, out, insOut io.Writer, s Scoring, threads int) error {
+	refs, err := fastaio.ReadFastaToListUncounted(refIn)
+	if err != nil {
+		return err
+	}
+	if len(refs) != 1 {
+		return errors.New("there must be exactly one record in --reference")
+	}
+	return s.AlignAll(ctx, refs[0].Seq, in, out, insOut, threads)
+}
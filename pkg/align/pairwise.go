@@ -0,0 +1,238 @@
+package align
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+// The types of Difference
+const (
+	DiffSNP       = "snp"
+	DiffInsertion = "insertion" // bases in b that aren't in a
+	DiffDeletion  = "deletion"  // bases in a that aren't in b
+)
+
+// Difference is an SNP or an indel between two aligned sequences. APosition and BPosition are the 1-based
+// positions of the first base in each sequence; for an indel, the position in the sequence without the bases
+// is the one that the indel comes after. A and B are the bases in each sequence, or "" for an indel
+type Difference struct {
+	Type      string
+	APosition int
+	BPosition int
+	A         string
+	B         string
+}
+
+// String returns the difference as position:a>b, in a's coordinates, with "-" for the missing side of an indel
+func (d Difference) String() string {
+	a, b := d.A, d.B
+	if a == "" {
+		a = "-"
+	}
+	if b == "" {
+		b = "-"
+	}
+	return strconv.Itoa(d.APosition) + ":" + a + ">" + b
+}
+
+// Pairwise is the alignment of two sequences, a and b. A and B are the two sequences with gaps, the same
+// length. The aligned part of a is a[AStart-1:AEnd] and of b is b[BStart-1:BEnd] (1-based, inclusive); because
+// end gaps are free, either sequence can overhang the other at either end
+type Pairwise struct {
+	A           string
+	B           string
+	AStart      int
+	AEnd        int
+	BStart      int
+	BEnd        int
+	Cigar       string
+	Identity    float64 // percent of the aligned columns, excluding overhangs, that are identical
+	Differences []Difference
+}
+
+// Count returns the number of differences of type t
+func (p Pairwise) Count(t string) int {
+	n := 0
+	for _, d := range p.Differences {
+		if d.Type == t {
+			n++
+		}
+	}
+	return n
+}
+
+// cigar run-length encodes a list of alignment operations
+func cigar(ops []byte) string {
+	var sb strings.Builder
+	for i := 0; i < len(ops); {
+		j := i
+		for j < len(ops) && ops[j] == ops[i] {
+			j++
+		}
+		sb.WriteString(strconv.Itoa(j - i))
+		sb.WriteByte(ops[i])
+		i = j
+	}
+	return sb.String()
+}
+
+// Pairwise aligns b to a. Both should be uppercase and without gaps. The CIGAR string describes b relative to
+// a, using = for identical bases, X for other aligned bases, I and D for indels, and S for any part of b that
+// overhangs a. Only pairs of different unambiguous nucleotides are SNPs
+func (s *Scoring) Pairwise(a, b string) (Pairwise, error) {
+
+	p, err := s.path(a, newKmerIndex(a, s.K), b)
+	if err != nil {
+		return Pairwise{}, err
+	}
+
+	var ga, gb strings.Builder
+	ops := make([]byte, 0, len(p.ops)+2)
+
+	// leading overhangs
+	ga.WriteString(a[:p.refStart])
+	gb.WriteString(strings.Repeat("-", p.refStart))
+	ga.WriteString(strings.Repeat("-", p.queryStart))
+	gb.WriteString(b[:p.queryStart])
+	for k := 0; k < p.queryStart; k++ {
+		ops = append(ops, 'S')
+	}
+
+	pw := Pairwise{AStart: p.refStart + 1, AEnd: p.refEnd, BStart: p.queryStart + 1, BEnd: p.queryEnd, Differences: make([]Difference, 0)}
+
+	identical := 0
+	i, j := p.refStart, p.queryStart
+	var prev byte
+	for _, op := range p.ops {
+		switch op {
+		case opMatch:
+			ga.WriteByte(a[i])
+			gb.WriteByte(b[j])
+			br, bq := nucBits[a[i]], nucBits[b[j]]
+			switch {
+			case a[i] == b[j]:
+				identical++
+				ops = append(ops, '=')
+			default:
+				ops = append(ops, 'X')
+				if br != 0 && bq != 0 && br&(br-1) == 0 && bq&(bq-1) == 0 {
+					pw.Differences = append(pw.Differences, Difference{Type: DiffSNP, APosition: i + 1, BPosition: j + 1, A: a[i : i+1], B: b[j : j+1]})
+				}
+			}
+			i++
+			j++
+		case opDel:
+			ga.WriteByte(a[i])
+			gb.WriteByte('-')
+			ops = append(ops, 'D')
+			if prev == opDel {
+				pw.Differences[len(pw.Differences)-1].A += a[i : i+1]
+			} else {
+				pw.Differences = append(pw.Differences, Difference{Type: DiffDeletion, APosition: i + 1, BPosition: j, A: a[i : i+1]})
+			}
+			i++
+		case opIns:
+			ga.WriteByte('-')
+			gb.WriteByte(b[j])
+			ops = append(ops, 'I')
+			if prev == opIns {
+				pw.Differences[len(pw.Differences)-1].B += b[j : j+1]
+			} else {
+				pw.Differences = append(pw.Differences, Difference{Type: DiffInsertion, APosition: i, BPosition: j + 1, B: b[j : j+1]})
+			}
+			j++
+		}
+		prev = op
+	}
+
+	// trailing overhangs
+	ga.WriteString(a[p.refEnd:])
+	gb.WriteString(strings.Repeat("-", len(a)-p.refEnd))
+	ga.WriteString(strings.Repeat("-", len(b)-p.queryEnd))
+	gb.WriteString(b[p.queryEnd:])
+	for k := p.queryEnd; k < len(b); k++ {
+		ops = append(ops, 'S')
+	}
+
+	pw.A, pw.B, pw.Cigar = ga.String(), gb.String(), cigar(ops)
+	if len(p.ops) > 0 {
+		pw.Identity = 100 * float64(identical) / float64(len(p.ops))
+	}
+
+	return pw, nil
+}
+
+// prepare uppercases seq and removes any gaps
+func prepare(seq string) string {
+	return strings.ReplaceAll(strings.ToUpper(seq), "-", "")
+}
+
+// PairAlign aligns the two records in records and writes a two-column summary (stat,value) to out. If they
+// aren't nil, the differences are written to variantsOut as a CSV with the columns
+// type,a_position,b_position,a,b and the pairwise alignment to alignmentOut in fasta format
+func PairAlign(records []fastaio.FastaRecord, out, variantsOut, alignmentOut io.Writer, s Scoring) error {
+
+	if len(records) != 2 {
+		return errors.New("need exactly two sequences to align, found " + strconv.Itoa(len(records)))
+	}
+
+	a, b := records[0], records[1]
+	pw, err := s.Pairwise(prepare(a.Seq), prepare(b.Seq))
+	if err != nil {
+		return errors.New("couldn't align " + b.ID + " to " + a.ID + ": " + err.Error())
+	}
+
+	diffs := make([]string, len(pw.Differences))
+	for i, d := range pw.Differences {
+		diffs[i] = d.String()
+	}
+
+	rows := [][2]string{
+		{"a", a.ID},
+		{"b", b.ID},
+		{"a_length", strconv.Itoa(len(prepare(a.Seq)))},
+		{"b_length", strconv.Itoa(len(prepare(b.Seq)))},
+		{"a_start", strconv.Itoa(pw.AStart)},
+		{"a_end", strconv.Itoa(pw.AEnd)},
+		{"b_start", strconv.Itoa(pw.BStart)},
+		{"b_end", strconv.Itoa(pw.BEnd)},
+		{"cigar", pw.Cigar},
+		{"identity", strconv.FormatFloat(pw.Identity, 'f', 2, 64)},
+		{"snps", strconv.Itoa(pw.Count(DiffSNP))},
+		{"insertions", strconv.Itoa(pw.Count(DiffInsertion))},
+		{"deletions", strconv.Itoa(pw.Count(DiffDeletion))},
+		{"differences", strings.Join(diffs, ";")},
+	}
+
+	if _, err := out.Write([]byte("stat,value\n")); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if _, err := out.Write([]byte(row[0] + "," + row[1] + "\n")); err != nil {
+			return err
+		}
+	}
+
+	if variantsOut != nil {
+		if _, err := variantsOut.Write([]byte("type,a_position,b_position,a,b\n")); err != nil {
+			return err
+		}
+		for _, d := range pw.Differences {
+			if _, err := variantsOut.Write([]byte(d.Type + "," + strconv.Itoa(d.APosition) + "," + strconv.Itoa(d.BPosition) + "," + d.A + "," + d.B + "\n")); err != nil {
+				return err
+			}
+		}
+	}
+
+	if alignmentOut != nil {
+		if _, err := alignmentOut.Write([]byte(">" + a.ID + "\n" + pw.A + "\n>" + b.ID + "\n" + pw.B + "\n")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package align
+
+import (
+	"bytes"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+func TestPairwise(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	ab := []byte(randomSeq(r, 600))
+	// fix the bases either side of the indels so that they can't be shifted
+	ab[299], ab[300], ab[303], ab[304] = 'A', 'G', 'C', 'T'
+	ab[449], ab[450] = 'A', 'C'
+	a := string(ab)
+
+	// b is missing the first 20 bases of a, has an SNP at 100 (0-based), a 4-base deletion at 300-303,
+	// a 2-base insertion after position 450 (1-based) and 5 extra bases at its end
+	snp := "A"
+	if a[100] == 'A' {
+		snp = "C"
+	}
+	b := a[20:100] + snp + a[101:300] + a[304:450] + "TT" + a[450:] + "GGGGG"
+
+	s := DefaultScoring()
+	pw, err := s.Pairwise(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if pw.AStart != 21 || pw.AEnd != 600 || pw.BStart != 1 || pw.BEnd != len(b)-5 {
+		t.Errorf("problem in TestPairwise(): got aligned region a %d-%d b %d-%d", pw.AStart, pw.AEnd, pw.BStart, pw.BEnd)
+	}
+	if len(pw.A) != len(pw.B) || strings.ReplaceAll(pw.A, "-", "") != a || strings.ReplaceAll(pw.B, "-", "") != b {
+		t.Errorf("problem in TestPairwise(): the gapped sequences don't match the inputs")
+	}
+	if pw.Cigar != "80=1X199=4D146=2I150=5S" {
+		t.Errorf("problem in TestPairwise(): got cigar %s", pw.Cigar)
+	}
+
+	want := []Difference{
+		{Type: DiffSNP, APosition: 101, BPosition: 81, A: a[100:101], B: snp},
+		{Type: DiffDeletion, APosition: 301, BPosition: 280, A: a[300:304]},
+		{Type: DiffInsertion, APosition: 450, BPosition: 427, B: "TT"},
+	}
+	if len(pw.Differences) != len(want) {
+		t.Fatalf("problem in TestPairwise(): got %v", pw.Differences)
+	}
+	for i := range want {
+		if pw.Differences[i] != want[i] {
+			t.Errorf("problem in TestPairwise(): got %v, want %v", pw.Differences[i], want[i])
+		}
+	}
+}
+
+func TestPairAlign(t *testing.T) {
+	records := []fastaio.FastaRecord{{ID: "a", Seq: "ACGTACGTTTGACCATGGAC"}, {ID: "b", Seq: "acgtacgttt-accatggac"}}
+	s := DefaultScoring()
+	s.K = 5
+
+	out, variantsOut := new(bytes.Buffer), new(bytes.Buffer)
+	if err := PairAlign(records, out, variantsOut, nil, s); err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(out.String(), "cigar,10=1D9=\n") || !strings.Contains(out.String(), "deletions,1\n") || !strings.Contains(out.String(), "differences,11:G>-\n") {
+		t.Errorf("problem in TestPairAlign(): got\n%s", out.String())
+	}
+	if variantsOut.String() != "type,a_position,b_position,a,b\ndeletion,11,10,G,\n" {
+		t.Errorf("problem in TestPairAlign(): got\n%s", variantsOut.String())
+	}
+
+	if err := PairAlign(records[:1], out, nil, nil, s); err == nil {
+		t.Errorf("problem in TestPairAlign(): expected an error with one record")
+	}
+}
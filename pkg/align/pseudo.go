@@ -0,0 +1,183 @@
+package align
+
+import (
+	"context"
+	"errors"
+	"io"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/internal/pipeline"
+	"github.com/virus-evolution/gofasta/pkg/alphabet"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/summary"
+)
+
+// Placement is where a fragment was placed on the reference. RefStart and RefEnd are 1-based and inclusive,
+// Strand is -1 if the fragment's reverse complement was aligned, Anchors is the number of its k-mers that occur
+// once in the reference, and Identity is the percentage of aligned bases that match it
+type Placement struct {
+	Query    string
+	Strand   int
+	RefStart int
+	RefEnd   int
+	Anchors  int
+	Identity float64
+}
+
+// PseudoOptions controls pseudoalignment. Fragments with fewer than MinAnchors k-mers that occur once in the
+// reference (on their better strand) aren't placed, and reference positions a fragment doesn't cover are Pad
+type PseudoOptions struct {
+	MinAnchors int
+	Pad        byte
+	Threads    int
+}
+
+// anchors returns the number of k-mers in query that occur exactly once in the reference
+func (idx kmerIndex) anchors(query string) int {
+	n := 0
+	for j := 0; j+idx.k <= len(query); j++ {
+		if i, ok := idx.positions[query[j:j+idx.k]]; ok && i >= 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// place aligns a fragment, on whichever strand has more anchors, and returns it in reference coordinates with
+// pad outside the part of the reference that it covers. ok is false if it has fewer than minAnchors anchors
+func (s *Scoring) place(ref string, idx kmerIndex, query string, o PseudoOptions) (Result, Placement, bool, error) {
+
+	pl := Placement{Strand: 1, Anchors: idx.anchors(query)}
+	rc := alphabet.ReverseComplement(query)
+	if n := idx.anchors(rc); n > pl.Anchors {
+		pl.Strand, pl.Anchors, query = -1, n, rc
+	}
+	if pl.Anchors < o.MinAnchors || pl.Anchors == 0 {
+		return Result{}, pl, false, nil
+	}
+
+	p, err := s.path(ref, idx, query)
+	if err != nil {
+		return Result{}, pl, false, err
+	}
+	res := p.result(ref, query)
+
+	seq := []byte(res.Seq)
+	for i := 0; i < p.refStart; i++ {
+		seq[i] = o.Pad
+	}
+	for i := p.refEnd; i < len(seq); i++ {
+		seq[i] = o.Pad
+	}
+	res.Seq = string(seq)
+
+	aligned, same := 0, 0
+	i, j := p.refStart, p.queryStart
+	for _, op := range p.ops {
+		switch op {
+		case opMatch:
+			aligned++
+			if ref[i] == query[j] {
+				same++
+			}
+			i++
+			j++
+		case opDel:
+			i++
+		case opIns:
+			j++
+		}
+	}
+	pl.RefStart, pl.RefEnd = p.refStart+1, p.refEnd
+	if aligned > 0 {
+		pl.Identity = 100 * float64(same) / float64(aligned)
+	}
+
+	return res, pl, true, nil
+}
+
+// placed is one fragment's placement
+type placed struct {
+	ID  string
+	res Result
+	pl  Placement
+	ok  bool
+}
+
+// PseudoAlign places every fragment in the fasta file in on ref, on either strand, using o.Threads goroutines (all
+// CPUs if it is 0), and writes those with enough anchors to out in reference coordinates, padded to the
+// reference's length. Insertions relative to the reference are omitted. If reportOut is not nil, every fragment's
+// placement is written to it as CSV with the columns query,strand,ref_start,ref_end,anchors,identity,insertions,
+// which are empty (except anchors) for fragments that weren't placed
+func (s *Scoring) PseudoAlign(ctx context.Context, ref string, in io.Reader, out, reportOut io.Writer, o PseudoOptions) error {
+
+	threads := o.Threads
+	if threads < 1 {
+		threads = runtime.NumCPU()
+	}
+
+	ref = strings.ReplaceAll(strings.ToUpper(ref), "-", "")
+	if len(ref) < s.K {
+		return errors.New("the reference is shorter than the k-mer length")
+	}
+	idx := newKmerIndex(ref, s.K)
+
+	if reportOut != nil {
+		if _, err := reportOut.Write([]byte("query,strand,ref_start,ref_end,anchors,identity,insertions\n")); err != nil {
+			return err
+		}
+	}
+
+	source := func(ctx context.Context, emit func(fastaio.FastaRecord) error) error {
+		return fastaio.EachRecord(ctx, in, emit)
+	}
+
+	work := func(FR fastaio.FastaRecord) (placed, error) {
+		res, pl, ok, err := s.place(ref, idx, strings.ReplaceAll(strings.ToUpper(FR.Seq), "-", ""), o)
+		if err != nil {
+			return placed{}, errors.New(FR.ID + ": " + err.Error())
+		}
+		if !ok {
+			summary.Skipped(FR.ID, "too few anchors")
+		}
+		pl.Query = FR.ID
+		return placed{ID: FR.ID, res: res, pl: pl, ok: ok}, nil
+	}
+
+	return pipeline.Run(ctx, threads, 0, source, work, func(p placed) error {
+		if p.ok {
+			if _, err := out.Write([]byte(">" + p.ID + "\n" + p.res.Seq + "\n")); err != nil {
+				return err
+			}
+		}
+		if reportOut == nil {
+			return nil
+		}
+		line := p.ID + ",,,," + strconv.Itoa(p.pl.Anchors) + ",,\n"
+		if p.ok {
+			strand := "+"
+			if p.pl.Strand == -1 {
+				strand = "-"
+			}
+			line = p.ID + "," + strand + "," + strconv.Itoa(p.pl.RefStart) + "," + strconv.Itoa(p.pl.RefEnd) + "," +
+				strconv.Itoa(p.pl.Anchors) + "," + strconv.FormatFloat(p.pl.Identity, 'f', 2, 64) + "," +
+				strconv.Itoa(len(p.res.Insertions)) + "\n"
+		}
+		_, err := reportOut.Write([]byte(line))
+		return err
+	})
+}
+
+// PseudoAlignToReference reads the reference sequence from refIn and places every fragment in in on it, as PseudoAlign
+func PseudoAlignToReference(ctx context.Context, refIn, in io.Reader, out, reportOut io.Writer, s Scoring, o PseudoOptions) error {
+	refs, err := fastaio.ReadFastaToListUncounted(refIn)
+	if err != nil {
+		return err
+	}
+	if len(refs) != 1 {
+		return errors.New("there must be exactly one record in --reference")
+	}
+	return s.PseudoAlign(ctx, refs[0].Seq, in, out, reportOut, o)
+}
@@ -0,0 +1,45 @@
+package align
+
+import (
+	"bytes"
+	"context"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/alphabet"
+)
+
+func TestPseudoAlign(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	ref := randomSeq(r, 2000)
+
+	// fwd is bases 300-599 with a SNP at 400, rev is the reverse complement of bases 1500-1799, and none is
+	// unrelated to the reference
+	snp := "A"
+	if ref[400] == 'A' {
+		snp = "C"
+	}
+	fwd := ref[300:400] + snp + ref[401:600]
+	rev := alphabet.ReverseComplement(ref[1500:1800])
+	fasta := ">fwd\n" + fwd + "\n>none\n" + randomSeq(r, 300) + "\n>rev\n" + rev + "\n"
+
+	out := new(bytes.Buffer)
+	report := new(bytes.Buffer)
+	s := DefaultScoring()
+	s.Band = 20
+	if err := s.PseudoAlign(context.Background(), ref, strings.NewReader(fasta), out, report, PseudoOptions{MinAnchors: 10, Pad: 'N', Threads: 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := ">fwd\n" + strings.Repeat("N", 300) + fwd + strings.Repeat("N", 1400) + "\n" +
+		">rev\n" + strings.Repeat("N", 1500) + ref[1500:1800] + strings.Repeat("N", 200) + "\n"
+	if out.String() != want {
+		t.Errorf("problem in TestPseudoAlign(): wrong alignment")
+	}
+
+	lines := strings.Split(report.String(), "\n")
+	if len(lines) != 5 || lines[1] != "fwd,+,301,600,271,99.67,0" || !strings.HasPrefix(lines[2], "none,,,,") || lines[3] != "rev,-,1501,1800,286,100.00,0" {
+		t.Errorf("problem in TestPseudoAlign(): got report\n%s", report.String())
+	}
+}
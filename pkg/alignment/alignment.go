@@ -0,0 +1,184 @@
+/*
+Package alignment implements an in-memory multiple sequence alignment of
+EP-encoded records, with views of its columns, per-column counts of each
+nucleotide, slices by coordinates and masking, for the routines (consensus,
+entropy, variable sites) that work column by column.
+*/
+package alignment
+
+import (
+	"errors"
+	"io"
+	"strconv"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/mask"
+)
+
+// columnBlock is the number of columns EachColumn gathers in one pass over the records. Records are stored a
+// row at a time, so reading one column touches every record; gathering a block of them at once reads each
+// record's memory once per block rather than once per column
+const columnBlock = 64
+
+// Alignment is a set of EP-encoded records that are all the same width. Slice returns a view that shares the
+// records' memory, so an alignment's sequences shouldn't be modified in place; Mask and Keep return copies
+type Alignment struct {
+	records []fastaio.EncodedFastaRecord
+	width   int
+}
+
+// New returns an alignment of records, which must all be the same width
+func New(records []fastaio.EncodedFastaRecord) (*Alignment, error) {
+	a := &Alignment{records: records}
+	if len(records) == 0 {
+		return a, nil
+	}
+	a.width = len(records[0].Seq)
+	for _, EFR := range records[1:] {
+		if len(EFR.Seq) != a.width {
+			return nil, errors.New(EFR.ID + " is not the same length as the other records")
+		}
+	}
+	return a, nil
+}
+
+// Read reads a fasta-format alignment into memory
+func Read(r io.Reader) (*Alignment, error) {
+	records, err := fastaio.ReadEncodeAlignmentToList(r, false)
+	if err != nil {
+		return nil, err
+	}
+	return New(records)
+}
+
+// Len returns the number of records in the alignment
+func (a *Alignment) Len() int {
+	return len(a.records)
+}
+
+// Width returns the number of columns in the alignment
+func (a *Alignment) Width() int {
+	return a.width
+}
+
+// Records returns the alignment's records
+func (a *Alignment) Records() []fastaio.EncodedFastaRecord {
+	return a.records
+}
+
+// Column appends the nucleotides in column i (0-based), in record order, to dst[:0] and returns it, so that
+// one buffer can be reused for every column
+func (a *Alignment) Column(i int, dst []byte) []byte {
+	dst = dst[:0]
+	for _, EFR := range a.records {
+		dst = append(dst, EFR.Seq[i])
+	}
+	return dst
+}
+
+// EachColumn calls f with each column of the alignment in turn, stopping at and returning the first error
+// from f. col is only valid until f returns
+func (a *Alignment) EachColumn(f func(i int, col []byte) error) error {
+	n := len(a.records)
+	block := make([]byte, columnBlock*n)
+	for start := 0; start < a.width; start += columnBlock {
+		end := start + columnBlock
+		if end > a.width {
+			end = a.width
+		}
+		for r, EFR := range a.records {
+			for i, nuc := range EFR.Seq[start:end] {
+				block[i*n+r] = nuc
+			}
+		}
+		for i := start; i < end; i++ {
+			if err := f(i, block[(i-start)*n:(i-start+1)*n]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Counts is the number of each kind of character in a column. N counts N and ?, and Ambiguous counts the
+// other IUPAC ambiguity codes
+type Counts struct {
+	A         int
+	C         int
+	G         int
+	T         int
+	Gaps      int
+	N         int
+	Ambiguous int
+}
+
+// Add counts one EP-encoded nucleotide
+func (c *Counts) Add(nuc byte) {
+	switch nuc {
+	case 136:
+		c.A++
+	case 40:
+		c.C++
+	case 72:
+		c.G++
+	case 24:
+		c.T++
+	case 244, 4:
+		c.Gaps++
+	case 240, 242:
+		c.N++
+	default:
+		c.Ambiguous++
+	}
+}
+
+// Frequencies returns the counts of every column of the alignment
+func (a *Alignment) Frequencies() []Counts {
+	counts := make([]Counts, a.width)
+	for _, EFR := range a.records {
+		for i, nuc := range EFR.Seq {
+			counts[i].Add(nuc)
+		}
+	}
+	return counts
+}
+
+// Slice returns a view of columns [start, end) (0-based, half-open, as a BED interval)
+func (a *Alignment) Slice(start, end int) (*Alignment, error) {
+	if start < 0 || end < start || end > a.width {
+		return nil, errors.New("can't slice columns " + strconv.Itoa(start+1) + "-" + strconv.Itoa(end) + " from an alignment of " + strconv.Itoa(a.width) + " columns")
+	}
+	records := make([]fastaio.EncodedFastaRecord, len(a.records))
+	for i, EFR := range a.records {
+		EFR.Seq = EFR.Seq[start:end:end]
+		records[i] = EFR
+	}
+	return &Alignment{records: records, width: end - start}, nil
+}
+
+// Keep returns a copy of the alignment with only the columns in cols (0-based), in that order
+func (a *Alignment) Keep(cols []int) *Alignment {
+	records := make([]fastaio.EncodedFastaRecord, len(a.records))
+	for r, EFR := range a.records {
+		seq := make([]byte, len(cols))
+		for k, i := range cols {
+			seq[k] = EFR.Seq[i]
+		}
+		EFR.Seq = seq
+		records[r] = EFR
+	}
+	return &Alignment{records: records, width: len(cols)}
+}
+
+// Mask returns a copy of the alignment with the sites in m replaced with N, as gofasta mask does
+func (a *Alignment) Mask(m mask.Masks) (*Alignment, error) {
+	records := make([]fastaio.EncodedFastaRecord, len(a.records))
+	for i, EFR := range a.records {
+		masked, err := m.ApplyEncoded(EFR)
+		if err != nil {
+			return nil, err
+		}
+		records[i] = masked
+	}
+	return &Alignment{records: records, width: a.width}, nil
+}
@@ -0,0 +1,94 @@
+package alignment
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/encoding"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/mask"
+)
+
+func TestAlignment(t *testing.T) {
+	a, err := Read(strings.NewReader(">s1\nACGT-N\n>s2\nACGTRA\n>s3\nTCGA-?\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Len() != 3 || a.Width() != 6 {
+		t.Errorf("problem in TestAlignment(): %d records of width %d", a.Len(), a.Width())
+	}
+
+	if col := encoding.DecodeToString(a.Column(0, nil)); col != "AAT" {
+		t.Errorf("problem in TestAlignment(): column 1 is %s", col)
+	}
+
+	counts := a.Frequencies()
+	if counts[0] != (Counts{A: 2, T: 1}) || counts[4] != (Counts{Gaps: 2, Ambiguous: 1}) || counts[5] != (Counts{A: 1, N: 2}) {
+		t.Errorf("problem in TestAlignment(): %v", counts)
+	}
+
+	s, err := a.Slice(1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Width() != 2 || encoding.DecodeToString(s.Records()[2].Seq) != "CG" {
+		t.Errorf("problem in TestAlignment(): slice is %v", s.Records())
+	}
+	if _, err := a.Slice(4, 7); err == nil {
+		t.Errorf("problem in TestAlignment(): expected an error slicing past the end")
+	}
+
+	k := a.Keep([]int{5, 0})
+	if encoding.DecodeToString(k.Records()[1].Seq) != "AA" {
+		t.Errorf("problem in TestAlignment(): kept %v", k.Records())
+	}
+
+	m := mask.NewMasks()
+	m.Global = append(m.Global, mask.Site{Start: 0, End: 2})
+	m.PerRecord["s2"] = []mask.Site{{Start: 3, End: 4}}
+	masked, err := a.Mask(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := encoding.DecodeToString(masked.Records()[1].Seq); got != "NNGNRA" {
+		t.Errorf("problem in TestAlignment(): masked s2 is %s", got)
+	}
+	if got := encoding.DecodeToString(a.Records()[1].Seq); got != "ACGTRA" {
+		t.Errorf("problem in TestAlignment(): masking modified the alignment: %s", got)
+	}
+
+	if _, err := New([]fastaio.EncodedFastaRecord{{ID: "a", Seq: []byte{136}}, {ID: "b", Seq: []byte{136, 136}}}); err == nil {
+		t.Errorf("problem in TestAlignment(): expected an error for records of different widths")
+	}
+}
+
+func TestEachColumn(t *testing.T) {
+	// wider than a block of columns, and not a multiple of it
+	var sb strings.Builder
+	seqs := []string{strings.Repeat("ACGT", 50), strings.Repeat("TGCA", 50)}
+	for i, seq := range seqs {
+		sb.WriteString(">s" + string(rune('1'+i)) + "\n" + seq + "\n")
+	}
+	a, err := Read(strings.NewReader(sb.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n := 0
+	err = a.EachColumn(func(i int, col []byte) error {
+		if i != n {
+			t.Errorf("problem in TestEachColumn(): column %d came at %d", i, n)
+		}
+		n++
+		if got, want := encoding.DecodeToString(col), string([]byte{seqs[0][i], seqs[1][i]}); got != want {
+			t.Errorf("problem in TestEachColumn(): column %d is %s, want %s", i+1, got, want)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Error(err)
+	}
+	if n != 200 {
+		t.Errorf("problem in TestEachColumn(): %d columns", n)
+	}
+}
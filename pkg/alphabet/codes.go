@@ -0,0 +1,111 @@
+package alphabet
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// geneticCodes are NCBI's translation tables, as the amino acid for each codon in the order
+// TTT, TTC, TTA, TTG, TCT, ..., GGG (see https://www.ncbi.nlm.nih.gov/Taxonomy/Utils/wprintgc.cgi)
+var geneticCodes = map[int]string{
+	1:  "FFLLSSSSYY**CC*WLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG", // standard
+	2:  "FFLLSSSSYY**CCWWLLLLPPPPHHQQRRRRIIMMTTTTNNKKSS**VVVVAAAADDEEGGGG", // vertebrate mitochondrial
+	3:  "FFLLSSSSYY**CCWWTTTTPPPPHHQQRRRRIIMMTTTTNNKKSSRRVVVVAAAADDEEGGGG", // yeast mitochondrial
+	4:  "FFLLSSSSYY**CCWWLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG", // mold, protozoan, coelenterate mitochondrial and mycoplasma
+	5:  "FFLLSSSSYY**CCWWLLLLPPPPHHQQRRRRIIMMTTTTNNKKSSSSVVVVAAAADDEEGGGG", // invertebrate mitochondrial
+	6:  "FFLLSSSSYYQQCC*WLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG", // ciliate, dasycladacean and hexamita nuclear
+	9:  "FFLLSSSSYY**CCWWLLLLPPPPHHQQRRRRIIIMTTTTNNNKSSSSVVVVAAAADDEEGGGG", // echinoderm and flatworm mitochondrial
+	10: "FFLLSSSSYY**CCCWLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG", // euplotid nuclear
+	11: "FFLLSSSSYY**CC*WLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG", // bacterial, archaeal and plant plastid
+	12: "FFLLSSSSYY**CC*WLLLSPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG", // alternative yeast nuclear
+	13: "FFLLSSSSYY**CCWWLLLLPPPPHHQQRRRRIIMMTTTTNNKKSSGGVVVVAAAADDEEGGGG", // ascidian mitochondrial
+	14: "FFLLSSSSYYY*CCWWLLLLPPPPHHQQRRRRIIIMTTTTNNNKSSSSVVVVAAAADDEEGGGG", // alternative flatworm mitochondrial
+}
+
+// iupacSets maps each IUPAC nucleotide code to the unambiguous nucleotides it represents
+var iupacSets = map[byte]string{
+	'A': "A", 'C': "C", 'G': "G", 'T': "T",
+	'R': "AG", 'Y': "CT", 'S': "CG", 'W': "AT", 'K': "GT", 'M': "AC",
+	'B': "CGT", 'D': "AGT", 'H': "ACT", 'V': "ACG", 'N': "ACGT",
+}
+
+// GeneticCodes returns the numbers of the NCBI translation tables that are available
+func GeneticCodes() []int {
+	return []int{1, 2, 3, 4, 5, 6, 9, 10, 11, 12, 13, 14}
+}
+
+// MakeCodonDictFromTable returns a map from codon to amino acid for one of NCBI's translation tables.
+// As for MakeCodonDict, codons with ambiguous nucleotides are included if they can only represent one
+// amino acid
+func MakeCodonDictFromTable(table int) (map[string]string, error) {
+	aas, ok := geneticCodes[table]
+	if !ok {
+		return nil, errors.New("unknown genetic code: " + strconv.Itoa(table))
+	}
+
+	order := "TCAG"
+	base := make(map[string]string, 64)
+	for i := 0; i < 64; i++ {
+		codon := string([]byte{order[i/16], order[(i/4)%4], order[i%4]})
+		base[codon] = string(aas[i])
+	}
+
+	codonAA := make(map[string]string)
+	codes := "ACGTRYSWKMBDHVN"
+	for _, a := range []byte(codes) {
+		for _, b := range []byte(codes) {
+			for _, c := range []byte(codes) {
+				aa := ""
+				consistent := true
+				for _, x := range iupacSets[a] {
+					for _, y := range iupacSets[b] {
+						for _, z := range iupacSets[c] {
+							t := base[string([]rune{x, y, z})]
+							if aa == "" {
+								aa = t
+							} else if aa != t {
+								consistent = false
+							}
+						}
+					}
+				}
+				if consistent {
+					codonAA[string([]byte{a, b, c})] = aa
+				}
+			}
+		}
+	}
+
+	return codonAA, nil
+}
+
+// TranslateWithCode is as Translate, but uses the codon dictionary CD (e.g. from MakeCodonDictFromTable).
+// A codon of gaps is translated as a gap. If the sequence isn't a multiple of three long, the trailing
+// partial codon is ignored unless strict is true, in which case it is an error
+func TranslateWithCode(nuc string, strict bool, CD map[string]string) (string, error) {
+	if len(nuc)%3 != 0 {
+		if strict {
+			return "", ErrorCDSNotModThree
+		}
+		nuc = nuc[:len(nuc)-len(nuc)%3]
+	}
+	nuc = strings.ToUpper(nuc)
+
+	var sb strings.Builder
+	sb.Grow(len(nuc) / 3)
+	for i := 0; i < len(nuc); i += 3 {
+		codon := nuc[i : i+3]
+		if t, ok := CD[codon]; ok {
+			sb.WriteString(t)
+		} else if codon == "---" {
+			sb.WriteString("-")
+		} else if strict {
+			return "", errors.New("Translation error: Untranslatable codon: " + codon)
+		} else {
+			sb.WriteString("X")
+		}
+	}
+
+	return sb.String(), nil
+}
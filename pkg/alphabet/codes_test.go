@@ -0,0 +1,54 @@
+package alphabet
+
+import "testing"
+
+func TestMakeCodonDictFromTable(t *testing.T) {
+	standard, err := MakeCodonDictFromTable(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// every codon in the hard-coded dictionary should translate the same way
+	for codon, aa := range MakeCodonDict() {
+		if standard[codon] != aa {
+			t.Errorf("problem in TestMakeCodonDictFromTable(): %s is %s, not %s", codon, standard[codon], aa)
+		}
+	}
+
+	if standard["TAR"] != "*" || standard["YTR"] != "L" {
+		t.Errorf("problem in TestMakeCodonDictFromTable(): ambiguous codons not resolved")
+	}
+	if _, ok := standard["NNN"]; ok {
+		t.Errorf("problem in TestMakeCodonDictFromTable(): NNN shouldn't be translatable")
+	}
+
+	mito, err := MakeCodonDictFromTable(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mito["TGA"] != "W" || mito["AGA"] != "*" || mito["ATA"] != "M" {
+		t.Errorf("problem in TestMakeCodonDictFromTable(): vertebrate mitochondrial code is wrong")
+	}
+
+	_, err = MakeCodonDictFromTable(7)
+	if err == nil {
+		t.Errorf("expected an error for an unknown table")
+	}
+}
+
+func TestTranslateWithCode(t *testing.T) {
+	CD, _ := MakeCodonDictFromTable(1)
+
+	aa, err := TranslateWithCode("ATGTGA---NNNAT", false, CD)
+	if err != nil {
+		t.Error(err)
+	}
+	if aa != "M*-X" {
+		t.Errorf("problem in TestTranslateWithCode(): %s", aa)
+	}
+
+	_, err = TranslateWithCode("ATGTGAA", true, CD)
+	if err != ErrorCDSNotModThree {
+		t.Errorf("expected ErrorCDSNotModThree, got %v", err)
+	}
+}
@@ -0,0 +1,235 @@
+/*
+Package amplicons implements per-amplicon summaries of an alignment in the
+coordinates of a tiled amplicon scheme, such as the ARTIC primer schemes: how
+complete each record is within each amplicon and how far it is from the
+reference there, to monitor how well each amplicon in the scheme performs.
+*/
+package amplicons
+
+import (
+	"context"
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/encoding"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/primers"
+)
+
+var ep = encoding.MakeEncodingArray()
+
+// Amplicon is one amplicon in a scheme. Start and End are the outer edges of its primers, and InsertStart and
+// InsertEnd the inner edges, all in 0-based, half-open alignment coordinates
+type Amplicon struct {
+	Name        string
+	Start       int
+	End         int
+	InsertStart int
+	InsertEnd   int
+}
+
+// side matches primer names in the style of primer scheme BED files, e.g. SARS-CoV-2_1_LEFT, SARS-CoV-2_7_RIGHT_alt2
+// or SARS-CoV-2_3_LEFT_1, capturing the amplicon's name and which side the primer is on
+var side = regexp.MustCompile(`(?i)^(.+)_(LEFT|RIGHT)(_.*)?$`)
+
+// FromPrimers pairs the primers of a scheme into amplicons, by their names, in the order that they first appear.
+// When there are several (alternative) primers on one side, the amplicon spans all of them and its insert is what
+// none of them cover
+func FromPrimers(ps []primers.Primer) ([]Amplicon, error) {
+	type sides struct {
+		left, right []primers.Primer
+	}
+	order := make([]string, 0)
+	byName := make(map[string]*sides)
+	for _, p := range ps {
+		m := side.FindStringSubmatch(p.Name)
+		if m == nil {
+			return nil, errors.New("couldn't tell which amplicon primer " + p.Name + " belongs to: names should end in _LEFT or _RIGHT (optionally followed by a suffix)")
+		}
+		s, ok := byName[m[1]]
+		if !ok {
+			s = &sides{}
+			byName[m[1]] = s
+			order = append(order, m[1])
+		}
+		if strings.ToUpper(m[2]) == "LEFT" {
+			s.left = append(s.left, p)
+		} else {
+			s.right = append(s.right, p)
+		}
+	}
+
+	amplicons := make([]Amplicon, 0, len(order))
+	for _, name := range order {
+		s := byName[name]
+		if len(s.left) == 0 || len(s.right) == 0 {
+			return nil, errors.New("amplicon " + name + " needs both a _LEFT and a _RIGHT primer")
+		}
+		a := Amplicon{Name: name, Start: s.left[0].Start, End: s.right[0].End, InsertStart: s.left[0].End, InsertEnd: s.right[0].Start}
+		for _, p := range s.left[1:] {
+			if p.Start < a.Start {
+				a.Start = p.Start
+			}
+			if p.End > a.InsertStart {
+				a.InsertStart = p.End
+			}
+		}
+		for _, p := range s.right[1:] {
+			if p.End > a.End {
+				a.End = p.End
+			}
+			if p.Start < a.InsertEnd {
+				a.InsertEnd = p.Start
+			}
+		}
+		if a.InsertEnd <= a.InsertStart {
+			return nil, errors.New("amplicon " + name + "'s primers overlap, so it has no insert")
+		}
+		amplicons = append(amplicons, a)
+	}
+
+	return amplicons, nil
+}
+
+// Stats are one record's statistics in one amplicon's insert. Bases is the number of unambiguous nucleotides,
+// Compared the number of those where the reference is also unambiguous, and Differences the number of those
+// that differ from it
+type Stats struct {
+	Bases       int
+	Compared    int
+	Differences int
+}
+
+// Measure returns the statistics of seq in the insert of a. ref may be empty, in which case nothing is compared
+func Measure(seq, ref string, a Amplicon) Stats {
+	var s Stats
+	for i := a.InsertStart; i < a.InsertEnd; i++ {
+		nuc := ep[seq[i]]
+		if nuc&8 != 8 {
+			continue
+		}
+		s.Bases++
+		if ref == "" || ep[ref[i]]&8 != 8 {
+			continue
+		}
+		s.Compared++
+		if ep[ref[i]]&nuc < 16 {
+			s.Differences++
+		}
+	}
+	return s
+}
+
+// Options controls the summary: a record drops out of an amplicon if less than MinCompleteness of its insert
+// is unambiguous
+type Options struct {
+	MinCompleteness float64
+}
+
+// ratio formats a/b with four decimal places, or returns an empty field if b is 0
+func ratio(a, b int) string {
+	if b == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(float64(a)/float64(b), 'f', 4, 64)
+}
+
+// Amplicons measures every record of the alignment msa, which must be in the scheme's (and ref's) coordinates,
+// in every amplicon, and writes a CSV file with the columns
+// record,amplicon,start,end,completeness,compared_sites,differences,divergence to out, where start and end are the
+// amplicon's insert, 1-based and inclusive. ref may be empty, in which case the last three columns are empty.
+// If summaryOut is not nil, a CSV file with the columns amplicon,start,end,records,mean_completeness,dropouts,divergence
+// is written to it, where divergence is over every record. If split is not nil, it is called with each amplicon
+// and must return where to write that amplicon's insert of every record, in fasta format
+func Amplicons(ctx context.Context, msa io.Reader, ref string, amplicons []Amplicon, out, summaryOut io.Writer, split func(Amplicon) (io.Writer, error), o Options) error {
+
+	if len(amplicons) == 0 {
+		return errors.New("no amplicons in the scheme")
+	}
+	last := 0
+	for _, a := range amplicons {
+		if a.End > last {
+			last = a.End
+		}
+	}
+	if ref != "" && len(ref) < last {
+		return errors.New("the scheme extends beyond the end of the reference")
+	}
+
+	var splits []io.Writer
+	if split != nil {
+		splits = make([]io.Writer, len(amplicons))
+		for i, a := range amplicons {
+			w, err := split(a)
+			if err != nil {
+				return err
+			}
+			splits[i] = w
+		}
+	}
+
+	type totals struct {
+		records, bases, dropouts, compared, differences int
+	}
+	sums := make([]totals, len(amplicons))
+
+	if _, err := out.Write([]byte("record,amplicon,start,end,completeness,compared_sites,differences,divergence\n")); err != nil {
+		return err
+	}
+
+	err := fastaio.EachAlignedRecord(ctx, msa, func(FR fastaio.FastaRecord) error {
+		if len(FR.Seq) < last {
+			return errors.New(FR.ID + " is shorter than the scheme: is the alignment in the reference's coordinates?")
+		}
+		var sb strings.Builder
+		for i, a := range amplicons {
+			s := Measure(FR.Seq, ref, a)
+			length := a.InsertEnd - a.InsertStart
+			t := &sums[i]
+			t.records++
+			t.bases += s.Bases
+			t.compared += s.Compared
+			t.differences += s.Differences
+			if float64(s.Bases)/float64(length) < o.MinCompleteness {
+				t.dropouts++
+			}
+
+			sb.WriteString(FR.ID + "," + a.Name + "," + strconv.Itoa(a.InsertStart+1) + "," + strconv.Itoa(a.InsertEnd) + "," + ratio(s.Bases, length) + ",")
+			if ref != "" {
+				sb.WriteString(strconv.Itoa(s.Compared) + "," + strconv.Itoa(s.Differences) + "," + ratio(s.Differences, s.Compared))
+			} else {
+				sb.WriteString(",,")
+			}
+			sb.WriteString("\n")
+
+			if splits != nil {
+				if _, err := splits[i].Write([]byte(">" + FR.Description + "\n" + FR.Seq[a.InsertStart:a.InsertEnd] + "\n")); err != nil {
+					return err
+				}
+			}
+		}
+		_, err := out.Write([]byte(sb.String()))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if summaryOut == nil {
+		return nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("amplicon,start,end,records,mean_completeness,dropouts,divergence\n")
+	for i, a := range amplicons {
+		t := sums[i]
+		sb.WriteString(a.Name + "," + strconv.Itoa(a.InsertStart+1) + "," + strconv.Itoa(a.InsertEnd) + "," + strconv.Itoa(t.records) + "," +
+			ratio(t.bases, t.records*(a.InsertEnd-a.InsertStart)) + "," + strconv.Itoa(t.dropouts) + "," + ratio(t.differences, t.compared) + "\n")
+	}
+	_, err = summaryOut.Write([]byte(sb.String()))
+
+	return err
+}
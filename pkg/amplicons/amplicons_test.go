@@ -0,0 +1,86 @@
+package amplicons
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/primers"
+)
+
+// two amplicons: 1 has inserts 4-8 (0-based, half-open), 2 has an alternative left primer and inserts 12-16
+var scheme = []primers.Primer{
+	{Name: "s_1_LEFT", Start: 0, End: 4, Strand: 1},
+	{Name: "s_1_RIGHT", Start: 8, End: 11, Strand: -1},
+	{Name: "s_2_LEFT_1", Start: 7, End: 11, Strand: 1},
+	{Name: "s_2_LEFT_alt1", Start: 8, End: 12, Strand: 1},
+	{Name: "s_2_RIGHT_1", Start: 16, End: 20, Strand: -1},
+}
+
+func TestFromPrimers(t *testing.T) {
+	as, err := FromPrimers(scheme)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Amplicon{{"s_1", 0, 11, 4, 8}, {"s_2", 7, 20, 12, 16}}
+	if len(as) != 2 || as[0] != want[0] || as[1] != want[1] {
+		t.Errorf("problem in TestFromPrimers(): got %+v", as)
+	}
+
+	if _, err := FromPrimers(scheme[:1]); err == nil {
+		t.Errorf("problem in TestFromPrimers(): expected an error for an amplicon with no right primer")
+	}
+	if _, err := FromPrimers([]primers.Primer{{Name: "fwd"}}); err == nil {
+		t.Errorf("problem in TestFromPrimers(): expected an error for a primer name without a side")
+	}
+}
+
+func TestAmplicons(t *testing.T) {
+	as, err := FromPrimers(scheme)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ref := "AAAACCCCGGGGTTTTAAAA"
+	msa := ">a\nAAAACCACGGGGTTTTAAAA\n>b\nAAAACNNNGGGGNNNTAAAA\n"
+
+	out := new(bytes.Buffer)
+	summaryOut := new(bytes.Buffer)
+	splits := make(map[string]*bytes.Buffer)
+	split := func(a Amplicon) (io.Writer, error) {
+		splits[a.Name] = new(bytes.Buffer)
+		return splits[a.Name], nil
+	}
+	if err := Amplicons(context.Background(), strings.NewReader(msa), ref, as, out, summaryOut, split, Options{MinCompleteness: 0.5}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "record,amplicon,start,end,completeness,compared_sites,differences,divergence\n" +
+		"a,s_1,5,8,1.0000,4,1,0.2500\n" +
+		"a,s_2,13,16,1.0000,4,0,0.0000\n" +
+		"b,s_1,5,8,0.2500,1,0,0.0000\n" +
+		"b,s_2,13,16,0.2500,1,0,0.0000\n"
+	if out.String() != want {
+		t.Errorf("problem in TestAmplicons(): got\n%s", out.String())
+	}
+
+	want = "amplicon,start,end,records,mean_completeness,dropouts,divergence\n" +
+		"s_1,5,8,2,0.6250,1,0.2000\n" +
+		"s_2,13,16,2,0.6250,1,0.0000\n"
+	if summaryOut.String() != want {
+		t.Errorf("problem in TestAmplicons(): got summary\n%s", summaryOut.String())
+	}
+
+	if splits["s_1"].String() != ">a\nCCAC\n>b\nCNNN\n" {
+		t.Errorf("problem in TestAmplicons(): got sub-alignment\n%s", splits["s_1"].String())
+	}
+
+	out.Reset()
+	if err := Amplicons(context.Background(), strings.NewReader(msa), "", as, out, nil, nil, Options{}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(out.String(), "b,s_2,13,16,0.2500,,,\n") {
+		t.Errorf("problem in TestAmplicons(): got\n%s", out.String())
+	}
+}
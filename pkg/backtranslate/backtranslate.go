@@ -0,0 +1,93 @@
+/*
+Package backtranslate implements routines to map a protein alignment back onto
+the nucleotide sequences that code for it, to give a codon alignment.
+*/
+package backtranslate
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/alphabet"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+// Record returns the codon alignment of nuc, following the aligned protein sequence aa: each amino acid is
+// replaced by the next codon of nuc (after removing any gaps) and each gap by three gaps. The codons are
+// checked against the amino acids using the codon dictionary CD; X in either matches anything. A stop codon
+// left over at the end of nuc is allowed, and dropped
+func Record(aa string, nuc string, CD map[string]string) (string, error) {
+
+	nuc = strings.ToUpper(strings.ReplaceAll(nuc, "-", ""))
+
+	var sb strings.Builder
+	sb.Grow(3 * len(aa))
+
+	codons := 0
+	for i := 0; i < len(aa); i++ {
+		a := aa[i]
+		if a == '-' {
+			sb.WriteString("---")
+			continue
+		}
+		if 3*codons+3 > len(nuc) {
+			return "", errors.New("protein is longer than the nucleotide sequence")
+		}
+		codon := nuc[3*codons : 3*codons+3]
+		t, ok := CD[codon]
+		if !ok {
+			t = "X"
+		}
+		if a != 'X' && a != '?' && t != "X" && t != string(a) {
+			return "", errors.New("codon " + strconv.Itoa(codons+1) + " (" + codon + ") codes for " + t + ", not " + string(a))
+		}
+		sb.WriteString(codon)
+		codons++
+	}
+
+	rest := nuc[3*codons:]
+	if len(rest) > 0 && !(len(rest) == 3 && CD[rest] == "*") {
+		return "", errors.New("nucleotide sequence is " + strconv.Itoa(len(rest)) + " bases longer than the protein")
+	}
+
+	return sb.String(), nil
+}
+
+// BackTranslate writes the codon alignment of every record in the protein alignment aaMSA to out. Each record
+// is matched by name to a record in the fasta file nucIn, which is read into memory. table is the NCBI
+// translation table that the proteins were translated with
+func BackTranslate(ctx context.Context, aaMSA io.Reader, nucIn io.Reader, out io.Writer, table int) error {
+
+	CD, err := alphabet.MakeCodonDictFromTable(table)
+	if err != nil {
+		return err
+	}
+
+	nucs, err := fastaio.ReadFastaToList(nucIn)
+	if err != nil {
+		return err
+	}
+	byName := make(map[string]string, len(nucs))
+	for _, FR := range nucs {
+		if _, ok := byName[FR.ID]; ok {
+			return errors.New("duplicate name in nucleotide sequences: " + FR.ID)
+		}
+		byName[FR.ID] = FR.Seq
+	}
+
+	return fastaio.EachAlignedRecord(ctx, aaMSA, func(FR fastaio.FastaRecord) error {
+		nuc, ok := byName[FR.ID]
+		if !ok {
+			return errors.New("couldn't find " + FR.ID + " in the nucleotide sequences")
+		}
+		codons, err := Record(FR.Seq, nuc, CD)
+		if err != nil {
+			return errors.New(FR.ID + ": " + err.Error())
+		}
+		_, err = out.Write([]byte(">" + FR.Description + "\n" + codons + "\n"))
+		return err
+	})
+}
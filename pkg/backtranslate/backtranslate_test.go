@@ -0,0 +1,51 @@
+package backtranslate
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/alphabet"
+)
+
+func TestRecord(t *testing.T) {
+	CD, err := alphabet.MakeCodonDictFromTable(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		aa, nuc, want string
+		ok            bool
+	}{
+		{"M-K", "ATGAAA", "ATG---AAA", true},
+		{"MK-", "ATG-AAATAA", "ATGAAA---", true}, // gaps in the nucleotides and a final stop codon
+		{"MX", "ATGNNN", "ATGNNN", true},
+		{"MW", "ATGAAA", "", false}, // AAA is K
+		{"MKK", "ATGAAA", "", false},
+		{"M", "ATGAAA", "", false},
+	}
+	for _, test := range tests {
+		got, err := Record(test.aa, test.nuc, CD)
+		if (err == nil) != test.ok || got != test.want {
+			t.Errorf("problem in TestRecord(): %s %s gave %s (%v)", test.aa, test.nuc, got, err)
+		}
+	}
+}
+
+func TestBackTranslate(t *testing.T) {
+	var out bytes.Buffer
+	err := BackTranslate(context.Background(), strings.NewReader(">s2\nM-K\n>s1\nMWK\n"), strings.NewReader(">s1\nATGTGGAAA\n>s2\nATGAAG\n"), &out, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != ">s2\nATG---AAG\n>s1\nATGTGGAAA\n" {
+		t.Errorf("problem in TestBackTranslate(): %s", out.String())
+	}
+
+	err = BackTranslate(context.Background(), strings.NewReader(">s3\nM\n"), strings.NewReader(">s1\nATG\n"), &out, 1)
+	if err == nil {
+		t.Error("expected an error for a missing nucleotide sequence")
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Package bloom implements a scalable Bloom filter: a set that can say for
+certain that something has never been added to it, but only probably that
+something has, in a small, fixed number of bits per item however large the
+items are. It is used to find the records that might have duplicates in one
+low-memory pass over a file, so that only those have to be kept track of
+exactly.
+*/
+package bloom
+
+import (
+	"math"
+)
+
+// stage is one Bloom filter of m bits and k hash functions, which holds up to capacity items at its false
+// positive rate
+type stage struct {
+	bits     []uint64
+	m, k     uint64
+	n        int
+	capacity int
+}
+
+func newStage(capacity int, fp float64) *stage {
+	m := uint64(math.Ceil(-float64(capacity) * math.Log(fp) / (math.Ln2 * math.Ln2)))
+	if m < 64 {
+		m = 64
+	}
+	k := uint64(math.Round(float64(m) / float64(capacity) * math.Ln2))
+	if k < 1 {
+		k = 1
+	}
+	return &stage{bits: make([]uint64, (m+63)/64), m: m, k: k, capacity: capacity}
+}
+
+// bit returns the ith bit position of the item with hashes h1 and h2, by double hashing
+func (s *stage) bit(h1, h2 uint64, i uint64) uint64 {
+	return (h1 + i*h2) % s.m
+}
+
+func (s *stage) add(h1, h2 uint64) {
+	for i := uint64(0); i < s.k; i++ {
+		b := s.bit(h1, h2, i)
+		s.bits[b/64] |= 1 << (b % 64)
+	}
+	s.n++
+}
+
+func (s *stage) test(h1, h2 uint64) bool {
+	for i := uint64(0); i < s.k; i++ {
+		b := s.bit(h1, h2, i)
+		if s.bits[b/64]&(1<<(b%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Filter is a scalable Bloom filter (after Almeida et al. 2007, Scalable Bloom Filters, Information Processing
+// Letters 101 (6)). It starts with room for the capacity it was made with, and each time it fills up, adds a
+// filter twice the size of the last with half its false positive rate, so that it doesn't have to be told how
+// many items there will be and its false positive rate stays under the one it was made with. Items are given as
+// two independent 64-bit hashes of them (from a cryptographic digest, say). A Filter isn't safe for use from more
+// than one goroutine at once
+type Filter struct {
+	stages []*stage
+	fp     float64
+}
+
+// New returns an empty filter with room for capacity items before it grows, with a false positive rate of at
+// most fp
+func New(capacity int, fp float64) *Filter {
+	if capacity < 1 {
+		capacity = 1
+	}
+	// the stages' rates are fp/2, fp/4, ..., which sum to less than fp
+	return &Filter{stages: []*stage{newStage(capacity, fp/2)}, fp: fp / 2}
+}
+
+// Add adds the item with hashes h1 and h2
+func (f *Filter) Add(h1, h2 uint64) {
+	s := f.stages[len(f.stages)-1]
+	if s.n >= s.capacity {
+		f.fp /= 2
+		s = newStage(2*s.capacity, f.fp)
+		f.stages = append(f.stages, s)
+	}
+	s.add(h1, h2)
+}
+
+// Test returns false if the item with hashes h1 and h2 has certainly never been added, and true if it probably
+// has
+func (f *Filter) Test(h1, h2 uint64) bool {
+	for _, s := range f.stages {
+		if s.test(h1, h2) {
+			return true
+		}
+	}
+	return false
+}
+
+// TestAndAdd returns what Test would, then adds the item
+func (f *Filter) TestAndAdd(h1, h2 uint64) bool {
+	seen := f.Test(h1, h2)
+	if !seen {
+		f.Add(h1, h2)
+	}
+	return seen
+}
+
+// Len returns the number of items that have been added
+func (f *Filter) Len() int {
+	n := 0
+	for _, s := range f.stages {
+		n += s.n
+	}
+	return n
+}
+
+// Size returns the number of bytes of the filter's bits
+func (f *Filter) Size() int {
+	size := 0
+	for _, s := range f.stages {
+		size += 8 * len(s.bits)
+	}
+	return size
+}
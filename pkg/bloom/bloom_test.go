@@ -0,0 +1,43 @@
+package bloom
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"strconv"
+	"testing"
+)
+
+func hashes(s string) (uint64, uint64) {
+	d := sha256.Sum256([]byte(s))
+	return binary.LittleEndian.Uint64(d[0:8]), binary.LittleEndian.Uint64(d[8:16])
+}
+
+func TestFilter(t *testing.T) {
+	// far more items than the filter starts with room for, so that it has to grow
+	f := New(100, 0.01)
+	for i := 0; i < 20000; i++ {
+		f.Add(hashes("in" + strconv.Itoa(i)))
+	}
+	for i := 0; i < 20000; i++ {
+		if !f.Test(hashes("in" + strconv.Itoa(i))) {
+			t.Fatalf("problem in TestFilter(): item %d was added but isn't in the filter", i)
+		}
+	}
+	if f.Len() != 20000 || len(f.stages) < 2 {
+		t.Errorf("problem in TestFilter(): %d items in %d stages", f.Len(), len(f.stages))
+	}
+
+	falsePositives := 0
+	for i := 0; i < 20000; i++ {
+		if f.Test(hashes("out" + strconv.Itoa(i))) {
+			falsePositives++
+		}
+	}
+	if rate := float64(falsePositives) / 20000; rate > 0.015 {
+		t.Errorf("problem in TestFilter(): false positive rate %f", rate)
+	}
+
+	if f.TestAndAdd(hashes("new")) || !f.TestAndAdd(hashes("new")) {
+		t.Errorf("problem in TestFilter(): TestAndAdd is wrong")
+	}
+}
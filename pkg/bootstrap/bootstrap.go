@@ -0,0 +1,92 @@
+/*
+Package bootstrap implements the generation of bootstrap pseudo-replicates
+of an alignment, by resampling its columns with replacement.
+*/
+package bootstrap
+
+import (
+	"errors"
+	"io"
+	"math/rand"
+	"strconv"
+
+	"github.com/virus-evolution/gofasta/pkg/convert"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+)
+
+// Options controls the replicates. Format is the output format (see package convert). If Prefix isn't
+// empty, each replicate is written to its own file, called Prefix_N.suffix for N from 1
+type Options struct {
+	Replicates int
+	Seed       int64
+	Format     string
+	Prefix     string
+}
+
+// Columns returns width column indices drawn uniformly with replacement
+func Columns(r *rand.Rand, width int) []int {
+	cols := make([]int, width)
+	for i := range cols {
+		cols[i] = r.Intn(width)
+	}
+	return cols
+}
+
+// Resample returns a copy of records made of the columns cols
+func Resample(records []fastaio.FastaRecord, cols []int) []fastaio.FastaRecord {
+	rep := make([]fastaio.FastaRecord, len(records))
+	for i, FR := range records {
+		seq := make([]byte, len(cols))
+		for k, c := range cols {
+			seq[k] = FR.Seq[c]
+		}
+		rep[i] = fastaio.FastaRecord{ID: FR.ID, Description: FR.Description, Seq: string(seq), Idx: i}
+	}
+	return rep
+}
+
+// Bootstrap writes o.Replicates pseudo-replicates of the alignment msa, one after another to out, or to
+// separate files if o.Prefix is set. The same seed with the same alignment always gives the same replicates
+func Bootstrap(msa io.Reader, out io.Writer, o Options) error {
+
+	if o.Replicates < 1 {
+		return errors.New("need at least one replicate")
+	}
+
+	records, err := fastaio.ReadFastaToList(msa)
+	if err != nil {
+		return err
+	}
+	for _, FR := range records {
+		if len(FR.Seq) != len(records[0].Seq) {
+			return errors.New(FR.ID + " is not the same length as " + records[0].ID + ": bootstrap needs an alignment")
+		}
+	}
+
+	co := convert.Options{To: o.Format}
+	r := rand.New(rand.NewSource(o.Seed))
+
+	for n := 1; n <= o.Replicates; n++ {
+		rep := Resample(records, Columns(r, len(records[0].Seq)))
+		if o.Prefix == "" {
+			if err := convert.Write(out, rep, co); err != nil {
+				return err
+			}
+			continue
+		}
+		f, err := gfio.OpenOutPath(o.Prefix + "_" + strconv.Itoa(n) + "." + convert.Suffix(o.Format))
+		if err != nil {
+			return err
+		}
+		if err := convert.Write(f, rep, co); err != nil {
+			f.Close()
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
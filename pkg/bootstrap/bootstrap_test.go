@@ -0,0 +1,64 @@
+package bootstrap
+
+import (
+	"bytes"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+func TestResample(t *testing.T) {
+	records := []fastaio.FastaRecord{{ID: "a", Seq: "ACGT"}, {ID: "b", Seq: "TGCA"}}
+	rep := Resample(records, []int{3, 3, 0, 1})
+	if rep[0].Seq != "TTAC" || rep[1].Seq != "AATG" {
+		t.Errorf("problem in TestResample(): got %v", rep)
+	}
+
+	cols := Columns(rand.New(rand.NewSource(1)), 100)
+	for _, c := range cols {
+		if c < 0 || c >= 100 {
+			t.Errorf("problem in TestResample(): column %d out of range", c)
+		}
+	}
+}
+
+func TestBootstrap(t *testing.T) {
+	msa := ">a\nACGTACGTAC\n>b\nACGTTCGTAA\n"
+
+	run := func(seed int64) string {
+		out := new(bytes.Buffer)
+		if err := Bootstrap(strings.NewReader(msa), out, Options{Replicates: 3, Seed: seed, Format: "fasta"}); err != nil {
+			t.Fatal(err)
+		}
+		return out.String()
+	}
+	first := run(7)
+	if strings.Count(first, ">a\n") != 3 {
+		t.Errorf("problem in TestBootstrap(): got\n%s", first)
+	}
+	if run(7) != first {
+		t.Errorf("problem in TestBootstrap(): the same seed gave different replicates")
+	}
+
+	prefix := filepath.Join(t.TempDir(), "boot")
+	if err := Bootstrap(strings.NewReader(msa), nil, Options{Replicates: 2, Seed: 1, Format: "phylip", Prefix: prefix}); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"boot_1.phy", "boot_2.phy"} {
+		b, err := os.ReadFile(filepath.Join(filepath.Dir(prefix), name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(string(b), "2 10\n") {
+			t.Errorf("problem in TestBootstrap(): got\n%s", b)
+		}
+	}
+
+	if err := Bootstrap(strings.NewReader(">a\nACGT\n>b\nAC\n"), new(bytes.Buffer), Options{Replicates: 1, Format: "fasta"}); err == nil {
+		t.Errorf("problem in TestBootstrap(): expected an error for an unaligned input")
+	}
+}
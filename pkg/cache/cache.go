@@ -0,0 +1,132 @@
+/*
+Package cache keeps alignments that have been read and encoded in memory, so
+that a program that runs several of gofasta's routines on the same alignment in
+one process (snps, closest and matrix, for example) only parses it once.
+
+Alignments are keyed by the SHA-256 checksum of their bytes, so the same file
+opened twice, or a copy of it under another name, is found in the cache, and a
+file that has changed since it was cached isn't.
+*/
+package cache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+	"sync"
+
+	"github.com/virus-evolution/gofasta/pkg/distance"
+	"github.com/virus-evolution/gofasta/pkg/encoding"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+type key struct {
+	sum      [sha256.Size]byte
+	hardGaps bool
+}
+
+// entry is one alignment. Its records are encoded, and packed, at most once, however many goroutines ask for them
+type entry struct {
+	once    sync.Once
+	records []fastaio.EncodedFastaRecord
+	err     error
+
+	packOnce sync.Once
+	packed   []distance.Packed
+}
+
+// Cache is a set of encoded alignments. It is safe to use from more than one goroutine. The records it returns
+// are shared by everything that asks for the same alignment, and mustn't be modified
+type Cache struct {
+	mu      sync.Mutex
+	entries map[key]*entry
+}
+
+// New returns an empty cache
+func New() *Cache {
+	return &Cache{entries: make(map[key]*entry)}
+}
+
+// lookup reads the whole of r, and returns its entry in the cache (which is new and unfilled if the alignment
+// hasn't been seen before) and its bytes
+func (c *Cache) lookup(r io.Reader, hardGaps bool) (*entry, []byte, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	k := key{sum: sha256.Sum256(b), hardGaps: hardGaps}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[k]
+	if !ok {
+		e = &entry{}
+		c.entries[k] = e
+	}
+	return e, b, nil
+}
+
+// Encoded returns the EP-encoded records of the alignment in r, reading and encoding them if the alignment isn't
+// already in the cache. Each record's Score and base counts are filled in, as fastaio.ReadEncodeScoreAlignment
+// does, so the records can be used as closest's targets as well as its queries. With hardGaps, gaps are encoded
+// as a fifth character rather than as missing data, and are cached separately. An alignment that couldn't be
+// read returns the same error every time
+func (c *Cache) Encoded(r io.Reader, hardGaps bool) ([]fastaio.EncodedFastaRecord, error) {
+	e, b, err := c.lookup(r, hardGaps)
+	if err != nil {
+		return nil, err
+	}
+	e.once.Do(func() {
+		e.records, e.err = encode(b, hardGaps)
+	})
+	return e.records, e.err
+}
+
+// Packed returns every record of the alignment in r packed for distance.SNPMatrix, as distance.PackAlignment
+// does, packing them if the alignment hasn't been packed before
+func (c *Cache) Packed(r io.Reader) ([]distance.Packed, error) {
+	e, b, err := c.lookup(r, false)
+	if err != nil {
+		return nil, err
+	}
+	e.once.Do(func() {
+		e.records, e.err = encode(b, false)
+	})
+	if e.err != nil {
+		return nil, e.err
+	}
+	e.packOnce.Do(func() {
+		e.packed = distance.PackRecords(e.records)
+	})
+	return e.packed, nil
+}
+
+// Len returns the number of alignments in the cache
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// Clear empties the cache
+func (c *Cache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[key]*entry)
+}
+
+// encode reads and encodes an alignment, and scores and counts the bases of each record
+func encode(b []byte, hardGaps bool) ([]fastaio.EncodedFastaRecord, error) {
+	records, err := fastaio.ReadEncodeAlignmentToList(bytes.NewReader(b), hardGaps)
+	if err != nil {
+		return nil, err
+	}
+	scoring := encoding.MakeEncodedScoreArray()
+	for i := range records {
+		records[i].CalculateBaseContent()
+		for _, nuc := range records[i].Seq {
+			records[i].Score += scoring[nuc]
+		}
+	}
+	return records, nil
+}
@@ -0,0 +1,128 @@
+package cache
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/closest"
+	"github.com/virus-evolution/gofasta/pkg/distance"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/snps"
+)
+
+const alignment = `>ref
+ATGATG
+>Query1
+ATGATC
+>Query2
+ATTTTW
+>Query3
+ATGA-G
+`
+
+func TestCache(t *testing.T) {
+	c := New()
+
+	first, err := c.Encoded(strings.NewReader(alignment), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := c.Encoded(strings.NewReader(alignment), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first) != 4 || &first[0] != &second[0] || c.Len() != 1 {
+		t.Errorf("problem in TestCache(): the second read wasn't from the cache")
+	}
+	cErr := make(chan error)
+	cEFR := make(chan fastaio.EncodedFastaRecord, len(first))
+	cDone := make(chan bool)
+	go fastaio.ReadEncodeScoreAlignment(strings.NewReader(alignment), false, cEFR, cErr, cDone)
+	<-cDone
+	for i := range first {
+		if EFR := <-cEFR; !reflect.DeepEqual(EFR, first[i]) {
+			t.Errorf("problem in TestCache(): records aren't scored as by fastaio.ReadEncodeScoreAlignment(): %+v", first[i])
+		}
+	}
+
+	hard, err := c.Encoded(strings.NewReader(alignment), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if &hard[0] == &first[0] || c.Len() != 2 {
+		t.Errorf("problem in TestCache(): hard gaps should be cached separately")
+	}
+
+	if _, err = c.Encoded(strings.NewReader(">a\nACGT\n>b\nAC\n"), false); err == nil {
+		t.Errorf("problem in TestCache(): expected an error for a bad alignment")
+	}
+	if _, err = c.Encoded(strings.NewReader(">a\nACGT\n>b\nAC\n"), false); err == nil {
+		t.Errorf("problem in TestCache(): expected the error again for a cached bad alignment")
+	}
+
+	packed, err := c.Packed(strings.NewReader(alignment))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := distance.PackAlignment(strings.NewReader(alignment))
+	got, err := distance.SNPMatrix(context.Background(), packed, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantMatrix, err := distance.SNPMatrix(context.Background(), want, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, wantMatrix) {
+		t.Errorf("problem in TestCache(): packed records don't match distance.PackAlignment()")
+	}
+
+	c.Clear()
+	if c.Len() != 0 {
+		t.Errorf("problem in TestCache(): Clear() left %d alignments", c.Len())
+	}
+}
+
+func TestCachedRecords(t *testing.T) {
+	c := New()
+	ctx := context.Background()
+	records, err := c.Encoded(strings.NewReader(alignment), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var fromCache, fromFile []snps.SNPRecord
+	if err = snps.CallRecords(ctx, records[0], records, func(SR snps.SNPRecord) error {
+		fromCache = append(fromCache, SR)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err = snps.Call(ctx, strings.NewReader(">ref\nATGATG\n"), strings.NewReader(alignment), false, func(SR snps.SNPRecord) error {
+		fromFile = append(fromFile, SR)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(fromCache, fromFile) {
+		t.Errorf("problem in TestCachedRecords(): snps.CallRecords() doesn't match snps.Call()")
+	}
+
+	// tn93 isn't compared, because Nearest doesn't count the bases of queries it reads itself, and cached
+	// queries have theirs counted
+	for _, measure := range []string{"raw", "snp"} {
+		hits, err := closest.NearestRecords(ctx, records[1:], records, measure, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := closest.Nearest(ctx, strings.NewReader(alignment[strings.Index(alignment, ">Query1"):]), strings.NewReader(alignment), measure, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(hits, want) {
+			t.Errorf("problem in TestCachedRecords(): closest.NearestRecords() doesn't match closest.Nearest() for %s: %v %v", measure, hits, want)
+		}
+	}
+}
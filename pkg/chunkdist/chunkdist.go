@@ -0,0 +1,330 @@
+/*
+Package chunkdist implements an all-vs-all SNP-distance matrix that is
+calculated in blocks, so that it never needs more than two blocks of records
+in memory at once.
+
+The alignment is first split into blocks of records in a working directory.
+Then the matrix is tiled by pairs of blocks, and each tile's distances are
+written to their own file in that directory, which is only given its final
+name once it is complete. A run that is interrupted can be restarted with the
+same directory, and will pick up from the tiles that are missing.
+*/
+package chunkdist
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/internal/pipeline"
+	"github.com/virus-evolution/gofasta/pkg/distance"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+// Options controls the tiling. MaxDist, if it isn't negative, only keeps pairs of records whose distance is at
+// most MaxDist, which can make the output much smaller
+type Options struct {
+	BlockSize int
+	MaxDist   int
+	Threads   int
+}
+
+// DefaultOptions returns options that hold two blocks of 30kb genomes in a few hundred megabytes
+func DefaultOptions() Options {
+	return Options{BlockSize: 5000, MaxDist: -1}
+}
+
+// Manifest describes how an alignment was split into blocks
+type Manifest struct {
+	BlockSize int
+	Records   int
+	Width     int
+	Blocks    int
+}
+
+const manifestName = "manifest.csv"
+const header = "sequence1,sequence2,distance\n"
+
+func blockPath(dir string, b int) string {
+	return filepath.Join(dir, "block_"+strconv.Itoa(b)+".fasta")
+}
+
+func tilePath(dir string, row, col int) string {
+	return filepath.Join(dir, "tile_"+strconv.Itoa(row)+"_"+strconv.Itoa(col)+".csv")
+}
+
+// writeFile writes a file by handing a temporary file in the same directory to write, and renaming it to path
+// once write has returned without an error, so that path only ever exists when it is complete
+func writeFile(path string, write func(io.Writer) error) error {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	bw := bufio.NewWriter(f)
+	if err = write(bw); err == nil {
+		err = bw.Flush()
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// ReadManifest reads the manifest in dir. It returns nil and no error if dir hasn't been split yet
+func ReadManifest(dir string) (*Manifest, error) {
+	b, err := os.ReadFile(filepath.Join(dir, manifestName))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	if len(lines) != 2 || lines[0] != "block_size,records,width,blocks" {
+		return nil, errors.New("badly formatted manifest in " + dir)
+	}
+	fields := strings.Split(lines[1], ",")
+	if len(fields) != 4 {
+		return nil, errors.New("badly formatted manifest in " + dir)
+	}
+	v := make([]int, 4)
+	for i := range fields {
+		if v[i], err = strconv.Atoi(fields[i]); err != nil {
+			return nil, errors.New("badly formatted manifest in " + dir)
+		}
+	}
+	return &Manifest{BlockSize: v[0], Records: v[1], Width: v[2], Blocks: v[3]}, nil
+}
+
+// Split writes the records in the alignment msa to dir, blockSize records to a file, and then writes the
+// manifest, which marks the split as complete
+func Split(ctx context.Context, msa io.Reader, dir string, blockSize int) (*Manifest, error) {
+
+	if blockSize < 1 {
+		return nil, errors.New("the block size must be at least 1")
+	}
+
+	m := &Manifest{BlockSize: blockSize, Width: -1}
+	block := make([]fastaio.FastaRecord, 0, blockSize)
+
+	flush := func() error {
+		if len(block) == 0 {
+			return nil
+		}
+		err := writeFile(blockPath(dir, m.Blocks), func(w io.Writer) error {
+			for _, FR := range block {
+				if _, err := w.Write([]byte(">" + FR.ID + "\n" + FR.Seq + "\n")); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		m.Blocks++
+		block = block[:0]
+		return nil
+	}
+
+	err := fastaio.EachRecord(ctx, msa, func(FR fastaio.FastaRecord) error {
+		if m.Width == -1 {
+			m.Width = len(FR.Seq)
+		} else if len(FR.Seq) != m.Width {
+			return fastaio.NotAlignment(FR.ID, FR.Idx, 0, len(FR.Seq), m.Width)
+		}
+		m.Records++
+		block = append(block, FR)
+		if len(block) == blockSize {
+			return flush()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = flush(); err != nil {
+		return nil, err
+	}
+	if m.Records == 0 {
+		return nil, errors.New("no records in the alignment")
+	}
+
+	err = writeFile(filepath.Join(dir, manifestName), func(w io.Writer) error {
+		_, err := w.Write([]byte("block_size,records,width,blocks\n" + strconv.Itoa(m.BlockSize) + "," +
+			strconv.Itoa(m.Records) + "," + strconv.Itoa(m.Width) + "," + strconv.Itoa(m.Blocks) + "\n"))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// loadBlock reads and packs one block of records
+func loadBlock(dir string, b int) ([]distance.Packed, error) {
+	f, err := os.Open(blockPath(dir, b))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return distance.PackAlignment(f)
+}
+
+// writeTile writes the distances between the records in rows and those in cols, in long format without a
+// header. If rows and cols are the same block, only the pairs above the diagonal are written. Rows are
+// handed out to threads goroutines but are written in order, unless gofasta was run with --unordered
+func writeTile(ctx context.Context, w io.Writer, rows, cols []distance.Packed, same bool, o Options) error {
+
+	source := func(ctx context.Context, emit func(int) error) error {
+		for i := range rows {
+			if err := emit(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	work := func(i int) (string, error) {
+		var sb strings.Builder
+		start := 0
+		if same {
+			start = i + 1
+		}
+		for j := start; j < len(cols); j++ {
+			var d int
+			if o.MaxDist >= 0 {
+				d = distance.SNPUpTo(&rows[i], &cols[j], o.MaxDist)
+				if d > o.MaxDist {
+					continue
+				}
+			} else {
+				d = distance.SNP(&rows[i], &cols[j])
+			}
+			sb.WriteString(rows[i].ID + "," + cols[j].ID + "," + strconv.Itoa(d) + "\n")
+		}
+		return sb.String(), nil
+	}
+
+	return pipeline.Run(ctx, o.Threads, 0, source, work, func(lines string) error {
+		_, err := w.Write([]byte(lines))
+		return err
+	})
+}
+
+// Tiles calculates every tile of the matrix that doesn't already have a file in dir, which must have been
+// split into blocks by Split. progress, if it isn't nil, is called after each tile with the number of tiles
+// that are complete and the total number
+func Tiles(ctx context.Context, dir string, m *Manifest, o Options, progress func(done, total int)) error {
+
+	if o.Threads < 1 {
+		o.Threads = runtime.NumCPU()
+	}
+
+	total := m.Blocks * (m.Blocks + 1) / 2
+	done := 0
+	for row := 0; row < m.Blocks; row++ {
+		var rows []distance.Packed
+		for col := row; col < m.Blocks; col++ {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			path := tilePath(dir, row, col)
+			if _, err := os.Stat(path); err == nil {
+				done++
+				continue
+			}
+			var err error
+			if rows == nil {
+				if rows, err = loadBlock(dir, row); err != nil {
+					return err
+				}
+			}
+			cols := rows
+			if col != row {
+				if cols, err = loadBlock(dir, col); err != nil {
+					return err
+				}
+			}
+			err = writeFile(path, func(w io.Writer) error {
+				return writeTile(ctx, w, rows, cols, col == row, o)
+			})
+			if err != nil {
+				return err
+			}
+			done++
+			if progress != nil {
+				progress(done, total)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Gather writes every tile in dir to out, in order, as one CSV file with the columns
+// sequence1,sequence2,distance
+func Gather(dir string, m *Manifest, out io.Writer) error {
+	if _, err := out.Write([]byte(header)); err != nil {
+		return err
+	}
+	for row := 0; row < m.Blocks; row++ {
+		for col := row; col < m.Blocks; col++ {
+			f, err := os.Open(tilePath(dir, row, col))
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(out, f)
+			f.Close()
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ChunkDist calculates the SNP-distance between every pair of records in the alignment msa in blocks, using dir
+// to hold the blocks and the finished tiles, and writes them all to out in long format. If dir already holds a
+// complete split, msa isn't read again, and only the tiles that are missing are calculated. Cancelling ctx
+// stops it between records or rows, leaving the tiles that were finished in place to resume from
+func ChunkDist(ctx context.Context, msa io.Reader, dir string, out io.Writer, o Options, progress func(done, total int)) error {
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	m, err := ReadManifest(dir)
+	if err != nil {
+		return err
+	}
+	if m != nil && m.BlockSize != o.BlockSize {
+		return errors.New(dir + " was split with a block size of " + strconv.Itoa(m.BlockSize) +
+			": use the same block size to resume, or a new directory")
+	}
+	if m == nil {
+		if m, err = Split(ctx, msa, dir, o.BlockSize); err != nil {
+			return err
+		}
+	}
+
+	if err = Tiles(ctx, dir, m, o, progress); err != nil {
+		return err
+	}
+
+	return Gather(dir, m, out)
+}
@@ -0,0 +1,116 @@
+package chunkdist
+
+import (
+	"bytes"
+	"context"
+	"math/rand"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/distance"
+)
+
+func randomAlignment(r *rand.Rand, n, l int) string {
+	var sb strings.Builder
+	for i := 0; i < n; i++ {
+		sb.WriteString(">s" + strconv.Itoa(i) + "\n")
+		for j := 0; j < l; j++ {
+			sb.WriteByte("ACGTN-"[r.Intn(6)])
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// sortedLines returns the lines of a long-format matrix after its header, sorted
+func sortedLines(s string) []string {
+	lines := strings.Split(strings.TrimSuffix(s, "\n"), "\n")[1:]
+	sort.Strings(lines)
+	return lines
+}
+
+func TestChunkDist(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	aln := randomAlignment(r, 23, 100)
+
+	packed, err := distance.PackAlignment(strings.NewReader(aln))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := new(bytes.Buffer)
+	m, err := distance.SNPMatrix(context.Background(), packed, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = m.WriteLong(want); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, blockSize := range []int{1, 5, 23, 100} {
+		out := new(bytes.Buffer)
+		o := Options{BlockSize: blockSize, MaxDist: -1, Threads: 3}
+		if err = ChunkDist(context.Background(), strings.NewReader(aln), t.TempDir(), out, o, nil); err != nil {
+			t.Fatal(err)
+		}
+		if strings.Join(sortedLines(out.String()), "\n") != strings.Join(sortedLines(want.String()), "\n") {
+			t.Errorf("problem in TestChunkDist(): block size %d doesn't match distance.SNPMatrix()", blockSize)
+		}
+	}
+
+	// the pairs within MaxDist are the same as in the full matrix
+	out := new(bytes.Buffer)
+	if err = ChunkDist(context.Background(), strings.NewReader(aln), t.TempDir(), out, Options{BlockSize: 4, MaxDist: 40, Threads: 2}, nil); err != nil {
+		t.Fatal(err)
+	}
+	near := make([]string, 0)
+	for _, line := range sortedLines(want.String()) {
+		d, _ := strconv.Atoi(line[strings.LastIndex(line, ",")+1:])
+		if d <= 40 {
+			near = append(near, line)
+		}
+	}
+	if len(near) == 0 || strings.Join(sortedLines(out.String()), "\n") != strings.Join(near, "\n") {
+		t.Errorf("problem in TestChunkDist(): the pairs within MaxDist don't match")
+	}
+}
+
+func TestResume(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	aln := randomAlignment(r, 10, 70)
+	dir := t.TempDir()
+	o := Options{BlockSize: 3, MaxDist: -1, Threads: 2}
+
+	first := new(bytes.Buffer)
+	if err := ChunkDist(context.Background(), strings.NewReader(aln), dir, first, o, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// lose some tiles, as if the run had been interrupted, and resume without the alignment
+	os.Remove(tilePath(dir, 1, 2))
+	os.Remove(tilePath(dir, 3, 3))
+	calculated := 0
+	second := new(bytes.Buffer)
+	err := ChunkDist(context.Background(), strings.NewReader(""), dir, second, o, func(done, total int) {
+		calculated++
+		if total != 10 {
+			t.Errorf("problem in TestResume(): expected 10 tiles, got %d", total)
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calculated != 2 {
+		t.Errorf("problem in TestResume(): expected 2 tiles to be recalculated, got %d", calculated)
+	}
+	if second.String() != first.String() {
+		t.Errorf("problem in TestResume(): resumed output is different")
+	}
+
+	o.BlockSize = 4
+	if err = ChunkDist(context.Background(), strings.NewReader(aln), dir, second, o, nil); err == nil {
+		t.Errorf("problem in TestResume(): expected an error for a different block size")
+	}
+}
@@ -0,0 +1,147 @@
+package closest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"runtime"
+
+	"github.com/virus-evolution/gofasta/internal/pipeline"
+	"github.com/virus-evolution/gofasta/pkg/distance"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/schema"
+)
+
+// Assignment is the label of a query's nearest representative. Margin is how much further the nearest
+// representative with any other label is (SecondLabel, at SecondDistance), and is NaN if there is no other label
+type Assignment struct {
+	Query          string
+	Label          string
+	Representative string
+	Distance       float64
+	SecondLabel    string
+	SecondDistance float64
+	Margin         float64
+}
+
+// assign finds the nearest representative of each label to query. Ties within a label are broken by completeness,
+// and between labels by the order of the representatives
+func assign(query fastaio.EncodedFastaRecord, reps []fastaio.EncodedFastaRecord, labels []string, m distance.Measure) Assignment {
+
+	type nearest struct {
+		rep      int
+		distance float64
+	}
+	byLabel := make(map[string]nearest)
+	order := make([]string, 0)
+	for r, rep := range reps {
+		d, _ := m.Distance(query, rep)
+		if math.IsNaN(d) {
+			continue
+		}
+		best, ok := byLabel[labels[r]]
+		if !ok {
+			order = append(order, labels[r])
+		}
+		if !ok || d < best.distance || (d == best.distance && rep.Score > reps[best.rep].Score) {
+			byLabel[labels[r]] = nearest{rep: r, distance: d}
+		}
+	}
+
+	a := Assignment{Query: query.ID, Distance: math.NaN(), SecondDistance: math.NaN(), Margin: math.NaN()}
+	first, second := "", ""
+	for _, label := range order {
+		n := byLabel[label]
+		switch {
+		case first == "" || n.distance < byLabel[first].distance:
+			first, second = label, first
+		case second == "" || n.distance < byLabel[second].distance:
+			second = label
+		}
+	}
+	if first == "" {
+		return a
+	}
+	a.Label, a.Representative, a.Distance = first, reps[byLabel[first].rep].ID, byLabel[first].distance
+	if second != "" {
+		a.SecondLabel, a.SecondDistance = second, byLabel[second].distance
+		a.Margin = a.SecondDistance - a.Distance
+	}
+	return a
+}
+
+// writeAssignment writes one assignment to out as a line of CSV
+func writeAssignment(out io.Writer, a Assignment, measure string, minMargin float64) error {
+	label := a.Label
+	if a.Margin < minMargin {
+		label = ""
+	}
+	_, err := out.Write([]byte(a.Query + "," + label + "," + a.Representative + "," + distance.FormatDistance(a.Distance, measure) + "," +
+		a.SecondLabel + "," + distance.FormatDistance(a.SecondDistance, measure) + "," + distance.FormatDistance(a.Margin, measure) + "\n"))
+	return err
+}
+
+// Assign gives each record in the alignment query the label of its nearest record in reps, by measure (raw, snp or
+// tn93), using threads goroutines (all CPUs if it is 0), and writes a CSV file with the columns
+// query,label,representative,distance,second_label,second_distance,margin to out, in query order (unless gofasta
+// was run with --unordered). labelOf returns the label of a representative, and false if it should be left out. If
+// a query's margin (the distance to the nearest representative with another label, minus the distance to its
+// nearest) is less than minMargin, its label is left empty. A query with no other label to compare to is always
+// labelled. The representatives are held in memory and the queries are streamed, until ctx is cancelled
+func Assign(ctx context.Context, query, reps io.Reader, labelOf func(string) (string, bool), measureName string, minMargin float64, out io.Writer, threads int) error {
+
+	m, err := distance.Lookup(measureName)
+	if err != nil {
+		return err
+	}
+
+	if threads == 0 {
+		threads = runtime.NumCPU()
+	}
+
+	all, err := fastaio.ReadEncodeAlignmentToListUncounted(reps, false)
+	if err != nil {
+		return err
+	}
+	representatives := make([]fastaio.EncodedFastaRecord, 0, len(all))
+	labels := make([]string, 0, len(all))
+	for _, EFR := range all {
+		label, ok := labelOf(EFR.ID)
+		if !ok {
+			continue
+		}
+		EFR.CalculateBaseContent()
+		for _, nuc := range EFR.Seq {
+			if nuc&8 == 8 {
+				EFR.Score++
+			}
+		}
+		representatives = append(representatives, EFR)
+		labels = append(labels, label)
+	}
+	if len(representatives) == 0 {
+		return errors.New("no labelled representatives")
+	}
+
+	if _, err := out.Write([]byte(schema.Assign.HeaderLine())); err != nil {
+		return err
+	}
+
+	source := func(ctx context.Context, emit func(fastaio.EncodedFastaRecord) error) error {
+		return fastaio.Each(ctx, query, func(r io.Reader, c chan fastaio.EncodedFastaRecord, cErr chan error, cDone chan bool) {
+			fastaio.ReadEncodeScoreAlignment(r, false, c, cErr, cDone)
+		}, emit)
+	}
+
+	return pipeline.Run(ctx, threads, 0, source,
+		func(EFR fastaio.EncodedFastaRecord) (Assignment, error) {
+			if len(EFR.Seq) != len(representatives[0].Seq) {
+				return Assignment{}, errors.New(EFR.ID + " is not the same length as the representatives")
+			}
+			warnAmbiguous(EFR)
+			return assign(EFR, representatives, labels, m), nil
+		}, func(a Assignment) error {
+			return writeAssignment(out, a, measureName, minMargin)
+		})
+}
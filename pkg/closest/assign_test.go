@@ -0,0 +1,44 @@
+package closest
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestAssign(t *testing.T) {
+	reps := ">r1\nAAAAAAAAAA\n>r2\nAAAAAAAAAT\n>r3\nCCCCCAAAAA\n>r4\nAAAAAAAAAA\n"
+	labels := map[string]string{"r1": "A", "r2": "A", "r3": "B", "r4": "C"}
+	labelOf := func(name string) (string, bool) {
+		l, ok := labels[name]
+		return l, ok
+	}
+	queries := ">q1\nAAAAAAAAAT\n>q2\nCCCCAAAAAA\n>q3\nAAAAAAAAAA\n"
+
+	out := new(bytes.Buffer)
+	if err := Assign(context.Background(), strings.NewReader(queries), strings.NewReader(reps), labelOf, "snp", 0, out, 2); err != nil {
+		t.Fatal(err)
+	}
+	want := "query,label,representative,distance,second_label,second_distance,margin\n" +
+		"q1,A,r2,0,C,1,1\n" +
+		"q2,B,r3,1,A,4,3\n" +
+		"q3,A,r1,0,C,0,0\n"
+	if out.String() != want {
+		t.Errorf("problem in TestAssign(): got\n%s", out.String())
+	}
+
+	// q3 is as close to A as to C, so isn't assigned with a margin of at least 1
+	delete(labels, "r3")
+	out.Reset()
+	if err := Assign(context.Background(), strings.NewReader(queries), strings.NewReader(reps), labelOf, "snp", 1, out, 1); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(out.String(), "\nq3,,r1,0,C,0,0\n") {
+		t.Errorf("problem in TestAssign(): got\n%s", out.String())
+	}
+
+	if err := Assign(context.Background(), strings.NewReader(queries), strings.NewReader(reps), labelOf, "jc69", 0, out, 1); err == nil {
+		t.Errorf("problem in TestAssign(): expected an error for an unknown measure")
+	}
+}
@@ -5,304 +5,251 @@ to a set of query sequences, by genetic distance
 package closest
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"io"
-	"math"
 	"os"
 	"runtime"
 	"strconv"
-	"strings"
 
+	"github.com/virus-evolution/gofasta/pkg/distance"
 	"github.com/virus-evolution/gofasta/pkg/encoding"
 	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/schema"
+	"github.com/virus-evolution/gofasta/pkg/summary"
 )
 
 // resultsStruct is a struct that contains information about a query sequence and its (current)
-// single closest target by raw genetic distance, including the snps that distinguish them and
-// the total snp-distance between them.
+// single closest target by raw genetic distance, and the distance between them. The target's
+// sequence is kept rather than the snps that distinguish them, since the closest target so far
+// can change many times before the query has seen every target, and the snps are only wanted
+// for the last one
 type resultsStruct struct {
-	qname        string
-	qidx         int
 	tname        string
 	completeness int64
 	distance     float64
-	snps         []string
+	target       []byte
 }
 
-func rawDistance(query, target fastaio.EncodedFastaRecord) float64 {
-	n := 0
-	d := 0
-	for i, tNuc := range target.Seq {
-		if (query.Seq[i] & tNuc) < 16 {
-			n += 1
-			d += 1
-		}
-		if (query.Seq[i]&8 == 8) && query.Seq[i] == tNuc {
-			d += 1
-		}
-	}
-	distance := float64(n) / float64(d)
-	return distance
+// decoding is what the EP-encoded nucleotides are written as
+var decoding = encoding.MakeDecodingArray()
+
+// change is a site at which a query and a target certainly differ: its 0-based position, and the query's and
+// the target's EP-encoded nucleotides there
+type change struct {
+	pos           int
+	query, target byte
 }
 
-// TO DO - have this operate on the lists of snps not the entire sequences
-func snpDistance(query, target fastaio.EncodedFastaRecord) float64 {
-	n := 0
-	for i, tNuc := range target.Seq {
-		if (query.Seq[i] & tNuc) < 16 {
-			n += 1
-		}
+// changes returns the sites at which query and target certainly differ
+func changes(query, target []byte) []change {
+	sites := distance.EncodedDiffSites(query, target)
+	c := make([]change, len(sites))
+	for j, i := range sites {
+		c[j] = change{pos: i, query: query[i], target: target[i]}
 	}
-	return float64(n)
+	return c
 }
 
-// See equation (7) in Tamura K, Nei M. Estimation of the number of nucleotide substitutions in the control region of mitochondrial DNA in humans and chimpanzees.
-// Mol Biol Evol. 1993 May;10(3):512-26. doi: 10.1093/oxfordjournals.molbev.a040023. PMID: 8336541.
-// See also ape: https://github.com/cran/ape/blob/c2fd899f66d6493a80484033772a3418e5d706a4/src/dist_dna.c
-func tn93Distance(query, target fastaio.EncodedFastaRecord) float64 {
-
-	// Total ATGC length of the two sequences
-	L := float64(target.Count_A + target.Count_C + target.Count_G + target.Count_T + query.Count_A + query.Count_C + query.Count_G + query.Count_T)
-
-	// estimates of the equilibrium base contents from the pair's sequence data
-	g_A := float64(target.Count_A+query.Count_A) / L
-	g_C := float64(target.Count_C+query.Count_C) / L
-	g_G := float64(target.Count_G+query.Count_G) / L
-	g_T := float64(target.Count_T+query.Count_T) / L
-
-	g_R := float64(target.Count_A+query.Count_A+target.Count_G+query.Count_G) / L
-	g_Y := float64(target.Count_C+query.Count_C+target.Count_T+query.Count_T) / L
-
-	// tidies up the equations a bit, after ape
-	k1 := 2.0 * g_A * g_G / g_R
-	k2 := 2.0 * g_T * g_C / g_Y
-	k3 := 2.0 * (g_R*g_Y - g_A*g_G*g_Y/g_R - g_T*g_C*g_R/g_Y)
-
-	count_P1 := 0 // count of transitional differences between purines (A ⇄ G)
-	count_P2 := 0 // count of transitional differences between pyramidines (C ⇄ T)
-
-	count_d := 0 // total number of differences
-	count_L := 0 // total length of resolved comparison
-
-	// calculate the three types of change from the pairwise comparison
-	for i, tNuc := range target.Seq {
-		if (query.Seq[i]&tNuc) < 16 && query.Seq[i]&8 == 8 && tNuc&8 == 8 { // are the bases different (and known for sure)
-			count_d++
-			count_L++
-			if (query.Seq[i] | tNuc) == 200 { // 1 if one of the bases is adenine and the other one is guanine, 0 otherwise
-				count_P1++
-			} else if (query.Seq[i] | tNuc) == 56 { // 1 if one of the bases is cytosine and the other one is thymine, 0 otherwise
-				count_P2++
-			}
-		} else if query.Seq[i]&8 == 8 && query.Seq[i] == tNuc { // are the bases certainly the same
-			count_L++
+// snpList returns changes as position, query base, target base (e.g. 241CT). The strings are cut from one
+// string, so there are two allocations however many snps there are, rather than a couple per snp
+func snpList(changes []change) []string {
+	if len(changes) == 0 {
+		return nil
+	}
+	b := make([]byte, 0, 8*len(changes))
+	ends := make([]int, len(changes))
+	for j, c := range changes {
+		b = strconv.AppendInt(b, int64(c.pos+1), 10)
+		b = append(b, decoding[c.query]...)
+		b = append(b, decoding[c.target]...)
+		ends[j] = len(b)
+	}
+	all := string(b)
+	snps := make([]string, len(changes))
+	start := 0
+	for j, end := range ends {
+		snps[j] = all[start:end]
+		start = end
+	}
+	return snps
+}
+
+// warnAmbiguous warns if query has no unambiguous nucleotides, since then it can't really be compared with anything
+func warnAmbiguous(query fastaio.EncodedFastaRecord) {
+	for _, nuc := range query.Seq {
+		if nuc&8 == 8 {
+			return
 		}
 	}
+	summary.Warn(query.ID + " is only ambiguity codes and gaps, so its distances to the targets are meaningless")
+}
 
-	// estimated rates from this pairwise comparison
-	P1 := float64(count_P1) / float64(count_L)                   // rate of changes which are transitional differences between purines (A ⇄ G)
-	P2 := float64(count_P2) / float64(count_L)                   // rate of changes which are transitional differences between pyramidines (C ⇄ T)
-	Q := float64(count_d-(count_P1+count_P2)) / float64(count_L) // rate of changes which are transversional differences  (A ⇄ C || A ⇄ T || G ⇄ T || C ⇄ G) (i.e. everything else)
-
-	// tidies up the equations a bit, after ape
-	w1 := 1.0 - P1/k1 - Q/(2*g_R)
-	w2 := 1.0 - P2/k2 - Q/(2*g_Y)
-	w3 := 1.0 - Q/(2*g_R*g_Y)
+// closestTarget is the single closest target to one query of those it has been compared with so far
+type closestTarget struct {
+	result resultsStruct
+	seen   bool
+}
 
-	// tn93 distance:
-	d := -k1*math.Log(w1) - k2*math.Log(w2) - k3*math.Log(w3)
+// compare compares query with the next target, and keeps the target if it is closer than the closest so far. Ties
+// for distance are broken by genome completeness, and then by whichever target came first
+func (c *closestTarget) compare(query, target fastaio.EncodedFastaRecord, m distance.Measure) {
+	distance, _ := m.Distance(query, target)
 
-	if d == 0.0 {
-		d = 0.0
+	if !c.seen || distance < c.result.distance || (distance == c.result.distance && target.Score > c.result.completeness) {
+		c.result = resultsStruct{tname: target.ID, completeness: target.Score, distance: distance, target: target.Seq}
+		c.seen = true
 	}
+}
 
-	return d
+// ClosestHit is a target near a query, at Distance by the measure that was used. For the single closest target,
+// SNPs is the differences between them, as position, query base and target base (e.g. 241CT)
+type ClosestHit struct {
+	Query    string   `json:"query"`
+	Target   string   `json:"target"`
+	Distance float64  `json:"distance"`
+	SNPs     []string `json:"snps,omitempty"`
 }
 
-// findClosest finds the single closest sequence by genetic distance among a set of target sequences to a query sequence
-func findClosest(query fastaio.EncodedFastaRecord, measure string, cIn chan fastaio.EncodedFastaRecord, cOut chan resultsStruct) {
-	var closest resultsStruct
-	var distance float64
-	var snps []string
+// hit returns the public form of a result for query. The snps between them are listed if the target's sequence
+// was kept
+func (r resultsStruct) hit(query fastaio.EncodedFastaRecord) ClosestHit {
+	h := ClosestHit{Query: query.ID, Target: r.tname, Distance: r.distance}
+	if r.target != nil {
+		h.SNPs = snpList(changes(query.Seq, r.target))
+	}
+	return h
+}
 
-	first := true
+// writeClosest writes the closest target to each query as CSV, usually to stdout or file
+func writeClosest(hits []ClosestHit, measure string, w io.Writer) error {
 
-	decoding := encoding.MakeDecodingArray()
+	var err error
 
-	for target := range cIn {
+	_, err = w.Write([]byte(schema.Closest.HeaderLine()))
+	if err != nil {
+		return err
+	}
 
-		switch measure {
-		case "raw":
-			distance = rawDistance(query, target)
-		case "snp":
-			distance = snpDistance(query, target)
-		case "tn93":
-			distance = tn93Distance(query, target)
+	// each row is formatted into the same buffer
+	var row []byte
+	for _, hit := range hits {
+		row = appendClosest(row[:0], hit, measure)
+		_, err = w.Write(row)
+		if err != nil {
+			return err
 		}
+	}
 
-		if first {
-			snps = make([]string, 0)
-			for i, tNuc := range target.Seq {
-				if (query.Seq[i] & tNuc) < 16 {
-					snps = append(snps, strconv.Itoa(i+1)+decoding[query.Seq[i]]+decoding[tNuc])
-				}
-			}
-			closest = resultsStruct{tname: target.ID, completeness: target.Score, distance: distance, snps: snps}
-			first = false
-			continue
-		}
+	return nil
+}
 
-		if distance < closest.distance {
-			snps = make([]string, 0)
-			for i, tNuc := range target.Seq {
-				if (query.Seq[i] & tNuc) < 16 {
-					snps = append(snps, strconv.Itoa(i+1)+decoding[query.Seq[i]]+decoding[tNuc])
-				}
-			}
-			closest = resultsStruct{tname: target.ID, completeness: target.Score, distance: distance, snps: snps}
-
-		} else if distance == closest.distance {
-			if target.Score > closest.completeness {
-				snps = make([]string, 0)
-				for i, tNuc := range target.Seq {
-					if (query.Seq[i] & tNuc) < 16 {
-						snps = append(snps, strconv.Itoa(i+1)+decoding[query.Seq[i]]+decoding[tNuc])
-					}
-				}
-				closest = resultsStruct{tname: target.ID, completeness: target.Score, distance: distance, snps: snps}
-			}
+// appendClosest appends the line of writeClosest's output for one hit to row
+func appendClosest(row []byte, hit ClosestHit, measure string) []byte {
+	row = append(row, hit.Query...)
+	row = append(row, ',')
+	row = append(row, hit.Target...)
+	row = append(row, ',')
+	row = append(row, distance.FormatDistance(hit.Distance, measure)...)
+	row = append(row, ',')
+	for i, snp := range hit.SNPs {
+		if i > 0 {
+			row = append(row, ';')
 		}
+		row = append(row, snp...)
 	}
-
-	closest.qname = query.ID
-	closest.qidx = query.Idx
-
-	cOut <- closest
+	return append(row, '\n')
 }
 
-// splitInput fans out target sequences over an array of query sequences, so that each target is passed over each query.
-func splitInput(queries []fastaio.EncodedFastaRecord, measure string, cIn chan fastaio.EncodedFastaRecord, cOut chan resultsStruct, cErr chan error, cSplitDone chan bool) {
-
-	nQ := len(queries)
-
-	// make an array of channels, one for each query
-	QChanArray := make([]chan fastaio.EncodedFastaRecord, nQ)
-	for i := 0; i < nQ; i++ {
-		QChanArray[i] = make(chan fastaio.EncodedFastaRecord)
+// Closest finds the single closest sequence by genetic distance to a query/queries. It writes the results
+// to stdout or to file. Ties for distance are broken by genome completeness. If ctx is cancelled, the targets
+// stop being compared and ctx's error is returned
+func Closest(ctx context.Context, query, target io.Reader, measure string, out io.Writer, threads int) error {
+	hits, err := Nearest(ctx, query, target, measure, threads)
+	if err != nil {
+		return err
 	}
+	return writeClosest(hits, measure, out)
+}
+
+// targetSource sends the targets to c, scored and with their bases counted, as fastaio.ReadEncodeScoreAlignment
+// does
+type targetSource func(c chan fastaio.EncodedFastaRecord, cErr chan error, cDone chan bool)
 
-	for i, q := range queries {
-		go findClosest(q, measure, QChanArray[i], cOut)
+// readTargets is the targetSource for an alignment that hasn't been read yet
+func readTargets(target io.Reader) targetSource {
+	return func(c chan fastaio.EncodedFastaRecord, cErr chan error, cDone chan bool) {
+		fastaio.ReadEncodeScoreAlignmentUncounted(target, false, c, cErr, cDone)
 	}
+}
 
-	targetCounter := 0
-	for EFR := range cIn {
-		if targetCounter == 0 {
-			if len(EFR.Seq) != len(queries[0].Seq) {
-				cErr <- errors.New("query and target alignments are not the same width")
+// recordTargets is the targetSource for targets that are already in memory, e.g. from a cache.Cache
+func recordTargets(ctx context.Context, targets []fastaio.EncodedFastaRecord) targetSource {
+	return func(c chan fastaio.EncodedFastaRecord, cErr chan error, cDone chan bool) {
+		for _, t := range targets {
+			select {
+			case c <- t:
+			case <-ctx.Done():
+				return
 			}
 		}
-		targetCounter++
-
-		for i, _ := range QChanArray {
-			QChanArray[i] <- EFR
+		select {
+		case cDone <- true:
+		case <-ctx.Done():
 		}
 	}
+}
 
-	fmt.Fprintf(os.Stderr, "number of sequences in target alignment: %d\n", targetCounter)
-
-	for i := range QChanArray {
-		close(QChanArray[i])
+// Nearest returns the single closest target to each query, in query order, as Closest does
+func Nearest(ctx context.Context, query, target io.Reader, measure string, threads int) ([]ClosestHit, error) {
+	queries, err := fastaio.ReadEncodeAlignmentToList(query, false)
+	if err != nil {
+		return nil, err
 	}
-
-	cSplitDone <- true
+	return nearest(ctx, queries, readTargets(target), measure, threads)
 }
 
-// writeClosest parses an array of resultsStructs in order to write them, usually to stdout or file
-func writeClosest(results []resultsStruct, measure string, w io.Writer) error {
+// NearestRecords is Nearest for queries and targets that have already been read and encoded. The targets'
+// Score and base counts must be filled in, as they are by cache.Cache.Encoded. Queries' base counts are used by
+// tn93 if they are filled in, so tn93 distances from cached queries can differ slightly from Nearest's
+func NearestRecords(ctx context.Context, queries, targets []fastaio.EncodedFastaRecord, measure string, threads int) ([]ClosestHit, error) {
+	return nearest(ctx, queries, recordTargets(ctx, targets), measure, threads)
+}
 
-	var err error
+func nearest(ctx context.Context, queries []fastaio.EncodedFastaRecord, targets targetSource, measure string, threads int) ([]ClosestHit, error) {
 
-	_, err = w.Write([]byte("query,closest,distance,SNPs\n"))
+	m, err := distance.Lookup(measure)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	for _, result := range results {
-		switch measure {
-		case "raw":
-			w.Write([]byte(result.qname + "," + result.tname + "," + strconv.FormatFloat(result.distance, 'f', 9, 64) + "," + strings.Join(result.snps, ";") + "\n"))
-		case "snp":
-			w.Write([]byte(result.qname + "," + result.tname + "," + strconv.Itoa(int(result.distance)) + "," + strings.Join(result.snps, ";") + "\n"))
-		case "tn93":
-			w.Write([]byte(result.qname + "," + result.tname + "," + strconv.FormatFloat(result.distance, 'f', 9, 64) + "," + strings.Join(result.snps, ";") + "\n"))
-		}
-	}
-
-	return nil
-}
-
-// Closest finds the single closest sequence by genetic distance to a query/queries. It writes the results
-// to stdout or to file. Ties for distance are broken by genome completeness
-func Closest(query, target io.Reader, measure string, out io.Writer, threads int) error {
-
 	if threads == 0 {
 		threads = runtime.NumCPU()
 	} else if threads < runtime.NumCPU() {
 		runtime.GOMAXPROCS(threads)
 	}
 
-	queries, err := fastaio.ReadEncodeAlignmentToList(query, false)
-	if err != nil {
-		return err
-	}
-
 	nQ := len(queries)
 
 	fmt.Fprintf(os.Stderr, "number of sequences in query alignment: %d\n", nQ)
 
-	QResultsArray := make([]resultsStruct, nQ)
-
-	cErr := make(chan error)
-
-	cTEFR := make(chan fastaio.EncodedFastaRecord, runtime.NumCPU())
-	cTEFRdone := make(chan bool)
-	cSplitDone := make(chan bool)
-	cResults := make(chan resultsStruct)
-
-	go fastaio.ReadEncodeScoreAlignment(target, false, cTEFR, cErr, cTEFRdone)
-
-	go splitInput(queries, measure, cTEFR, cResults, cErr, cSplitDone)
-
-	for n := 1; n > 0; {
-		select {
-		case err := <-cErr:
-			return err
-		case <-cTEFRdone:
-			close(cTEFR)
-			n--
-		}
+	for _, q := range queries {
+		warnAmbiguous(q)
 	}
 
-	for n := 1; n > 0; {
-		select {
-		case err := <-cErr:
-			return err
-		case <-cSplitDone:
-			n--
-		}
-	}
+	closest := make([]closestTarget, nQ)
 
-	for i := 0; i < nQ; i++ {
-		result := <-cResults
-		QResultsArray[result.qidx] = result
+	err = scan(ctx, queries, targets, threads, func(i int, target fastaio.EncodedFastaRecord) {
+		closest[i].compare(queries[i], target, m)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	err = writeClosest(QResultsArray, measure, out)
-	if err != nil {
-		return err
+	hits := make([]ClosestHit, nQ)
+	for i, c := range closest {
+		hits[i] = c.result.hit(queries[i])
 	}
 
-	return nil
+	return hits, nil
 }
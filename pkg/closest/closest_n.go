@@ -1,33 +1,30 @@
 package closest
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"io"
 	"math"
 	"os"
 	"runtime"
 	"sort"
-	"strconv"
 	"strings"
 
+	"github.com/virus-evolution/gofasta/pkg/distance"
 	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/schema"
 )
 
 // this is defined elsewhere, but for reference:
 // type resultsStruct struct {
-// 	qname string
-// 	qidx int
 // 	tname string
 // 	completeness int64
 // 	distance float64
-// 	snps []string
+// 	target []byte
 // }
 
 // catchmentStruct contains information about the closest sequences to a particular query
 type catchmentStruct struct {
-	qname                string
-	qidx                 int
 	catchment            []resultsStruct
 	furthestDistance     float64 // this is distance for the least close of the current set of neighbours in catchment
 	furthestCompleteness int64   // this is completeness for the least close of the current set of neighbours in catchment
@@ -45,152 +42,206 @@ func rearrangeCatchment(nS *catchmentStruct, catchmentSize int) {
 	nS.furthestCompleteness = nS.catchment[catchmentSize-1].completeness
 }
 
-// findClosestN finds the closest sequences by genetic distance to single a query sequence
-func findClosestN(query fastaio.EncodedFastaRecord, catchmentSize int, maxdist float64, measure string, cIn chan fastaio.EncodedFastaRecord, cOut chan catchmentStruct) {
+// compare compares a query with the next target, and adds the target to the catchment if it is one of the
+// catchmentSize closest so far
+func (neighbours *catchmentStruct) compare(query, target fastaio.EncodedFastaRecord, catchmentSize int, maxdist float64, m distance.Measure) {
 
-	neighbours := catchmentStruct{qname: query.ID, qidx: query.Idx}
-	neighbours.catchment = make([]resultsStruct, 0)
+	distance, _ := m.Distance(query, target)
 
-	var rs resultsStruct
-
-	var distance float64
-
-	for target := range cIn {
-
-		switch measure {
-		case "raw":
-			distance = rawDistance(query, target)
-		case "snp":
-			distance = snpDistance(query, target)
-		case "tn93":
-			distance = tn93Distance(query, target)
+	if maxdist != -1.0 {
+		if distance > maxdist {
+			return
 		}
+	}
 
-		if maxdist != -1.0 {
-			if distance > maxdist {
-				continue
-			}
-		}
+	rs := resultsStruct{tname: target.ID, completeness: target.Score, distance: distance}
 
-		if len(neighbours.catchment) < catchmentSize {
-			rs = resultsStruct{tname: target.ID, completeness: target.Score, distance: distance}
-			neighbours.catchment = append(neighbours.catchment, rs)
+	if len(neighbours.catchment) < catchmentSize {
+		neighbours.catchment = append(neighbours.catchment, rs)
 
-			if len(neighbours.catchment) == catchmentSize {
-				rearrangeCatchment(&neighbours, catchmentSize)
-			}
+		if len(neighbours.catchment) == catchmentSize {
+			rearrangeCatchment(neighbours, catchmentSize)
+		}
 
-		} else if distance < neighbours.furthestDistance {
-			rs = resultsStruct{tname: target.ID, completeness: target.Score, distance: distance}
-			neighbours.catchment = append(neighbours.catchment, rs)
-			rearrangeCatchment(&neighbours, catchmentSize)
+	} else if distance < neighbours.furthestDistance {
+		neighbours.catchment = append(neighbours.catchment, rs)
+		rearrangeCatchment(neighbours, catchmentSize)
 
-		} else if distance == neighbours.furthestDistance && target.Score > neighbours.furthestCompleteness {
-			rs = resultsStruct{tname: target.ID, completeness: target.Score, distance: distance}
-			neighbours.catchment = append(neighbours.catchment, rs)
-			rearrangeCatchment(&neighbours, catchmentSize)
-		}
+	} else if distance == neighbours.furthestDistance && target.Score > neighbours.furthestCompleteness {
+		neighbours.catchment = append(neighbours.catchment, rs)
+		rearrangeCatchment(neighbours, catchmentSize)
 	}
+}
 
+// finish sorts a catchment once every target has been compared
+func (neighbours *catchmentStruct) finish(catchmentSize int) {
 	// If the user specified a larger catchment than there are records in the target file,
 	// they won't be sorted above, so do it here (need to modify the size argument passed
 	// to the function):
 	if len(neighbours.catchment) < catchmentSize && len(neighbours.catchment) > 0 {
-		rearrangeCatchment(&neighbours, len(neighbours.catchment))
+		rearrangeCatchment(neighbours, len(neighbours.catchment))
 	}
+}
 
-	cOut <- neighbours
+// Catchment is the targets nearest one query, closest first
+type Catchment struct {
+	Query string       `json:"query"`
+	Hits  []ClosestHit `json:"hits"`
 }
 
-// splitInputN fans out target sequences over an array of query sequences, so that each target is passed over each query.
-func splitInputN(queries []fastaio.EncodedFastaRecord, catchmentSize int, maxdist float64, measure string, cIn chan fastaio.EncodedFastaRecord, cOut chan catchmentStruct, cErr chan error, cSplitDone chan bool) {
+// writeClosestN writes each query's catchment on one line, usually to stdout or file
+func writeClosestN(catchments []Catchment, w io.Writer) error {
 
-	nQ := len(queries)
-
-	// make an array of channels, one for each query
-	QChanArray := make([]chan fastaio.EncodedFastaRecord, nQ)
-	for i := 0; i < nQ; i++ {
-		QChanArray[i] = make(chan fastaio.EncodedFastaRecord)
-	}
+	var err error
 
-	for i, q := range queries {
-		go findClosestN(q, catchmentSize, maxdist, measure, QChanArray[i], cOut)
+	_, err = w.Write([]byte(schema.ClosestN.HeaderLine()))
+	if err != nil {
+		return err
 	}
 
-	targetCounter := 0
-	for EFR := range cIn {
-		if targetCounter == 0 {
-			if len(EFR.Seq) != len(queries[0].Seq) {
-				cErr <- errors.New("query and target alignments are not the same width")
-			}
-		}
-		targetCounter++
-
-		for i, _ := range QChanArray {
-			QChanArray[i] <- EFR
+	for _, c := range catchments {
+		_, err = w.Write([]byte(closestNRow(c)))
+		if err != nil {
+			return err
 		}
 	}
 
-	fmt.Fprintf(os.Stderr, "number of sequences in target alignment: %d\n", targetCounter)
+	return nil
+}
 
-	for i, _ := range QChanArray {
-		close(QChanArray[i])
+// closestNRow is the line of writeClosestN's output for one catchment
+func closestNRow(c Catchment) string {
+	temp := make([]string, 0)
+	for _, hit := range c.Hits {
+		temp = append(temp, hit.Target)
 	}
-
-	cSplitDone <- true
+	return c.Query + "," + strings.Join(temp, ";") + "\n"
 }
 
-// writeClosestN parses an array of catchmentStructs in order to write them, usually to stdout or file
-func writeClosestN(results []catchmentStruct, w io.Writer) error {
+// writeClosestNTable writes each query-target pair in the catchments on one line, usually to stdout or file
+func writeClosestNTable(catchments []Catchment, w io.Writer, measure string) error {
 
 	var err error
 
-	_, err = w.Write([]byte("query,closest\n"))
+	_, err = w.Write([]byte(schema.ClosestTable.HeaderLine()))
 	if err != nil {
 		return err
 	}
 
-	for _, result := range results {
-		temp := make([]string, 0)
-		for _, hit := range result.catchment {
-			temp = append(temp, hit.tname)
+	for _, c := range catchments {
+		_, err = w.Write([]byte(closestNTableRows(c, measure)))
+		if err != nil {
+			return err
 		}
-		w.Write([]byte(result.qname + "," + strings.Join(temp, ";") + "\n"))
 	}
 
 	return nil
 }
 
-func writeClosestNTable(results []catchmentStruct, w io.Writer, measure string) error {
+// closestNTableRows is the lines of writeClosestNTable's output for one catchment
+func closestNTableRows(c Catchment, measure string) string {
+	var sb strings.Builder
+	for _, hit := range c.Hits {
+		sb.WriteString(c.Query + "," + hit.Target + "," + distance.FormatDistance(hit.Distance, measure) + "\n")
+	}
+	return sb.String()
+}
+
+// NOptions controls ClosestN. For each query, up to N of the closest targets are found, or, with HasMaxDist,
+// every target within MaxDist of the query (but only the N closest, if N is greater than 0 too). Without
+// HasMaxDist there is no limit on distance, so a MaxDist of 0 has to be asked for. Measure is one of raw, snp or
+// tn93 ("" means raw), or the name of a distance.Measure that has been registered. With Table, the output is a
+// long-form table with one line per pair. Threads is the number of CPUs to use (0 means all available CPUs). With
+// StreamQueries, the targets are held in memory and the queries are streamed, as by StreamClosest, rather than
+// the other way round
+type NOptions struct {
+	N             int
+	MaxDist       float64
+	HasMaxDist    bool
+	Measure       string
+	Table         bool
+	Threads       int
+	StreamQueries bool
+}
 
-	var err error
+// DefaultNOptions returns options with no maximum distance and the raw measure
+func DefaultNOptions() NOptions {
+	return NOptions{Measure: "raw"}
+}
 
-	_, err = w.Write([]byte("query,target,distance\n"))
+// maxDist is the limit on distance that compare uses, which is -1.0 if there isn't one
+func (o NOptions) maxDist() float64 {
+	if !o.HasMaxDist || o.MaxDist < 0 {
+		return -1.0
+	}
+	return o.MaxDist
+}
+
+// ClosestN finds the closest sequence(s) by genetic distance to a query/queries. It writes the results
+// to stdout or to file. Ties for distance are broken by genome completeness. It stops comparing targets and
+// returns ctx's error if ctx is cancelled
+func ClosestN(ctx context.Context, query, target io.Reader, out io.Writer, o NOptions) error {
+	if o.StreamQueries {
+		return streamClosestN(ctx, query, target, out, o)
+	}
+	catchments, err := NearestN(ctx, query, target, o)
 	if err != nil {
 		return err
 	}
+	measure := o.Measure
+	if measure == "" {
+		measure = "raw"
+	}
+	if o.Table {
+		return writeClosestNTable(catchments, out, measure)
+	}
+	return writeClosestN(catchments, out)
+}
 
-	switch measure {
-	case "snp":
-		for _, result := range results {
-			for _, hit := range result.catchment {
-				w.Write([]byte(result.qname + "," + hit.tname + "," + strconv.Itoa(int(hit.distance)) + "\n"))
-			}
-		}
-	default:
-		for _, result := range results {
-			for _, hit := range result.catchment {
-				w.Write([]byte(result.qname + "," + hit.tname + "," + strconv.FormatFloat(hit.distance, 'f', 9, 64) + "\n"))
-			}
+// streamClosestN is ClosestN with the queries streamed
+func streamClosestN(ctx context.Context, query, target io.Reader, out io.Writer, o NOptions) error {
+	measure := o.Measure
+	if measure == "" {
+		measure = "raw"
+	}
+	header := schema.ClosestN.HeaderLine()
+	if o.Table {
+		header = schema.ClosestTable.HeaderLine()
+	}
+	if _, err := out.Write([]byte(header)); err != nil {
+		return err
+	}
+	return streamN(ctx, query, target, o, func(c Catchment) error {
+		row := closestNRow(c)
+		if o.Table {
+			row = closestNTableRows(c, measure)
 		}
+		_, err := out.Write([]byte(row))
+		return err
+	})
+}
+
+// NearestN returns the catchment of each query, in query order, as ClosestN does
+func NearestN(ctx context.Context, query, target io.Reader, o NOptions) ([]Catchment, error) {
+	queries, err := fastaio.ReadEncodeAlignmentToList(query, false)
+	if err != nil {
+		return nil, err
 	}
+	return nearestN(ctx, queries, readTargets(target), o)
+}
 
-	return nil
+// NearestNRecords is NearestN for queries and targets that have already been read and encoded, as for
+// NearestRecords
+func NearestNRecords(ctx context.Context, queries, targets []fastaio.EncodedFastaRecord, o NOptions) ([]Catchment, error) {
+	return nearestN(ctx, queries, recordTargets(ctx, targets), o)
 }
 
-// ClosestN finds the closest sequence(s) by genetic distance to a query/queries. It writes the results
-// to stdout or to file. Ties for distance are broken by genome completeness.
-func ClosestN(catchmentSize int, maxdist float64, query, target io.Reader, measure string, out io.Writer, table bool, threads int) error {
+func nearestN(ctx context.Context, queries []fastaio.EncodedFastaRecord, targets targetSource, o NOptions) ([]Catchment, error) {
+
+	catchmentSize, maxdist, measure, threads := o.N, o.maxDist(), o.Measure, o.Threads
+	if measure == "" {
+		measure = "raw"
+	}
 
 	if threads == 0 {
 		threads = runtime.NumCPU()
@@ -202,61 +253,36 @@ func ClosestN(catchmentSize int, maxdist float64, query, target io.Reader, measu
 		catchmentSize = math.MaxInt
 	}
 
-	queries, err := fastaio.ReadEncodeAlignmentToList(query, false)
-	if err != nil {
-		return err
-	}
-
 	nQ := len(queries)
 
 	fmt.Fprintf(os.Stderr, "number of sequences in query alignment: %d\n", nQ)
 
-	QResultsArray := make([]catchmentStruct, nQ)
-
-	cErr := make(chan error)
-
-	cTEFR := make(chan fastaio.EncodedFastaRecord, runtime.NumCPU())
-	cTEFRdone := make(chan bool)
-	cSplitDone := make(chan bool)
-	cResults := make(chan catchmentStruct)
-
-	go fastaio.ReadEncodeScoreAlignment(target, false, cTEFR, cErr, cTEFRdone)
-
-	go splitInputN(queries, catchmentSize, maxdist, measure, cTEFR, cResults, cErr, cSplitDone)
-
-	for n := 1; n > 0; {
-		select {
-		case err := <-cErr:
-			return err
-		case <-cTEFRdone:
-			close(cTEFR)
-			n--
-		}
+	for _, q := range queries {
+		warnAmbiguous(q)
 	}
 
-	for n := 1; n > 0; {
-		select {
-		case err := <-cErr:
-			return err
-		case <-cSplitDone:
-			n--
-		}
-	}
+	neighbours := make([]catchmentStruct, nQ)
 
-	for i := 0; i < nQ; i++ {
-		result := <-cResults
-		QResultsArray[result.qidx] = result
+	m, err := distance.Lookup(measure)
+	if err != nil {
+		return nil, err
 	}
 
-	switch table {
-	case true:
-		err = writeClosestNTable(QResultsArray, out, measure)
-	case false:
-		err = writeClosestN(QResultsArray, out)
-	}
+	err = scan(ctx, queries, targets, threads, func(i int, target fastaio.EncodedFastaRecord) {
+		neighbours[i].compare(queries[i], target, catchmentSize, maxdist, m)
+	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	catchments := make([]Catchment, nQ)
+	for i := range neighbours {
+		neighbours[i].finish(catchmentSize)
+		catchments[i] = Catchment{Query: queries[i].ID, Hits: make([]ClosestHit, len(neighbours[i].catchment))}
+		for j, rs := range neighbours[i].catchment {
+			catchments[i].Hits[j] = rs.hit(queries[i])
+		}
+	}
+
+	return catchments, nil
 }
@@ -2,8 +2,14 @@ package closest
 
 import (
 	"bytes"
+	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/summary"
 )
 
 func TestClosestSNP(t *testing.T) {
@@ -35,7 +41,7 @@ ATTTTG
 
 	out := new(bytes.Buffer)
 
-	err := Closest(query, target, "snp", out, 2)
+	err := Closest(context.Background(), query, target, "snp", out, 2)
 	if err != nil {
 		t.Error(err)
 	}
@@ -78,7 +84,7 @@ ATTTTG
 
 	out := new(bytes.Buffer)
 
-	err := Closest(query, target, "raw", out, 2)
+	err := Closest(context.Background(), query, target, "raw", out, 2)
 	if err != nil {
 		t.Error(err)
 	}
@@ -127,7 +133,7 @@ ATTAAAGGTTTATACCTTCCCAGGTAACAAACCAACCAACTTTCGATCTCTTGTAGATCTGTTCTCTAAACGAACTTTAA
 
 	out := new(bytes.Buffer)
 
-	err := Closest(query, target, "tn93", out, 2)
+	err := Closest(context.Background(), query, target, "tn93", out, 2)
 	if err != nil {
 		t.Error(err)
 	}
@@ -140,3 +146,152 @@ query2,target10,0.000000000,
 		fmt.Println(string(out.Bytes()))
 	}
 }
+
+func TestNearest(t *testing.T) {
+	target := bytes.NewReader([]byte(">Target1\nATGATC\n>Target2\nATTTTC\n"))
+	query := bytes.NewReader([]byte(">Query1\nATGATC\n>Query2\nATTTTG\n"))
+
+	hits, err := Nearest(context.Background(), query, target, "snp", 2)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(hits) != 2 ||
+		hits[0].Query != "Query1" || hits[0].Target != "Target1" || hits[0].Distance != 0 || len(hits[0].SNPs) != 0 ||
+		hits[1].Query != "Query2" || hits[1].Target != "Target2" || hits[1].Distance != 1 || len(hits[1].SNPs) != 1 || hits[1].SNPs[0] != "6GC" {
+		t.Errorf("problem in TestNearest(): %v", hits)
+	}
+}
+
+func TestNearestProcessed(t *testing.T) {
+	target := bytes.NewReader([]byte(">Target1\nATGATC\n>Target2\nATTTTC\n>Target3\nATTTTT\n"))
+	query := bytes.NewReader([]byte(">Query1\nATGATC\n>Query2\nATTTTG\n"))
+
+	summary.Reset()
+	if _, err := Nearest(context.Background(), query, target, "snp", 2); err != nil {
+		t.Fatal(err)
+	}
+	// only the queries are counted, not the targets
+	if got := summary.Collect().RecordsProcessed; got != 2 {
+		t.Errorf("problem in TestNearestProcessed(): %d records processed, expected 2", got)
+	}
+}
+
+func TestNearestCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	target := bytes.NewReader([]byte(">Target1\nATGATC\n>Target2\nATTTTC\n"))
+	query := bytes.NewReader([]byte(">Query1\nATGATC\n"))
+	if _, err := Nearest(ctx, query, target, "snp", 2); err != context.Canceled {
+		t.Errorf("problem in TestNearestCancelled(): expected context.Canceled, got %v", err)
+	}
+}
+
+func TestScan(t *testing.T) {
+	for _, nQ := range []int{0, 1, 7, 100} {
+		queries := make([]fastaio.EncodedFastaRecord, nQ)
+		for i := range queries {
+			queries[i] = fastaio.EncodedFastaRecord{ID: "q" + strconv.Itoa(i), Seq: []byte{136}}
+		}
+		targets := make([]fastaio.EncodedFastaRecord, 3*targetBatchSize+5)
+		for i := range targets {
+			targets[i] = fastaio.EncodedFastaRecord{ID: strconv.Itoa(i), Seq: []byte{136}, Idx: i}
+		}
+
+		for _, threads := range []int{1, 3, 8} {
+			seen := make([][]int, nQ)
+			err := scan(context.Background(), queries, recordTargets(context.Background(), targets), threads, func(i int, target fastaio.EncodedFastaRecord) {
+				seen[i] = append(seen[i], target.Idx)
+			})
+			if err != nil {
+				t.Error(err)
+			}
+			for i := range seen {
+				if len(seen[i]) != len(targets) {
+					t.Errorf("problem in TestScan(): query %d of %d saw %d targets with %d threads", i, nQ, len(seen[i]), threads)
+					continue
+				}
+				for j, idx := range seen[i] {
+					if idx != j {
+						t.Errorf("problem in TestScan(): query %d of %d saw target %d at %d with %d threads", i, nQ, idx, j, threads)
+						break
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestStream(t *testing.T) {
+	targetData := ">Target1\nATGATC\n>Target2\nWTGATG\n>Target3\nWTTTTC\n>Target4\nATGATG\n>Target5\nATTTTC\n"
+	queryData := ">Query1\nATGATG\n>Query2\nATGATC\n>Query3\nATTTTG\n"
+
+	for _, measure := range []string{"raw", "snp", "tn93"} {
+		want, err := Nearest(context.Background(), bytes.NewReader([]byte(queryData)), bytes.NewReader([]byte(targetData)), measure, 2)
+		if err != nil {
+			t.Error(err)
+		}
+
+		cHits, cErr := Stream(context.Background(), StreamOptions{
+			Query:   bytes.NewReader([]byte(queryData)),
+			Target:  bytes.NewReader([]byte(targetData)),
+			Measure: measure,
+			Threads: 2,
+		})
+		hits := make([]ClosestHit, 0)
+		for hit := range cHits {
+			hits = append(hits, hit)
+		}
+		if err := <-cErr; err != nil {
+			t.Error(err)
+		}
+
+		if fmt.Sprint(hits) != fmt.Sprint(want) {
+			t.Errorf("problem in TestStream(): %s: got %v, expected %v", measure, hits, want)
+		}
+	}
+
+	cHits, cErr := Stream(context.Background(), StreamOptions{
+		Query:  bytes.NewReader([]byte(">Query1\nATG\n")),
+		Target: bytes.NewReader([]byte(targetData)),
+	})
+	for range cHits {
+		t.Errorf("problem in TestStream(): expected no hits for a query of the wrong width")
+	}
+	if err := <-cErr; err == nil {
+		t.Errorf("problem in TestStream(): expected an error for a query of the wrong width")
+	}
+}
+
+func TestStreamQueries(t *testing.T) {
+	targetData := ">Target1\nATGATC\n>Target2\nWTGATG\n>Target3\nWTTTTC\n>Target4\nATGATG\n>Target5\nATTTTC\n"
+	queryData := ">Query1\nATGATG\n>Query2\nATGATC\n>Query3\nATTTTG\n"
+
+	var want, got bytes.Buffer
+	if err := Closest(context.Background(), strings.NewReader(queryData), strings.NewReader(targetData), "snp", &want, 2); err != nil {
+		t.Fatal(err)
+	}
+	if err := StreamClosest(context.Background(), strings.NewReader(queryData), strings.NewReader(targetData), "snp", &got, 2); err != nil {
+		t.Fatal(err)
+	}
+	if got.String() != want.String() {
+		t.Errorf("problem in TestStreamQueries(): got\n%s\nexpected\n%s", got.String(), want.String())
+	}
+
+	for _, table := range []bool{false, true} {
+		o := DefaultNOptions()
+		o.N, o.Measure, o.Table, o.Threads = 3, "snp", table, 2
+		want.Reset()
+		got.Reset()
+		if err := ClosestN(context.Background(), strings.NewReader(queryData), strings.NewReader(targetData), &want, o); err != nil {
+			t.Fatal(err)
+		}
+		o.StreamQueries = true
+		if err := ClosestN(context.Background(), strings.NewReader(queryData), strings.NewReader(targetData), &got, o); err != nil {
+			t.Fatal(err)
+		}
+		if got.String() != want.String() {
+			t.Errorf("problem in TestStreamQueries(): with table %t got\n%s\nexpected\n%s", table, got.String(), want.String())
+		}
+	}
+}
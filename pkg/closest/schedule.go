@@ -0,0 +1,235 @@
+package closest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"sync"
+
+	"github.com/virus-evolution/gofasta/internal/pipeline"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+// Every query has to be compared with every target, in target order (ties are broken by whichever target came
+// first), but the queries are independent of each other. So the targets are read in small batches, the queries
+// are split into small batches too, and each pair of batches is a piece of work that any thread can pick up as
+// soon as the query batch has finished with the target batch before it. A query batch that is slow (because its
+// queries are very ambiguous, say) holds up no one but itself, until it is so far behind that the oldest target
+// batch it still needs is the only thing keeping the reader from reading more
+
+const (
+	// targetBatchSize is the number of targets in each batch
+	targetBatchSize = 32
+	// maxQueryBatchSize is the most queries in a batch. There are fewer if there aren't enough queries to give
+	// every thread a few batches
+	maxQueryBatchSize = 16
+)
+
+// targetBatch is a batch of targets, and the number of query batches that haven't been compared with it yet
+type targetBatch struct {
+	targets   []fastaio.EncodedFastaRecord
+	remaining int
+}
+
+// queryBatch is the queries [start, end), and the target batches they have still to be compared with, in order.
+// It is queued whenever it has a target batch pending and no thread is comparing it with one
+type queryBatch struct {
+	start, end int
+	pending    []*targetBatch
+	queued     bool
+}
+
+// scheduler is the work shared out between the threads. ready is the query batches that are waiting for a
+// thread, and outstanding is the number of pairs of batches still to compare
+type scheduler struct {
+	mu          sync.Mutex
+	cond        *sync.Cond
+	ready       []*queryBatch
+	outstanding int
+	finished    bool
+}
+
+// publish hands a batch of targets to every query batch
+func (s *scheduler) publish(qbs []*queryBatch, tb *targetBatch) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, qb := range qbs {
+		qb.pending = append(qb.pending, tb)
+		s.outstanding++
+		if !qb.queued {
+			qb.queued = true
+			s.ready = append(s.ready, qb)
+		}
+	}
+	s.cond.Broadcast()
+}
+
+// finish records that there are no more target batches to come
+func (s *scheduler) finish() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.finished = true
+	s.cond.Broadcast()
+}
+
+// next waits for a query batch that has a target batch to compare, and returns them both. It returns nil once
+// every pair of batches has been compared, or if ctx is cancelled
+func (s *scheduler) next(ctx context.Context) (*queryBatch, *targetBatch) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for len(s.ready) == 0 && !(s.finished && s.outstanding == 0) && ctx.Err() == nil {
+		s.cond.Wait()
+	}
+	if len(s.ready) == 0 || ctx.Err() != nil {
+		return nil, nil
+	}
+	qb := s.ready[0]
+	s.ready = s.ready[1:]
+	return qb, qb.pending[0]
+}
+
+// done records that qb has been compared with its first pending target batch, and returns whether that was the
+// last query batch the target batch was needed by
+func (s *scheduler) done(qb *queryBatch) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tb := qb.pending[0]
+	qb.pending = qb.pending[1:]
+	s.outstanding--
+	tb.remaining--
+	if len(qb.pending) > 0 {
+		s.ready = append(s.ready, qb)
+	} else {
+		qb.queued = false
+	}
+	if s.finished && s.outstanding == 0 {
+		s.cond.Broadcast()
+	}
+	return tb.remaining == 0
+}
+
+// queryBatches splits n queries into batches, small enough that each of threads threads has several
+func queryBatches(n, threads int) []*queryBatch {
+	size := n / (4 * threads)
+	if size < 1 {
+		size = 1
+	} else if size > maxQueryBatchSize {
+		size = maxQueryBatchSize
+	}
+	qbs := make([]*queryBatch, 0, (n+size-1)/size)
+	for start := 0; start < n; start += size {
+		end := start + size
+		if end > n {
+			end = n
+		}
+		qbs = append(qbs, &queryBatch{start: start, end: end})
+	}
+	return qbs
+}
+
+// scan calls visit(i, target) for each query i and every target from targets, on threads goroutines. Each query
+// sees the targets in the order they are read, and visit is never called for the same query from two goroutines
+// at once. If ctx is cancelled, the targets stop being compared and ctx's error is returned
+func scan(ctx context.Context, queries []fastaio.EncodedFastaRecord, targets targetSource, threads int, visit func(i int, target fastaio.EncodedFastaRecord)) error {
+
+	if threads < 1 {
+		threads = runtime.NumCPU()
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	qbs := queryBatches(len(queries), threads)
+
+	s := &scheduler{}
+	s.cond = sync.NewCond(&s.mu)
+
+	// wake any thread that is waiting for work if ctx is cancelled
+	go func() {
+		<-ctx.Done()
+		s.mu.Lock()
+		s.cond.Broadcast()
+		s.mu.Unlock()
+	}()
+
+	// the target batches in memory at once. A slot is taken when a batch is read, and given back when every
+	// query batch has been compared with it
+	slots := make(chan struct{}, 2*threads)
+
+	var wg sync.WaitGroup
+	wg.Add(threads)
+	for w := 0; w < threads; w++ {
+		go func() {
+			defer wg.Done()
+			for {
+				qb, tb := s.next(ctx)
+				if qb == nil {
+					return
+				}
+				for i := qb.start; i < qb.end; i++ {
+					for _, target := range tb.targets {
+						visit(i, target)
+					}
+				}
+				if s.done(qb) {
+					<-slots
+				}
+			}
+		}()
+	}
+
+	c := make(chan fastaio.EncodedFastaRecord, targetBatchSize)
+	cErr := make(chan error)
+	cDone := make(chan bool)
+	go targets(c, cErr, cDone)
+
+	tb := &targetBatch{targets: make([]fastaio.EncodedFastaRecord, 0, targetBatchSize)}
+	send := func() error {
+		full := tb
+		tb = &targetBatch{targets: make([]fastaio.EncodedFastaRecord, 0, targetBatchSize)}
+		if len(qbs) == 0 {
+			return nil
+		}
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		full.remaining = len(qbs)
+		s.publish(qbs, full)
+		return nil
+	}
+
+	targetCounter := 0
+	err := pipeline.FromChannels(c, cErr, cDone)(ctx, func(target fastaio.EncodedFastaRecord) error {
+		if targetCounter == 0 && len(queries) > 0 && len(target.Seq) != len(queries[0].Seq) {
+			return errors.New("query and target alignments are not the same width")
+		}
+		targetCounter++
+		tb.targets = append(tb.targets, target)
+		if len(tb.targets) < targetBatchSize {
+			return nil
+		}
+		return send()
+	})
+	if err == nil && len(tb.targets) > 0 {
+		err = send()
+	}
+	if err == nil {
+		fmt.Fprintf(os.Stderr, "number of sequences in target alignment: %d\n", targetCounter)
+	}
+	if err != nil {
+		cancel()
+	}
+	s.finish()
+
+	wg.Wait()
+
+	if err == nil {
+		// the threads stop early if the caller is cancelled, even once every target has been read
+		return ctx.Err()
+	}
+	return err
+}
@@ -0,0 +1,164 @@
+package closest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"runtime"
+
+	"github.com/virus-evolution/gofasta/internal/pipeline"
+	"github.com/virus-evolution/gofasta/pkg/distance"
+	"github.com/virus-evolution/gofasta/pkg/encoding"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/schema"
+)
+
+// StreamOptions controls Stream. Measure is one of raw, snp or tn93 ("" means raw), or the name of a registered
+// distance.Measure, and Threads is the number of CPUs to use (0 means all available CPUs)
+type StreamOptions struct {
+	Query   io.Reader
+	Target  io.Reader
+	Measure string
+	Threads int
+}
+
+// Stream finds the single closest target to each query, as Nearest does, but sends each query's hit on the first
+// channel as soon as it is found, in query order (or in the order they are found, if gofasta was run with
+// --unordered), so that they can be acted on while the rest are still being found. Unlike Nearest, the targets are
+// read into memory first and the queries are streamed. The hits channel is closed once every query has been sent,
+// or on the first error, which is sent on the second channel before it is closed. The channel of hits must be read
+// until it is closed, unless ctx is cancelled
+func Stream(ctx context.Context, o StreamOptions) (<-chan ClosestHit, <-chan error) {
+	cHits := make(chan ClosestHit)
+	cErr := make(chan error, 1)
+
+	go func() {
+		defer close(cErr)
+		defer close(cHits)
+		err := stream(ctx, o, func(hit ClosestHit) error {
+			select {
+			case cHits <- hit:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		if err != nil {
+			cErr <- err
+		}
+	}()
+
+	return cHits, cErr
+}
+
+// stream passes the hit for each query to f, in query order unless ctx is unordered
+func stream(ctx context.Context, o StreamOptions, f func(ClosestHit) error) error {
+
+	measureName := o.Measure
+	if measureName == "" {
+		measureName = "raw"
+	}
+	m, err := distance.Lookup(measureName)
+	if err != nil {
+		return err
+	}
+
+	return streamQueries(ctx, o.Query, o.Target, o.Threads, func(query fastaio.EncodedFastaRecord, targets []fastaio.EncodedFastaRecord) ClosestHit {
+		var c closestTarget
+		for _, target := range targets {
+			c.compare(query, target, m)
+		}
+		return c.result.hit(query)
+	}, f)
+}
+
+// streamN passes the catchment of each query to f, as stream does the single closest target
+func streamN(ctx context.Context, query, target io.Reader, o NOptions, f func(Catchment) error) error {
+
+	catchmentSize, maxdist, measure := o.N, o.maxDist(), o.Measure
+	if measure == "" {
+		measure = "raw"
+	}
+	if maxdist != -1.0 && catchmentSize == 0 {
+		catchmentSize = math.MaxInt
+	}
+	m, err := distance.Lookup(measure)
+	if err != nil {
+		return err
+	}
+
+	return streamQueries(ctx, query, target, o.Threads, func(query fastaio.EncodedFastaRecord, targets []fastaio.EncodedFastaRecord) Catchment {
+		var neighbours catchmentStruct
+		for _, target := range targets {
+			neighbours.compare(query, target, catchmentSize, maxdist, m)
+		}
+		neighbours.finish(catchmentSize)
+		c := Catchment{Query: query.ID, Hits: make([]ClosestHit, len(neighbours.catchment))}
+		for j, rs := range neighbours.catchment {
+			c.Hits[j] = rs.hit(query)
+		}
+		return c
+	}, f)
+}
+
+// streamQueries reads the targets in target into memory, then streams the queries in query through find, which
+// compares one query with every target, on threads goroutines (0 means all available CPUs), and passes the
+// results to f. Memory use is the targets plus the queries in flight, however many queries there are
+func streamQueries[Out any](ctx context.Context, query, target io.Reader, threads int, find func(query fastaio.EncodedFastaRecord, targets []fastaio.EncodedFastaRecord) Out, f func(Out) error) error {
+
+	if threads == 0 {
+		threads = runtime.NumCPU()
+	}
+
+	targets, err := fastaio.ReadEncodeAlignmentToListUncounted(target, false)
+	if err != nil {
+		return err
+	}
+	if len(targets) == 0 {
+		return errors.New("no sequences in target alignment")
+	}
+	// scored and counted as they would be by fastaio.ReadEncodeScoreAlignment, so ties are broken as by Nearest
+	scoring := encoding.MakeEncodedScoreArray()
+	for i := range targets {
+		targets[i].CalculateBaseContent()
+		for _, nuc := range targets[i].Seq {
+			targets[i].Score += scoring[nuc]
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "number of sequences in target alignment: %d\n", len(targets))
+
+	source := func(ctx context.Context, emit func(fastaio.EncodedFastaRecord) error) error {
+		return fastaio.EachEncodedRecord(ctx, query, false, emit)
+	}
+
+	return pipeline.Run(ctx, threads, 0, source,
+		func(query fastaio.EncodedFastaRecord) (Out, error) {
+			if len(query.Seq) != len(targets[0].Seq) {
+				var zero Out
+				return zero, errors.New("query and target alignments are not the same width")
+			}
+			warnAmbiguous(query)
+			return find(query, targets), nil
+		}, f)
+}
+
+// StreamClosest is Closest with the targets held in memory and the queries streamed, so that memory use depends
+// on the number of targets rather than of queries. Each query's line is written as soon as it has been compared
+// with every target
+func StreamClosest(ctx context.Context, query, target io.Reader, measure string, out io.Writer, threads int) error {
+
+	if _, err := out.Write([]byte(schema.Closest.HeaderLine())); err != nil {
+		return err
+	}
+
+	var row []byte
+	return stream(ctx, StreamOptions{Query: query, Target: target, Measure: measure, Threads: threads}, func(hit ClosestHit) error {
+		row = appendClosest(row[:0], hit, measure)
+		_, err := out.Write(row)
+		return err
+	})
+}
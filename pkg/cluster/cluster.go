@@ -0,0 +1,224 @@
+/*
+Package cluster implements single-linkage clustering of the sequences in an
+alignment at a SNP-distance threshold, as used to find putative transmission
+clusters.
+*/
+package cluster
+
+import (
+	"context"
+	"io"
+	"runtime"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/virus-evolution/gofasta/pkg/distance"
+	"github.com/virus-evolution/gofasta/pkg/encoding"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+// Cluster is a group of records, identified by their index in the alignment. Representative is the
+// medoid: the member with the smallest total distance to the other members
+type Cluster struct {
+	Members        []int
+	Representative int
+}
+
+// unionFind is a disjoint-set forest over the records
+type unionFind struct {
+	parent []int
+}
+
+func newUnionFind(n int) *unionFind {
+	u := &unionFind{parent: make([]int, n)}
+	for i := range u.parent {
+		u.parent[i] = i
+	}
+	return u
+}
+
+func (u *unionFind) find(i int) int {
+	for u.parent[i] != i {
+		u.parent[i] = u.parent[u.parent[i]]
+		i = u.parent[i]
+	}
+	return i
+}
+
+func (u *unionFind) union(i, j int) {
+	ri, rj := u.find(i), u.find(j)
+	if ri == rj {
+		return
+	}
+	// keep the smaller index as the root, so that clusters are numbered in input order
+	if rj < ri {
+		ri, rj = rj, ri
+	}
+	u.parent[rj] = ri
+}
+
+// edge is a pair of records that are within the threshold of each other
+type edge struct {
+	i, j int
+}
+
+// SingleLinkage clusters the records so that two are in the same cluster if there is a chain of records
+// between them with every step at most threshold SNPs. Clusters are ordered by their first member in
+// the input. It stops and returns ctx's error if ctx is cancelled
+func SingleLinkage(ctx context.Context, packed []distance.Packed, threshold int, threads int) ([]Cluster, error) {
+	return singleLinkage(ctx, len(packed), threads, func(i, j int) bool {
+		return distance.SNPUpTo(&packed[i], &packed[j], threshold) <= threshold
+	}, func(i, j int) float64 {
+		return float64(distance.SNP(&packed[i], &packed[j]))
+	})
+}
+
+// SingleLinkageBy clusters the records as SingleLinkage does, but by the distance measure m, which can be one of
+// gofasta's or one registered with distance.Register. Records at a distance of NaN aren't linked
+func SingleLinkageBy(ctx context.Context, records []fastaio.EncodedFastaRecord, m distance.Measure, threshold float64, threads int) ([]Cluster, error) {
+	dist := func(i, j int) float64 {
+		d, _ := m.Distance(records[i], records[j])
+		return d
+	}
+	return singleLinkage(ctx, len(records), threads, func(i, j int) bool {
+		return dist(i, j) <= threshold
+	}, dist)
+}
+
+// singleLinkage clusters n records, linking every pair for which linked is true, and picks the representatives
+// by dist
+func singleLinkage(ctx context.Context, n, threads int, linked func(i, j int) bool, dist func(i, j int) float64) ([]Cluster, error) {
+
+	if threads < 1 {
+		threads = runtime.NumCPU()
+	}
+
+	u := newUnionFind(n)
+
+	rows := make(chan int)
+	cEdges := make(chan []edge)
+
+	var wg sync.WaitGroup
+	wg.Add(threads)
+	for t := 0; t < threads; t++ {
+		go func() {
+			defer wg.Done()
+			for i := range rows {
+				edges := make([]edge, 0)
+				for j := 0; j < i; j++ {
+					if linked(i, j) {
+						edges = append(edges, edge{i, j})
+					}
+				}
+				cEdges <- edges
+			}
+		}()
+	}
+
+	go func() {
+	loop:
+		for i := n - 1; i >= 0; i-- {
+			select {
+			case rows <- i:
+			case <-ctx.Done():
+				break loop
+			}
+		}
+		close(rows)
+		wg.Wait()
+		close(cEdges)
+	}()
+
+	for edges := range cEdges {
+		for _, e := range edges {
+			u.union(e.i, e.j)
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	byRoot := make(map[int]int)
+	clusters := make([]Cluster, 0)
+	for i := 0; i < n; i++ {
+		r := u.find(i)
+		c, ok := byRoot[r]
+		if !ok {
+			c = len(clusters)
+			byRoot[r] = c
+			clusters = append(clusters, Cluster{})
+		}
+		clusters[c].Members = append(clusters[c].Members, i)
+	}
+
+	for c := range clusters {
+		clusters[c].Representative = medoid(dist, clusters[c].Members)
+	}
+
+	return clusters, nil
+}
+
+// medoid returns the member with the smallest total distance to the other members. Ties go to
+// the earliest in the input
+func medoid(dist func(i, j int) float64, members []int) int {
+	if len(members) < 3 {
+		return members[0]
+	}
+	sums := make([]float64, len(members))
+	for a := range members {
+		for b := 0; b < a; b++ {
+			d := dist(members[a], members[b])
+			sums[a] += d
+			sums[b] += d
+		}
+	}
+	best := 0
+	for a := range sums {
+		if sums[a] < sums[best] {
+			best = a
+		}
+	}
+	return members[best]
+}
+
+// WriteAssignments writes a CSV file with the columns sequence,cluster,cluster_size,representative,
+// with one line per record in input order. Clusters are numbered from 1
+func WriteAssignments(w io.Writer, clusters []Cluster, names []string) error {
+
+	type row struct {
+		idx  int
+		line string
+	}
+	rows := make([]row, 0, len(names))
+	for c, cl := range clusters {
+		for _, m := range cl.Members {
+			rows = append(rows, row{m, names[m] + "," + strconv.Itoa(c+1) + "," + strconv.Itoa(len(cl.Members)) + "," + names[cl.Representative] + "\n"})
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].idx < rows[j].idx })
+
+	if _, err := w.Write([]byte("sequence,cluster,cluster_size,representative\n")); err != nil {
+		return err
+	}
+	for _, r := range rows {
+		if _, err := w.Write([]byte(r.line)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteRepresentatives writes the representative of every cluster with at least minSize members to w in fasta format
+func WriteRepresentatives(w io.Writer, clusters []Cluster, records []fastaio.EncodedFastaRecord, minSize int) error {
+	for _, cl := range clusters {
+		if len(cl.Members) < minSize {
+			continue
+		}
+		r := records[cl.Representative]
+		if _, err := w.Write([]byte(">" + r.ID + "\n" + encoding.DecodeToString(r.Seq) + "\n")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package cluster
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/distance"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+func TestSingleLinkage(t *testing.T) {
+	// s1-s2 and s2-s3 are 1 SNP apart, but s1-s3 are 2 apart. s4 is far from everything
+	msa := ">s1\nAAAAAA\n>s4\nTTTTTT\n>s2\nAAAAAC\n>s3\nAAAACC\n>s5\nTTTTTA\n"
+	records, err := fastaio.ReadEncodeAlignmentToList(strings.NewReader(msa), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	packed := make([]distance.Packed, len(records))
+	names := make([]string, len(records))
+	for i := range records {
+		packed[i] = distance.Pack(records[i])
+		names[i] = records[i].ID
+	}
+
+	clusters, err := SingleLinkage(context.Background(), packed, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(clusters) != 2 {
+		t.Fatalf("problem in TestSingleLinkage(): %v", clusters)
+	}
+
+	var out bytes.Buffer
+	WriteAssignments(&out, clusters, names)
+	want := "sequence,cluster,cluster_size,representative\n" +
+		"s1,1,3,s2\n" +
+		"s4,2,2,s4\n" +
+		"s2,1,3,s2\n" +
+		"s3,1,3,s2\n" +
+		"s5,2,2,s4\n"
+	if out.String() != want {
+		t.Errorf("problem in TestSingleLinkage(): got\n%s\nwant\n%s", out.String(), want)
+	}
+
+	out.Reset()
+	WriteRepresentatives(&out, clusters, records, 3)
+	if out.String() != ">s2\nAAAAAC\n" {
+		t.Errorf("problem in TestSingleLinkage() representatives: %s", out.String())
+	}
+
+	if clusters, _ := SingleLinkage(context.Background(), packed, 0, 1); len(clusters) != 5 {
+		t.Errorf("problem in TestSingleLinkage() with threshold 0")
+	}
+}
+
+func TestSingleLinkageBy(t *testing.T) {
+	msa := ">s1\nAAAAAA\n>s4\nTTTTTT\n>s2\nAAAAAC\n>s3\nAAAACC\n>s5\nTTTTTA\n"
+	records, err := fastaio.ReadEncodeAlignmentToList(strings.NewReader(msa), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snp, err := distance.Lookup("snp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	byMeasure, err := SingleLinkageBy(context.Background(), records, snp, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := SingleLinkage(context.Background(), distance.PackRecords(records), 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(byMeasure, want) {
+		t.Errorf("problem in TestSingleLinkageBy(): got %v, want %v", byMeasure, want)
+	}
+
+	// by raw distance s1-s2 are 1/6 apart
+	raw, err := distance.Lookup("raw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if clusters, _ := SingleLinkageBy(context.Background(), records, raw, 0.1, 2); len(clusters) != 5 {
+		t.Errorf("problem in TestSingleLinkageBy(): %v", clusters)
+	}
+	if clusters, _ := SingleLinkageBy(context.Background(), records, raw, 0.2, 2); len(clusters) != 2 {
+		t.Errorf("problem in TestSingleLinkageBy(): %v", clusters)
+	}
+}
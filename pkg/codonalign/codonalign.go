@@ -0,0 +1,271 @@
+/*
+Package codonalign implements codon-aware adjustment of an alignment to an
+annotated reference: in-frame deletions inside protein-coding regions that
+don't start at a codon boundary (as generic nucleotide aligners often place
+them) are shifted to the nearest codon boundary where that costs no more than
+a given number of extra mismatches to the reference.
+*/
+package codonalign
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/encoding"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/summary"
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+var ep = encoding.MakeEncodingArray()
+
+// maxShift is the furthest a gap can need to move to reach a codon boundary
+const maxShift = 2
+
+// Shift is one deletion that was moved. Start and NewStart are the 1-based positions of its first gap before
+// and after, and Before and After are the mismatches to the reference in the columns it moved over
+type Shift struct {
+	Start    int
+	NewStart int
+	Length   int
+	Before   int
+	After    int
+}
+
+// frames maps every (0-based) reference position to its index in each region, or -1 if it isn't in it
+type frames struct {
+	regions []variants.Region
+	index   [][]int
+}
+
+func newFrames(regions []variants.Region, refLen int) frames {
+	f := frames{regions: regions, index: make([][]int, len(regions))}
+	for k, r := range regions {
+		f.index[k] = make([]int, refLen)
+		for i := range f.index[k] {
+			f.index[k][i] = -1
+		}
+		for i, p := range r.Positions {
+			if p >= 1 && p <= refLen && f.index[k][p-1] == -1 {
+				f.index[k][p-1] = i
+			}
+		}
+	}
+	return f
+}
+
+// inFrame returns whether the gap over the columns [s, e) removes whole codons from region k: its positions
+// must be consecutive in the region, in one direction or the other, and start at a codon boundary
+func (f frames) inFrame(k, s, e int) bool {
+	idx := f.index[k]
+	first, last := idx[s], idx[e-1]
+	if first == -1 || last == -1 {
+		return false
+	}
+	step := 1
+	if last < first {
+		step = -1
+	}
+	for i := s + 1; i < e; i++ {
+		if idx[i] != idx[i-1]+step {
+			return false
+		}
+	}
+	lo := first
+	if last < lo {
+		lo = last
+	}
+	return lo%3 == 0
+}
+
+// containing returns the regions that the columns [s, e) are entirely inside
+func (f frames) containing(s, e int) []int {
+	ks := make([]int, 0)
+	for k, r := range f.regions {
+		if s+1 >= r.Start && e <= r.Stop {
+			ks = append(ks, k)
+		}
+	}
+	return ks
+}
+
+// mismatches returns the number of columns in [lo, hi) where seq has a nucleotide that is incompatible
+// with ref
+func mismatches(seq []byte, ref string, lo, hi int) int {
+	n := 0
+	for i := lo; i < hi; i++ {
+		if seq[i] != '-' && ep[seq[i]]&ep[ref[i]] < 16 {
+			n++
+		}
+	}
+	return n
+}
+
+// move moves the gap over the columns [s, e) of seq by d columns, where the d columns it moves over are bases
+func move(seq []byte, s, e, d int) {
+	if d > 0 {
+		copy(seq[s:s+d], seq[e:e+d])
+		for i := e; i < e+d; i++ {
+			seq[i] = '-'
+		}
+		return
+	}
+	copy(seq[e+d:e], seq[s+d:s])
+	for i := s + d; i < s; i++ {
+		seq[i] = '-'
+	}
+}
+
+// Adjust moves the in-frame deletions in seq, which must be aligned to ref (and so the same length), to codon
+// boundaries in the regions that they are inside. A deletion is moved by at most two columns, to the placement
+// that is in frame in every region it is inside with the fewest mismatches to ref (and then the shortest move),
+// as long as that is at most maxCost more mismatches than it had. Deletions that shift the reading frame, and
+// gaps at the ends of seq, are left alone. It returns the new sequence, the moves, and the number of
+// out-of-frame in-frame deletions that couldn't be moved
+func Adjust(seq, ref string, regions []variants.Region, maxCost int) (string, []Shift, int) {
+	return newFrames(regions, len(ref)).adjust(seq, ref, maxCost)
+}
+
+func (f frames) adjust(seq, ref string, maxCost int) (string, []Shift, int) {
+	row := []byte(seq)
+	shifts := make([]Shift, 0)
+	stuck := 0
+
+	first, last := strings.IndexFunc(seq, func(r rune) bool { return r != '-' }), strings.LastIndexFunc(seq, func(r rune) bool { return r != '-' })
+	if first == -1 {
+		return seq, shifts, 0
+	}
+
+	for i := first; i <= last; i++ {
+		if row[i] != '-' {
+			continue
+		}
+		s := i
+		for i <= last && row[i] == '-' {
+			i++
+		}
+		e := i
+		if (e-s)%3 != 0 {
+			continue
+		}
+
+		ks := f.containing(s, e)
+		if len(ks) == 0 {
+			continue
+		}
+		ok := true
+		for _, k := range ks {
+			ok = ok && f.inFrame(k, s, e)
+		}
+		if ok {
+			continue
+		}
+
+		lo, hi := s-maxShift, e+maxShift
+		if lo < first {
+			lo = first
+		}
+		if hi > last+1 {
+			hi = last + 1
+		}
+		before := mismatches(row, ref, lo, hi)
+
+		bestD, bestCost := 0, -1
+		for _, d := range []int{-1, 1, -2, 2} {
+			ns, ne := s+d, e+d
+			if ns < lo || ne > hi {
+				continue
+			}
+			// the columns the gap moves over must all be bases
+			a, b := e, ne
+			if d < 0 {
+				a, b = ns, s
+			}
+			if strings.Contains(string(row[a:b]), "-") {
+				continue
+			}
+			nks := f.containing(ns, ne)
+			if len(nks) != len(ks) {
+				continue
+			}
+			inFrame := true
+			for _, k := range nks {
+				inFrame = inFrame && f.inFrame(k, ns, ne)
+			}
+			if !inFrame {
+				continue
+			}
+			trial := make([]byte, hi-lo)
+			copy(trial, row[lo:hi])
+			move(trial, s-lo, e-lo, d)
+			cost := mismatches(trial, ref[lo:hi], 0, hi-lo)
+			if bestCost == -1 || cost < bestCost {
+				bestD, bestCost = d, cost
+			}
+		}
+
+		if bestCost == -1 || bestCost-before > maxCost {
+			stuck++
+			continue
+		}
+		move(row, s, e, bestD)
+		shifts = append(shifts, Shift{Start: s + 1, NewStart: s + bestD + 1, Length: e - s, Before: before, After: bestCost})
+		i = e + bestD - 1
+	}
+
+	return string(row), shifts, stuck
+}
+
+// CodonAlign adjusts every record in the alignment msa, which must be aligned to ref (the annotation's
+// reference), and writes the result to out. If reportOut isn't nil, a CSV file with the columns
+// query,position,length,new_position,mismatches_before,mismatches_after is written to it, with one line for
+// every deletion that was moved
+func CodonAlign(ctx context.Context, msa io.Reader, ref string, regions []variants.Region, out, reportOut io.Writer, maxCost int) error {
+
+	if maxCost < 0 {
+		return errors.New("the maximum number of extra mismatches can't be negative")
+	}
+	f := newFrames(regions, len(ref))
+
+	if reportOut != nil {
+		if _, err := reportOut.Write([]byte("query,position,length,new_position,mismatches_before,mismatches_after\n")); err != nil {
+			return err
+		}
+	}
+
+	moved, stuck := 0, 0
+	err := fastaio.EachAlignedRecord(ctx, msa, func(FR fastaio.FastaRecord) error {
+		if len(FR.Seq) != len(ref) {
+			return errors.New(FR.ID + " (" + strconv.Itoa(len(FR.Seq)) + " bases) is not the same length as the annotation's reference (" + strconv.Itoa(len(ref)) + " bases)")
+		}
+		seq, shifts, n := f.adjust(FR.Seq, ref, maxCost)
+		moved += len(shifts)
+		stuck += n
+		if _, err := out.Write([]byte(">" + FR.Description + "\n" + seq + "\n")); err != nil {
+			return err
+		}
+		if reportOut == nil {
+			return nil
+		}
+		var sb strings.Builder
+		for _, s := range shifts {
+			sb.WriteString(FR.ID + "," + strconv.Itoa(s.Start) + "," + strconv.Itoa(s.Length) + "," + strconv.Itoa(s.NewStart) + "," +
+				strconv.Itoa(s.Before) + "," + strconv.Itoa(s.After) + "\n")
+		}
+		_, err := reportOut.Write([]byte(sb.String()))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if stuck > 0 {
+		summary.Warn(strconv.Itoa(stuck) + " in-frame deletions that don't start at a codon boundary couldn't be moved to one (" +
+			strconv.Itoa(moved) + " were)")
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package codonalign
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+func positions(start, stop int, reverse bool) []int {
+	ps := make([]int, 0)
+	for p := start; p <= stop; p++ {
+		ps = append(ps, p)
+	}
+	if reverse {
+		for i, j := 0, len(ps)-1; i < j; i, j = i+1, j-1 {
+			ps[i], ps[j] = ps[j], ps[i]
+		}
+	}
+	return ps
+}
+
+func TestAdjust(t *testing.T) {
+	fwd := []variants.Region{{Name: "fwd", Start: 1, Stop: 12, Strand: 1, Positions: positions(1, 12, false)}}
+	// on the reverse strand, codons start at positions 11, 8 and 5
+	rev := []variants.Region{{Name: "rev", Start: 2, Stop: 11, Strand: -1, Positions: positions(2, 11, true)}}
+
+	tests := []struct {
+		seq, ref string
+		regions  []variants.Region
+		maxCost  int
+		want     string
+		shifts   int
+		stuck    int
+	}{
+		// an equivalent placement at a codon boundary
+		{"ATGAA---ACCC", "ATGAAAGAACCC", fwd, 0, "ATGAAA---CCC", 1, 0},
+		// already in frame, a frameshift, and terminal gaps are left alone
+		{"ATG---AAACCC", "ATGGGGAAACCC", fwd, 0, "ATG---AAACCC", 0, 0},
+		{"ATGA--AAACCC", "ATGAGGAAACCC", fwd, 0, "ATGA--AAACCC", 0, 0},
+		{"-----AAAACCC", "ATGAAAAAACCC", fwd, 0, "-----AAAACCC", 0, 0},
+		// the nearest boundary costs a mismatch, so it is only used if that's allowed
+		{"ATGG---TTCCC", "ATGGCATTTCCC", fwd, 0, "ATGG---TTCCC", 0, 1},
+		{"ATGG---TTCCC", "ATGGCATTTCCC", fwd, 1, "ATG---GTTCCC", 1, 0},
+		// codon boundaries on the reverse strand
+		{"AAAA---AAAAA", "AAAAAAAAAAAA", rev, 0, "AAAAA---AAAA", 1, 0},
+	}
+
+	for i, test := range tests {
+		got, shifts, stuck := Adjust(test.seq, test.ref, test.regions, test.maxCost)
+		if got != test.want || len(shifts) != test.shifts || stuck != test.stuck {
+			t.Errorf("problem in TestAdjust() %d: got %s %v %d", i, got, shifts, stuck)
+		}
+	}
+}
+
+func TestCodonAlign(t *testing.T) {
+	regions := []variants.Region{{Name: "fwd", Start: 1, Stop: 12, Strand: 1, Positions: positions(1, 12, false)}}
+	out, report := new(bytes.Buffer), new(bytes.Buffer)
+	msa := ">a\nATGAA---ACCC\n>b\nATGAAAGAACCC\n"
+	if err := CodonAlign(context.Background(), strings.NewReader(msa), "ATGAAAGAACCC", regions, out, report, 0); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != ">a\nATGAAA---CCC\n>b\nATGAAAGAACCC\n" {
+		t.Errorf("problem in TestCodonAlign(): got\n%s", out.String())
+	}
+	if report.String() != "query,position,length,new_position,mismatches_before,mismatches_after\na,6,3,7,0,0\n" {
+		t.Errorf("problem in TestCodonAlign(): got\n%s", report.String())
+	}
+
+	if err := CodonAlign(context.Background(), strings.NewReader(">a\nATG\n"), "ATGAAAGAACCC", regions, out, nil, 0); err == nil {
+		t.Errorf("problem in TestCodonAlign(): expected an error for a record of the wrong length")
+	}
+}
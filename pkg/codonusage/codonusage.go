@@ -0,0 +1,152 @@
+/*
+Package codonusage implements routines to count the codons used by
+nucleotide sequences, either in frame 1 or in each protein-coding region of
+an annotation, and to calculate relative synonymous codon usage (RSCU).
+*/
+package codonusage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/alphabet"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/restrict"
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+// Codons are the 64 unambiguous codons, in the conventional TCAG order
+var Codons = func() []string {
+	order := "TCAG"
+	codons := make([]string, 64)
+	for i := range codons {
+		codons[i] = string([]byte{order[i/16], order[(i/4)%4], order[i%4]})
+	}
+	return codons
+}()
+
+// Counts is the number of times each unambiguous codon is used
+type Counts map[string]int
+
+// Add adds the complete, unambiguous codons in seq (read from its first base) to c. Codons containing gaps or
+// ambiguity codes, and a trailing partial codon, are ignored
+func (c Counts) Add(seq string) {
+	for i := 0; i+3 <= len(seq); i += 3 {
+		codon := seq[i : i+3]
+		if strings.Trim(codon, "ACGT") == "" {
+			c[codon]++
+		}
+	}
+}
+
+// Merge adds the counts in other to c
+func (c Counts) Merge(other Counts) {
+	for codon, n := range other {
+		c[codon] += n
+	}
+}
+
+// Usage is one row of a codon usage table. PerThousand is the number of uses per thousand codons, and RSCU is
+// the number of uses divided by the mean number of uses of the codons for the same amino acid (or stop), so
+// is 1 when synonymous codons are used equally often
+type Usage struct {
+	Codon       string
+	AminoAcid   string
+	Count       int
+	PerThousand float64
+	RSCU        float64
+}
+
+// Table returns the usage of every codon in c, in the order of Codons, using the codon dictionary CD
+func (c Counts) Table(CD map[string]string) []Usage {
+	total := 0
+	byAA := make(map[string]int)
+	synonyms := make(map[string]int)
+	for _, codon := range Codons {
+		total += c[codon]
+		byAA[CD[codon]] += c[codon]
+		synonyms[CD[codon]]++
+	}
+
+	table := make([]Usage, len(Codons))
+	for i, codon := range Codons {
+		aa := CD[codon]
+		u := Usage{Codon: codon, AminoAcid: aa, Count: c[codon]}
+		if total > 0 {
+			u.PerThousand = 1000 * float64(u.Count) / float64(total)
+		}
+		if byAA[aa] > 0 {
+			u.RSCU = float64(u.Count) * float64(synonyms[aa]) / float64(byAA[aa])
+		}
+		table[i] = u
+	}
+	return table
+}
+
+const tableHeader = "codon,amino_acid,count,per_thousand,rscu"
+
+func (u Usage) csv() string {
+	return u.Codon + "," + u.AminoAcid + "," + strconv.Itoa(u.Count) + "," + strconv.FormatFloat(u.PerThousand, 'f', 2, 64) + "," +
+		strconv.FormatFloat(u.RSCU, 'f', 3, 64)
+}
+
+// CodonUsage counts the codons in every record in in. If regions is empty, each record is read in frame 1 after
+// removing gaps; otherwise the codons in each region are counted, and the records must be in the regions'
+// coordinates. The total usage is written to out as a CSV file with the columns codon,amino_acid,count,
+// per_thousand,rscu, and (if perRecord is not nil) the usage in each record to perRecord, with an extra first
+// column, record. table is the NCBI translation table to use
+func CodonUsage(ctx context.Context, in io.Reader, regions []variants.Region, out io.Writer, perRecord io.Writer, table int) error {
+
+	CD, err := alphabet.MakeCodonDictFromTable(table)
+	if err != nil {
+		return err
+	}
+
+	if perRecord != nil {
+		if _, err := perRecord.Write([]byte("record," + tableHeader + "\n")); err != nil {
+			return err
+		}
+	}
+
+	total := make(Counts)
+
+	err = fastaio.EachRecord(ctx, in, func(FR fastaio.FastaRecord) error {
+		c := make(Counts)
+		if len(regions) == 0 {
+			c.Add(strings.ReplaceAll(FR.Seq, "-", ""))
+		}
+		for _, region := range regions {
+			cds, err := restrict.CodingSequence(FR.Seq, region)
+			if err != nil {
+				return errors.New(FR.ID + ": " + err.Error())
+			}
+			c.Add(cds)
+		}
+		total.Merge(c)
+
+		if perRecord == nil {
+			return nil
+		}
+		var sb strings.Builder
+		for _, u := range c.Table(CD) {
+			sb.WriteString(FR.ID + "," + u.csv() + "\n")
+		}
+		_, err := perRecord.Write([]byte(sb.String()))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	var sb strings.Builder
+	sb.WriteString(tableHeader + "\n")
+	for _, u := range total.Table(CD) {
+		sb.WriteString(u.csv() + "\n")
+	}
+	_, err = out.Write([]byte(sb.String()))
+
+	return err
+}
@@ -0,0 +1,61 @@
+package codonusage
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/alphabet"
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+func TestTable(t *testing.T) {
+	CD, err := alphabet.MakeCodonDictFromTable(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := make(Counts)
+	// three GCT and one GCC (alanine, four codons), one codon with an N, one with a gap and a partial codon
+	c.Add("GCTGCTGCCGCTGNTG-TAT")
+	table := c.Table(CD)
+
+	byCodon := make(map[string]Usage)
+	for _, u := range table {
+		byCodon[u.Codon] = u
+	}
+	if byCodon["GCT"].Count != 3 || byCodon["GCT"].RSCU != 3 || byCodon["GCC"].RSCU != 1 || byCodon["GCA"].RSCU != 0 {
+		t.Errorf("problem in TestTable(): %v %v %v", byCodon["GCT"], byCodon["GCC"], byCodon["GCA"])
+	}
+	if byCodon["GCT"].PerThousand != 750 || byCodon["GCT"].AminoAcid != "A" {
+		t.Errorf("problem in TestTable(): %v", byCodon["GCT"])
+	}
+	if len(table) != 64 || table[0].Codon != "TTT" {
+		t.Errorf("problem in TestTable(): %v", table[0])
+	}
+}
+
+func TestCodonUsage(t *testing.T) {
+	in := ">s1\nATG-AAATAA\n>s2\nATGAAGTAG\n"
+
+	var out, perRecord bytes.Buffer
+	if err := CodonUsage(context.Background(), strings.NewReader(in), nil, &out, &perRecord, 1); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "\nAAA,K,1,166.67,1.000\n") || !strings.Contains(out.String(), "\nATG,M,2,333.33,1.000\n") {
+		t.Errorf("problem in TestCodonUsage(): %s", out.String())
+	}
+	if !strings.Contains(perRecord.String(), "\ns2,AAG,K,1,333.33,2.000\n") {
+		t.Errorf("problem in TestCodonUsage() per record: %s", perRecord.String())
+	}
+
+	// a reverse-strand region covering CAT (ATG on the reverse strand)
+	regions := []variants.Region{{Name: "g", Strand: -1, Positions: []int{3, 2, 1}}}
+	out.Reset()
+	if err := CodonUsage(context.Background(), strings.NewReader(">s1\nCATGG\n"), regions, &out, nil, 1); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "\nATG,M,1,1000.00,1.000\n") {
+		t.Errorf("problem in TestCodonUsage() with regions: %s", out.String())
+	}
+}
@@ -0,0 +1,215 @@
+/*
+Package collapse implements routines to collapse the sequences in an
+alignment into haplotypes, writing one representative sequence per
+haplotype and a table of the members of each.
+*/
+package collapse
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/dedup"
+	"github.com/virus-evolution/gofasta/pkg/distance"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/mask"
+)
+
+// Options controls how sequences are collapsed
+type Options struct {
+	Masks     *mask.Masks // sites to mask with N before comparing sequences (nil means none)
+	Ambiguous bool        // also collapse sequences that differ only at sites where one is ambiguous (SNP-distance zero)
+	Prefix    string      // haplotypes are named Prefix1, Prefix2, ... in order of first appearance
+}
+
+// Haplotype is a set of sequences that are the same at every (unmasked) site
+type Haplotype struct {
+	Name           string
+	Representative fastaio.FastaRecord
+	Members        []string
+
+	complete int
+	packed   distance.Packed
+}
+
+// acgt returns the number of unambiguous nucleotides in seq
+func acgt(seq string) int {
+	n := 0
+	for i := 0; i < len(seq); i++ {
+		switch seq[i] {
+		case 'A', 'C', 'G', 'T':
+			n++
+		}
+	}
+	return n
+}
+
+// Collapse groups the records in the alignment in msa into haplotypes, in order of first appearance. Without
+// o.Ambiguous, records must be identical (after masking) to be in the same haplotype, and the first is its
+// representative. With o.Ambiguous, a record joins the first haplotype whose representative it has an
+// SNP-distance of zero to, and the most complete member of a haplotype is its representative
+func Collapse(ctx context.Context, msa io.Reader, o Options) ([]Haplotype, error) {
+
+	haplotypes := make([]Haplotype, 0)
+	seen := make(map[[32]byte]int)
+
+	err := fastaio.EachAlignedRecord(ctx, msa, func(FR fastaio.FastaRecord) error {
+		if o.Masks != nil {
+			var err error
+			FR, err = o.Masks.Apply(FR, 'N')
+			if err != nil {
+				return err
+			}
+		}
+
+		h := sha256.Sum256([]byte(FR.Seq))
+		if i, ok := seen[h]; ok {
+			haplotypes[i].Members = append(haplotypes[i].Members, FR.ID)
+			return nil
+		}
+
+		if o.Ambiguous {
+			p := distance.Pack(FR.Encode())
+			for i := range haplotypes {
+				if distance.SNPUpTo(&haplotypes[i].packed, &p, 1) > 0 {
+					continue
+				}
+				hap := &haplotypes[i]
+				hap.Members = append(hap.Members, FR.ID)
+				seen[h] = i
+				if c := acgt(FR.Seq); c > hap.complete {
+					hap.Representative, hap.complete, hap.packed = FR, c, p
+				}
+				return nil
+			}
+			seen[h] = len(haplotypes)
+			haplotypes = append(haplotypes, Haplotype{Representative: FR, Members: []string{FR.ID}, complete: acgt(FR.Seq), packed: p})
+			return nil
+		}
+
+		seen[h] = len(haplotypes)
+		haplotypes = append(haplotypes, Haplotype{Representative: FR, Members: []string{FR.ID}})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range haplotypes {
+		haplotypes[i].Name = o.Prefix + strconv.Itoa(i+1)
+		haplotypes[i].packed = distance.Packed{}
+	}
+
+	return haplotypes, nil
+}
+
+// Prescreen is Collapse for alignments with so many records that keeping the representative and the names of the
+// members of every haplotype in memory is too much. The alignment is read twice, so it is given as a function that
+// opens it. The first pass finds the (masked) sequences that are possibly duplicated, as dedup.Candidates does. The
+// second pass writes the representative of each haplotype to out as it first appears, as WriteAlignment would,
+// and only keeps track of the members of the possible duplicates. In tableOut, which is as WriteTable's if it isn't
+// nil, those haplotypes come after the others, once all of their members are known. o.Ambiguous compares each
+// sequence with every haplotype, so it can't be used
+func Prescreen(ctx context.Context, open func() (io.ReadCloser, error), out io.Writer, tableOut io.Writer, o Options) error {
+
+	if o.Ambiguous {
+		return errors.New("a prescreen can't collapse ambiguous sequences")
+	}
+
+	masked := func(FR fastaio.FastaRecord) (fastaio.FastaRecord, error) {
+		if o.Masks == nil {
+			return FR, nil
+		}
+		return o.Masks.Apply(FR, 'N')
+	}
+
+	candidates, err := dedup.Candidates(ctx, open, func(FR fastaio.FastaRecord) ([32]byte, error) {
+		FR, err := masked(FR)
+		return sha256.Sum256([]byte(FR.Seq)), err
+	})
+	if err != nil {
+		return err
+	}
+	if tableOut != nil {
+		if err := WriteTable(tableOut, nil); err != nil {
+			return err
+		}
+	}
+
+	in, err := open()
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	n := 0
+	haplotypes := make([]Haplotype, 0)
+	seen := make(map[[32]byte]int)
+	err = fastaio.EachAlignedRecord(ctx, in, func(FR fastaio.FastaRecord) error {
+		FR, err := masked(FR)
+		if err != nil {
+			return err
+		}
+		h := sha256.Sum256([]byte(FR.Seq))
+		if i, ok := seen[h]; ok {
+			haplotypes[i].Members = append(haplotypes[i].Members, FR.ID)
+			return nil
+		}
+
+		n++
+		hap := Haplotype{Name: o.Prefix + strconv.Itoa(n), Representative: FR, Members: []string{FR.ID}}
+		if candidates[h] {
+			seen[h] = len(haplotypes)
+			haplotypes = append(haplotypes, Haplotype{Name: hap.Name, Representative: fastaio.FastaRecord{ID: FR.ID}, Members: hap.Members})
+		} else if tableOut != nil {
+			if err := writeRows(tableOut, []Haplotype{hap}); err != nil {
+				return err
+			}
+		}
+		return WriteAlignment(out, []Haplotype{hap})
+	})
+	if err != nil {
+		return err
+	}
+
+	if tableOut == nil {
+		return nil
+	}
+	return writeRows(tableOut, haplotypes)
+}
+
+// WriteAlignment writes the representative of each haplotype to out, named after the haplotype
+func WriteAlignment(out io.Writer, haplotypes []Haplotype) error {
+	for _, hap := range haplotypes {
+		_, err := out.Write([]byte(">" + hap.Name + "\n" + hap.Representative.Seq + "\n"))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteTable writes a CSV file with the columns haplotype,representative,count,members to out, where
+// members is a ";"-delimited list of every record in the haplotype
+func WriteTable(out io.Writer, haplotypes []Haplotype) error {
+	_, err := out.Write([]byte("haplotype,representative,count,members\n"))
+	if err != nil {
+		return err
+	}
+	return writeRows(out, haplotypes)
+}
+
+// writeRows writes the lines of WriteTable's table for each haplotype, without its header
+func writeRows(out io.Writer, haplotypes []Haplotype) error {
+	for _, hap := range haplotypes {
+		_, err := out.Write([]byte(hap.Name + "," + hap.Representative.ID + "," + strconv.Itoa(len(hap.Members)) + "," + strings.Join(hap.Members, ";") + "\n"))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package collapse
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/mask"
+)
+
+var collapseMSA = `>s1
+ACGTA
+>s2
+ACGTA
+>s3
+ACNTA
+>s4
+TCGTA
+>s5
+ACGTC
+`
+
+func TestCollapse(t *testing.T) {
+	haps, err := Collapse(context.Background(), strings.NewReader(collapseMSA), Options{Prefix: "hap"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var table bytes.Buffer
+	if err = WriteTable(&table, haps); err != nil {
+		t.Fatal(err)
+	}
+	want := "haplotype,representative,count,members\nhap1,s1,2,s1;s2\nhap2,s3,1,s3\nhap3,s4,1,s4\nhap4,s5,1,s5\n"
+	if table.String() != want {
+		t.Errorf("problem in TestCollapse(): %s", table.String())
+	}
+
+	var aln bytes.Buffer
+	if err = WriteAlignment(&aln, haps); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(aln.String(), ">hap1\nACGTA\n>hap2\nACNTA\n") {
+		t.Errorf("problem in TestCollapse() alignment: %s", aln.String())
+	}
+}
+
+func TestCollapseAmbiguousMasked(t *testing.T) {
+	masks := mask.NewMasks()
+	masks.Global = append(masks.Global, mask.Site{Start: 4, End: 5})
+
+	// s0 starts the first haplotype with an N, but s1 is more complete so becomes the representative
+	msa := ">s0\nACNTA\n" + collapseMSA
+	haps, err := Collapse(context.Background(), strings.NewReader(msa), Options{Masks: &masks, Ambiguous: true, Prefix: "h"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var table bytes.Buffer
+	if err = WriteTable(&table, haps); err != nil {
+		t.Fatal(err)
+	}
+	want := "haplotype,representative,count,members\nh1,s1,5,s0;s1;s2;s3;s5\nh2,s4,1,s4\n"
+	if table.String() != want {
+		t.Errorf("problem in TestCollapseAmbiguousMasked(): %s", table.String())
+	}
+	if haps[0].Representative.Seq != "ACGTN" {
+		t.Errorf("problem in TestCollapseAmbiguousMasked(): representative %s", haps[0].Representative.Seq)
+	}
+}
+
+func TestPrescreen(t *testing.T) {
+	masks := mask.NewMasks()
+	masks.Global = append(masks.Global, mask.Site{Start: 4, End: 5})
+	open := func() (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(collapseMSA)), nil
+	}
+
+	for _, o := range []Options{{Prefix: "hap"}, {Masks: &masks, Prefix: "hap"}} {
+		haps, err := Collapse(context.Background(), strings.NewReader(collapseMSA), o)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var want bytes.Buffer
+		if err = WriteAlignment(&want, haps); err != nil {
+			t.Fatal(err)
+		}
+
+		var aln, table bytes.Buffer
+		if err = Prescreen(context.Background(), open, &aln, &table, o); err != nil {
+			t.Fatal(err)
+		}
+		if aln.String() != want.String() {
+			t.Errorf("problem in TestPrescreen(): got\n%s\nexpected\n%s", aln.String(), want.String())
+		}
+		if o.Masks == nil && table.String() != "haplotype,representative,count,members\nhap2,s3,1,s3\nhap3,s4,1,s4\nhap4,s5,1,s5\nhap1,s1,2,s1;s2\n" {
+			t.Errorf("problem in TestPrescreen() table: %s", table.String())
+		}
+		if o.Masks != nil && table.String() != "haplotype,representative,count,members\nhap2,s3,1,s3\nhap3,s4,1,s4\nhap1,s1,3,s1;s2;s5\n" {
+			t.Errorf("problem in TestPrescreen() masked table: %s", table.String())
+		}
+	}
+
+	if err := Prescreen(context.Background(), open, io.Discard, nil, Options{Ambiguous: true}); err == nil {
+		t.Errorf("problem in TestPrescreen(): no error with Ambiguous")
+	}
+}
@@ -0,0 +1,216 @@
+/*
+Package compare implements routines to compare two alignments record by
+record, for example the output of two versions of an assembly pipeline on the
+same samples, reporting the differences within each pair of records and how
+concordant the alignments are overall.
+*/
+package compare
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/summary"
+)
+
+// Pair holds the differences between one record in the first alignment (A) and its partner in the second (B).
+// Differences lists every differing site as "position:a>b" (1-based)
+type Pair struct {
+	A                string
+	B                string
+	SNPs             int
+	Indels           int
+	NChanges         int
+	AmbiguityChanges int
+	Differences      []string
+}
+
+// Concordant reports whether the two records are identical
+func (p Pair) Concordant() bool {
+	return len(p.Differences) == 0
+}
+
+// Summary holds the totals over every pair
+type Summary struct {
+	Pairs            int
+	Concordant       int
+	SNPs             int
+	Indels           int
+	NChanges         int
+	AmbiguityChanges int
+	UnpairedA        int
+	UnpairedB        int
+}
+
+// ReadMapping reads a CSV file with a header and two columns, a record name in the first alignment and the name of
+// its partner in the second, and returns a map from the first to the second
+func ReadMapping(r io.Reader) (map[string]string, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = 2
+	rows, err := cr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, errors.New("pairing file is empty")
+	}
+	m := make(map[string]string, len(rows)-1)
+	for _, row := range rows[1:] {
+		if _, ok := m[row[0]]; ok {
+			return nil, errors.New(row[0] + " is paired more than once")
+		}
+		m[row[0]] = row[1]
+	}
+	return m, nil
+}
+
+func isN(c byte) bool {
+	return c == 'N' || c == '?'
+}
+
+func isACGT(c byte) bool {
+	switch c {
+	case 'A', 'C', 'G', 'T':
+		return true
+	}
+	return false
+}
+
+// Diff compares two aligned sequences of the same length site by site. A gap in one but not the other is an
+// indel, an N (or ?) in one but not the other is an N change, two different unambiguous nucleotides are an SNP,
+// and any other difference (e.g. R vs A) is an ambiguity change
+func Diff(a, b fastaio.FastaRecord) (Pair, error) {
+	p := Pair{A: a.ID, B: b.ID, Differences: make([]string, 0)}
+	if len(a.Seq) != len(b.Seq) {
+		return p, errors.New(a.ID + " and " + b.ID + " are different lengths (" + strconv.Itoa(len(a.Seq)) + " and " + strconv.Itoa(len(b.Seq)) + ")")
+	}
+	for i := 0; i < len(a.Seq); i++ {
+		x, y := a.Seq[i], b.Seq[i]
+		if x == y {
+			continue
+		}
+		switch {
+		case x == '-' || y == '-':
+			p.Indels++
+		case isN(x) || isN(y):
+			p.NChanges++
+		case isACGT(x) && isACGT(y):
+			p.SNPs++
+		default:
+			p.AmbiguityChanges++
+		}
+		p.Differences = append(p.Differences, strconv.Itoa(i+1)+":"+string(x)+">"+string(y))
+	}
+	return p, nil
+}
+
+// Compare pairs every record in the alignment aIn with one in the alignment bIn, by name or (if mapping is not nil)
+// using mapping, and passes the differences for each pair to f in the order of aIn. Records without a partner are
+// counted in the summary and warned about
+func Compare(ctx context.Context, aIn, bIn io.Reader, mapping map[string]string, f func(Pair) error) (Summary, error) {
+
+	var s Summary
+
+	bRecords, err := fastaio.ReadFastaToList(bIn)
+	if err != nil {
+		return s, err
+	}
+	bByName := make(map[string]int, len(bRecords))
+	for i, FR := range bRecords {
+		bByName[FR.ID] = i
+	}
+	used := make([]bool, len(bRecords))
+
+	err = fastaio.EachAlignedRecord(ctx, aIn, func(FR fastaio.FastaRecord) error {
+		name := FR.ID
+		if mapping != nil {
+			var ok bool
+			if name, ok = mapping[FR.ID]; !ok {
+				summary.Warn(FR.ID + " has no partner in the pairing file")
+				s.UnpairedA++
+				return nil
+			}
+		}
+		i, ok := bByName[name]
+		if !ok {
+			summary.Warn(FR.ID + " has no partner (" + name + ") in the second alignment")
+			s.UnpairedA++
+			return nil
+		}
+		used[i] = true
+
+		p, err := Diff(FR, bRecords[i])
+		if err != nil {
+			return err
+		}
+		s.Pairs++
+		if p.Concordant() {
+			s.Concordant++
+		}
+		s.SNPs += p.SNPs
+		s.Indels += p.Indels
+		s.NChanges += p.NChanges
+		s.AmbiguityChanges += p.AmbiguityChanges
+		return f(p)
+	})
+	if err != nil {
+		return s, err
+	}
+
+	for _, u := range used {
+		if !u {
+			s.UnpairedB++
+		}
+	}
+
+	return s, nil
+}
+
+// Write compares the alignments aIn and bIn as Compare does, and writes a CSV file with the columns
+// a,b,snps,indels,n_changes,ambiguity_changes,concordant,differences to out, and (if summaryOut is not nil)
+// the totals to summaryOut as a two-column CSV of stat,value
+func Write(ctx context.Context, aIn, bIn io.Reader, mapping map[string]string, out io.Writer, summaryOut io.Writer) error {
+
+	_, err := out.Write([]byte("a,b,snps,indels,n_changes,ambiguity_changes,concordant,differences\n"))
+	if err != nil {
+		return err
+	}
+
+	s, err := Compare(ctx, aIn, bIn, mapping, func(p Pair) error {
+		_, err := out.Write([]byte(p.A + "," + p.B + "," + strconv.Itoa(p.SNPs) + "," + strconv.Itoa(p.Indels) + "," +
+			strconv.Itoa(p.NChanges) + "," + strconv.Itoa(p.AmbiguityChanges) + "," + strconv.FormatBool(p.Concordant()) + "," +
+			strings.Join(p.Differences, ";") + "\n"))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if summaryOut == nil {
+		return nil
+	}
+
+	concordance := 0.0
+	if s.Pairs > 0 {
+		concordance = float64(s.Concordant) / float64(s.Pairs)
+	}
+
+	_, err = summaryOut.Write([]byte("stat,value\n" +
+		"pairs," + strconv.Itoa(s.Pairs) + "\n" +
+		"concordant," + strconv.Itoa(s.Concordant) + "\n" +
+		"discordant," + strconv.Itoa(s.Pairs-s.Concordant) + "\n" +
+		"concordance," + strconv.FormatFloat(concordance, 'f', 4, 64) + "\n" +
+		"snps," + strconv.Itoa(s.SNPs) + "\n" +
+		"indels," + strconv.Itoa(s.Indels) + "\n" +
+		"n_changes," + strconv.Itoa(s.NChanges) + "\n" +
+		"ambiguity_changes," + strconv.Itoa(s.AmbiguityChanges) + "\n" +
+		"unpaired_a," + strconv.Itoa(s.UnpairedA) + "\n" +
+		"unpaired_b," + strconv.Itoa(s.UnpairedB) + "\n"))
+
+	return err
+}
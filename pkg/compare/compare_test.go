@@ -0,0 +1,49 @@
+package compare
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	a := ">s1\nACGTACGT\n>s2\nACGTACGT\n>s3\nAAAAAAAA\n"
+	b := ">s2\nACNTAC-R\n>s1\nACGTACGT\n>extra\nAAAAAAAA\n"
+
+	var out, summary bytes.Buffer
+	if err := Write(context.Background(), strings.NewReader(a), strings.NewReader(b), nil, &out, &summary); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "a,b,snps,indels,n_changes,ambiguity_changes,concordant,differences\n" +
+		"s1,s1,0,0,0,0,true,\n" +
+		"s2,s2,0,1,1,1,false,3:G>N;7:G>-;8:T>R\n"
+	if out.String() != want {
+		t.Errorf("problem in TestCompare(): %s", out.String())
+	}
+	if !strings.Contains(summary.String(), "pairs,2\nconcordant,1\n") || !strings.Contains(summary.String(), "unpaired_a,1\nunpaired_b,1\n") {
+		t.Errorf("problem in TestCompare() summary: %s", summary.String())
+	}
+}
+
+func TestCompareMapping(t *testing.T) {
+	mapping, err := ReadMapping(strings.NewReader("old,new\ns1,sample1\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	err = Write(context.Background(), strings.NewReader(">s1\nACGT\n"), strings.NewReader(">sample1\nACTT\n"), mapping, &out, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "s1,sample1,1,0,0,0,false,3:G>T\n") {
+		t.Errorf("problem in TestCompareMapping(): %s", out.String())
+	}
+
+	err = Write(context.Background(), strings.NewReader(">s1\nACGT\n"), strings.NewReader(">sample1\nACT\n"), mapping, &out, nil)
+	if err == nil {
+		t.Error("expected an error for records of different lengths")
+	}
+}
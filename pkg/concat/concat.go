@@ -0,0 +1,140 @@
+/*
+Package concat implements the concatenation of several alignments (e.g. one
+per gene) into a supermatrix, by matching record names, with a partitions
+file describing which columns came from which alignment.
+*/
+package concat
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/summary"
+)
+
+// Partitions file formats
+const (
+	FormatRAxML = "raxml"
+	FormatNexus = "nexus"
+)
+
+// Input is one of the alignments to concatenate. Name is the name of its partition
+type Input struct {
+	R    io.Reader
+	Name string
+}
+
+// Partition is the 1-based, inclusive range of columns that came from one alignment
+type Partition struct {
+	Name  string
+	Start int
+	End   int
+}
+
+// alignment is one input read into memory
+type alignment struct {
+	width int
+	seqs  map[string]string
+}
+
+// Concat concatenates the alignments in ins, and writes the supermatrix to out and the partitions to
+// partitionsOut (if it isn't nil) in format (one of FormatRAxML or FormatNexus). Records are matched by
+// name, and written in the order that they are first seen. A record that is missing from an alignment is
+// filled with fill for that alignment's columns
+func Concat(ctx context.Context, ins []Input, out, partitionsOut io.Writer, fill byte, format string) error {
+
+	switch format {
+	case FormatRAxML, FormatNexus:
+	default:
+		return errors.New("unknown partitions format: " + format + " (choose one of raxml or nexus)")
+	}
+
+	alignments := make([]alignment, len(ins))
+	names := make([]string, 0)
+	seen := make(map[string]bool)
+
+	for i, in := range ins {
+		a := alignment{width: -1, seqs: make(map[string]string)}
+		err := fastaio.EachAlignedRecord(ctx, in.R, func(FR fastaio.FastaRecord) error {
+			if _, ok := a.seqs[FR.ID]; ok {
+				return errors.New(FR.ID + " is in " + in.Name + " more than once")
+			}
+			a.seqs[FR.ID] = FR.Seq
+			a.width = len(FR.Seq)
+			if !seen[FR.ID] {
+				seen[FR.ID] = true
+				names = append(names, FR.ID)
+			}
+			return nil
+		})
+		if err != nil {
+			return errors.New(in.Name + ": " + err.Error())
+		}
+		if a.width == -1 {
+			return errors.New("no records in " + in.Name)
+		}
+		alignments[i] = a
+	}
+
+	partitions := make([]Partition, len(ins))
+	start := 1
+	for i, a := range alignments {
+		partitions[i] = Partition{Name: ins[i].Name, Start: start, End: start + a.width - 1}
+		start += a.width
+	}
+
+	for _, name := range names {
+		var sb strings.Builder
+		sb.Grow(start - 1)
+		missing := 0
+		for _, a := range alignments {
+			if seq, ok := a.seqs[name]; ok {
+				sb.WriteString(seq)
+			} else {
+				sb.WriteString(strings.Repeat(string(fill), a.width))
+				missing++
+			}
+		}
+		if missing > 0 {
+			summary.Warn(name + " is missing from " + strconv.Itoa(missing) + " of " + strconv.Itoa(len(alignments)) + " alignments")
+		}
+		if _, err := out.Write([]byte(">" + name + "\n" + sb.String() + "\n")); err != nil {
+			return err
+		}
+	}
+
+	if partitionsOut == nil {
+		return nil
+	}
+
+	return WritePartitions(partitionsOut, partitions, format)
+}
+
+// WritePartitions writes partitions in format: one of FormatRAxML ("DNA, name = start-end") or FormatNexus
+// (a sets block of charsets)
+func WritePartitions(w io.Writer, partitions []Partition, format string) error {
+	var sb strings.Builder
+	if format == FormatNexus {
+		sb.WriteString("#NEXUS\nbegin sets;\n")
+	}
+	for _, p := range partitions {
+		r := strconv.Itoa(p.Start) + "-" + strconv.Itoa(p.End)
+		switch format {
+		case FormatRAxML:
+			sb.WriteString("DNA, " + p.Name + " = " + r + "\n")
+		case FormatNexus:
+			sb.WriteString("\tcharset " + p.Name + " = " + r + ";\n")
+		default:
+			return errors.New("unknown partitions format: " + format + " (choose one of raxml or nexus)")
+		}
+	}
+	if format == FormatNexus {
+		sb.WriteString("end;\n")
+	}
+	_, err := w.Write([]byte(sb.String()))
+	return err
+}
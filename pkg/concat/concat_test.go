@@ -0,0 +1,41 @@
+package concat
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestConcat(t *testing.T) {
+	ins := []Input{
+		{R: strings.NewReader(">a\nATG\n>b\nATC\n"), Name: "gene1"},
+		{R: strings.NewReader(">b\nGGTT\n>c\nGGTA\n"), Name: "gene2"},
+	}
+
+	out, partitions := new(bytes.Buffer), new(bytes.Buffer)
+	if err := Concat(context.Background(), ins, out, partitions, '-', FormatRAxML); err != nil {
+		t.Fatal(err)
+	}
+	if want := ">a\nATG----\n>b\nATCGGTT\n>c\n---GGTA\n"; out.String() != want {
+		t.Errorf("problem in TestConcat(): got\n%s\nwant\n%s", out.String(), want)
+	}
+	if want := "DNA, gene1 = 1-3\nDNA, gene2 = 4-7\n"; partitions.String() != want {
+		t.Errorf("problem in TestConcat(): got\n%s\nwant\n%s", partitions.String(), want)
+	}
+
+	ins = []Input{{R: strings.NewReader(">a\nATG\n>a\nATC\n"), Name: "gene1"}}
+	if err := Concat(context.Background(), ins, out, nil, 'N', FormatRAxML); err == nil {
+		t.Errorf("problem in TestConcat(): expected an error for a duplicate name")
+	}
+}
+
+func TestWritePartitions(t *testing.T) {
+	out := new(bytes.Buffer)
+	if err := WritePartitions(out, []Partition{{Name: "S", Start: 1, End: 10}}, FormatNexus); err != nil {
+		t.Fatal(err)
+	}
+	if want := "#NEXUS\nbegin sets;\n\tcharset S = 1-10;\nend;\n"; out.String() != want {
+		t.Errorf("problem in TestWritePartitions(): got\n%s", out.String())
+	}
+}
@@ -0,0 +1,200 @@
+/*
+Package consensus implements routines to build a consensus sequence from
+a multiple sequence alignment in fasta format.
+*/
+package consensus
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/bits"
+	"sort"
+
+	"github.com/virus-evolution/gofasta/pkg/alignment"
+	"github.com/virus-evolution/gofasta/pkg/encoding"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+var (
+	// ErrThreshold is returned when Options.Threshold isn't between 0 and 1
+	ErrThreshold = errors.New("Options.Threshold must be between 0 and 1")
+	// ErrMinFraction is returned when Options.MinFraction isn't between 0 and 1
+	ErrMinFraction = errors.New("Options.MinFraction must be between 0 and 1")
+)
+
+// the four unambiguous nucleotides, as the bit they set in EP's coding scheme (>> 4)
+var nucBits = [4]byte{8, 4, 2, 1} // A, G, C, T
+
+// columnCounts holds the tallies for one alignment column. Ambiguous nucleotides are split
+// equally between the bases they could represent, so an R adds 0.5 to A and 0.5 to G
+type columnCounts struct {
+	nucs    [4]float64 // A, G, C, T
+	gaps    float64
+	missing float64 // N and ?
+}
+
+// add tallies one encoded nucleotide
+func (cc *columnCounts) add(nuc byte) {
+	switch nuc {
+	case 244, 4:
+		cc.gaps++
+		return
+	case 240, 242:
+		cc.missing++
+		return
+	}
+	set := nuc >> 4
+	w := 1.0 / float64(bits.OnesCount8(set))
+	for i, b := range nucBits {
+		if set&b != 0 {
+			cc.nucs[i] += w
+		}
+	}
+}
+
+// call returns the (encoded) consensus nucleotide for this column.
+//
+// threshold is the minimum proportion of counted sequences that the consensus base must make up. If no
+// single base reaches it, the base is N, unless iupac is true, in which case the smallest set of bases that
+// together reach the threshold is called as the corresponding IUPAC code.
+//
+// If countGaps is true, gaps compete with nucleotides and can be the consensus character, otherwise they
+// are treated as missing data. Columns where the proportion of sequences with nucleotide (or counted gap)
+// information is less than minNonN are called as N.
+func (cc columnCounts) call(n int, threshold float64, iupac bool, countGaps bool, minNonN float64) byte {
+
+	denom := cc.nucs[0] + cc.nucs[1] + cc.nucs[2] + cc.nucs[3]
+	if countGaps {
+		denom += cc.gaps
+	}
+
+	if denom == 0 || denom/float64(n) < minNonN {
+		return 240
+	}
+
+	if countGaps && cc.gaps/denom >= threshold && cc.gaps >= gmax(cc.nucs[:]) {
+		return 244
+	}
+
+	order := []int{0, 1, 2, 3}
+	sort.SliceStable(order, func(i, j int) bool { return cc.nucs[order[i]] > cc.nucs[order[j]] })
+
+	top := cc.nucs[order[0]]
+	if top/denom >= threshold && top > cc.nucs[order[1]] {
+		return nucBits[order[0]]<<4 | 8
+	}
+
+	if !iupac {
+		return 240
+	}
+
+	var set byte
+	cumulative := 0.0
+	for i, o := range order {
+		if cc.nucs[o] == 0 {
+			break
+		}
+		set |= nucBits[o]
+		cumulative += cc.nucs[o]
+		// take tied bases together, so the call doesn't depend on the order of A, G, C, T
+		if cumulative/denom >= threshold && (i == 3 || cc.nucs[order[i+1]] < cc.nucs[o]) {
+			break
+		}
+	}
+
+	if bits.OnesCount8(set) == 1 {
+		return set<<4 | 8
+	}
+
+	return set << 4
+}
+
+func gmax(s []float64) float64 {
+	m := s[0]
+	for _, x := range s[1:] {
+		if x > m {
+			m = x
+		}
+	}
+	return m
+}
+
+// Options controls the calling of a consensus. Name is the name of the consensus record ("" means consensus). A
+// base is called if it makes up at least Threshold of a column's counted bases (0 means a simple majority), and
+// otherwise, with IUPAC, the ambiguity code of the bases that together do, or else N. With CountGaps, gaps are
+// counted as a character that can be the consensus rather than as missing data. Columns where less than
+// MinFraction of the records aren't N are called N
+type Options struct {
+	Name        string
+	Threshold   float64
+	IUPAC       bool
+	CountGaps   bool
+	MinFraction float64
+}
+
+// Consensus writes a single consensus sequence for the alignment in msa to out, in fasta format
+func Consensus(ctx context.Context, msa io.Reader, out io.Writer, o Options) error {
+
+	name := o.Name
+	if name == "" {
+		name = "consensus"
+	}
+	threshold, iupac, countGaps, minNonN := o.Threshold, o.IUPAC, o.CountGaps, o.MinFraction
+
+	if threshold < 0.0 || threshold > 1.0 {
+		return ErrThreshold
+	}
+	if minNonN < 0.0 || minNonN > 1.0 {
+		return ErrMinFraction
+	}
+
+	var counts []columnCounts
+	n := 0
+
+	err := fastaio.EachEncodedRecord(ctx, msa, false, func(EFR fastaio.EncodedFastaRecord) error {
+		if n == 0 {
+			counts = make([]columnCounts, len(EFR.Seq))
+		}
+		for i, nuc := range EFR.Seq {
+			counts[i].add(nuc)
+		}
+		n++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	consensus := make([]byte, len(counts))
+	for i, cc := range counts {
+		consensus[i] = cc.call(n, threshold, iupac, countGaps, minNonN)
+	}
+
+	_, err = out.Write([]byte(">" + name + "\n" + encoding.DecodeToString(consensus) + "\n"))
+
+	return err
+}
+
+// Encoded returns the (EP-encoded) consensus of records, which must all be the same length, called as by Consensus
+func Encoded(records []fastaio.EncodedFastaRecord, o Options) []byte {
+	a, err := alignment.New(records)
+	if err != nil || a.Len() == 0 {
+		return nil
+	}
+	return OfAlignment(a, o)
+}
+
+// OfAlignment returns the (EP-encoded) consensus of a, called as by Consensus
+func OfAlignment(a *alignment.Alignment, o Options) []byte {
+	consensus := make([]byte, a.Width())
+	a.EachColumn(func(i int, col []byte) error {
+		var cc columnCounts
+		for _, nuc := range col {
+			cc.add(nuc)
+		}
+		consensus[i] = cc.call(a.Len(), o.Threshold, o.IUPAC, o.CountGaps, o.MinFraction)
+		return nil
+	})
+	return consensus
+}
@@ -0,0 +1,135 @@
+package consensus
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+)
+
+var msaData = []byte(`>seq1
+ATGATGAC-N
+>seq2
+ATGATCAC-N
+>seq3
+ATTATCGCAN
+>seq4
+ATTAKCGC-A
+`)
+
+func TestConsensus(t *testing.T) {
+	out := new(bytes.Buffer)
+	err := Consensus(context.Background(), bytes.NewReader(msaData), out, Options{})
+	if err != nil {
+		t.Error(err)
+	}
+	// positions 3 and 7 are 2-2 ties, position 5 is T=3.5 vs G=0.5 (K is split between G and T),
+	// and position 9 has only one informative sequence once gaps are ignored
+	if out.String() != ">consensus\nATNATCNCAA\n" {
+		t.Errorf("problem in TestConsensus(): %s", out.String())
+	}
+}
+
+func TestConsensusIUPAC(t *testing.T) {
+	out := new(bytes.Buffer)
+	err := Consensus(context.Background(), bytes.NewReader(msaData), out, Options{Name: "cons", IUPAC: true})
+	if err != nil {
+		t.Error(err)
+	}
+	if out.String() != ">cons\nATKATCRCAA\n" {
+		t.Errorf("problem in TestConsensusIUPAC(): %s", out.String())
+	}
+}
+
+func TestConsensusThresholdGaps(t *testing.T) {
+	out := new(bytes.Buffer)
+	err := Consensus(context.Background(), bytes.NewReader(msaData), out, Options{Threshold: 0.75, IUPAC: true, CountGaps: true, MinFraction: 0.5})
+	if err != nil {
+		t.Error(err)
+	}
+	// position 6: C makes up exactly 3/4
+	// position 9: gaps are counted, and make up 3/4
+	// position 10: only one sequence in four isn't N, which is under MinFraction
+	if out.String() != ">consensus\nATKATCRC-N\n" {
+		t.Errorf("problem in TestConsensusThresholdGaps(): %s", out.String())
+	}
+}
+
+func TestConsensusOptionErrors(t *testing.T) {
+	for _, tc := range []struct {
+		o    Options
+		want error
+	}{
+		{Options{Threshold: 1.5}, ErrThreshold},
+		{Options{MinFraction: -0.1}, ErrMinFraction},
+	} {
+		err := Consensus(context.Background(), bytes.NewReader(msaData), new(bytes.Buffer), tc.o)
+		if !errors.Is(err, tc.want) {
+			t.Errorf("problem in TestConsensusOptionErrors(): expected %v, got %v", tc.want, err)
+		}
+	}
+}
+
+func TestColumnCall(t *testing.T) {
+	var cc columnCounts
+	for _, nuc := range []byte{136, 136, 72, 240} {
+		cc.add(nuc)
+	}
+	if got := cc.call(4, 0.6, false, false, 0.0); got != 136 {
+		t.Errorf("expected A (136), got %d", got)
+	}
+	if got := cc.call(4, 0.7, false, false, 0.0); got != 240 {
+		t.Errorf("expected N (240), got %d", got)
+	}
+	if got := cc.call(4, 0.7, true, false, 0.0); got != 192 {
+		t.Errorf("expected R (192), got %d", got)
+	}
+	if got := cc.call(4, 0.0, false, false, 0.8); got != 240 {
+		t.Errorf("expected N (240) under MinFraction, got %d", got)
+	}
+}
+
+func TestGroups(t *testing.T) {
+	groups := map[string]string{"seq1": "A", "seq2": "A", "seq3": "B", "seq4": "B"}
+	groupOf := func(name string) (string, bool) {
+		g, ok := groups[name]
+		return g, ok
+	}
+
+	out := new(bytes.Buffer)
+	members := new(bytes.Buffer)
+	err := Groups(context.Background(), bytes.NewReader(msaData), out, members, groupOf, GroupOptions{IUPAC: true})
+	if err != nil {
+		t.Error(err)
+	}
+	if out.String() != ">A\nATGATSACNN\n>B\nATTATCGCAA\n" {
+		t.Errorf("problem in TestGroups(): %s", out.String())
+	}
+	if members.String() != "group,size,members\nA,2,seq1;seq2\nB,2,seq3;seq4\n" {
+		t.Errorf("problem in TestGroups(): %s", members.String())
+	}
+
+	delete(groups, "seq4")
+	out.Reset()
+	err = Groups(context.Background(), bytes.NewReader(msaData), out, nil, groupOf, GroupOptions{IUPAC: true, MinSize: 2})
+	if err != nil {
+		t.Error(err)
+	}
+	if out.String() != ">A\nATGATSACNN\n" {
+		t.Errorf("problem in TestGroups(): %s", out.String())
+	}
+}
+
+func TestDropMinor(t *testing.T) {
+	var cc columnCounts
+	for _, nuc := range []byte{136, 136, 136, 136, 136, 136, 136, 136, 136, 72} {
+		cc.add(nuc)
+	}
+	if got := cc.call(10, 1.0, true, false, 0.0); got != 192 {
+		t.Errorf("expected R (192), got %d", got)
+	}
+	cc.dropMinor(0.2, false)
+	if got := cc.call(10, 1.0, true, false, 0.0); got != 136 {
+		t.Errorf("expected A (136) once G is dropped, got %d", got)
+	}
+}
@@ -0,0 +1,130 @@
+package consensus
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/encoding"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/summary"
+)
+
+// GroupOptions controls the calling of per-group consensus sequences. Threshold, IUPAC, CountGaps and
+// MinFraction are as for Consensus. Alleles that make up less than MinAllele of a column's counted bases are
+// dropped before calling, so that a few sequencing errors don't make a column ambiguous. Groups with fewer than
+// MinSize members aren't written
+type GroupOptions struct {
+	Threshold   float64
+	IUPAC       bool
+	CountGaps   bool
+	MinFraction float64
+	MinAllele   float64
+	MinSize     int
+}
+
+// dropMinor sets the counts of the alleles (and gaps, if countGaps) that make up less than minAllele of the column
+// to zero
+func (cc *columnCounts) dropMinor(minAllele float64, countGaps bool) {
+	if minAllele <= 0 {
+		return
+	}
+	denom := cc.nucs[0] + cc.nucs[1] + cc.nucs[2] + cc.nucs[3]
+	if countGaps {
+		denom += cc.gaps
+	}
+	if denom == 0 {
+		return
+	}
+	for i := range cc.nucs {
+		if cc.nucs[i]/denom < minAllele {
+			cc.nucs[i] = 0
+		}
+	}
+	if countGaps && cc.gaps/denom < minAllele {
+		cc.gaps = 0
+	}
+}
+
+// group is the column counts of the members of one group
+type group struct {
+	name    string
+	counts  []columnCounts
+	members []string
+}
+
+// Groups writes one consensus sequence per group of records in the alignment msa to out, in fasta format, named
+// after the group and in the order that the groups are first seen. groupOf returns the group of a record, and false
+// if the record isn't in one, in which case it is skipped. If membersOut isn't nil, a CSV file with the columns
+// group,size,members is written to it, where members is a ";"-delimited list of the records in the group.
+func Groups(ctx context.Context, msa io.Reader, out, membersOut io.Writer, groupOf func(string) (string, bool), o GroupOptions) error {
+
+	if o.Threshold < 0.0 || o.Threshold > 1.0 {
+		return errors.New("--threshold must be between 0 and 1")
+	}
+	if o.MinFraction < 0.0 || o.MinFraction > 1.0 {
+		return errors.New("--min-fraction must be between 0 and 1")
+	}
+	if o.MinAllele < 0.0 || o.MinAllele > 1.0 {
+		return errors.New("--min-allele must be between 0 and 1")
+	}
+
+	groups := make([]*group, 0)
+	byName := make(map[string]*group)
+	skipped := 0
+
+	err := fastaio.EachEncodedRecord(ctx, msa, false, func(EFR fastaio.EncodedFastaRecord) error {
+		name, ok := groupOf(EFR.ID)
+		if !ok {
+			skipped++
+			return nil
+		}
+		g, ok := byName[name]
+		if !ok {
+			g = &group{name: name, counts: make([]columnCounts, len(EFR.Seq))}
+			byName[name] = g
+			groups = append(groups, g)
+		}
+		for i, nuc := range EFR.Seq {
+			g.counts[i].add(nuc)
+		}
+		g.members = append(g.members, EFR.ID)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if skipped > 0 {
+		summary.Warn(strconv.Itoa(skipped) + " records weren't in a group, and were skipped")
+	}
+
+	if membersOut != nil {
+		if _, err := membersOut.Write([]byte("group,size,members\n")); err != nil {
+			return err
+		}
+	}
+
+	for _, g := range groups {
+		if len(g.members) < o.MinSize {
+			continue
+		}
+		consensus := make([]byte, len(g.counts))
+		for i, cc := range g.counts {
+			cc.dropMinor(o.MinAllele, o.CountGaps)
+			consensus[i] = cc.call(len(g.members), o.Threshold, o.IUPAC, o.CountGaps, o.MinFraction)
+		}
+		if _, err := out.Write([]byte(">" + g.name + "\n" + encoding.DecodeToString(consensus) + "\n")); err != nil {
+			return err
+		}
+		if membersOut != nil {
+			if _, err := membersOut.Write([]byte(g.name + "," + strconv.Itoa(len(g.members)) + "," + strings.Join(g.members, ";") + "\n")); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+/*
+Package convert implements conversion between alignment file formats:
+fasta, PHYLIP, NEXUS, Clustal and Stockholm. Records are passed on as they
+are read where both formats allow it (e.g. from fasta or sequential PHYLIP
+to fasta); otherwise the alignment is held in memory.
+*/
+package convert
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+// Formats
+const (
+	Fasta     = "fasta"
+	Phylip    = "phylip"
+	Nexus     = "nexus"
+	Clustal   = "clustal"
+	Stockholm = "stockholm"
+)
+
+// Formats returns the names of the formats that can be converted between
+func Formats() []string {
+	return []string{Fasta, Phylip, Nexus, Clustal, Stockholm}
+}
+
+// Options are the format-specific settings. Strict applies to reading and writing PHYLIP, and Interleaved to
+// writing PHYLIP and NEXUS (they are read either way)
+type Options struct {
+	From        string
+	To          string
+	Strict      bool
+	Interleaved bool
+}
+
+// reader returns the function that reads format. Reading fasta stops if ctx is cancelled
+func reader(ctx context.Context, format string, o Options) (func(io.Reader, func(fastaio.FastaRecord) error) error, error) {
+	switch format {
+	case Fasta:
+		return func(in io.Reader, f func(fastaio.FastaRecord) error) error {
+			return readFasta(ctx, in, f)
+		}, nil
+	case Phylip:
+		return func(in io.Reader, f func(fastaio.FastaRecord) error) error {
+			return readPhylip(in, o.Strict, f)
+		}, nil
+	case Nexus:
+		return readNexus, nil
+	case Clustal:
+		return readClustal, nil
+	case Stockholm:
+		return readStockholm, nil
+	}
+	return nil, errors.New("unknown input format: " + format + " (choose one of fasta, phylip, nexus, clustal or stockholm)")
+}
+
+// newWriter returns a writer of format to w
+func newWriter(w io.Writer, format string, o Options) (writer, error) {
+	switch format {
+	case Fasta:
+		return &fastaWriter{w: w}, nil
+	case Phylip:
+		return &alignmentWriter{w: w, write: func(w io.Writer, records []fastaio.FastaRecord) error {
+			return writePhylip(w, records, o.Strict, o.Interleaved)
+		}}, nil
+	case Nexus:
+		return &alignmentWriter{w: w, write: func(w io.Writer, records []fastaio.FastaRecord) error {
+			return writeNexus(w, records, o.Interleaved)
+		}}, nil
+	case Clustal:
+		return &alignmentWriter{w: w, write: writeClustal}, nil
+	case Stockholm:
+		return &alignmentWriter{w: w, write: writeStockholm}, nil
+	}
+	return nil, errors.New("unknown output format: " + format + " (choose one of fasta, phylip, nexus, clustal or stockholm)")
+}
+
+// Convert reads the alignment in in, in the format o.From, and writes it to out in the format o.To. Only
+// a record's ID is kept
+func Convert(ctx context.Context, in io.Reader, out io.Writer, o Options) error {
+
+	read, err := reader(ctx, o.From, o)
+	if err != nil {
+		return err
+	}
+	w, err := newWriter(out, o.To, o)
+	if err != nil {
+		return err
+	}
+
+	if err := read(in, w.add); err != nil {
+		return err
+	}
+
+	return w.flush()
+}
+
+// Write writes records to out in the format o.To
+func Write(out io.Writer, records []fastaio.FastaRecord, o Options) error {
+	w, err := newWriter(out, o.To, o)
+	if err != nil {
+		return err
+	}
+	for _, FR := range records {
+		if err := w.add(FR); err != nil {
+			return err
+		}
+	}
+	return w.flush()
+}
+
+// Suffix returns the usual filename suffix for format, without the dot
+func Suffix(format string) string {
+	switch format {
+	case Phylip:
+		return "phy"
+	case Nexus:
+		return "nex"
+	case Clustal:
+		return "aln"
+	case Stockholm:
+		return "sto"
+	}
+	return "fasta"
+}
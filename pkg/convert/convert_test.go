@@ -0,0 +1,91 @@
+package convert
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+var testFasta = ">seq1\nACGTACGTAC-T\n>seq_two\nACGAACGTACNT\n>s3\nAC-TACGTACGT\n"
+
+func TestRoundTrip(t *testing.T) {
+	long := ">a\n" + strings.Repeat("ACGT", 40) + "\n>b\n" + strings.Repeat("ACGA", 40) + "\n"
+	for _, fasta := range []string{testFasta, long} {
+		for _, format := range Formats() {
+			for _, o := range []Options{{}, {Interleaved: true}, {Strict: true}, {Strict: true, Interleaved: true}} {
+				o.From, o.To = Fasta, format
+				out := new(bytes.Buffer)
+				if err := Convert(context.Background(), strings.NewReader(fasta), out, o); err != nil {
+					t.Fatalf("problem in TestRoundTrip(): %s: %s", format, err)
+				}
+				o.From, o.To = format, Fasta
+				back := new(bytes.Buffer)
+				if err := Convert(context.Background(), bytes.NewReader(out.Bytes()), back, o); err != nil {
+					t.Fatalf("problem in TestRoundTrip(): %s %+v: %s\n%s", format, o, err, out.String())
+				}
+				if back.String() != fasta {
+					t.Errorf("problem in TestRoundTrip(): %s %+v: got\n%s\nvia\n%s", format, o, back.String(), out.String())
+				}
+			}
+		}
+	}
+}
+
+func TestWritePhylip(t *testing.T) {
+	out := new(bytes.Buffer)
+	if err := Convert(context.Background(), strings.NewReader(testFasta), out, Options{From: Fasta, To: Phylip}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "3 12\nseq1    ACGTACGTAC-T\nseq_two ACGAACGTACNT\ns3      AC-TACGTACGT\n"; out.String() != want {
+		t.Errorf("problem in TestWritePhylip(): got\n%s", out.String())
+	}
+
+	dup := ">sequence_1a\nACGT\n>sequence_1b\nACGT\n"
+	if err := Convert(context.Background(), strings.NewReader(dup), out, Options{From: Fasta, To: Phylip, Strict: true}); err == nil {
+		t.Errorf("problem in TestWritePhylip(): expected an error for names that aren't unique in ten characters")
+	}
+
+	unaligned := ">a\nACGT\n>b\nACG\n"
+	if err := Convert(context.Background(), strings.NewReader(unaligned), out, Options{From: Fasta, To: Phylip}); err == nil {
+		t.Errorf("problem in TestWritePhylip(): expected an error for an unaligned input")
+	}
+}
+
+func TestReadNexus(t *testing.T) {
+	nexus := `#NEXUS
+[a comment]
+begin taxa;
+	dimensions ntax=2;
+end;
+begin data;
+	dimensions ntax=2 nchar=8;
+	format datatype=dna interleave=yes;
+	matrix
+	'my seq' acgt
+	other    ACGA [first block]
+
+	'my seq' ACGT
+	other    AC-A
+	;
+end;
+`
+	out := new(bytes.Buffer)
+	if err := Convert(context.Background(), strings.NewReader(nexus), out, Options{From: Nexus, To: Fasta}); err != nil {
+		t.Fatal(err)
+	}
+	if want := ">my seq\nACGTACGT\n>other\nACGAAC-A\n"; out.String() != want {
+		t.Errorf("problem in TestReadNexus(): got\n%s", out.String())
+	}
+}
+
+func TestReadStockholm(t *testing.T) {
+	sto := "# STOCKHOLM 1.0\n#=GF ID test\na  AC.GT\nb  ACAGT\n#=GC SS_cons .....\n//\n"
+	out := new(bytes.Buffer)
+	if err := Convert(context.Background(), strings.NewReader(sto), out, Options{From: Stockholm, To: Fasta}); err != nil {
+		t.Fatal(err)
+	}
+	if want := ">a\nAC-GT\n>b\nACAGT\n"; out.String() != want {
+		t.Errorf("problem in TestReadStockholm(): got\n%s", out.String())
+	}
+}
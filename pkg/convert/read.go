@@ -0,0 +1,311 @@
+package convert
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/summary"
+)
+
+// builder accumulates sequences by name, for the formats whose records are split over several blocks
+type builder struct {
+	names []string
+	seqs  map[string]*strings.Builder
+}
+
+func newBuilder() *builder {
+	return &builder{names: make([]string, 0), seqs: make(map[string]*strings.Builder)}
+}
+
+func (b *builder) add(name, seq string) {
+	sb, ok := b.seqs[name]
+	if !ok {
+		sb = new(strings.Builder)
+		b.seqs[name] = sb
+		b.names = append(b.names, name)
+	}
+	sb.WriteString(seq)
+}
+
+// emit passes every record to f, in the order that they were first seen
+func (b *builder) emit(f func(fastaio.FastaRecord) error) error {
+	if len(b.names) == 0 {
+		return errors.New("no records found")
+	}
+	for i, name := range b.names {
+		if err := f(fastaio.FastaRecord{ID: name, Description: name, Seq: strings.ToUpper(b.seqs[name].String()), Idx: i}); err != nil {
+			return err
+		}
+		summary.Processed(1)
+	}
+	return nil
+}
+
+// newScanner returns a line scanner that can cope with long lines
+func newScanner(r io.Reader) *bufio.Scanner {
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 0), 64*1024*1024)
+	return s
+}
+
+// readFasta passes every record in a fasta file to f, in order
+func readFasta(ctx context.Context, in io.Reader, f func(fastaio.FastaRecord) error) error {
+	return fastaio.EachRecord(ctx, in, f)
+}
+
+// readPhylip reads a PHYLIP file, sequential (with each sequence on one line) or interleaved. Which is
+// detected from the length of the first line: if it holds the whole of the first sequence the file is
+// sequential. With strict, names are the first ten characters of a line, otherwise they are the first word
+func readPhylip(in io.Reader, strict bool, f func(fastaio.FastaRecord) error) error {
+	s := newScanner(in)
+
+	ntax, nchar := -1, -1
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) < 2 {
+			return errors.New("couldn't parse PHYLIP header: " + s.Text())
+		}
+		var err1, err2 error
+		ntax, err1 = strconv.Atoi(fields[0])
+		nchar, err2 = strconv.Atoi(fields[1])
+		if err1 != nil || err2 != nil || ntax < 1 || nchar < 0 {
+			return errors.New("couldn't parse PHYLIP header: " + s.Text())
+		}
+		break
+	}
+	if ntax == -1 {
+		if err := s.Err(); err != nil {
+			return err
+		}
+		return errors.New("empty PHYLIP file")
+	}
+
+	split := func(line string) (string, string) {
+		if strict {
+			if len(line) <= 10 {
+				return strings.TrimSpace(line), ""
+			}
+			return strings.TrimSpace(line[:10]), strings.Join(strings.Fields(line[10:]), "")
+		}
+		fields := strings.Fields(line)
+		return fields[0], strings.Join(fields[1:], "")
+	}
+
+	names := make([]string, 0, ntax)
+	seqs := make([]strings.Builder, ntax)
+	interleaved := false
+	next := 0 // the taxon that the next line of sequence belongs to, after the first block
+
+	for s.Scan() {
+		line := s.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if len(names) < ntax {
+			name, seq := split(line)
+			if name == "" {
+				return errors.New("couldn't parse PHYLIP line: " + line)
+			}
+			if len(names) == 0 && len(seq) < nchar {
+				interleaved = true
+			}
+			names = append(names, name)
+			seqs[len(names)-1].WriteString(seq)
+			continue
+		}
+		if !interleaved {
+			return errors.New("more than " + strconv.Itoa(ntax) + " sequences in PHYLIP file")
+		}
+		seqs[next].WriteString(strings.Join(strings.Fields(line), ""))
+		next = (next + 1) % ntax
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+
+	if len(names) < ntax {
+		return errors.New("fewer than " + strconv.Itoa(ntax) + " sequences in PHYLIP file")
+	}
+	for i, name := range names {
+		seq := strings.ToUpper(seqs[i].String())
+		if len(seq) != nchar {
+			return errors.New(name + " is " + strconv.Itoa(len(seq)) + " characters long in PHYLIP file, not " + strconv.Itoa(nchar))
+		}
+		if err := f(fastaio.FastaRecord{ID: name, Description: name, Seq: seq, Idx: i}); err != nil {
+			return err
+		}
+		summary.Processed(1)
+	}
+
+	return nil
+}
+
+// stripComments removes NEXUS comments, which are in square brackets. depth is the nesting depth at the
+// start of the line, and the depth at the end is returned
+func stripComments(line string, depth int) (string, int) {
+	var sb strings.Builder
+	for i := 0; i < len(line); i++ {
+		switch {
+		case line[i] == '[':
+			depth++
+		case line[i] == ']' && depth > 0:
+			depth--
+		case depth == 0:
+			sb.WriteByte(line[i])
+		}
+	}
+	return sb.String(), depth
+}
+
+// nexusName splits a line of a NEXUS matrix into the name, which may be in single quotes, and the rest
+func nexusName(line string) (string, string) {
+	line = strings.TrimSpace(line)
+	if strings.HasPrefix(line, "'") {
+		var sb strings.Builder
+		for i := 1; i < len(line); i++ {
+			if line[i] == '\'' {
+				if i+1 < len(line) && line[i+1] == '\'' {
+					sb.WriteByte('\'')
+					i++
+					continue
+				}
+				return sb.String(), line[i+1:]
+			}
+			sb.WriteByte(line[i])
+		}
+		return sb.String(), ""
+	}
+	fields := strings.Fields(line)
+	return fields[0], strings.Join(fields[1:], "")
+}
+
+// readNexus reads the matrix of the first DATA or CHARACTERS block of a NEXUS file, sequential or interleaved
+func readNexus(in io.Reader, f func(fastaio.FastaRecord) error) error {
+	s := newScanner(in)
+	b := newBuilder()
+
+	inBlock, inMatrix := false, false
+	depth := 0
+	for s.Scan() {
+		var line string
+		line, depth = stripComments(s.Text(), depth)
+		trimmed := strings.TrimSpace(line)
+		lower := strings.ToLower(trimmed)
+
+		switch {
+		case !inBlock:
+			if strings.HasPrefix(lower, "begin data") || strings.HasPrefix(lower, "begin characters") {
+				inBlock = true
+			}
+		case !inMatrix:
+			if strings.HasPrefix(lower, "end") {
+				inBlock = false
+			} else if strings.HasPrefix(lower, "matrix") {
+				inMatrix = true
+			}
+		default:
+			end := strings.Contains(trimmed, ";")
+			if end {
+				trimmed = strings.TrimSpace(trimmed[:strings.Index(trimmed, ";")])
+			}
+			if trimmed != "" {
+				name, seq := nexusName(trimmed)
+				b.add(name, strings.Join(strings.Fields(seq), ""))
+			}
+			if end {
+				if err := s.Err(); err != nil {
+					return err
+				}
+				return b.emit(f)
+			}
+		}
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+	if inMatrix {
+		return errors.New("unterminated matrix in NEXUS file")
+	}
+	return errors.New("no DATA or CHARACTERS block with a matrix in NEXUS file")
+}
+
+// readClustal reads a Clustal file
+func readClustal(in io.Reader, f func(fastaio.FastaRecord) error) error {
+	s := newScanner(in)
+	b := newBuilder()
+
+	first := true
+	for s.Scan() {
+		line := s.Text()
+		if first {
+			if strings.TrimSpace(line) == "" {
+				continue
+			}
+			if !strings.HasPrefix(line, "CLUSTAL") && !strings.HasPrefix(line, "MUSCLE") {
+				return errors.New("badly formatted Clustal file: the first line should start CLUSTAL")
+			}
+			first = false
+			continue
+		}
+		// blank lines separate blocks, and lines starting with a space have the conservation symbols
+		if strings.TrimSpace(line) == "" || line[0] == ' ' || line[0] == '\t' {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			return errors.New("couldn't parse Clustal line: " + line)
+		}
+		b.add(fields[0], fields[1])
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+
+	return b.emit(f)
+}
+
+// readStockholm reads the first alignment in a Stockholm file. Markup lines are ignored, and '.' gaps
+// are written as '-'
+func readStockholm(in io.Reader, f func(fastaio.FastaRecord) error) error {
+	s := newScanner(in)
+	b := newBuilder()
+
+	first := true
+	for s.Scan() {
+		line := s.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if first {
+			if !strings.HasPrefix(line, "# STOCKHOLM") {
+				return errors.New("badly formatted Stockholm file: the first line should be # STOCKHOLM 1.0")
+			}
+			first = false
+			continue
+		}
+		if strings.HasPrefix(line, "//") {
+			break
+		}
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return errors.New("couldn't parse Stockholm line: " + line)
+		}
+		b.add(fields[0], strings.ReplaceAll(fields[1], ".", "-"))
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+
+	return b.emit(f)
+}
@@ -0,0 +1,241 @@
+package convert
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+// blockWidth is the number of alignment columns per block in interleaved output
+const blockWidth = 60
+
+// writer is an output format. Formats that need to know about every record before writing the first one
+// (e.g. for a header with their number) keep them until flush
+type writer interface {
+	add(FR fastaio.FastaRecord) error
+	flush() error
+}
+
+type fastaWriter struct {
+	w io.Writer
+}
+
+func (fw *fastaWriter) add(FR fastaio.FastaRecord) error {
+	_, err := fw.w.Write([]byte(">" + FR.ID + "\n" + FR.Seq + "\n"))
+	return err
+}
+
+func (fw *fastaWriter) flush() error {
+	return nil
+}
+
+// alignmentWriter keeps the records for the formats that are written in one go, checking that they are aligned
+type alignmentWriter struct {
+	w       io.Writer
+	records []fastaio.FastaRecord
+	write   func(w io.Writer, records []fastaio.FastaRecord) error
+}
+
+func (aw *alignmentWriter) add(FR fastaio.FastaRecord) error {
+	if len(aw.records) > 0 && len(FR.Seq) != len(aw.records[0].Seq) {
+		return errors.New(FR.ID + " is not the same length as " + aw.records[0].ID + ": the output format needs an alignment")
+	}
+	aw.records = append(aw.records, FR)
+	return nil
+}
+
+func (aw *alignmentWriter) flush() error {
+	if len(aw.records) == 0 {
+		return errors.New("no records to write")
+	}
+	return aw.write(aw.w, aw.records)
+}
+
+// pad returns s followed by enough spaces to make it width characters long
+func pad(s string, width int) string {
+	if len(s) >= width {
+		return s
+	}
+	return s + strings.Repeat(" ", width-len(s))
+}
+
+// longest returns the length of the longest ID in records
+func longest(records []fastaio.FastaRecord) int {
+	n := 0
+	for _, FR := range records {
+		if len(FR.ID) > n {
+			n = len(FR.ID)
+		}
+	}
+	return n
+}
+
+// writePhylip writes records in PHYLIP format. With strict, names are truncated or padded to ten characters,
+// and must still be unique; otherwise they are followed by one or more spaces, so mustn't contain any
+func writePhylip(w io.Writer, records []fastaio.FastaRecord, strict, interleaved bool) error {
+	nchar := len(records[0].Seq)
+
+	names := make([]string, len(records))
+	seen := make(map[string]bool)
+	width := longest(records) + 1
+	for i, FR := range records {
+		name := FR.ID
+		if strict {
+			if len(name) > 10 {
+				name = name[:10]
+			}
+			if seen[name] {
+				return errors.New("names are not unique when truncated to ten characters for strict PHYLIP: " + name)
+			}
+			seen[name] = true
+			name = pad(name, 10)
+		} else {
+			if strings.ContainsAny(name, " \t") {
+				return errors.New("names can't contain spaces in relaxed PHYLIP: " + name)
+			}
+			name = pad(name, width)
+		}
+		names[i] = name
+	}
+
+	var sb strings.Builder
+	sb.WriteString(strconv.Itoa(len(records)) + " " + strconv.Itoa(nchar) + "\n")
+
+	if !interleaved {
+		for i, FR := range records {
+			sb.WriteString(names[i] + FR.Seq + "\n")
+		}
+		_, err := w.Write([]byte(sb.String()))
+		return err
+	}
+
+	for start := 0; start < nchar || start == 0; start += blockWidth {
+		end := start + blockWidth
+		if end > nchar {
+			end = nchar
+		}
+		if start > 0 {
+			sb.WriteString("\n")
+		}
+		for i, FR := range records {
+			if start == 0 {
+				sb.WriteString(names[i])
+			}
+			sb.WriteString(FR.Seq[start:end] + "\n")
+		}
+	}
+	_, err := w.Write([]byte(sb.String()))
+	return err
+}
+
+// nexusQuote quotes name for NEXUS if it has spaces or punctuation
+func nexusQuote(name string) string {
+	if strings.ContainsAny(name, " \t()[]{}/\\,;:=*'\"`+-<>") {
+		return "'" + strings.ReplaceAll(name, "'", "''") + "'"
+	}
+	return name
+}
+
+// writeNexus writes records as a NEXUS DATA block
+func writeNexus(w io.Writer, records []fastaio.FastaRecord, interleaved bool) error {
+	nchar := len(records[0].Seq)
+
+	names := make([]string, len(records))
+	width := 0
+	for i, FR := range records {
+		names[i] = nexusQuote(FR.ID)
+		if len(names[i]) > width {
+			width = len(names[i])
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString("#NEXUS\nbegin data;\n")
+	sb.WriteString("\tdimensions ntax=" + strconv.Itoa(len(records)) + " nchar=" + strconv.Itoa(nchar) + ";\n")
+	sb.WriteString("\tformat datatype=dna missing=? gap=-")
+	if interleaved {
+		sb.WriteString(" interleave=yes")
+	}
+	sb.WriteString(";\n\tmatrix\n")
+
+	step := nchar
+	if interleaved {
+		step = blockWidth
+	}
+	for start := 0; start < nchar || start == 0; start += step {
+		end := start + step
+		if end > nchar {
+			end = nchar
+		}
+		if start > 0 {
+			sb.WriteString("\n")
+		}
+		for i, FR := range records {
+			sb.WriteString("\t" + pad(names[i], width+1) + FR.Seq[start:end] + "\n")
+		}
+		if step == 0 {
+			break
+		}
+	}
+	sb.WriteString("\t;\nend;\n")
+
+	_, err := w.Write([]byte(sb.String()))
+	return err
+}
+
+// writeClustal writes records in Clustal format, with a line of conservation symbols under each block: "*"
+// where every record has the same nucleotide
+func writeClustal(w io.Writer, records []fastaio.FastaRecord) error {
+	nchar := len(records[0].Seq)
+	width := longest(records) + 6
+
+	var sb strings.Builder
+	sb.WriteString("CLUSTAL W multiple sequence alignment\n\n")
+
+	for start := 0; start < nchar; start += blockWidth {
+		end := start + blockWidth
+		if end > nchar {
+			end = nchar
+		}
+		sb.WriteString("\n")
+		for _, FR := range records {
+			sb.WriteString(pad(FR.ID, width) + FR.Seq[start:end] + "\n")
+		}
+		conserved := make([]byte, end-start)
+		for i := start; i < end; i++ {
+			c := records[0].Seq[i]
+			conserved[i-start] = ' '
+			if c == 'A' || c == 'C' || c == 'G' || c == 'T' || c == 'U' {
+				conserved[i-start] = '*'
+				for _, FR := range records[1:] {
+					if FR.Seq[i] != c {
+						conserved[i-start] = ' '
+						break
+					}
+				}
+			}
+		}
+		sb.WriteString(strings.Repeat(" ", width) + string(conserved) + "\n")
+	}
+
+	_, err := w.Write([]byte(sb.String()))
+	return err
+}
+
+// writeStockholm writes records in Stockholm format, with each sequence on one line
+func writeStockholm(w io.Writer, records []fastaio.FastaRecord) error {
+	width := longest(records) + 1
+
+	var sb strings.Builder
+	sb.WriteString("# STOCKHOLM 1.0\n")
+	for _, FR := range records {
+		sb.WriteString(pad(FR.ID, width) + FR.Seq + "\n")
+	}
+	sb.WriteString("//\n")
+
+	_, err := w.Write([]byte(sb.String()))
+	return err
+}
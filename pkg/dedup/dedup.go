@@ -0,0 +1,182 @@
+/*
+Package dedup implements routines to remove duplicate sequences from a
+fasta format file, keeping the first occurrence of each as its representative.
+*/
+package dedup
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/bloom"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+// group is a representative sequence and the names of the records that are duplicates of it
+type group struct {
+	representative string
+	duplicates     []string
+}
+
+// Key returns the string that two sequences must share to be considered duplicates. Sequences are
+// compared case-insensitively. If ignoreEnds is true, leading and trailing Ns and gaps are removed first;
+// if degap is true, all gaps are removed first
+func Key(seq string, ignoreEnds bool, degap bool) string {
+	seq = strings.ToUpper(seq)
+	if degap {
+		seq = strings.ReplaceAll(seq, "-", "")
+	}
+	if ignoreEnds {
+		seq = strings.Trim(seq, "N-?")
+	}
+	return seq
+}
+
+// writeRecord writes a record with its whole header line, as fastaio.WriteFasta does
+func writeRecord(w io.Writer, FR fastaio.FastaRecord) error {
+	header := FR.Description
+	if header == "" {
+		header = FR.ID
+	}
+	_, err := w.Write([]byte(">" + header + "\n" + FR.Seq + "\n"))
+	return err
+}
+
+// writeMap writes the representative->duplicates table
+func writeMap(w io.Writer, groups []group) error {
+	_, err := w.Write([]byte("representative,count,duplicates\n"))
+	if err != nil {
+		return err
+	}
+	for _, g := range groups {
+		_, err = w.Write([]byte(g.representative + "," + strconv.Itoa(len(g.duplicates)+1) + "," + strings.Join(g.duplicates, ";") + "\n"))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Dedup writes the first record of each distinct sequence in the fasta file in to out. If mapOut is not nil,
+// a CSV file with the columns representative, count and duplicates is written to it, where duplicates is a
+// ";"-delimited list of the records that were removed in favour of the representative
+func Dedup(ctx context.Context, in io.Reader, out io.Writer, mapOut io.Writer, ignoreEnds bool, degap bool) error {
+
+	seen := make(map[[32]byte]int)
+	groups := make([]group, 0)
+
+	err := fastaio.EachRecord(ctx, in, func(FR fastaio.FastaRecord) error {
+		h := sha256.Sum256([]byte(Key(FR.Seq, ignoreEnds, degap)))
+		if i, ok := seen[h]; ok {
+			groups[i].duplicates = append(groups[i].duplicates, FR.ID)
+			return nil
+		}
+		seen[h] = len(groups)
+		groups = append(groups, group{representative: FR.ID, duplicates: make([]string, 0)})
+		return writeRecord(out, FR)
+	})
+	if err != nil {
+		return err
+	}
+
+	if mapOut != nil {
+		return writeMap(mapOut, groups)
+	}
+
+	return nil
+}
+
+// hashes returns the two halves of the start of a digest, as the hashes of it for a bloom.Filter
+func hashes(h [32]byte) (uint64, uint64) {
+	return binary.LittleEndian.Uint64(h[0:8]), binary.LittleEndian.Uint64(h[8:16])
+}
+
+// readPass opens the input and passes every record in it, as read by read, to f
+func readPass(ctx context.Context, open func() (io.ReadCloser, error), read fastaio.Reader[fastaio.FastaRecord], f func(fastaio.FastaRecord) error) error {
+	in, err := open()
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	return fastaio.Each(ctx, in, read, f)
+}
+
+// Candidates is the first pass of a prescreen. It puts the digest of every record in the input, which is opened
+// with open, into a Bloom filter, using a couple of bytes per record, and returns the digests that the filter
+// says it has probably seen before: those of every record that has a duplicate, and of a few that don't. The
+// records aren't counted in the summary, so that the pass that writes them out can count them
+func Candidates(ctx context.Context, open func() (io.ReadCloser, error), digest func(fastaio.FastaRecord) ([32]byte, error)) (map[[32]byte]bool, error) {
+	candidates := make(map[[32]byte]bool)
+	filter := bloom.New(1<<20, 0.001)
+	err := readPass(ctx, open, fastaio.ReadFastaUncounted, func(FR fastaio.FastaRecord) error {
+		h, err := digest(FR)
+		if err != nil {
+			return err
+		}
+		if filter.TestAndAdd(hashes(h)) {
+			candidates[h] = true
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return candidates, nil
+}
+
+// Prescreen is Dedup for files with so many records that keeping the hash and name of every distinct sequence in
+// memory, as Dedup does, is too much. The input is read twice, so it is given as a function that opens it. The
+// first pass finds the sequences that are possibly duplicated, as Candidates does. The second pass writes each
+// record whose sequence isn't one of those straight away, and only keeps track of the others. The records
+// written to out are the same as Dedup's, in the same order, but in mapOut the representatives of the possibly
+// duplicated sequences come after the others, once all of their duplicates are known
+func Prescreen(ctx context.Context, open func() (io.ReadCloser, error), out io.Writer, mapOut io.Writer, ignoreEnds bool, degap bool) error {
+
+	candidates, err := Candidates(ctx, open, func(FR fastaio.FastaRecord) ([32]byte, error) {
+		return sha256.Sum256([]byte(Key(FR.Seq, ignoreEnds, degap))), nil
+	})
+	if err != nil {
+		return err
+	}
+	if mapOut != nil {
+		if _, err := mapOut.Write([]byte("representative,count,duplicates\n")); err != nil {
+			return err
+		}
+	}
+
+	seen := make(map[[32]byte]int)
+	groups := make([]group, 0)
+	err = readPass(ctx, open, fastaio.ReadFasta, func(FR fastaio.FastaRecord) error {
+		h := sha256.Sum256([]byte(Key(FR.Seq, ignoreEnds, degap)))
+		if candidates[h] {
+			if i, ok := seen[h]; ok {
+				groups[i].duplicates = append(groups[i].duplicates, FR.ID)
+				return nil
+			}
+			seen[h] = len(groups)
+			groups = append(groups, group{representative: FR.ID, duplicates: make([]string, 0)})
+		} else if mapOut != nil {
+			if _, err := mapOut.Write([]byte(FR.ID + ",1,\n")); err != nil {
+				return err
+			}
+		}
+		return writeRecord(out, FR)
+	})
+	if err != nil {
+		return err
+	}
+
+	if mapOut == nil {
+		return nil
+	}
+	for _, g := range groups {
+		if _, err := mapOut.Write([]byte(g.representative + "," + strconv.Itoa(len(g.duplicates)+1) + "," + strings.Join(g.duplicates, ";") + "\n")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
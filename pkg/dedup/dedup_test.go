@@ -0,0 +1,122 @@
+package dedup
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/summary"
+)
+
+var fastaData = []byte(`>seq1
+ATGATG
+>seq2
+atgatg
+>seq3
+NATGATG-
+>seq4
+AT-GATG
+>seq5
+ATGATC
+`)
+
+func TestDedup(t *testing.T) {
+	out := new(bytes.Buffer)
+	mapOut := new(bytes.Buffer)
+	err := Dedup(context.Background(), bytes.NewReader(fastaData), out, mapOut, false, false)
+	if err != nil {
+		t.Error(err)
+	}
+	if out.String() != `>seq1
+ATGATG
+>seq3
+NATGATG-
+>seq4
+AT-GATG
+>seq5
+ATGATC
+` {
+		t.Errorf("problem in TestDedup(): %s", out.String())
+	}
+	if mapOut.String() != `representative,count,duplicates
+seq1,2,seq2
+seq3,1,
+seq4,1,
+seq5,1,
+` {
+		t.Errorf("problem in TestDedup() map: %s", mapOut.String())
+	}
+}
+
+func TestDedupIgnoreEndsDegap(t *testing.T) {
+	out := new(bytes.Buffer)
+	mapOut := new(bytes.Buffer)
+	err := Dedup(context.Background(), bytes.NewReader(fastaData), out, mapOut, true, true)
+	if err != nil {
+		t.Error(err)
+	}
+	if out.String() != `>seq1
+ATGATG
+>seq5
+ATGATC
+` {
+		t.Errorf("problem in TestDedupIgnoreEndsDegap(): %s", out.String())
+	}
+	if mapOut.String() != `representative,count,duplicates
+seq1,4,seq2;seq3;seq4
+seq5,1,
+` {
+		t.Errorf("problem in TestDedupIgnoreEndsDegap() map: %s", mapOut.String())
+	}
+}
+
+func TestKey(t *testing.T) {
+	if Key("nnAT-Gn-", true, false) != "AT-G" {
+		t.Errorf("problem in TestKey()")
+	}
+	if Key("nnAT-Gn-", false, true) != "NNATGN" {
+		t.Errorf("problem in TestKey()")
+	}
+}
+
+func TestPrescreen(t *testing.T) {
+	opens := 0
+	open := func() (io.ReadCloser, error) {
+		opens++
+		return io.NopCloser(bytes.NewReader(fastaData)), nil
+	}
+
+	for _, ignore := range []bool{false, true} {
+		want := new(bytes.Buffer)
+		summary.Reset()
+		if err := Dedup(context.Background(), bytes.NewReader(fastaData), want, nil, ignore, ignore); err != nil {
+			t.Fatal(err)
+		}
+		wantProcessed := summary.Collect().RecordsProcessed
+		out := new(bytes.Buffer)
+		mapOut := new(bytes.Buffer)
+		summary.Reset()
+		if err := Prescreen(context.Background(), open, out, mapOut, ignore, ignore); err != nil {
+			t.Fatal(err)
+		}
+		// the input is read twice, but each record is only counted once
+		if got := summary.Collect().RecordsProcessed; got != wantProcessed {
+			t.Errorf("problem in TestPrescreen(): %d records processed, expected %d", got, wantProcessed)
+		}
+		if out.String() != want.String() {
+			t.Errorf("problem in TestPrescreen(): got\n%s\nexpected\n%s", out.String(), want.String())
+		}
+		if !ignore && mapOut.String() != `representative,count,duplicates
+seq3,1,
+seq4,1,
+seq5,1,
+seq1,2,seq2
+` {
+			t.Errorf("problem in TestPrescreen() map: %s", mapOut.String())
+		}
+	}
+	if opens != 4 {
+		t.Errorf("problem in TestPrescreen(): the input was opened %d times", opens)
+	}
+}
@@ -0,0 +1,113 @@
+/*
+Package degap implements routines to remove gaps from fasta format files,
+either from every record individually or as whole columns of an alignment.
+*/
+package degap
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+// Unalign removes every gap from every record in the fasta file in, and writes the result to out
+func Unalign(ctx context.Context, in io.Reader, out io.Writer) error {
+	return fastaio.EachRecord(ctx, in, func(FR fastaio.FastaRecord) error {
+		_, err := out.Write([]byte(">" + FR.Description + "\n" + strings.ReplaceAll(FR.Seq, "-", "") + "\n"))
+		return err
+	})
+}
+
+// writeColumns writes one record with only the columns in keep
+func writeColumns(out io.Writer, FR fastaio.FastaRecord, keep []bool) error {
+	seq := make([]byte, 0, len(FR.Seq))
+	for i := 0; i < len(FR.Seq); i++ {
+		if keep[i] {
+			seq = append(seq, FR.Seq[i])
+		}
+	}
+	_, err := out.Write([]byte(">" + FR.Description + "\n" + string(seq) + "\n"))
+	return err
+}
+
+// DropColumns removes every column from the alignment in msa in which the proportion of records with a gap
+// is greater than or equal to threshold (so a threshold of 1 removes only gap-only columns), and writes the
+// result to out. If dropped isn't nil, a CSV file of the (1-based) positions that were removed and their
+// proportions of gaps is written to it.
+//
+// This needs two passes over the alignment. If msa is an io.Seeker it is read twice, otherwise it is held in memory
+func DropColumns(ctx context.Context, msa io.Reader, out io.Writer, dropped io.Writer, threshold float64) error {
+
+	if threshold <= 0.0 || threshold > 1.0 {
+		return errors.New("gap threshold must be > 0 and <= 1")
+	}
+
+	seeker, canSeek := msa.(io.Seeker)
+	if canSeek {
+		// stdin is an *os.File, but it can't be rewound
+		if _, err := seeker.Seek(0, io.SeekCurrent); err != nil {
+			canSeek = false
+		}
+	}
+
+	var gaps []int
+	n := 0
+	held := make([]fastaio.FastaRecord, 0)
+
+	err := fastaio.EachAlignedRecord(ctx, msa, func(FR fastaio.FastaRecord) error {
+		if n == 0 {
+			gaps = make([]int, len(FR.Seq))
+		}
+		for i := 0; i < len(FR.Seq); i++ {
+			if FR.Seq[i] == '-' {
+				gaps[i]++
+			}
+		}
+		n++
+		if !canSeek {
+			held = append(held, FR)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	keep := make([]bool, len(gaps))
+	if dropped != nil {
+		if _, err = dropped.Write([]byte("position,gap_proportion\n")); err != nil {
+			return err
+		}
+	}
+	for i, g := range gaps {
+		prop := float64(g) / float64(n)
+		keep[i] = prop < threshold
+		if !keep[i] && dropped != nil {
+			_, err = dropped.Write([]byte(strconv.Itoa(i+1) + "," + strconv.FormatFloat(prop, 'f', 9, 64) + "\n"))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if !canSeek {
+		for _, FR := range held {
+			if err = writeColumns(out, FR, keep); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if _, err = seeker.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	return fastaio.EachAlignedRecord(ctx, msa, func(FR fastaio.FastaRecord) error {
+		return writeColumns(out, FR, keep)
+	})
+}
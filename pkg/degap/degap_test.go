@@ -0,0 +1,53 @@
+package degap
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+)
+
+var msaData = []byte(`>seq1 desc
+AT-G-TG-
+>seq2
+AT-GA-G-
+>seq3
+ATTG--G-
+`)
+
+func TestUnalign(t *testing.T) {
+	out := new(bytes.Buffer)
+	err := Unalign(context.Background(), bytes.NewReader(msaData), out)
+	if err != nil {
+		t.Error(err)
+	}
+	if out.String() != ">seq1 desc\nATGTG\n>seq2\nATGAG\n>seq3\nATTGG\n" {
+		t.Errorf("problem in TestUnalign(): %s", out.String())
+	}
+}
+
+func TestDropColumns(t *testing.T) {
+	// a bytes.Reader can be read twice
+	out := new(bytes.Buffer)
+	dropped := new(bytes.Buffer)
+	err := DropColumns(context.Background(), bytes.NewReader(msaData), out, dropped, 1.0)
+	if err != nil {
+		t.Error(err)
+	}
+	if out.String() != ">seq1 desc\nAT-G-TG\n>seq2\nAT-GA-G\n>seq3\nATTG--G\n" {
+		t.Errorf("problem in TestDropColumns(): %s", out.String())
+	}
+	if dropped.String() != "position,gap_proportion\n8,1.000000000\n" {
+		t.Errorf("problem in TestDropColumns() dropped: %s", dropped.String())
+	}
+
+	// a reader that can't seek has to be held in memory
+	out = new(bytes.Buffer)
+	err = DropColumns(context.Background(), io.MultiReader(bytes.NewReader(msaData)), out, nil, 0.6)
+	if err != nil {
+		t.Error(err)
+	}
+	if out.String() != ">seq1 desc\nATGG\n>seq2\nATGG\n>seq3\nATGG\n" {
+		t.Errorf("problem in TestDropColumns() with a threshold: %s", out.String())
+	}
+}
@@ -0,0 +1,179 @@
+/*
+Package disambiguate implements routines to replace IUPAC ambiguity codes in
+sequences with one of the unambiguous nucleotides that they represent.
+*/
+package disambiguate
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/encoding"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+// The ways that an ambiguity code can be resolved
+const (
+	Reference = "reference" // the reference's nucleotide, if it is compatible
+	Frequency = "frequency" // the most common compatible nucleotide in the alignment column
+	Random    = "random"    // a compatible nucleotide chosen uniformly at random
+)
+
+// Options controls how ambiguity codes are resolved
+type Options struct {
+	Methods   []string // tried in order until one resolves the code. Codes that none resolves are left as they are
+	Reference string   // with Reference, the reference sequence, in the alignment's coordinates
+	IncludeN  bool     // also resolve N (which is otherwise left as it is)
+	Seed      int64    // with Random, the seed for the random number generator
+}
+
+// bases are the unambiguous nucleotides in the order of the bits of the high nibble of the EP encoding
+var bases = [4]byte{'T', 'C', 'G', 'A'}
+
+var ep = encoding.MakeEncodingArray()
+
+// set returns the bits (A=8, G=4, C=2, T=1) of the nucleotides that c represents, or 0 for anything that isn't
+// a nucleotide (including gaps)
+func set(c byte) byte {
+	if c == '-' || c == '?' {
+		return 0
+	}
+	return ep[c] >> 4
+}
+
+func unambiguous(s byte) bool {
+	return s == 1 || s == 2 || s == 4 || s == 8
+}
+
+type resolver struct {
+	o      Options
+	rng    *rand.Rand
+	counts [][4]int
+}
+
+// resolve returns the nucleotide that the code at column i (whose base set is s) is resolved to, or 0 if none
+// of the methods resolves it
+func (r *resolver) resolve(s byte, i int) byte {
+	for _, m := range r.o.Methods {
+		switch m {
+		case Reference:
+			if i < len(r.o.Reference) {
+				if rs := set(r.o.Reference[i]); unambiguous(rs) && rs&s != 0 {
+					return r.o.Reference[i]
+				}
+			}
+		case Frequency:
+			best, bestN := byte(0), 0
+			// iterate A, C, G, T so that ties go to the first alphabetically
+			for _, b := range []int{3, 1, 2, 0} {
+				if s&(1<<b) != 0 && r.counts[i][b] > bestN {
+					best, bestN = bases[b], r.counts[i][b]
+				}
+			}
+			if best != 0 {
+				return best
+			}
+		case Random:
+			choices := make([]byte, 0, 4)
+			for _, b := range []int{3, 1, 2, 0} {
+				if s&(1<<b) != 0 {
+					choices = append(choices, bases[b])
+				}
+			}
+			return choices[r.rng.Intn(len(choices))]
+		}
+	}
+	return 0
+}
+
+func (r *resolver) record(FR fastaio.FastaRecord) fastaio.FastaRecord {
+	var seq []byte
+	for i := 0; i < len(FR.Seq); i++ {
+		s := set(FR.Seq[i])
+		if s == 0 || unambiguous(s) || (s == 15 && !r.o.IncludeN) {
+			continue
+		}
+		if b := r.resolve(s, i); b != 0 {
+			if seq == nil {
+				seq = []byte(FR.Seq)
+			}
+			seq[i] = b
+		}
+	}
+	if seq != nil {
+		FR.Seq = string(seq)
+	}
+	return FR
+}
+
+// Disambiguate writes every record in in to out with its ambiguity codes resolved according to o. With the
+// Reference or Frequency methods the records must be aligned, and with Frequency they are all read into memory
+// first, so that the nucleotides in each column can be counted
+func Disambiguate(ctx context.Context, in io.Reader, out io.Writer, o Options) error {
+
+	r := &resolver{o: o, rng: rand.New(rand.NewSource(o.Seed))}
+	frequency := false
+	for _, m := range o.Methods {
+		switch m {
+		case Reference:
+			if o.Reference == "" {
+				return errors.New("resolving to the reference needs a reference")
+			}
+			r.o.Reference = strings.ToUpper(o.Reference)
+		case Frequency:
+			frequency = true
+		case Random:
+		default:
+			return errors.New("unknown method: " + m + " (choose from reference, frequency or random)")
+		}
+	}
+	if len(o.Methods) == 0 {
+		return errors.New("no methods to resolve ambiguity codes with")
+	}
+
+	write := func(FR fastaio.FastaRecord) error {
+		if r.o.Reference != "" && len(FR.Seq) != len(r.o.Reference) {
+			return errors.New(FR.ID + " (" + strconv.Itoa(len(FR.Seq)) + " bases) is not the same length as the reference (" + strconv.Itoa(len(r.o.Reference)) + " bases)")
+		}
+		FR = r.record(FR)
+		_, err := out.Write([]byte(">" + FR.Description + "\n" + FR.Seq + "\n"))
+		return err
+	}
+
+	if !frequency {
+		return fastaio.EachRecord(ctx, in, write)
+	}
+
+	records, err := fastaio.ReadFastaToList(in)
+	if err != nil {
+		return err
+	}
+	for _, FR := range records {
+		if r.counts == nil {
+			r.counts = make([][4]int, len(FR.Seq))
+		}
+		if len(FR.Seq) != len(r.counts) {
+			return fastaio.NotAlignment(FR.ID, FR.Idx, 0, len(FR.Seq), len(r.counts))
+		}
+		for i := 0; i < len(FR.Seq); i++ {
+			if s := set(FR.Seq[i]); unambiguous(s) {
+				for b := 0; b < 4; b++ {
+					if s == 1<<b {
+						r.counts[i][b]++
+					}
+				}
+			}
+		}
+	}
+	for _, FR := range records {
+		if err := write(FR); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
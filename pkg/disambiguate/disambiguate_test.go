@@ -0,0 +1,74 @@
+package disambiguate
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestDisambiguateReference(t *testing.T) {
+	in := ">q1\nRYN-K\n"
+	var out bytes.Buffer
+	err := Disambiguate(context.Background(), strings.NewReader(in), &out, Options{Methods: []string{Reference}, Reference: "ACGTA"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// R (A/G) is compatible with A, Y (C/T) with C, N is skipped and K (G/T) isn't compatible with A
+	if out.String() != ">q1\nACN-K\n" {
+		t.Errorf("problem in TestDisambiguateReference(): %s", out.String())
+	}
+
+	out.Reset()
+	err = Disambiguate(context.Background(), strings.NewReader(in), &out, Options{Methods: []string{Reference}, Reference: "ACGTA", IncludeN: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != ">q1\nACG-K\n" {
+		t.Errorf("problem in TestDisambiguateReference() with N: %s", out.String())
+	}
+}
+
+func TestDisambiguateFrequency(t *testing.T) {
+	in := ">q1\nRK\n>q2\nGT\n>q3\nGG\n>q4\nAT\n"
+	var out bytes.Buffer
+	err := Disambiguate(context.Background(), strings.NewReader(in), &out, Options{Methods: []string{Frequency}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// column 1 has two Gs and an A, column 2 two Ts and a G
+	if !strings.HasPrefix(out.String(), ">q1\nGT\n") {
+		t.Errorf("problem in TestDisambiguateFrequency(): %s", out.String())
+	}
+}
+
+func TestDisambiguateRandom(t *testing.T) {
+	in := ">q1\n" + strings.Repeat("R", 100) + "\n"
+	var out1, out2 bytes.Buffer
+	if err := Disambiguate(context.Background(), strings.NewReader(in), &out1, Options{Methods: []string{Random}, Seed: 5}); err != nil {
+		t.Fatal(err)
+	}
+	if err := Disambiguate(context.Background(), strings.NewReader(in), &out2, Options{Methods: []string{Random}, Seed: 5}); err != nil {
+		t.Fatal(err)
+	}
+	seq := strings.Split(out1.String(), "\n")[1]
+	if out1.String() != out2.String() || strings.Trim(seq, "AG") != "" || !strings.Contains(seq, "A") || !strings.Contains(seq, "G") {
+		t.Errorf("problem in TestDisambiguateRandom(): %s", out1.String())
+	}
+}
+
+func TestDisambiguateFallback(t *testing.T) {
+	var out bytes.Buffer
+	err := Disambiguate(context.Background(), strings.NewReader(">q1\nKR\n"), &out, Options{Methods: []string{Reference, Random}, Reference: "AA", Seed: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	seq := strings.Split(out.String(), "\n")[1]
+	if seq[1] != 'A' || (seq[0] != 'G' && seq[0] != 'T') {
+		t.Errorf("problem in TestDisambiguateFallback(): %s", out.String())
+	}
+
+	if err = Disambiguate(context.Background(), strings.NewReader(">q1\nKR\n"), &out, Options{Methods: []string{"coin"}}); err == nil {
+		t.Error("expected an error for an unknown method")
+	}
+}
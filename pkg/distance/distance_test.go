@@ -0,0 +1,346 @@
+package distance
+
+import (
+	"bytes"
+	"context"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/encoding"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+func encode(id, seq string) fastaio.EncodedFastaRecord {
+	ea := encoding.MakeEncodingArray()
+	b := make([]byte, len(seq))
+	for i := range seq {
+		b[i] = ea[seq[i]]
+	}
+	return fastaio.EncodedFastaRecord{ID: id, Seq: b}
+}
+
+// naive is the per-site SNP-distance, as in closest
+func naive(x, y fastaio.EncodedFastaRecord) int {
+	n := 0
+	for i := range x.Seq {
+		if x.Seq[i]&y.Seq[i] < 16 {
+			n++
+		}
+	}
+	return n
+}
+
+func TestSNP(t *testing.T) {
+	alphabet := "ACGTRYSWKMBDHVN-?"
+	r := rand.New(rand.NewSource(1))
+	for _, l := range []int{1, 63, 64, 65, 200} {
+		for k := 0; k < 20; k++ {
+			x := make([]byte, l)
+			y := make([]byte, l)
+			for i := range x {
+				x[i] = alphabet[r.Intn(len(alphabet))]
+				y[i] = alphabet[r.Intn(len(alphabet))]
+			}
+			ex, ey := encode("x", string(x)), encode("y", string(y))
+			px, py := Pack(ex), Pack(ey)
+			if got, want := SNP(&px, &py), naive(ex, ey); got != want {
+				t.Errorf("problem in TestSNP(): length %d: got %d, want %d", l, got, want)
+			}
+		}
+	}
+}
+
+func TestSNPMatrix(t *testing.T) {
+	msa := ">s1\nATGATG\n>s2\nATGATC\n>s3\nTTNAAC\n"
+	packed, err := PackAlignment(strings.NewReader(msa))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := SNPMatrix(context.Background(), packed, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	m.WriteSquare(&out)
+	if out.String() != ",s1,s2,s3\ns1,0,1,3\ns2,1,0,2\ns3,3,2,0\n" {
+		t.Errorf("problem in TestSNPMatrix() square: %s", out.String())
+	}
+
+	out.Reset()
+	m.WriteLower(&out)
+	if out.String() != "s1\ns2,1\ns3,3,2\n" {
+		t.Errorf("problem in TestSNPMatrix() lower: %s", out.String())
+	}
+
+	out.Reset()
+	m.WriteLong(&out)
+	if out.String() != "sequence1,sequence2,distance\ns1,s2,1\ns1,s3,3\ns2,s3,2\n" {
+		t.Errorf("problem in TestSNPMatrix() long: %s", out.String())
+	}
+
+	if err := m.Write(&out, "phylip"); err == nil {
+		t.Errorf("expected an error for an unknown format")
+	}
+}
+
+func TestSNPUpTo(t *testing.T) {
+	x, y := encode("x", strings.Repeat("A", 200)), encode("y", strings.Repeat("T", 200))
+	px, py := Pack(x), Pack(y)
+	if d := SNPUpTo(&px, &py, 10); d <= 10 || d > 64 {
+		t.Errorf("problem in TestSNPUpTo(): %d", d)
+	}
+	if d := SNPUpTo(&px, &py, 1000); d != 200 {
+		t.Errorf("problem in TestSNPUpTo(): %d", d)
+	}
+}
+
+func TestDiffSites(t *testing.T) {
+	alphabet := "ACGTRYN-"
+	r := rand.New(rand.NewSource(2))
+	for _, l := range []int{1, 64, 130} {
+		x := make([]byte, l)
+		y := make([]byte, l)
+		for i := range x {
+			x[i] = alphabet[r.Intn(len(alphabet))]
+			y[i] = alphabet[r.Intn(len(alphabet))]
+		}
+		ex, ey := encode("x", string(x)), encode("y", string(y))
+		px, py := Pack(ex), Pack(ey)
+		want := make([]int, 0)
+		for i := range ex.Seq {
+			if ex.Seq[i]&ey.Seq[i] < 16 {
+				want = append(want, i)
+			}
+		}
+		got := DiffSites(&px, &py)
+		if len(got) != len(want) {
+			t.Fatalf("problem in TestDiffSites(): length %d: got %v, want %v", l, got, want)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("problem in TestDiffSites(): length %d: got %v, want %v", l, got, want)
+				break
+			}
+		}
+	}
+}
+
+func TestCompareSegregating(t *testing.T) {
+	alphabet := "ACGTRN-"
+	r := rand.New(rand.NewSource(2))
+	for _, l := range []int{1, 64, 130} {
+		records := make([]fastaio.EncodedFastaRecord, 5)
+		for k := range records {
+			s := make([]byte, l)
+			for i := range s {
+				s[i] = alphabet[r.Intn(len(alphabet))]
+			}
+			records[k] = encode("r", string(s))
+		}
+		packed := PackRecords(records)
+		for _, window := range [][2]int{{0, l}, {l / 3, l - l/4}} {
+			start, end := window[0], window[1]
+			wantDiffs, wantCompared, wantSeg := 0, 0, 0
+			for i := start; i < end; i++ {
+				x, y := records[0].Seq[i], records[1].Seq[i]
+				if x&8 == 8 && y&8 == 8 {
+					wantCompared++
+					if x != y {
+						wantDiffs++
+					}
+				}
+				seen := make(map[byte]bool)
+				for _, EFR := range records {
+					if EFR.Seq[i]&8 == 8 {
+						seen[EFR.Seq[i]] = true
+					}
+				}
+				if len(seen) > 1 {
+					wantSeg++
+				}
+			}
+			diffs, compared := Compare(&packed[0], &packed[1], start, end)
+			if diffs != wantDiffs || compared != wantCompared {
+				t.Errorf("problem in TestCompareSegregating(): Compare() gave %d/%d, want %d/%d", diffs, compared, wantDiffs, wantCompared)
+			}
+			if seg := Segregating(packed, start, end); seg != wantSeg {
+				t.Errorf("problem in TestCompareSegregating(): Segregating() gave %d, want %d", seg, wantSeg)
+			}
+		}
+	}
+}
+
+func TestEncoded(t *testing.T) {
+	alphabet := "ACGTRYSWKMBDHVN-?X"
+	r := rand.New(rand.NewSource(3))
+	for _, l := range []int{0, 1, 7, 8, 9, 64, 101} {
+		for k := 0; k < 20; k++ {
+			x := make([]byte, l)
+			y := make([]byte, l)
+			for i := range x {
+				x[i] = alphabet[r.Intn(len(alphabet))]
+				y[i] = alphabet[r.Intn(len(alphabet))]
+				// mostly the same, as real sequences are
+				if r.Intn(2) == 0 {
+					y[i] = x[i]
+				}
+			}
+			ex, ey := encode("x", string(x)), encode("y", string(y))
+
+			var want Tally
+			sites := make([]int, 0)
+			for i := range ex.Seq {
+				a, b := ex.Seq[i], ey.Seq[i]
+				if a&b < 16 {
+					want.SNPs++
+					sites = append(sites, i)
+				}
+				if a&8 == 8 && b&8 == 8 {
+					want.Resolved++
+					if a != b {
+						want.Differences++
+						if a|b == 200 {
+							want.PurineTransitions++
+						} else if a|b == 56 {
+							want.PyrimidineTransitions++
+						}
+					}
+				}
+			}
+
+			if got := EncodedSNP(ex.Seq, ey.Seq); got != want.SNPs {
+				t.Errorf("problem in TestEncoded(): EncodedSNP() length %d: got %d, want %d", l, got, want.SNPs)
+			}
+			if got := EncodedTally(ex.Seq, ey.Seq); got != want {
+				t.Errorf("problem in TestEncoded(): EncodedTally() length %d: got %+v, want %+v", l, got, want)
+			}
+			got := EncodedDiffSites(ex.Seq, ey.Seq)
+			if len(got) != len(sites) {
+				t.Fatalf("problem in TestEncoded(): EncodedDiffSites() length %d: got %v, want %v", l, got, sites)
+			}
+			for i := range got {
+				if got[i] != sites[i] {
+					t.Errorf("problem in TestEncoded(): EncodedDiffSites() length %d: got %v, want %v", l, got, sites)
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestSNPTriangle(t *testing.T) {
+	msa := ">s1\nATGATG\n>s2\nATGATC\n>s3\nTTNAAC\n>s4\nAAAAAA\n"
+	packed, err := PackAlignment(strings.NewReader(msa))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := SNPMatrix(context.Background(), packed, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tri, err := SNPTriangle[uint16](context.Background(), packed, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range m.D {
+		for j := range m.D {
+			if int(tri.At(i, j)) != m.D[i][j] {
+				t.Errorf("problem in TestSNPTriangle(): At(%d, %d) is %d, expected %d", i, j, tri.At(i, j), m.D[i][j])
+			}
+		}
+	}
+
+	for _, format := range []string{"square", "lower", "long"} {
+		var want, got bytes.Buffer
+		m.Write(&want, format)
+		tri.Write(&got, format)
+		if got.String() != want.String() {
+			t.Errorf("problem in TestSNPTriangle() %s: %s", format, got.String())
+		}
+	}
+
+	f := NewTriangle[float32]([]string{"a", "b", "c"})
+	f.Set(2, 0, 0.1)
+	f.Set(1, 2, 0.25)
+	var out bytes.Buffer
+	f.WriteLong(&out)
+	if f.At(0, 2) != 0.1 || f.At(1, 1) != 0 || out.String() != "sequence1,sequence2,distance\na,b,0\na,c,0.1\nb,c,0.25\n" {
+		t.Errorf("problem in TestSNPTriangle() float32: %s", out.String())
+	}
+}
+
+func TestRegister(t *testing.T) {
+	if err := Register("snp", MeasureFunc(snp)); err == nil {
+		t.Errorf("problem in TestRegister(): expected an error registering snp twice")
+	}
+
+	// counts only the differences in the first half of the alignment
+	half := MeasureFunc(func(a, b fastaio.EncodedFastaRecord) (float64, Tally) {
+		d := EncodedSNP(a.Seq[:len(a.Seq)/2], b.Seq[:len(b.Seq)/2])
+		return float64(d), Tally{SNPs: d}
+	})
+	if err := Register("test-half", half); err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := Lookup("test-half")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d, tally := m.Distance(encode("a", "AAAATT"), encode("b", "ATAAAA")); d != 1 || tally.SNPs != 1 {
+		t.Errorf("problem in TestRegister(): %f %v", d, tally)
+	}
+
+	records := []fastaio.EncodedFastaRecord{encode("a", "AAAATT"), encode("b", "ATAAAA"), encode("c", "TTTAAA")}
+	tri, err := MeasureTriangle[float64](context.Background(), records, m, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tri.At(0, 1) != 1 || tri.At(2, 0) != 3 || tri.At(1, 2) != 2 {
+		t.Errorf("problem in TestRegister(): %v", tri)
+	}
+	tri32, err := MeasureTriangle[float32](context.Background(), records, m, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tri32.At(0, 1) != 1 || tri32.At(2, 0) != 3 || tri32.At(1, 2) != 2 {
+		t.Errorf("problem in TestRegister() float32: %v", tri32)
+	}
+
+	if _, err := Lookup("nonesuch"); err == nil {
+		t.Errorf("problem in TestRegister(): expected an error looking up an unregistered measure")
+	}
+	found := false
+	for _, name := range Measures() {
+		found = found || name == "test-half"
+	}
+	if !found {
+		t.Errorf("problem in TestRegister(): %v", Measures())
+	}
+}
+
+func TestSNPTriangleCancelled(t *testing.T) {
+	packed, err := PackAlignment(strings.NewReader(">s1\nATGATG\n>s2\nATGATC\n>s3\nTTNAAC\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := SNPTriangle[uint16](ctx, packed, 2); err != context.Canceled {
+		t.Errorf("problem in TestSNPTriangleCancelled(): expected context.Canceled, got %v", err)
+	}
+}
+
+func TestCheckFormat(t *testing.T) {
+	for _, format := range []string{"square", "lower", "long"} {
+		if err := CheckFormat(format); err != nil {
+			t.Errorf("problem in TestCheckFormat(): %v", err)
+		}
+	}
+	if err := CheckFormat("Square"); err == nil {
+		t.Errorf("problem in TestCheckFormat(): expected an error for an unknown format")
+	}
+}
@@ -0,0 +1,134 @@
+package distance
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// The functions in this file compare two EP-encoded sequences eight sites at a time, by loading eight bytes
+// into a uint64 and testing every byte at once, so that sequences don't need to be packed first. This is what
+// closest and snps compare each record with; the matrix uses the Packed form, which is four bits per site.
+// Both come down to popcounts over whole words, which the compiler turns into single instructions on amd64
+// and arm64, so they are kept in plain Go rather than assembly.
+
+const (
+	lows  = 0x0101010101010101
+	highs = 0x8080808080808080
+)
+
+// nonzero returns a word with the high bit of each byte of v set if that byte isn't zero. The sum can't carry
+// from one byte into the next, since (b & 0x7f) + 0x7f is at most 0xfe
+func nonzero(v uint64) uint64 {
+	return (((v &^ highs) + 0x7f*lows) | v) & highs
+}
+
+// differ returns the high bit of each byte of a pair of words that certainly differ, i.e. that share no
+// nucleotide, exactly as (a & b) < 16 for single bytes
+func differ(a, b uint64) uint64 {
+	return ^nonzero(a&b&(0xf0*lows)) & highs
+}
+
+// certain returns the high bit of each byte that is an unambiguous nucleotide, i.e. has the 8 bit set
+func certain(a uint64) uint64 {
+	return (a & (0x08 * lows)) << 4
+}
+
+// equalTo returns the high bit of each byte of v that is the same as the byte c
+func equalTo(v uint64, c byte) uint64 {
+	return ^nonzero(v^(uint64(c)*lows)) & highs
+}
+
+// EncodedSNP returns the number of sites at which the EP-encoded sequences a and b certainly differ, as SNP
+// does for Packed records. b must be at least as long as a
+func EncodedSNP(a, b []byte) int {
+	d := 0
+	// reslicing rather than indexing lets the compiler drop the bounds checks
+	b = b[:len(a)]
+	for len(a) >= 8 {
+		d += bits.OnesCount64(differ(binary.LittleEndian.Uint64(a), binary.LittleEndian.Uint64(b)))
+		a, b = a[8:], b[8:]
+	}
+	for i := range a {
+		if a[i]&b[i] < 16 {
+			d++
+		}
+	}
+	return d
+}
+
+// EncodedDiffSites returns the 0-based positions, in order, at which the EP-encoded sequences a and b certainly
+// differ. b must be at least as long as a
+func EncodedDiffSites(a, b []byte) []int {
+	sites := make([]int, 0)
+	b = b[:len(a)]
+	offset := 0
+	for len(a) >= 8 {
+		diff := differ(binary.LittleEndian.Uint64(a), binary.LittleEndian.Uint64(b))
+		for diff != 0 {
+			sites = append(sites, offset+bits.TrailingZeros64(diff)/8)
+			diff &= diff - 1
+		}
+		a, b = a[8:], b[8:]
+		offset += 8
+	}
+	for i := range a {
+		if a[i]&b[i] < 16 {
+			sites = append(sites, offset+i)
+		}
+	}
+	return sites
+}
+
+// Tally is the comparison of two sequences that the raw and TN93 distances are calculated from. SNPs is the
+// number of sites at which they certainly differ, and Resolved the number at which both have an unambiguous
+// nucleotide. Of the resolved sites, Differences differ, PurineTransitions by A-G and PyrimidineTransitions
+// by C-T
+type Tally struct {
+	SNPs                  int
+	Resolved              int
+	Differences           int
+	PurineTransitions     int
+	PyrimidineTransitions int
+}
+
+// EncodedTally compares the EP-encoded sequences a and b. b must be at least as long as a
+func EncodedTally(a, b []byte) Tally {
+	var t Tally
+	b = b[:len(a)]
+	for ; len(a) >= 8; a, b = a[8:], b[8:] {
+		x, y := binary.LittleEndian.Uint64(a), binary.LittleEndian.Uint64(b)
+		diff := differ(x, y)
+		resolved := certain(x) & certain(y)
+		resolvedDiff := resolved & diff
+		t.SNPs += bits.OnesCount64(diff)
+		t.Resolved += bits.OnesCount64(resolved)
+		if resolvedDiff == 0 {
+			continue
+		}
+		t.Differences += bits.OnesCount64(resolvedDiff)
+		t.PurineTransitions += bits.OnesCount64(resolvedDiff & equalTo(x|y, 136|72))
+		t.PyrimidineTransitions += bits.OnesCount64(resolvedDiff & equalTo(x|y, 40|24))
+	}
+	for i := range a {
+		x, y := a[i], b[i]
+		diff := x&y < 16
+		if diff {
+			t.SNPs++
+		}
+		if x&8 != 8 || y&8 != 8 {
+			continue
+		}
+		t.Resolved++
+		if !diff {
+			continue
+		}
+		t.Differences++
+		switch x | y {
+		case 136 | 72:
+			t.PurineTransitions++
+		case 40 | 24:
+			t.PyrimidineTransitions++
+		}
+	}
+	return t
+}
@@ -0,0 +1,170 @@
+package distance
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/schema"
+)
+
+// Matrix is a symmetric matrix of pairwise distances
+type Matrix struct {
+	Names []string
+	D     [][]int
+}
+
+// PackAlignment reads an alignment and packs every record in it
+func PackAlignment(msa io.Reader) ([]Packed, error) {
+	records, err := fastaio.ReadEncodeAlignmentToList(msa, false)
+	if err != nil {
+		return nil, err
+	}
+	return PackRecords(records), nil
+}
+
+// PackRecords packs every record in records
+func PackRecords(records []fastaio.EncodedFastaRecord) []Packed {
+	packed := make([]Packed, len(records))
+	for i := range records {
+		packed[i] = Pack(records[i])
+	}
+	return packed
+}
+
+// SNPMatrix calculates the SNP-distance between every pair of records, using threads goroutines. It stops and
+// returns ctx's error if ctx is cancelled
+func SNPMatrix(ctx context.Context, packed []Packed, threads int) (Matrix, error) {
+
+	n := len(packed)
+	m := Matrix{Names: make([]string, n), D: make([][]int, n)}
+	for i := range packed {
+		m.Names[i] = packed[i].ID
+		m.D[i] = make([]int, n)
+	}
+
+	// each row only fills in its own lower triangle, and the upper triangle is mirrored
+	// afterwards, so the goroutines never write to the same element. The longest rows are
+	// handed out first so that the threads finish at about the same time
+	err := eachRow(ctx, threads, n, func(k int) {
+		i := n - 1 - k
+		for j := 0; j < i; j++ {
+			m.D[i][j] = SNP(&packed[i], &packed[j])
+		}
+	})
+	if err != nil {
+		return Matrix{}, err
+	}
+
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			m.D[j][i] = m.D[i][j]
+		}
+	}
+
+	return m, nil
+}
+
+// at returns the formatted distance between records i and j
+func (m Matrix) at(i, j int) string {
+	return strconv.Itoa(m.D[i][j])
+}
+
+// WriteSquare writes the full matrix as CSV, with a header row of names and the name of each row in the first column
+func (m Matrix) WriteSquare(w io.Writer) error {
+	return writeSquare(w, m.Names, m.at)
+}
+
+// WriteLower writes the lower triangle of the matrix as CSV, without the diagonal: the ith line has the name
+// of record i followed by its distances to records 0..i-1
+func (m Matrix) WriteLower(w io.Writer) error {
+	return writeLower(w, m.Names, m.at)
+}
+
+// WriteLong writes the matrix in long (molten) format as CSV, with one line per pair of different records
+func (m Matrix) WriteLong(w io.Writer) error {
+	return writeLong(w, m.Names, m.at)
+}
+
+// writeSquare writes a symmetric matrix of the records called names, whose formatted distances are given by at,
+// in square format
+func writeSquare(w io.Writer, names []string, at func(i, j int) string) error {
+	_, err := w.Write([]byte("," + strings.Join(names, ",") + "\n"))
+	if err != nil {
+		return err
+	}
+	fields := make([]string, len(names)+1)
+	for i := range names {
+		fields[0] = names[i]
+		for j := range names {
+			fields[j+1] = at(i, j)
+		}
+		if _, err = w.Write([]byte(strings.Join(fields, ",") + "\n")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeLower writes a symmetric matrix in lower format, as writeSquare does in square format
+func writeLower(w io.Writer, names []string, at func(i, j int) string) error {
+	for i := range names {
+		fields := make([]string, i+1)
+		fields[0] = names[i]
+		for j := 0; j < i; j++ {
+			fields[j+1] = at(i, j)
+		}
+		if _, err := w.Write([]byte(strings.Join(fields, ",") + "\n")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeLong writes a symmetric matrix in long format, as writeSquare does in square format
+func writeLong(w io.Writer, names []string, at func(i, j int) string) error {
+	if _, err := w.Write([]byte(schema.MatrixLong.HeaderLine())); err != nil {
+		return err
+	}
+	for i := range names {
+		for j := i + 1; j < len(names); j++ {
+			if _, err := w.Write([]byte(names[i] + "," + names[j] + "," + at(i, j) + "\n")); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Write writes the matrix in format, which is one of "square", "lower" or "long"
+func (m Matrix) Write(w io.Writer, format string) error {
+	return WriteFormat(w, m.Names, m.at, format)
+}
+
+// WriteFormat writes a symmetric matrix of distances between the records called names in format, which is one
+// of "square", "lower" or "long", laid out as Matrix.Write does. at returns the distance between records i and
+// j, formatted however suits the distance
+func WriteFormat(w io.Writer, names []string, at func(i, j int) string, format string) error {
+	switch format {
+	case "square":
+		return writeSquare(w, names, at)
+	case "lower":
+		return writeLower(w, names, at)
+	case "long":
+		return writeLong(w, names, at)
+	}
+	return CheckFormat(format)
+}
+
+// CheckFormat returns an error if format isn't one that WriteFormat can write, so that it can be checked
+// before the distances are calculated
+func CheckFormat(format string) error {
+	switch format {
+	case "square", "lower", "long":
+		return nil
+	}
+	return errors.New("unknown matrix format: " + format + " (choose one of square, lower or long)")
+}
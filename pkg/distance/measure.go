@@ -0,0 +1,176 @@
+package distance
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+// Measure is a distance between two EP-encoded records of the same width, as used by closest, assign, matrix
+// and cluster. Distance returns the distance, and the comparison it was calculated from, as much of it as the
+// measure needs (snp only counts Tally.SNPs). Programs that embed gofasta can Register their own measures
+// (masked, weighted or codon-aware, say), which are then accepted wherever a measure is given by name. A
+// Measure is called from many goroutines at once
+type Measure interface {
+	Distance(a, b fastaio.EncodedFastaRecord) (float64, Tally)
+}
+
+// MeasureFunc is a function that is a Measure
+type MeasureFunc func(a, b fastaio.EncodedFastaRecord) (float64, Tally)
+
+// Distance calls f(a, b)
+func (f MeasureFunc) Distance(a, b fastaio.EncodedFastaRecord) (float64, Tally) {
+	return f(a, b)
+}
+
+var (
+	measuresMu sync.RWMutex
+	measures   = map[string]Measure{
+		"raw":  MeasureFunc(raw),
+		"snp":  MeasureFunc(snp),
+		"tn93": MeasureFunc(tn93),
+	}
+)
+
+// Register makes m available by name. It is an error to register a name twice, including raw, snp and tn93
+func Register(name string, m Measure) error {
+	measuresMu.Lock()
+	defer measuresMu.Unlock()
+	if _, ok := measures[name]; ok {
+		return errors.New("there is already a distance measure called " + name)
+	}
+	measures[name] = m
+	return nil
+}
+
+// Lookup returns the measure called name
+func Lookup(name string) (Measure, error) {
+	measuresMu.RLock()
+	defer measuresMu.RUnlock()
+	m, ok := measures[name]
+	if !ok {
+		return nil, errors.New("unknown distance measure: " + name + " (choose one of " + strings.Join(measureNames(), ", ") + ")")
+	}
+	return m, nil
+}
+
+// Measures returns the names of every measure, in alphabetical order
+func Measures() []string {
+	measuresMu.RLock()
+	defer measuresMu.RUnlock()
+	return measureNames()
+}
+
+func measureNames() []string {
+	names := make([]string, 0, len(measures))
+	for name := range measures {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// FormatDistance formats a distance by measure for CSV output: snp as a whole number, anything else with nine
+// decimal places, and NaN as an empty field
+func FormatDistance(d float64, measure string) string {
+	switch {
+	case math.IsNaN(d):
+		return ""
+	case measure == "snp":
+		return strconv.Itoa(int(d))
+	}
+	return strconv.FormatFloat(d, 'f', 9, 64)
+}
+
+// raw is the number of sites at which a and b differ, out of the sites that are certainly the same or differ
+func raw(a, b fastaio.EncodedFastaRecord) (float64, Tally) {
+	t := EncodedTally(a.Seq, b.Seq)
+	// the differences out of the differences and the sites that are certainly the same
+	return float64(t.SNPs) / float64(t.SNPs+t.Resolved-t.Differences), t
+}
+
+// TO DO - have this operate on the lists of snps not the entire sequences
+func snp(a, b fastaio.EncodedFastaRecord) (float64, Tally) {
+	d := EncodedSNP(a.Seq, b.Seq)
+	return float64(d), Tally{SNPs: d}
+}
+
+// See equation (7) in Tamura K, Nei M. Estimation of the number of nucleotide substitutions in the control region of mitochondrial DNA in humans and chimpanzees.
+// Mol Biol Evol. 1993 May;10(3):512-26. doi: 10.1093/oxfordjournals.molbev.a040023. PMID: 8336541.
+// See also ape: https://github.com/cran/ape/blob/c2fd899f66d6493a80484033772a3418e5d706a4/src/dist_dna.c
+// The base contents are estimated from the records' Count_ fields, so they should be filled in, by
+// EncodedFastaRecord.CalculateBaseContent
+func tn93(query, target fastaio.EncodedFastaRecord) (float64, Tally) {
+
+	// Total ATGC length of the two sequences
+	L := float64(target.Count_A + target.Count_C + target.Count_G + target.Count_T + query.Count_A + query.Count_C + query.Count_G + query.Count_T)
+
+	// estimates of the equilibrium base contents from the pair's sequence data
+	g_A := float64(target.Count_A+query.Count_A) / L
+	g_C := float64(target.Count_C+query.Count_C) / L
+	g_G := float64(target.Count_G+query.Count_G) / L
+	g_T := float64(target.Count_T+query.Count_T) / L
+
+	g_R := float64(target.Count_A+query.Count_A+target.Count_G+query.Count_G) / L
+	g_Y := float64(target.Count_C+query.Count_C+target.Count_T+query.Count_T) / L
+
+	// tidies up the equations a bit, after ape
+	k1 := 2.0 * g_A * g_G / g_R
+	k2 := 2.0 * g_T * g_C / g_Y
+	k3 := 2.0 * (g_R*g_Y - g_A*g_G*g_Y/g_R - g_T*g_C*g_R/g_Y)
+
+	// calculate the three types of change from the pairwise comparison
+	t := EncodedTally(query.Seq, target.Seq)
+	count_P1 := t.PurineTransitions     // count of transitional differences between purines (A ⇄ G)
+	count_P2 := t.PyrimidineTransitions // count of transitional differences between pyramidines (C ⇄ T)
+	count_d := t.Differences            // total number of differences (between bases known for sure)
+	count_L := t.Resolved               // total length of resolved comparison
+
+	// estimated rates from this pairwise comparison
+	P1 := float64(count_P1) / float64(count_L)                   // rate of changes which are transitional differences between purines (A ⇄ G)
+	P2 := float64(count_P2) / float64(count_L)                   // rate of changes which are transitional differences between pyramidines (C ⇄ T)
+	Q := float64(count_d-(count_P1+count_P2)) / float64(count_L) // rate of changes which are transversional differences  (A ⇄ C || A ⇄ T || G ⇄ T || C ⇄ G) (i.e. everything else)
+
+	// tidies up the equations a bit, after ape
+	w1 := 1.0 - P1/k1 - Q/(2*g_R)
+	w2 := 1.0 - P2/k2 - Q/(2*g_Y)
+	w3 := 1.0 - Q/(2*g_R*g_Y)
+
+	// tn93 distance:
+	d := -k1*math.Log(w1) - k2*math.Log(w2) - k3*math.Log(w3)
+
+	if d == 0.0 {
+		d = 0.0
+	}
+
+	return d, t
+}
+
+// MeasureTriangle calculates the distance by m between every pair of records, using threads goroutines, and
+// stores them as T. float32 takes half the memory of float64, but only holds about seven significant digits, so
+// can change the last decimal place or two that FormatDistance writes. It stops and returns ctx's error if ctx
+// is cancelled
+func MeasureTriangle[T float32 | float64](ctx context.Context, records []fastaio.EncodedFastaRecord, m Measure, threads int) (*Triangle[T], error) {
+
+	names := make([]string, len(records))
+	for i := range records {
+		names[i] = records[i].ID
+	}
+	t := NewTriangle[T](names)
+
+	err := t.Fill(ctx, threads, func(i, j int) T {
+		d, _ := m.Distance(records[i], records[j])
+		return T(d)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
@@ -0,0 +1,159 @@
+/*
+Package distance provides routines to calculate pairwise genetic distances
+between the sequences in an alignment.
+
+SNP-distances are calculated on a packed representation of each sequence:
+one bitset per nucleotide, where a bit is set if the nucleotide is compatible
+with the sequence at that site. Two sites differ if they share no compatible
+nucleotide, exactly as (a & b) < 16 in Emmanuel Paradis's bitwise coding, but
+64 sites are compared at a time.
+*/
+package distance
+
+import (
+	"math/bits"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+// Packed is a sequence packed into one bitset for each of A, G, C and T
+type Packed struct {
+	ID    string
+	Idx   int
+	Len   int
+	bases [4][]uint64
+}
+
+// Pack converts an EP-encoded record to a Packed one. Bits past the end of the sequence are
+// set for every nucleotide, so that they never count as differences
+func Pack(EFR fastaio.EncodedFastaRecord) Packed {
+	words := (len(EFR.Seq) + 63) / 64
+	p := Packed{ID: EFR.ID, Idx: EFR.Idx, Len: len(EFR.Seq)}
+	for b := 0; b < 4; b++ {
+		p.bases[b] = make([]uint64, words)
+	}
+	for i, nuc := range EFR.Seq {
+		// the high four bits of the EP encoding are A, G, C and T
+		set := nuc >> 4
+		w, bit := i/64, uint(i%64)
+		for b := 0; b < 4; b++ {
+			if set&(1<<b) != 0 {
+				p.bases[b][w] |= 1 << bit
+			}
+		}
+	}
+	if tail := len(EFR.Seq) % 64; tail != 0 {
+		pad := ^uint64(0) << uint(tail)
+		for b := 0; b < 4; b++ {
+			p.bases[b][words-1] |= pad
+		}
+	}
+	return p
+}
+
+// SNP returns the number of sites at which x and y certainly differ. They must be the same length
+func SNP(x, y *Packed) int {
+	d := 0
+	a0, a1, a2, a3 := x.bases[0], x.bases[1], x.bases[2], x.bases[3]
+	b0, b1, b2, b3 := y.bases[0], y.bases[1], y.bases[2], y.bases[3]
+	for w := range a0 {
+		same := (a0[w] & b0[w]) | (a1[w] & b1[w]) | (a2[w] & b2[w]) | (a3[w] & b3[w])
+		d += 64 - bits.OnesCount64(same)
+	}
+	return d
+}
+
+// SNPUpTo is as SNP, but stops counting once the distance is greater than max, so is faster when only
+// near neighbours are of interest. The value returned is only exact if it is at most max
+func SNPUpTo(x, y *Packed, max int) int {
+	d := 0
+	a0, a1, a2, a3 := x.bases[0], x.bases[1], x.bases[2], x.bases[3]
+	b0, b1, b2, b3 := y.bases[0], y.bases[1], y.bases[2], y.bases[3]
+	for w := range a0 {
+		same := (a0[w] & b0[w]) | (a1[w] & b1[w]) | (a2[w] & b2[w]) | (a3[w] & b3[w])
+		d += 64 - bits.OnesCount64(same)
+		if d > max {
+			return d
+		}
+	}
+	return d
+}
+
+// DiffSites returns the 0-based positions, in order, at which x and y certainly differ. They must be the
+// same length
+func DiffSites(x, y *Packed) []int {
+	sites := make([]int, 0)
+	a0, a1, a2, a3 := x.bases[0], x.bases[1], x.bases[2], x.bases[3]
+	b0, b1, b2, b3 := y.bases[0], y.bases[1], y.bases[2], y.bases[3]
+	for w := range a0 {
+		diff := ^((a0[w] & b0[w]) | (a1[w] & b1[w]) | (a2[w] & b2[w]) | (a3[w] & b3[w]))
+		for diff != 0 {
+			sites = append(sites, w*64+bits.TrailingZeros64(diff))
+			diff &= diff - 1
+		}
+	}
+	return sites
+}
+
+// rangeMask returns the bits of word w that are in the 0-based, half-open range of sites [start, end)
+func rangeMask(w, start, end int) uint64 {
+	lo := w * 64
+	m := ^uint64(0)
+	if start > lo {
+		m &= ^uint64(0) << uint(start-lo)
+	}
+	if end < lo+64 {
+		m &= ^(^uint64(0) << uint(end-lo))
+	}
+	return m
+}
+
+// unambiguous returns the bits of word w of x at which exactly one nucleotide is possible
+func (x *Packed) unambiguous(w int) uint64 {
+	a, g, c, t := x.bases[0][w], x.bases[1][w], x.bases[2][w], x.bases[3][w]
+	twoOrMore := (a & g) | (a & c) | (a & t) | (g & c) | (g & t) | (c & t)
+	return (a | g | c | t) &^ twoOrMore
+}
+
+// Compare returns the number of sites in the 0-based, half-open range [start, end) at which x and y both have
+// an unambiguous nucleotide, and the number of those at which they differ. They must be the same length
+func Compare(x, y *Packed, start, end int) (diffs int, compared int) {
+	if end > x.Len {
+		end = x.Len
+	}
+	if start >= end {
+		return 0, 0
+	}
+	for w := start / 64; w <= (end-1)/64; w++ {
+		both := x.unambiguous(w) & y.unambiguous(w) & rangeMask(w, start, end)
+		same := (x.bases[0][w] & y.bases[0][w]) | (x.bases[1][w] & y.bases[1][w]) | (x.bases[2][w] & y.bases[2][w]) | (x.bases[3][w] & y.bases[3][w])
+		compared += bits.OnesCount64(both)
+		diffs += bits.OnesCount64(both &^ same)
+	}
+	return diffs, compared
+}
+
+// Segregating returns the number of sites in the 0-based, half-open range [start, end) at which at least two
+// different unambiguous nucleotides are found among the records in packed, which must all be the same length
+func Segregating(packed []Packed, start, end int) int {
+	if len(packed) == 0 {
+		return 0
+	}
+	if end > packed[0].Len {
+		end = packed[0].Len
+	}
+	n := 0
+	for w := start / 64; start < end && w <= (end-1)/64; w++ {
+		var seen [4]uint64
+		for i := range packed {
+			one := packed[i].unambiguous(w)
+			for b := 0; b < 4; b++ {
+				seen[b] |= packed[i].bases[b][w] & one
+			}
+		}
+		a, g, c, t := seen[0], seen[1], seen[2], seen[3]
+		twoOrMore := (a & g) | (a & c) | (a & t) | (g & c) | (g & t) | (c & t)
+		n += bits.OnesCount64(twoOrMore & rangeMask(w, start, end))
+	}
+	return n
+}
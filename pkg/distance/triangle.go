@@ -0,0 +1,170 @@
+package distance
+
+import (
+	"context"
+	"io"
+	"runtime"
+	"strconv"
+	"sync"
+)
+
+// Number is the types a Triangle can hold its distances as
+type Number interface {
+	~uint16 | ~uint32 | ~int | ~float32 | ~float64
+}
+
+// Triangle is a symmetric matrix of pairwise distances with a zero diagonal, stored as its upper triangle in one
+// slice, so that it takes half the memory of a Matrix, or less with a smaller element type: n records need
+// n(n-1)/2 distances, which as uint16 SNP-counts is 1 GB for about 32,000 records
+type Triangle[T Number] struct {
+	Names []string
+	n     int
+	d     []T
+}
+
+// NewTriangle returns a triangle of zero distances between the records called names
+func NewTriangle[T Number](names []string) *Triangle[T] {
+	n := len(names)
+	return &Triangle[T]{Names: names, n: n, d: make([]T, n*(n-1)/2)}
+}
+
+// Len returns the number of records in the triangle
+func (t *Triangle[T]) Len() int {
+	return t.n
+}
+
+// index returns the position of the distance between records i and j in t.d. i must be less than j
+func (t *Triangle[T]) index(i, j int) int {
+	// rows 0..i-1 hold n-1, n-2, ... n-i distances, and row i starts with j = i+1
+	return i*(2*t.n-i-1)/2 + j - i - 1
+}
+
+// At returns the distance between records i and j, which is the same as between j and i, and is zero if i is j
+func (t *Triangle[T]) At(i, j int) T {
+	switch {
+	case i < j:
+		return t.d[t.index(i, j)]
+	case i > j:
+		return t.d[t.index(j, i)]
+	}
+	return 0
+}
+
+// Set sets the distance between records i and j, which must be different
+func (t *Triangle[T]) Set(i, j int, d T) {
+	if i > j {
+		i, j = j, i
+	}
+	t.d[t.index(i, j)] = d
+}
+
+// Row returns the distances from record i to records i+1..n-1, which can be modified in place
+func (t *Triangle[T]) Row(i int) []T {
+	start := t.index(i, i+1)
+	return t.d[start : start+t.n-i-1]
+}
+
+// SNPTriangle calculates the SNP-distance between every pair of records as SNPMatrix does, using threads
+// goroutines, but stores them as a Triangle. T must be able to hold the width of the alignment, which no
+// distance can be more than: uint16 is enough for alignments up to 65535 sites wide. It stops and returns ctx's
+// error if ctx is cancelled
+func SNPTriangle[T Number](ctx context.Context, packed []Packed, threads int) (*Triangle[T], error) {
+
+	names := make([]string, len(packed))
+	for i := range packed {
+		names[i] = packed[i].ID
+	}
+	t := NewTriangle[T](names)
+
+	err := t.Fill(ctx, threads, func(i, j int) T {
+		return T(SNP(&packed[i], &packed[j]))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// Fill sets every distance in t to dist(i, j), using threads goroutines (0 means all available CPUs). It
+// stops and returns ctx's error if ctx is cancelled
+func (t *Triangle[T]) Fill(ctx context.Context, threads int, dist func(i, j int) T) error {
+	// each row is its own part of the slice, so the goroutines never write to the same element. Handing out
+	// the longest rows first means that the threads finish at about the same time
+	return eachRow(ctx, threads, t.n-1, func(i int) {
+		row := t.Row(i)
+		for k := range row {
+			row[k] = dist(i, i+1+k)
+		}
+	})
+}
+
+// eachRow calls f for rows 0 to n-1, in that order, spread over threads goroutines. It stops handing out rows
+// if ctx is cancelled, and returns ctx's error once the rows already handed out are done
+func eachRow(ctx context.Context, threads, n int, f func(i int)) error {
+
+	if threads < 1 {
+		threads = runtime.NumCPU()
+	}
+
+	rows := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(threads)
+	for g := 0; g < threads; g++ {
+		go func() {
+			defer wg.Done()
+			for i := range rows {
+				f(i)
+			}
+		}()
+	}
+
+loop:
+	for i := 0; i < n; i++ {
+		select {
+		case rows <- i:
+		case <-ctx.Done():
+			break loop
+		}
+	}
+	close(rows)
+	wg.Wait()
+
+	return ctx.Err()
+}
+
+// format formats a distance, with no more digits than its type holds
+func (t *Triangle[T]) format(d T) string {
+	switch v := any(d).(type) {
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return strconv.FormatFloat(float64(d), 'f', -1, 64)
+}
+
+// at returns the formatted distance between records i and j
+func (t *Triangle[T]) at(i, j int) string {
+	return t.format(t.At(i, j))
+}
+
+// WriteSquare writes the full matrix as CSV, as Matrix.WriteSquare does
+func (t *Triangle[T]) WriteSquare(w io.Writer) error {
+	return writeSquare(w, t.Names, t.at)
+}
+
+// WriteLower writes the lower triangle of the matrix as CSV, as Matrix.WriteLower does
+func (t *Triangle[T]) WriteLower(w io.Writer) error {
+	return writeLower(w, t.Names, t.at)
+}
+
+// WriteLong writes the matrix in long format as CSV, as Matrix.WriteLong does
+func (t *Triangle[T]) WriteLong(w io.Writer) error {
+	return writeLong(w, t.Names, t.at)
+}
+
+// Write writes the matrix in format, which is one of "square", "lower" or "long"
+func (t *Triangle[T]) Write(w io.Writer, format string) error {
+	return WriteFormat(w, t.Names, t.at, format)
+}
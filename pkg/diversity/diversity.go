@@ -0,0 +1,197 @@
+/*
+Package diversity implements population-genetic summaries of an alignment:
+the nucleotide diversity (pi), the number of segregating sites and Watterson's
+theta, over the whole alignment or in sliding windows, and for every record
+together or for groups of records.
+*/
+package diversity
+
+import (
+	"errors"
+	"io"
+	"math"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/virus-evolution/gofasta/pkg/distance"
+	"github.com/virus-evolution/gofasta/pkg/encoding"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/summary"
+)
+
+// Options controls the windows, which are Window columns wide and start every Step columns. If Window is 0,
+// the whole alignment is one window
+type Options struct {
+	Window  int
+	Step    int
+	Threads int
+}
+
+// Stats are the statistics of one group in one window. Start and End are 1-based and inclusive. Pi is the mean,
+// over pairs of records, of the proportion of the sites where both have an unambiguous nucleotide at which they
+// differ, and ThetaW is Watterson's estimator per site: Segregating / a_n / the window's width. Both are NaN if
+// they can't be calculated
+type Stats struct {
+	Group       string
+	Start       int
+	End         int
+	Records     int
+	Segregating int
+	Pi          float64
+	ThetaW      float64
+}
+
+// windows returns the 0-based, half-open windows of an alignment of width columns
+func windows(width int, o Options) [][2]int {
+	if o.Window < 1 {
+		return [][2]int{{0, width}}
+	}
+	ws := make([][2]int, 0, width/o.Step+1)
+	for start := 0; start < width; start += o.Step {
+		end := start + o.Window
+		if end > width {
+			end = width
+		}
+		ws = append(ws, [2]int{start, end})
+		if end == width {
+			break
+		}
+	}
+	return ws
+}
+
+// pi returns the mean pairwise diversity of packed in each window, using threads goroutines
+func pi(packed []distance.Packed, ws [][2]int, threads int) []float64 {
+
+	n := len(packed)
+	sums := make([][]float64, n)
+	pairs := make([][]int, n)
+
+	// each row accumulates its own sums, so the goroutines never write to the same element
+	rows := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(threads)
+	for t := 0; t < threads; t++ {
+		go func() {
+			defer wg.Done()
+			for i := range rows {
+				sums[i] = make([]float64, len(ws))
+				pairs[i] = make([]int, len(ws))
+				for j := 0; j < i; j++ {
+					for k, w := range ws {
+						d, c := distance.Compare(&packed[i], &packed[j], w[0], w[1])
+						if c > 0 {
+							sums[i][k] += float64(d) / float64(c)
+							pairs[i][k]++
+						}
+					}
+				}
+			}
+		}()
+	}
+	// hand out the longest rows first so that the threads finish at about the same time
+	for i := n - 1; i >= 0; i-- {
+		rows <- i
+	}
+	close(rows)
+	wg.Wait()
+
+	p := make([]float64, len(ws))
+	for k := range ws {
+		sum, count := 0.0, 0
+		for i := 0; i < n; i++ {
+			sum += sums[i][k]
+			count += pairs[i][k]
+		}
+		p[k] = math.NaN()
+		if count > 0 {
+			p[k] = sum / float64(count)
+		}
+	}
+
+	return p
+}
+
+// Compute returns the statistics of packed, which must all be the same length, in each window
+func Compute(group string, packed []distance.Packed, o Options) []Stats {
+
+	threads := o.Threads
+	if threads < 1 {
+		threads = runtime.NumCPU()
+	}
+
+	width := 0
+	if len(packed) > 0 {
+		width = packed[0].Len
+	}
+	ws := windows(width, o)
+
+	an := 0.0
+	for i := 1; i < len(packed); i++ {
+		an += 1 / float64(i)
+	}
+
+	pis := pi(packed, ws, threads)
+	stats := make([]Stats, len(ws))
+	for k, w := range ws {
+		s := Stats{Group: group, Start: w[0] + 1, End: w[1], Records: len(packed), Pi: pis[k], ThetaW: math.NaN()}
+		s.Segregating = distance.Segregating(packed, w[0], w[1])
+		if an > 0 && w[1] > w[0] {
+			s.ThetaW = float64(s.Segregating) / an / float64(w[1]-w[0])
+		}
+		stats[k] = s
+	}
+
+	return stats
+}
+
+// Diversity calculates the statistics of the alignment msa, for every record together, or if groupOf isn't nil
+// for each group it returns, in the order that they are first seen (records without a group are skipped), and
+// writes a CSV file with the columns group,start,end,records,segregating_sites,pi,theta_w to out. The group is
+// "all" if groupOf is nil
+func Diversity(msa io.Reader, groupOf func(string) (string, bool), out io.Writer, o Options) error {
+
+	if o.Window > 0 && o.Step < 1 {
+		return errors.New("the step between windows must be at least 1")
+	}
+
+	records, err := fastaio.ReadEncodeAlignmentToList(msa, false)
+	if err != nil {
+		return err
+	}
+
+	order := make([]string, 0)
+	groups := make(map[string][]fastaio.EncodedFastaRecord)
+	for _, EFR := range records {
+		group := "all"
+		if groupOf != nil {
+			g, ok := groupOf(EFR.ID)
+			if !ok {
+				summary.Skipped(EFR.ID, "no group")
+				continue
+			}
+			group = g
+		}
+		if _, ok := groups[group]; !ok {
+			order = append(order, group)
+		}
+		groups[group] = append(groups[group], EFR)
+	}
+	if len(order) == 0 {
+		return errors.New("no records to calculate diversity from")
+	}
+
+	var sb strings.Builder
+	sb.WriteString("group,start,end,records,segregating_sites,pi,theta_w\n")
+	for _, group := range order {
+		for _, s := range Compute(group, distance.PackRecords(groups[group]), o) {
+			sb.WriteString(s.Group + "," + strconv.Itoa(s.Start) + "," + strconv.Itoa(s.End) + "," + strconv.Itoa(s.Records) + "," +
+				strconv.Itoa(s.Segregating) + "," + encoding.FormatFloat(s.Pi, 'f', 6) + "," + encoding.FormatFloat(s.ThetaW, 'f', 6) + "\n")
+		}
+	}
+
+	_, err = out.Write([]byte(sb.String()))
+	return err
+}
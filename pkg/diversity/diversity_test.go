@@ -0,0 +1,41 @@
+package diversity
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestDiversity(t *testing.T) {
+	// columns 2 and 6 are segregating; a and b differ at 1 of 6 sites, a and c at 1 of 5 (c has an N), b and c at 2 of 5
+	msa := ">a\nACGTAC\n>b\nAGGTAC\n>c\nACGTNT\n"
+
+	out := new(bytes.Buffer)
+	if err := Diversity(strings.NewReader(msa), nil, out, Options{Threads: 2}); err != nil {
+		t.Fatal(err)
+	}
+	// pi = (1/6 + 1/5 + 2/5) / 3, theta_w = 2 / 1.5 / 6
+	want := "group,start,end,records,segregating_sites,pi,theta_w\n" +
+		"all,1,6,3,2,0.255556,0.222222\n"
+	if out.String() != want {
+		t.Errorf("problem in TestDiversity(): got\n%s", out.String())
+	}
+
+	groups := map[string]string{"a": "x", "b": "x", "c": "y"}
+	groupOf := func(id string) (string, bool) {
+		g, ok := groups[id]
+		return g, ok
+	}
+	out.Reset()
+	if err := Diversity(strings.NewReader(msa), groupOf, out, Options{Window: 4, Step: 3}); err != nil {
+		t.Fatal(err)
+	}
+	want = "group,start,end,records,segregating_sites,pi,theta_w\n" +
+		"x,1,4,2,1,0.250000,0.250000\n" +
+		"x,4,6,2,0,0.000000,0.000000\n" +
+		"y,1,4,1,0,,\n" +
+		"y,4,6,1,0,,\n"
+	if out.String() != want {
+		t.Errorf("problem in TestDiversity(): got\n%s", out.String())
+	}
+}
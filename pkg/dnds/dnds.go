@@ -0,0 +1,244 @@
+/*
+Package dnds implements a first-pass screen for selection: the numbers of
+synonymous and non-synonymous differences from the reference in each CDS of
+each sequence in an alignment, and the numbers of synonymous and
+non-synonymous sites, as in Nei and Gojobori (1986), to give pN and pS.
+*/
+package dnds
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/alphabet"
+	"github.com/virus-evolution/gofasta/pkg/encoding"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+// Counts are the synonymous and non-synonymous sites and differences over the Codons of a CDS that were compared
+type Counts struct {
+	Codons      int
+	SynSites    float64
+	NonsynSites float64
+	SynDiffs    float64
+	NonsynDiffs float64
+}
+
+// Add adds the counts in o to c
+func (c *Counts) Add(o Counts) {
+	c.Codons += o.Codons
+	c.SynSites += o.SynSites
+	c.NonsynSites += o.NonsynSites
+	c.SynDiffs += o.SynDiffs
+	c.NonsynDiffs += o.NonsynDiffs
+}
+
+// PN returns the proportion of non-synonymous sites that differ, or NaN if there are none
+func (c Counts) PN() float64 {
+	if c.NonsynSites == 0 {
+		return math.NaN()
+	}
+	return c.NonsynDiffs / c.NonsynSites
+}
+
+// PS returns the proportion of synonymous sites that differ, or NaN if there are none
+func (c Counts) PS() float64 {
+	if c.SynSites == 0 {
+		return math.NaN()
+	}
+	return c.SynDiffs / c.SynSites
+}
+
+// code holds the translation of every codon, and the number of synonymous and non-synonymous sites in it
+type code struct {
+	aa    map[string]byte
+	sites map[string][2]float64
+}
+
+func newCode() code {
+	c := code{aa: make(map[string]byte, 64), sites: make(map[string][2]float64, 64)}
+	for codon, aa := range alphabet.MakeCodonDict() {
+		if len(codon) == 3 && strings.Trim(codon, "ACGT") == "" {
+			c.aa[codon] = aa[0]
+		}
+	}
+	for codon, aa := range c.aa {
+		var s, n float64
+		b := []byte(codon)
+		for i := 0; i < 3; i++ {
+			orig := b[i]
+			for _, nuc := range []byte("ACGT") {
+				if nuc == orig {
+					continue
+				}
+				b[i] = nuc
+				// changes to a stop codon aren't counted as either kind of site
+				switch c.aa[string(b)] {
+				case '*':
+				case aa:
+					s += 1.0 / 3
+				default:
+					n += 1.0 / 3
+				}
+			}
+			b[i] = orig
+		}
+		c.sites[codon] = [2]float64{s, n}
+	}
+	return c
+}
+
+// permutations returns every ordering of xs
+func permutations(xs []int) [][]int {
+	if len(xs) <= 1 {
+		return [][]int{append([]int{}, xs...)}
+	}
+	out := make([][]int, 0)
+	for i := range xs {
+		rest := make([]int, 0, len(xs)-1)
+		rest = append(rest, xs[:i]...)
+		rest = append(rest, xs[i+1:]...)
+		for _, p := range permutations(rest) {
+			out = append(out, append([]int{xs[i]}, p...))
+		}
+	}
+	return out
+}
+
+// differences returns the numbers of synonymous and non-synonymous differences between two codons, averaged
+// over the orders in which the differing sites could have changed, leaving out orders that go through a stop
+// codon. ok is false if every order does
+func (c code) differences(from, to string) (float64, float64, bool) {
+	diff := make([]int, 0, 3)
+	for i := 0; i < 3; i++ {
+		if from[i] != to[i] {
+			diff = append(diff, i)
+		}
+	}
+	if len(diff) == 0 {
+		return 0, 0, true
+	}
+	var s, n float64
+	paths := 0
+	for _, order := range permutations(diff) {
+		b := []byte(from)
+		var ps, pn float64
+		valid := true
+		for k, i := range order {
+			prev := c.aa[string(b)]
+			b[i] = to[i]
+			next := c.aa[string(b)]
+			if next == '*' && k < len(order)-1 {
+				valid = false
+				break
+			}
+			if prev == next {
+				ps++
+			} else {
+				pn++
+			}
+		}
+		if valid {
+			s += ps
+			n += pn
+			paths++
+		}
+	}
+	if paths == 0 {
+		return 0, 0, false
+	}
+	return s / float64(paths), n / float64(paths), true
+}
+
+// count compares the codons of region r in seq to those in ref. Codons that aren't unambiguous in both, and stop
+// codons in the reference, are skipped. Sites are counted on the reference codon
+func (c code) count(seq, ref string, r variants.Region) Counts {
+	var counts Counts
+	query, reference := make([]byte, 3), make([]byte, 3)
+	for i := 0; i+3 <= len(r.Positions); i += 3 {
+		for j := 0; j < 3; j++ {
+			p := r.Positions[i+j] - 1
+			query[j], reference[j] = seq[p], ref[p]
+		}
+		q, rc := strings.ToUpper(string(query)), string(reference)
+		if r.Strand == -1 {
+			q, rc = alphabet.Complement(q), alphabet.Complement(rc)
+		}
+		refAA, ok := c.aa[rc]
+		if !ok || refAA == '*' {
+			continue
+		}
+		if _, ok := c.aa[q]; !ok {
+			continue
+		}
+		s, n, ok := c.differences(rc, q)
+		if !ok {
+			continue
+		}
+		counts.Codons++
+		counts.SynSites += c.sites[rc][0]
+		counts.NonsynSites += c.sites[rc][1]
+		counts.SynDiffs += s
+		counts.NonsynDiffs += n
+	}
+	return counts
+}
+
+// line returns the CSV fields codons,syn_sites,nonsyn_sites,syn_diffs,nonsyn_diffs,pn,ps,pn_ps for c
+func (c Counts) line() string {
+	f := func(x float64) string { return encoding.FormatFloat(x, 'f', 4) }
+	return strconv.Itoa(c.Codons) + "," + f(c.SynSites) + "," + f(c.NonsynSites) + "," + f(c.SynDiffs) + "," + f(c.NonsynDiffs) + "," +
+		f(c.PN()) + "," + f(c.PS()) + "," + f(c.PN()/c.PS())
+}
+
+// DNDS counts the synonymous and non-synonymous differences from ref in every CDS in regions of every record in
+// the alignment msa, which must be in the reference's coordinates, and writes a CSV file with the columns
+// record,gene,codons,syn_sites,nonsyn_sites,syn_diffs,nonsyn_diffs,pn,ps,pn_ps to out. If aggregateOut isn't
+// nil, the counts summed over every record are written to it, with the columns
+// gene,records,codons,syn_sites,nonsyn_sites,syn_diffs,nonsyn_diffs,pn,ps,pn_ps
+func DNDS(ctx context.Context, msa io.Reader, ref string, regions []variants.Region, out, aggregateOut io.Writer) error {
+
+	ref = strings.ToUpper(ref)
+	c := newCode()
+	totals := make([]Counts, len(regions))
+	records := 0
+
+	if _, err := out.Write([]byte("record,gene,codons,syn_sites,nonsyn_sites,syn_diffs,nonsyn_diffs,pn,ps,pn_ps\n")); err != nil {
+		return err
+	}
+
+	err := fastaio.EachAlignedRecord(ctx, msa, func(FR fastaio.FastaRecord) error {
+		if len(FR.Seq) != len(ref) {
+			return errors.New(FR.ID + " is not the same length as the reference")
+		}
+		var sb strings.Builder
+		for k, r := range regions {
+			counts := c.count(FR.Seq, ref, r)
+			totals[k].Add(counts)
+			sb.WriteString(FR.ID + "," + r.Name + "," + counts.line() + "\n")
+		}
+		records++
+		_, err := out.Write([]byte(sb.String()))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if aggregateOut == nil {
+		return nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("gene,records,codons,syn_sites,nonsyn_sites,syn_diffs,nonsyn_diffs,pn,ps,pn_ps\n")
+	for k, r := range regions {
+		sb.WriteString(r.Name + "," + strconv.Itoa(records) + "," + totals[k].line() + "\n")
+	}
+	_, err = aggregateOut.Write([]byte(sb.String()))
+	return err
+}
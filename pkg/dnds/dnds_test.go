@@ -0,0 +1,75 @@
+package dnds
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+func approx(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestSites(t *testing.T) {
+	c := newCode()
+	if s := c.sites["ATG"]; !approx(s[0], 0) || !approx(s[1], 3) {
+		t.Errorf("problem in TestSites(): ATG has %v sites", s)
+	}
+	if s := c.sites["TTT"]; !approx(s[0], 1.0/3) || !approx(s[1], 8.0/3) {
+		t.Errorf("problem in TestSites(): TTT has %v sites", s)
+	}
+	// TGG (W) can only change to a stop codon at two sites
+	if s := c.sites["TGG"]; !approx(s[0]+s[1], 3-2.0/3) {
+		t.Errorf("problem in TestSites(): TGG has %v sites", s)
+	}
+}
+
+func TestDifferences(t *testing.T) {
+	c := newCode()
+	for _, tc := range []struct {
+		from, to string
+		s, n     float64
+		ok       bool
+	}{
+		{"GAT", "GAC", 1, 0, true},
+		{"GAT", "GAA", 0, 1, true},
+		{"AAA", "AGG", 1, 1, true},
+		// TTT -> TAT -> TAA and TTT -> TTA -> TAA are both non-synonymous twice
+		{"TTT", "TAA", 0, 2, true},
+		// TGA is a stop either way from TGG to AGA, via AGG, where it is R to R, or via TGA
+		{"TGG", "AGA", 1, 1, true},
+	} {
+		s, n, ok := c.differences(tc.from, tc.to)
+		if !approx(s, tc.s) || !approx(n, tc.n) || ok != tc.ok {
+			t.Errorf("problem in TestDifferences(): %s -> %s gave %f, %f, %v", tc.from, tc.to, s, n, ok)
+		}
+	}
+}
+
+func TestDNDS(t *testing.T) {
+	//     ATG GAT TTT AAA
+	ref := "ATGGATTTTAAAC"
+	regions := []variants.Region{{Name: "g", Start: 1, Stop: 12, Strand: 1, Positions: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}}}
+	msa := ">a\nATGGACTTTAAAC\n>b\nATGGAATTNAAAT\n"
+
+	out := new(bytes.Buffer)
+	aggregate := new(bytes.Buffer)
+	if err := DNDS(context.Background(), strings.NewReader(msa), ref, regions, out, aggregate); err != nil {
+		t.Fatal(err)
+	}
+	// synonymous + non-synonymous sites: ATG 0 + 3, GAT 1/3 + 8/3, TTT 1/3 + 8/3, AAA 1/3 + 7/3 (TAA is a stop)
+	want := "record,gene,codons,syn_sites,nonsyn_sites,syn_diffs,nonsyn_diffs,pn,ps,pn_ps\n" +
+		"a,g,4,1.0000,10.6667,1.0000,0.0000,0.0000,1.0000,0.0000\n" +
+		"b,g,3,0.6667,8.0000,0.0000,1.0000,0.1250,0.0000,\n"
+	if out.String() != want {
+		t.Errorf("problem in TestDNDS(): got\n%s", out.String())
+	}
+	if aggregate.String() != "gene,records,codons,syn_sites,nonsyn_sites,syn_diffs,nonsyn_diffs,pn,ps,pn_ps\n"+
+		"g,2,7,1.6667,18.6667,1.0000,1.0000,0.0536,0.6000,0.0893\n" {
+		t.Errorf("problem in TestDNDS(): got\n%s", aggregate.String())
+	}
+}
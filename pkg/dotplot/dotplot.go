@@ -0,0 +1,191 @@
+/*
+Package dotplot implements the data for a dotplot of two sequences: the runs
+of matching k-mers between them on either strand, merged into segments along
+each diagonal, so that structural differences such as large indels,
+duplications and inversions between the two can be seen at a glance.
+*/
+package dotplot
+
+import (
+	"errors"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+// Options controls which matches are reported. K-mers that occur more than MaxOccurrences times in a are
+// ignored, so that low-complexity sequence doesn't swamp the plot, and segments shorter than MinLength bases are left
+// out. With Forward, only matches on the forward strand of b are found
+type Options struct {
+	K              int
+	MinLength      int
+	MaxOccurrences int
+	Forward        bool
+}
+
+// Segment is a run of consecutive matching k-mers along one diagonal. The coordinates are 1-based and
+// inclusive, and the segment runs from (AStart, BStart) to (AEnd, BEnd): on the reverse strand (Strand -1), b
+// runs backwards, so BStart is greater than BEnd
+type Segment struct {
+	AStart int
+	AEnd   int
+	BStart int
+	BEnd   int
+	Strand int
+}
+
+// Length is the number of bases of a in the segment
+func (s Segment) Length() int {
+	return s.AEnd - s.AStart + 1
+}
+
+var twoBit = func() [256]int8 {
+	var a [256]int8
+	for i := range a {
+		a[i] = -1
+	}
+	a['A'], a['C'], a['G'], a['T'] = 0, 1, 2, 3
+	a['a'], a['c'], a['g'], a['t'] = 0, 1, 2, 3
+	return a
+}()
+
+// kmers calls f with the packed forward and reverse-complement k-mers at every position of seq (0-based, of
+// the k-mer's first base) whose k-mer has only A, C, G and T
+func kmers(seq string, k int, f func(i int, fwd, rev uint64)) {
+	mask := uint64(1)<<(2*uint(k)) - 1
+	var fwd, rev uint64
+	n := 0
+	for i := 0; i < len(seq); i++ {
+		b := twoBit[seq[i]]
+		if b < 0 {
+			n = 0
+			continue
+		}
+		fwd = (fwd<<2 | uint64(b)) & mask
+		rev = rev>>2 | uint64(3-b)<<(2*uint(k-1))
+		n++
+		if n >= k {
+			f(i-k+1, fwd, rev)
+		}
+	}
+}
+
+// run is a segment that is still being extended: the positions of its first and last k-mers in a and b
+type run struct {
+	a0, b0, a1, b1 int
+}
+
+// Dotplot returns the segments of matching k-mers between a and b, sorted by their position in a and then in b.
+// Gaps are removed first, so coordinates are in the ungapped sequences
+func Dotplot(a, b string, o Options) ([]Segment, error) {
+	if o.K < 1 || o.K > 32 {
+		return nil, errors.New("k must be between 1 and 32")
+	}
+	a = strings.ReplaceAll(a, "-", "")
+	b = strings.ReplaceAll(b, "-", "")
+
+	index := make(map[uint64][]int)
+	kmers(a, o.K, func(i int, fwd, rev uint64) {
+		index[fwd] = append(index[fwd], i)
+	})
+
+	segments := make([]Segment, 0)
+	flush := func(r run, strand int) {
+		s := Segment{AStart: r.a0 + 1, AEnd: r.a1 + o.K, BStart: r.b0 + 1, BEnd: r.b1 + o.K, Strand: strand}
+		if strand == -1 {
+			// the k-mers run backwards along a as b goes forwards
+			s = Segment{AStart: r.a1 + 1, AEnd: r.a0 + o.K, BStart: r.b1 + o.K, BEnd: r.b0 + 1, Strand: strand}
+		}
+		if s.Length() >= o.MinLength {
+			segments = append(segments, s)
+		}
+	}
+
+	// the runs being extended, keyed by diagonal (a - b) on the forward strand and anti-diagonal (a + b) on the reverse
+	fwdRuns := make(map[int]run)
+	revRuns := make(map[int]run)
+	kmers(b, o.K, func(j int, fwd, rev uint64) {
+		if is := index[fwd]; len(is) <= o.MaxOccurrences || o.MaxOccurrences < 1 {
+			for _, i := range is {
+				r, ok := fwdRuns[i-j]
+				if ok && r.b1 == j-1 {
+					r.a1, r.b1 = i, j
+				} else {
+					if ok {
+						flush(r, 1)
+					}
+					r = run{a0: i, b0: j, a1: i, b1: j}
+				}
+				fwdRuns[i-j] = r
+			}
+		}
+		if o.Forward {
+			return
+		}
+		if is := index[rev]; len(is) <= o.MaxOccurrences || o.MaxOccurrences < 1 {
+			for _, i := range is {
+				r, ok := revRuns[i+j]
+				if ok && r.b1 == j-1 {
+					r.a1, r.b1 = i, j
+				} else {
+					if ok {
+						flush(r, -1)
+					}
+					r = run{a0: i, b0: j, a1: i, b1: j}
+				}
+				revRuns[i+j] = r
+			}
+		}
+	})
+	for _, r := range fwdRuns {
+		flush(r, 1)
+	}
+	for _, r := range revRuns {
+		flush(r, -1)
+	}
+
+	sort.Slice(segments, func(i, j int) bool {
+		si, sj := segments[i], segments[j]
+		if si.AStart != sj.AStart {
+			return si.AStart < sj.AStart
+		}
+		if si.BStart != sj.BStart {
+			return si.BStart < sj.BStart
+		}
+		return si.Strand > sj.Strand
+	})
+
+	return segments, nil
+}
+
+// Write writes segments as CSV with the columns a_start,a_end,b_start,b_end,strand,length
+func Write(w io.Writer, segments []Segment) error {
+	var sb strings.Builder
+	sb.WriteString("a_start,a_end,b_start,b_end,strand,length\n")
+	for _, s := range segments {
+		strand := "+"
+		if s.Strand == -1 {
+			strand = "-"
+		}
+		sb.WriteString(strconv.Itoa(s.AStart) + "," + strconv.Itoa(s.AEnd) + "," + strconv.Itoa(s.BStart) + "," + strconv.Itoa(s.BEnd) + "," +
+			strand + "," + strconv.Itoa(s.Length()) + "\n")
+	}
+	_, err := w.Write([]byte(sb.String()))
+	return err
+}
+
+// Plot finds the segments of matching k-mers between the two records (the first is a, the second b) and writes
+// them to out, as Write
+func Plot(records []fastaio.FastaRecord, out io.Writer, o Options) error {
+	if len(records) != 2 {
+		return errors.New("a dotplot needs exactly two sequences")
+	}
+	segments, err := Dotplot(records[0].Seq, records[1].Seq, o)
+	if err != nil {
+		return err
+	}
+	return Write(out, segments)
+}
@@ -0,0 +1,42 @@
+package dotplot
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/alphabet"
+)
+
+func TestDotplot(t *testing.T) {
+	x := "ACGTTGCAAGGCTTAC"
+	y := "GGATCCTAGCATGACA"
+	z := "TTGACCAGTAGGCATC"
+	// b has x, then y inverted, then z after a 5-base insertion
+	a := x + y + z
+	b := x + alphabet.ReverseComplement(y) + "CCCCC" + z
+
+	segments, err := Dotplot(a, b, Options{K: 8, MinLength: 12, MaxOccurrences: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := new(bytes.Buffer)
+	if err := Write(out, segments); err != nil {
+		t.Fatal(err)
+	}
+	want := "a_start,a_end,b_start,b_end,strand,length\n" +
+		"1,16,1,16,+,16\n" +
+		"17,32,32,17,-,16\n" +
+		"33,48,38,53,+,16\n"
+	if out.String() != want {
+		t.Errorf("problem in TestDotplot(): got\n%s", out.String())
+	}
+
+	segments, err = Dotplot(a, b, Options{K: 8, MinLength: 12, MaxOccurrences: 10, Forward: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(segments) != 2 || segments[1].AStart != 33 {
+		t.Errorf("problem in TestDotplot(): got %+v with Forward", segments)
+	}
+}
@@ -0,0 +1,299 @@
+/*
+Package effect implements the annotation of existing lists of SNPs (from
+gofasta snps, or from a VCF file) with their consequences in the protein-coding
+regions of an annotated reference: the gene, codon and amino acid change, and
+whether the change is synonymous.
+*/
+package effect
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/alphabet"
+	"github.com/virus-evolution/gofasta/pkg/summary"
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+// Input formats
+const (
+	FormatSNPs = "snps"
+	FormatVCF  = "vcf"
+)
+
+// Effects
+const (
+	Intergenic    = "intergenic"
+	Synonymous    = "synonymous"
+	Nonsynonymous = "nonsynonymous"
+	StopGained    = "stop_gained"
+	StopLost      = "stop_lost"
+	Ambiguous     = "ambiguous"
+)
+
+// SNP is one nucleotide change at a 1-based reference position
+type SNP struct {
+	Ref      byte
+	Position int
+	Alt      byte
+}
+
+// String returns the SNP in the notation of gofasta snps, e.g. C241T
+func (s SNP) String() string {
+	return string(s.Ref) + strconv.Itoa(s.Position) + string(s.Alt)
+}
+
+// Set is a list of SNPs that are considered together, so that SNPs in the same codon are translated
+// together. Query is the record they are from, if known
+type Set struct {
+	Query string
+	SNPs  []SNP
+}
+
+// Effect is the consequence of one SNP in one CDS (or of an intergenic SNP). Codon is the 1-based codon number,
+// and the codons are on the gene's strand, with every SNP in the set that falls in the codon applied to Alt
+type Effect struct {
+	SNP      SNP
+	Gene     string
+	Codon    int
+	RefCodon string
+	AltCodon string
+	RefAA    string
+	AltAA    string
+	Effect   string
+}
+
+var snpRegex = regexp.MustCompile(`^([A-Za-z])([0-9]+)([A-Za-z])$`)
+
+// ParseSNP parses a SNP in the notation of gofasta snps, e.g. C241T
+func ParseSNP(s string) (SNP, error) {
+	m := snpRegex.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return SNP{}, errors.New("couldn't parse SNP: " + s)
+	}
+	pos, err := strconv.Atoi(m[2])
+	if err != nil || pos < 1 {
+		return SNP{}, errors.New("couldn't parse SNP: " + s)
+	}
+	return SNP{Ref: strings.ToUpper(m[1])[0], Position: pos, Alt: strings.ToUpper(m[3])[0]}, nil
+}
+
+// ReadSNPs reads the output of gofasta snps: either one line per query with the columns query,SNPs (with the
+// SNPs separated by "|"), or, with --aggregate, one line per SNP with the columns SNP,frequency, in which case
+// every SNP is in its own set
+func ReadSNPs(r io.Reader) ([]Set, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	aggregate := false
+	switch {
+	case len(header) >= 2 && header[0] == "query" && header[1] == "SNPs":
+	case len(header) >= 1 && header[0] == "SNP":
+		aggregate = true
+	default:
+		return nil, errors.New("SNPs file should have the columns query,SNPs or SNP,frequency")
+	}
+
+	sets := make([]Set, 0)
+	for {
+		row, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if aggregate {
+			s, err := ParseSNP(row[0])
+			if err != nil {
+				return nil, err
+			}
+			sets = append(sets, Set{SNPs: []SNP{s}})
+			continue
+		}
+		if len(row) < 2 {
+			return nil, errors.New("couldn't parse SNPs line for " + row[0])
+		}
+		set := Set{Query: row[0], SNPs: make([]SNP, 0)}
+		if row[1] != "" {
+			for _, field := range strings.Split(row[1], "|") {
+				s, err := ParseSNP(field)
+				if err != nil {
+					return nil, err
+				}
+				set.SNPs = append(set.SNPs, s)
+			}
+		}
+		sets = append(sets, set)
+	}
+	return sets, nil
+}
+
+// ReadVCF reads the single-nucleotide ALT alleles of every record in a VCF file, each in its own set. Other
+// alleles (indels, MNPs and symbolic alleles) are skipped with a warning
+func ReadVCF(r io.Reader) ([]Set, error) {
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 0), 64*1024*1024)
+	sets := make([]Set, 0)
+	skipped, lineN := 0, 0
+	for s.Scan() {
+		lineN++
+		line := s.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) < 5 {
+			return nil, errors.New("couldn't parse VCF line " + strconv.Itoa(lineN) + ": fewer than five tab-separated columns")
+		}
+		pos, err := strconv.Atoi(fields[1])
+		if err != nil || pos < 1 {
+			return nil, errors.New("couldn't parse VCF line " + strconv.Itoa(lineN) + ": bad POS")
+		}
+		ref := strings.ToUpper(fields[3])
+		for _, alt := range strings.Split(strings.ToUpper(fields[4]), ",") {
+			if len(ref) != 1 || len(alt) != 1 || alt == "*" || alt == "." {
+				skipped++
+				continue
+			}
+			sets = append(sets, Set{SNPs: []SNP{{Ref: ref[0], Position: pos, Alt: alt[0]}}})
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if skipped > 0 {
+		summary.Warn(strconv.Itoa(skipped) + " VCF alleles that aren't single-nucleotide changes were skipped")
+	}
+	return sets, nil
+}
+
+// annotator holds the CDS, and where each reference position is in each one
+type annotator struct {
+	ref     string
+	regions []variants.Region
+	index   []map[int]int
+	codons  map[string]string
+}
+
+func newAnnotator(ref string, regions []variants.Region) annotator {
+	a := annotator{ref: strings.ToUpper(ref), regions: regions, index: make([]map[int]int, len(regions)), codons: alphabet.MakeCodonDict()}
+	for k, r := range regions {
+		a.index[k] = make(map[int]int, len(r.Positions))
+		for i, p := range r.Positions {
+			if _, ok := a.index[k][p]; !ok {
+				a.index[k][p] = i
+			}
+		}
+	}
+	return a
+}
+
+func (a annotator) translate(codon string) string {
+	if aa, ok := a.codons[codon]; ok {
+		return aa
+	}
+	return "X"
+}
+
+// annotate returns the effects of every SNP in set
+func (a annotator) annotate(set Set) []Effect {
+	alts := make(map[int]byte, len(set.SNPs))
+	for _, s := range set.SNPs {
+		alts[s.Position] = s.Alt
+	}
+
+	effects := make([]Effect, 0, len(set.SNPs))
+	for _, s := range set.SNPs {
+		found := false
+		for k, r := range a.regions {
+			i, ok := a.index[k][s.Position]
+			if !ok {
+				continue
+			}
+			c := i / 3
+			if 3*c+3 > len(r.Positions) {
+				continue
+			}
+			found = true
+			refCodon, altCodon := make([]byte, 3), make([]byte, 3)
+			for j := 0; j < 3; j++ {
+				p := r.Positions[3*c+j]
+				refCodon[j] = a.ref[p-1]
+				altCodon[j] = refCodon[j]
+				if alt, ok := alts[p]; ok {
+					altCodon[j] = alt
+				}
+			}
+			e := Effect{SNP: s, Gene: r.Name, Codon: c + 1, RefCodon: string(refCodon), AltCodon: string(altCodon)}
+			if r.Strand == -1 {
+				e.RefCodon, e.AltCodon = alphabet.Complement(e.RefCodon), alphabet.Complement(e.AltCodon)
+			}
+			e.RefAA, e.AltAA = a.translate(e.RefCodon), a.translate(e.AltCodon)
+			switch {
+			case e.AltAA == "X" && e.RefAA != "X":
+				e.Effect = Ambiguous
+			case e.RefAA == e.AltAA:
+				e.Effect = Synonymous
+			case e.AltAA == "*":
+				e.Effect = StopGained
+			case e.RefAA == "*":
+				e.Effect = StopLost
+			default:
+				e.Effect = Nonsynonymous
+			}
+			effects = append(effects, e)
+		}
+		if !found {
+			effects = append(effects, Effect{SNP: s, Effect: Intergenic})
+		}
+	}
+	return effects
+}
+
+// Annotate finds the effects of the SNPs in every set in the CDS in regions, which are annotated on ref, and
+// writes a CSV file with the columns query,snp,gene,codon,ref_codon,alt_codon,aa_change,effect to out, with one
+// line for every SNP in every CDS it is in (or one line if it is in none). A SNP whose reference allele doesn't
+// match ref is an error
+func Annotate(sets []Set, ref string, regions []variants.Region, out io.Writer) error {
+
+	a := newAnnotator(ref, regions)
+
+	if _, err := out.Write([]byte("query,snp,gene,codon,ref_codon,alt_codon,aa_change,effect\n")); err != nil {
+		return err
+	}
+
+	for _, set := range sets {
+		for _, s := range set.SNPs {
+			if s.Position > len(a.ref) {
+				return errors.New("SNP " + s.String() + " is beyond the end of the reference")
+			}
+			if a.ref[s.Position-1] != s.Ref {
+				return errors.New("the reference allele of SNP " + s.String() + " doesn't match the reference (" + a.ref[s.Position-1:s.Position] + ")")
+			}
+		}
+		var sb strings.Builder
+		for _, e := range a.annotate(set) {
+			sb.WriteString(set.Query + "," + e.SNP.String() + "," + e.Gene + ",")
+			if e.Effect != Intergenic {
+				sb.WriteString(strconv.Itoa(e.Codon) + "," + e.RefCodon + "," + e.AltCodon + "," + e.RefAA + strconv.Itoa(e.Codon) + e.AltAA)
+			} else {
+				sb.WriteString(",,,")
+			}
+			sb.WriteString("," + e.Effect + "\n")
+		}
+		if _, err := out.Write([]byte(sb.String())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
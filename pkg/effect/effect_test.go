@@ -0,0 +1,89 @@
+package effect
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+func positions(start, stop int, reverse bool) []int {
+	ps := make([]int, 0)
+	for p := start; p <= stop; p++ {
+		ps = append(ps, p)
+	}
+	if reverse {
+		for i, j := 0, len(ps)-1; i < j; i, j = i+1, j-1 {
+			ps[i], ps[j] = ps[j], ps[i]
+		}
+	}
+	return ps
+}
+
+const reference = "AATGGATTGAAACCCATTAAA" // 21 bases
+
+var regions = []variants.Region{
+	// ATG GAT TGA: M D *
+	{Name: "fwd", Start: 2, Stop: 10, Strand: 1, Positions: positions(2, 10, false)},
+	// on the reverse strand, positions 19-11 complemented: TAA TGG GTT: * W V
+	{Name: "rev", Start: 11, Stop: 19, Strand: -1, Positions: positions(11, 19, true)},
+}
+
+func TestAnnotate(t *testing.T) {
+	sets := []Set{
+		{Query: "q1", SNPs: []SNP{{'G', 5, 'A'}, {'T', 7, 'C'}, {'A', 20, 'G'}}},
+		{Query: "q2", SNPs: []SNP{{'G', 9, 'C'}, {'A', 6, 'G'}}},
+		{Query: "q3", SNPs: []SNP{{'T', 8, 'A'}, {'T', 18, 'C'}, {'C', 14, 'Y'}}},
+	}
+	out := new(bytes.Buffer)
+	if err := Annotate(sets, reference, regions, out); err != nil {
+		t.Fatal(err)
+	}
+	want := "query,snp,gene,codon,ref_codon,alt_codon,aa_change,effect\n" +
+		// G5A and T7C are both in codon 2 of fwd, GAT>AAC
+		"q1,G5A,fwd,2,GAT,AAC,D2N,nonsynonymous\n" +
+		"q1,T7C,fwd,2,GAT,AAC,D2N,nonsynonymous\n" +
+		"q1,A20G,,,,,,intergenic\n" +
+		"q2,G9C,fwd,3,TGA,TCA,*3S,stop_lost\n" +
+		"q2,A6G,fwd,2,GAT,GGT,D2G,nonsynonymous\n" +
+		"q3,T8A,fwd,3,TGA,AGA,*3R,stop_lost\n" +
+		"q3,T18C,rev,1,TAA,TGA,*1*,synonymous\n" +
+		"q3,C14Y,rev,2,TGG,TGR,W2X,ambiguous\n"
+	if out.String() != want {
+		t.Errorf("problem in TestAnnotate(): got\n%s", out.String())
+	}
+
+	if err := Annotate([]Set{{SNPs: []SNP{{'C', 2, 'T'}}}}, reference, regions, out); err == nil {
+		t.Errorf("problem in TestAnnotate(): expected an error for a reference allele that doesn't match")
+	}
+}
+
+func TestRead(t *testing.T) {
+	sets, err := ReadSNPs(strings.NewReader("query,SNPs\nq1,G5A|T7C\nq2,\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sets) != 2 || sets[0].Query != "q1" || len(sets[0].SNPs) != 2 || sets[0].SNPs[1] != (SNP{'T', 7, 'C'}) || len(sets[1].SNPs) != 0 {
+		t.Errorf("problem in TestRead(): got %+v", sets)
+	}
+
+	sets, err = ReadSNPs(strings.NewReader("SNP,frequency\nG5A,0.5\nT7C,0.25\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sets) != 2 || len(sets[1].SNPs) != 1 || sets[1].SNPs[0] != (SNP{'T', 7, 'C'}) {
+		t.Errorf("problem in TestRead(): got %+v", sets)
+	}
+
+	vcf := "##fileformat=VCFv4.2\n#CHROM\tPOS\tID\tREF\tALT\n" +
+		"chr\t5\t.\tG\tA,C\n" +
+		"chr\t7\t.\tTA\tT\n"
+	sets, err = ReadVCF(strings.NewReader(vcf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sets) != 2 || sets[0].SNPs[0] != (SNP{'G', 5, 'A'}) || sets[1].SNPs[0] != (SNP{'G', 5, 'C'}) {
+		t.Errorf("problem in TestRead(): got %+v", sets)
+	}
+}
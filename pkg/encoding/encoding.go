@@ -4,9 +4,17 @@ and bitwise encodings, for fast sequence comparison.
 
 The coding scheme is Emmanual Paradis' design, which is described here:
 http://ape-package.ird.fr/misc/BitLevelCodingScheme.html
+
+It also has the helpers that the commands writing per-site statistics share, for telling
+unambiguous nucleotides apart and for formatting numbers as CSV fields.
 */
 package encoding
 
+import (
+	"math"
+	"strconv"
+)
+
 func DecodeToString(bs []byte) string {
 	da := MakeDecodingArray()
 	s := ""
@@ -217,3 +225,16 @@ func MakeDecodingArray() [256]string {
 
 	return byteArray
 }
+
+// Certain returns true if c is an unambiguous (upper case) nucleotide
+func Certain(c byte) bool {
+	return c == 'A' || c == 'C' || c == 'G' || c == 'T'
+}
+
+// FormatFloat formats x for a CSV field as strconv.FormatFloat does, with NaN and infinities as an empty field
+func FormatFloat(x float64, fmt byte, prec int) string {
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return ""
+	}
+	return strconv.FormatFloat(x, fmt, prec, 64)
+}
@@ -1,6 +1,7 @@
 package encoding
 
 import (
+	"math"
 	"strings"
 	"testing"
 )
@@ -231,3 +232,35 @@ func TestScoring(t *testing.T) {
 		}
 	}
 }
+
+func TestCertain(t *testing.T) {
+	for _, c := range []byte("ACGT") {
+		if !Certain(c) {
+			t.Errorf("problem in TestCertain(): %c isn't certain", c)
+		}
+	}
+	for _, c := range []byte("acgtRNn-?") {
+		if Certain(c) {
+			t.Errorf("problem in TestCertain(): %c is certain", c)
+		}
+	}
+}
+
+func TestFormatFloat(t *testing.T) {
+	for _, tc := range []struct {
+		x    float64
+		fmt  byte
+		prec int
+		want string
+	}{
+		{0.5, 'f', 4, "0.5000"},
+		{1.0 / 3.0, 'g', 6, "0.333333"},
+		{math.NaN(), 'f', 4, ""},
+		{math.Inf(1), 'f', 4, ""},
+		{math.Inf(-1), 'f', 4, ""},
+	} {
+		if got := FormatFloat(tc.x, tc.fmt, tc.prec); got != tc.want {
+			t.Errorf("problem in TestFormatFloat(): FormatFloat(%v, %c, %d) is %q, expected %q", tc.x, tc.fmt, tc.prec, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+/*
+Package entropy implements routines to summarise the variability of each
+column of an alignment: its Shannon entropy, allele counts and the fraction
+of gaps and Ns, and to find columns that are hypervariable or carry little
+information, for masking.
+*/
+package entropy
+
+import (
+	"context"
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/virus-evolution/gofasta/pkg/alignment"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/mask"
+)
+
+// Column holds the counts and summaries for one alignment column. Position is 1-based. N counts N and ?,
+// and Ambiguous counts the other IUPAC ambiguity codes. Entropy is in bits
+type Column struct {
+	Position    int
+	A           int
+	C           int
+	G           int
+	T           int
+	Gaps        int
+	N           int
+	Ambiguous   int
+	Entropy     float64
+	GapFraction float64
+	NFraction   float64
+}
+
+// shannon returns the entropy in bits of the distribution given by counts
+func shannon(counts ...int) float64 {
+	total := 0
+	for _, c := range counts {
+		total += c
+	}
+	if total == 0 {
+		return 0
+	}
+	h := 0.0
+	for _, c := range counts {
+		if c > 0 {
+			p := float64(c) / float64(total)
+			h -= p * math.Log2(p)
+		}
+	}
+	// avoid writing -0
+	return math.Abs(h)
+}
+
+// Columns counts the nucleotides in every column of the alignment in msa. Entropy is calculated from the
+// counts of A, C, G and T, plus gaps if gapsAsState is true; ambiguous nucleotides are ignored
+func Columns(ctx context.Context, msa io.Reader, gapsAsState bool) ([]Column, error) {
+
+	var counts []alignment.Counts
+	records := 0
+
+	// read as text rather than encoded, so that RNA (U) is counted as T
+	err := fastaio.EachAlignedRecord(ctx, msa, func(FR fastaio.FastaRecord) error {
+		if counts == nil {
+			counts = make([]alignment.Counts, len(FR.Seq))
+		}
+		records++
+		for i := 0; i < len(FR.Seq); i++ {
+			c := &counts[i]
+			switch FR.Seq[i] {
+			case 'A':
+				c.A++
+			case 'C':
+				c.C++
+			case 'G':
+				c.G++
+			case 'T', 'U':
+				c.T++
+			case '-':
+				c.Gaps++
+			case 'N', '?':
+				c.N++
+			default:
+				c.Ambiguous++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return columns(counts, records, gapsAsState), nil
+}
+
+// FromAlignment summarises every column of a, as Columns does
+func FromAlignment(a *alignment.Alignment, gapsAsState bool) []Column {
+	return columns(a.Frequencies(), a.Len(), gapsAsState)
+}
+
+// columns summarises the counts of each column of an alignment of records records
+func columns(counts []alignment.Counts, records int, gapsAsState bool) []Column {
+	cols := make([]Column, len(counts))
+	for i, c := range counts {
+		col := Column{Position: i + 1, A: c.A, C: c.C, G: c.G, T: c.T, Gaps: c.Gaps, N: c.N, Ambiguous: c.Ambiguous}
+		if gapsAsState {
+			col.Entropy = shannon(c.A, c.C, c.G, c.T, c.Gaps)
+		} else {
+			col.Entropy = shannon(c.A, c.C, c.G, c.T)
+		}
+		col.GapFraction = float64(col.Gaps) / float64(records)
+		col.NFraction = float64(col.N) / float64(records)
+		cols[i] = col
+	}
+	return cols
+}
+
+// WriteTable writes a CSV file with the columns position,A,C,G,T,gaps,N,ambiguous,entropy,gap_fraction,n_fraction
+func WriteTable(w io.Writer, cols []Column) error {
+	_, err := w.Write([]byte("position,A,C,G,T,gaps,N,ambiguous,entropy,gap_fraction,n_fraction\n"))
+	if err != nil {
+		return err
+	}
+	for _, c := range cols {
+		_, err = w.Write([]byte(strconv.Itoa(c.Position) + "," + strconv.Itoa(c.A) + "," + strconv.Itoa(c.C) + "," +
+			strconv.Itoa(c.G) + "," + strconv.Itoa(c.T) + "," + strconv.Itoa(c.Gaps) + "," + strconv.Itoa(c.N) + "," +
+			strconv.Itoa(c.Ambiguous) + "," + strconv.FormatFloat(c.Entropy, 'f', 4, 64) + "," +
+			strconv.FormatFloat(c.GapFraction, 'f', 4, 64) + "," + strconv.FormatFloat(c.NFraction, 'f', 4, 64) + "\n"))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Criteria are the thresholds above which a column is masked. A threshold of 0 is not used
+type Criteria struct {
+	MinEntropy     float64 // mask columns with at least this entropy
+	MaxGapFraction float64 // mask columns with more than this fraction of gaps
+	MaxNFraction   float64 // mask columns with more than this fraction of Ns
+}
+
+func (cr Criteria) masks(c Column) bool {
+	return (cr.MinEntropy > 0 && c.Entropy >= cr.MinEntropy) ||
+		(cr.MaxGapFraction > 0 && c.GapFraction > cr.MaxGapFraction) ||
+		(cr.MaxNFraction > 0 && c.NFraction > cr.MaxNFraction)
+}
+
+// Sites returns the columns that meet any of the criteria, with runs of adjacent columns merged
+func Sites(cols []Column, cr Criteria) []mask.Site {
+	sites := make([]mask.Site, 0)
+	for i, c := range cols {
+		if !cr.masks(c) {
+			continue
+		}
+		if n := len(sites); n > 0 && sites[n-1].End == i {
+			sites[n-1].End = i + 1
+			continue
+		}
+		sites = append(sites, mask.Site{Start: i, End: i + 1})
+	}
+	return sites
+}
+
+// WriteBED writes sites to w in BED format, with chrom in the first column, so that they can be used
+// with gofasta mask
+func WriteBED(w io.Writer, sites []mask.Site, chrom string) error {
+	for _, s := range sites {
+		_, err := w.Write([]byte(chrom + "\t" + strconv.Itoa(s.Start) + "\t" + strconv.Itoa(s.End) + "\n"))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package entropy
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/alignment"
+	"github.com/virus-evolution/gofasta/pkg/mask"
+)
+
+var entropyMSA = `>s1
+AAC-N
+>s2
+ACC-N
+>s3
+AGCTR
+>s4
+ATC-A
+`
+
+func TestColumns(t *testing.T) {
+	cols, err := Columns(context.Background(), strings.NewReader(entropyMSA), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cols) != 5 {
+		t.Fatalf("problem in TestColumns(): %d columns", len(cols))
+	}
+	if cols[0].Entropy != 0 || cols[0].A != 4 {
+		t.Errorf("problem in TestColumns(), column 1: %v", cols[0])
+	}
+	if math.Abs(cols[1].Entropy-2) > 1e-9 {
+		t.Errorf("problem in TestColumns(), column 2: %v", cols[1])
+	}
+	if cols[3].GapFraction != 0.75 || cols[3].Entropy != 0 {
+		t.Errorf("problem in TestColumns(), column 4: %v", cols[3])
+	}
+	if cols[4].N != 2 || cols[4].Ambiguous != 1 || cols[4].NFraction != 0.5 {
+		t.Errorf("problem in TestColumns(), column 5: %v", cols[4])
+	}
+
+	cols, err = Columns(context.Background(), strings.NewReader(entropyMSA), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(cols[3].Entropy-0.811278) > 1e-6 {
+		t.Errorf("problem in TestColumns() with gaps, column 4: %v", cols[3])
+	}
+}
+
+func TestSites(t *testing.T) {
+	cols, err := Columns(context.Background(), strings.NewReader(entropyMSA), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sites := Sites(cols, Criteria{MinEntropy: 1})
+	if len(sites) != 1 || sites[0] != (mask.Site{Start: 1, End: 2}) {
+		t.Errorf("problem in TestSites(): %v", sites)
+	}
+	sites = Sites(cols, Criteria{MinEntropy: 1, MaxGapFraction: 0.5, MaxNFraction: 0.25})
+	if len(sites) != 2 || sites[1] != (mask.Site{Start: 3, End: 5}) {
+		t.Errorf("problem in TestSites(): %v", sites)
+	}
+
+	var bed bytes.Buffer
+	if err = WriteBED(&bed, sites, "MN908947.3"); err != nil {
+		t.Fatal(err)
+	}
+	masks, err := mask.ReadBED(&bed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(masks.Global) != 2 || masks.Global[1] != sites[1] {
+		t.Errorf("problem in TestSites() BED round trip: %v", masks.Global)
+	}
+}
+
+func TestFromAlignment(t *testing.T) {
+	cols, err := Columns(context.Background(), strings.NewReader(entropyMSA), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := alignment.Read(strings.NewReader(entropyMSA))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, col := range FromAlignment(a, true) {
+		if col != cols[i] {
+			t.Errorf("problem in TestFromAlignment(): column %d is %v, want %v", i+1, col, cols[i])
+		}
+	}
+}
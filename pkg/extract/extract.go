@@ -0,0 +1,239 @@
+/*
+Package extract implements routines to pull records out of a fasta format
+file by name or by regular expression, using a samtools-style .fai index
+when one is available.
+*/
+package extract
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/summary"
+)
+
+// FaiEntry is one line of a samtools faidx index
+type FaiEntry struct {
+	Name      string
+	Length    int   // number of bases in the sequence
+	Offset    int64 // byte offset of the first base
+	LineBases int   // bases per line
+	LineWidth int   // bytes per line, including the newline
+}
+
+// ReadFai reads a .fai index
+func ReadFai(r io.Reader) (map[string]FaiEntry, error) {
+	index := make(map[string]FaiEntry)
+
+	s := bufio.NewScanner(r)
+	lineN := 0
+	for s.Scan() {
+		lineN++
+		fields := strings.Split(s.Text(), "\t")
+		if len(fields) < 5 {
+			return nil, errors.New("couldn't parse .fai line " + strconv.Itoa(lineN) + ": fewer than five columns")
+		}
+		var e FaiEntry
+		var err error
+		e.Name = fields[0]
+		if e.Length, err = strconv.Atoi(fields[1]); err != nil {
+			return nil, errors.New("couldn't parse .fai line " + strconv.Itoa(lineN) + ": " + err.Error())
+		}
+		if e.Offset, err = strconv.ParseInt(fields[2], 10, 64); err != nil {
+			return nil, errors.New("couldn't parse .fai line " + strconv.Itoa(lineN) + ": " + err.Error())
+		}
+		if e.LineBases, err = strconv.Atoi(fields[3]); err != nil {
+			return nil, errors.New("couldn't parse .fai line " + strconv.Itoa(lineN) + ": " + err.Error())
+		}
+		if e.LineWidth, err = strconv.Atoi(fields[4]); err != nil {
+			return nil, errors.New("couldn't parse .fai line " + strconv.Itoa(lineN) + ": " + err.Error())
+		}
+		index[e.Name] = e
+	}
+
+	return index, s.Err()
+}
+
+// ReadNames reads one name per line from a plain text file. Blank lines are skipped, and only the first
+// whitespace-delimited field of each line is used
+func ReadNames(r io.Reader) ([]string, error) {
+	names := make([]string, 0)
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		names = append(names, fields[0])
+	}
+	return names, s.Err()
+}
+
+// fetchIndexed reads one record using its index entry
+func fetchIndexed(in io.ReadSeeker, e FaiEntry) (string, error) {
+	if e.LineBases == 0 || e.Length == 0 {
+		return "", nil
+	}
+	// the span from the first to the last base, including the newlines in between
+	nbytes := int64((e.Length-1)/e.LineBases)*int64(e.LineWidth) + int64((e.Length-1)%e.LineBases) + 1
+	if _, err := in.Seek(e.Offset, io.SeekStart); err != nil {
+		return "", err
+	}
+	buf := make([]byte, nbytes)
+	if _, err := io.ReadFull(in, buf); err != nil {
+		return "", fmt.Errorf("reading %s using the .fai index: %w", e.Name, err)
+	}
+	buf = bytes.ReplaceAll(buf, []byte("\r"), []byte{})
+	buf = bytes.ReplaceAll(buf, []byte("\n"), []byte{})
+	if len(buf) != e.Length {
+		return "", errors.New("reading " + e.Name + " using the .fai index: wrong length. Is the index out of date?")
+	}
+	return strings.ToUpper(string(buf)), nil
+}
+
+// fetchHeader reads the header line of the record with index entry e, without its >. The header is the line
+// that ends just before the first base, so it is read backwards from there
+func fetchHeader(in io.ReadSeeker, e FaiEntry) (string, error) {
+	line := make([]byte, 0)
+	block := make([]byte, 4096)
+	// the byte before the first base is the header's newline
+	start := e.Offset - 1
+	for {
+		n := int64(len(block))
+		if start < n {
+			n = start
+		}
+		if n <= 0 {
+			break
+		}
+		start -= n
+		if _, err := in.Seek(start, io.SeekStart); err != nil {
+			return "", err
+		}
+		if _, err := io.ReadFull(in, block[:n]); err != nil {
+			return "", fmt.Errorf("reading the header of %s using the .fai index: %w", e.Name, err)
+		}
+		if i := bytes.LastIndexByte(block[:n], '\n'); i != -1 {
+			line = append(append([]byte{}, block[i+1:n]...), line...)
+			break
+		}
+		line = append(append([]byte{}, block[:n]...), line...)
+	}
+	line = bytes.TrimSuffix(line, []byte("\r"))
+	if len(line) > 0 && line[0] == '>' {
+		if fields := strings.Fields(string(line[1:])); len(fields) > 0 && fields[0] == e.Name {
+			return string(line[1:]), nil
+		}
+	}
+	return "", errors.New("reading the header of " + e.Name + " using the .fai index: it isn't the line before the sequence. Is the index out of date?")
+}
+
+// warnMissing reports requested names that weren't found
+func warnMissing(names []string, found map[string]bool) {
+	for _, name := range names {
+		if !found[name] {
+			summary.Warn("couldn't find " + name)
+		}
+	}
+}
+
+// ExtractIndexed writes the records called names from the fasta file in to out, using its .fai index to
+// seek to each one. If namesOrder is true they are written in the order of names, otherwise in the
+// order they are in the file. The records are written with their whole header lines, as Extract writes them
+func ExtractIndexed(in io.ReadSeeker, index map[string]FaiEntry, names []string, namesOrder bool, out io.Writer) error {
+
+	entries := make([]FaiEntry, 0, len(names))
+	found := make(map[string]bool)
+	for _, name := range names {
+		if e, ok := index[name]; ok && !found[name] {
+			entries = append(entries, e)
+			found[name] = true
+		}
+	}
+	warnMissing(names, found)
+
+	if !namesOrder {
+		sort.SliceStable(entries, func(i, j int) bool { return entries[i].Offset < entries[j].Offset })
+	}
+
+	for _, e := range entries {
+		header, err := fetchHeader(in, e)
+		if err != nil {
+			return err
+		}
+		seq, err := fetchIndexed(in, e)
+		if err != nil {
+			return err
+		}
+		_, err = out.Write([]byte(">" + header + "\n" + seq + "\n"))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Extract streams through the fasta file in and writes the records whose ID is in names, or whose header
+// matches re (if it isn't nil), to out. If namesOrder is true the records are written in the order of names,
+// which means holding the matched records in memory until the end of the file
+func Extract(ctx context.Context, in io.Reader, names []string, re *regexp.Regexp, namesOrder bool, out io.Writer) error {
+
+	if namesOrder && re != nil {
+		return errors.New("can't follow the order of the names when selecting by regular expression")
+	}
+
+	wanted := make(map[string]bool, len(names))
+	for _, name := range names {
+		wanted[name] = true
+	}
+	found := make(map[string]bool)
+	held := make(map[string]fastaio.FastaRecord)
+
+	err := fastaio.EachRecord(ctx, in, func(FR fastaio.FastaRecord) error {
+		match := wanted[FR.ID] && !found[FR.ID]
+		if re != nil && re.MatchString(FR.Description) {
+			match = true
+		}
+		if !match {
+			return nil
+		}
+		found[FR.ID] = true
+		if namesOrder {
+			held[FR.ID] = FR
+			return nil
+		}
+		_, err := out.Write([]byte(">" + FR.Description + "\n" + FR.Seq + "\n"))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	warnMissing(names, found)
+
+	if namesOrder {
+		for _, name := range names {
+			FR, ok := held[name]
+			if !ok {
+				continue
+			}
+			_, err := out.Write([]byte(">" + FR.Description + "\n" + FR.Seq + "\n"))
+			if err != nil {
+				return err
+			}
+			delete(held, name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package extract
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var fastaData = []byte(`>seq1 one
+ATGATGAT
+GA
+>seq2 two
+ATGA
+>seq3 three
+CCCCCCCC
+CCCC
+`)
+
+// as made by samtools faidx
+var faiData = `seq1	10	10	8	9
+seq2	4	32	4	5
+seq3	12	49	8	9
+`
+
+func TestExtract(t *testing.T) {
+	out := new(bytes.Buffer)
+	err := Extract(context.Background(), bytes.NewReader(fastaData), []string{"seq3", "seq1", "missing"}, nil, false, out)
+	if err != nil {
+		t.Error(err)
+	}
+	if out.String() != ">seq1 one\nATGATGATGA\n>seq3 three\nCCCCCCCCCCCC\n" {
+		t.Errorf("problem in TestExtract(): %s", out.String())
+	}
+
+	out = new(bytes.Buffer)
+	err = Extract(context.Background(), bytes.NewReader(fastaData), []string{"seq3", "seq1"}, nil, true, out)
+	if err != nil {
+		t.Error(err)
+	}
+	if out.String() != ">seq3 three\nCCCCCCCCCCCC\n>seq1 one\nATGATGATGA\n" {
+		t.Errorf("problem in TestExtract() with names order: %s", out.String())
+	}
+}
+
+func TestExtractRegex(t *testing.T) {
+	out := new(bytes.Buffer)
+	err := Extract(context.Background(), bytes.NewReader(fastaData), []string{}, regexp.MustCompile("t[wh]"), false, out)
+	if err != nil {
+		t.Error(err)
+	}
+	if out.String() != ">seq2 two\nATGA\n>seq3 three\nCCCCCCCCCCCC\n" {
+		t.Errorf("problem in TestExtractRegex(): %s", out.String())
+	}
+}
+
+func TestExtractIndexed(t *testing.T) {
+	index, err := ReadFai(strings.NewReader(faiData))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := new(bytes.Buffer)
+	err = ExtractIndexed(bytes.NewReader(fastaData), index, []string{"seq3", "seq1"}, false, out)
+	if err != nil {
+		t.Error(err)
+	}
+	if out.String() != ">seq1 one\nATGATGATGA\n>seq3 three\nCCCCCCCCCCCC\n" {
+		t.Errorf("problem in TestExtractIndexed(): %s", out.String())
+	}
+
+	out = new(bytes.Buffer)
+	err = ExtractIndexed(bytes.NewReader(fastaData), index, []string{"seq3", "seq2"}, true, out)
+	if err != nil {
+		t.Error(err)
+	}
+	if out.String() != ">seq3 three\nCCCCCCCCCCCC\n>seq2 two\nATGA\n" {
+		t.Errorf("problem in TestExtractIndexed() with names order: %s", out.String())
+	}
+
+	// an index that doesn't match the file
+	stale, _ := ReadFai(strings.NewReader("seq2\t4\t30\t4\t5\n"))
+	err = ExtractIndexed(bytes.NewReader(fastaData), stale, []string{"seq2"}, false, new(bytes.Buffer))
+	if err == nil {
+		t.Errorf("problem in TestExtractIndexed(): expected an error for an out of date index")
+	}
+}
+
+// the same records are written with and without the index, with CRLF line endings or a long header
+func TestExtractIndexedSameAsExtract(t *testing.T) {
+	longName := strings.Repeat("x", 5000)
+	crlf := []byte(">seq1 one\r\nATGATGAT\r\nGA\r\n>" + longName + " two words\r\nATGA\r\n")
+	faiCRLF := "seq1\t10\t11\t8\t10\n" + longName + "\t4\t" + strconv.Itoa(11+10+4+1+len(longName)+12) + "\t4\t6\n"
+
+	for _, tc := range []struct {
+		data  []byte
+		fai   string
+		names []string
+	}{
+		{fastaData, faiData, []string{"seq1", "seq2", "seq3"}},
+		{crlf, faiCRLF, []string{"seq1", longName}},
+	} {
+		index, err := ReadFai(strings.NewReader(tc.fai))
+		if err != nil {
+			t.Fatal(err)
+		}
+		indexed := new(bytes.Buffer)
+		if err := ExtractIndexed(bytes.NewReader(tc.data), index, tc.names, false, indexed); err != nil {
+			t.Error(err)
+		}
+		streamed := new(bytes.Buffer)
+		if err := Extract(context.Background(), bytes.NewReader(tc.data), tc.names, nil, false, streamed); err != nil {
+			t.Error(err)
+		}
+		if indexed.String() != streamed.String() {
+			t.Errorf("problem in TestExtractIndexedSameAsExtract(): %q with the index, %q without it", indexed.String(), streamed.String())
+		}
+	}
+}
+
+func TestReadNames(t *testing.T) {
+	names, err := ReadNames(strings.NewReader("seq1\n\nseq2 extra\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 2 || names[0] != "seq1" || names[1] != "seq2" {
+		t.Errorf("problem in TestReadNames(): %v", names)
+	}
+}
+
+func TestSets(t *testing.T) {
+	ids, err := ReadIDs(bytes.NewReader(fastaData))
+	if err != nil {
+		t.Error(err)
+	}
+	if strings.Join(ids, ",") != "seq1,seq2,seq3" {
+		t.Errorf("problem in TestSets(): ReadIDs() gave %v", ids)
+	}
+
+	index, err := ReadFai(strings.NewReader(faiData))
+	if err != nil {
+		t.Error(err)
+	}
+	if names := IndexNames(index); strings.Join(names, ",") != "seq1,seq2,seq3" {
+		t.Errorf("problem in TestSets(): IndexNames() gave %v", names)
+	}
+
+	out := new(bytes.Buffer)
+	if err := Subtract(context.Background(), bytes.NewReader(fastaData), []string{"seq2", "missing"}, out); err != nil {
+		t.Error(err)
+	}
+	if out.String() != ">seq1 one\nATGATGATGA\n>seq3 three\nCCCCCCCCCCCC\n" {
+		t.Errorf("problem in TestSets(): Subtract() gave %s", out.String())
+	}
+
+	out.Reset()
+	if err := Intersect(context.Background(), bytes.NewReader(fastaData), []string{"seq3", "seq2", "missing"}, out); err != nil {
+		t.Error(err)
+	}
+	if out.String() != ">seq2 two\nATGA\n>seq3 three\nCCCCCCCCCCCC\n" {
+		t.Errorf("problem in TestSets(): Intersect() gave %s", out.String())
+	}
+
+	out.Reset()
+	if err := IntersectIndexed(bytes.NewReader(fastaData), index, []string{"seq3", "seq2", "missing"}, out); err != nil {
+		t.Error(err)
+	}
+	if out.String() != ">seq2 two\nATGA\n>seq3 three\nCCCCCCCCCCCC\n" {
+		t.Errorf("problem in TestSets(): IntersectIndexed() gave %s", out.String())
+	}
+}
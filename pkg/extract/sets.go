@@ -0,0 +1,86 @@
+package extract
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+// ReadIDs returns the IDs of the records in the fasta file r, in order, without parsing their sequences
+func ReadIDs(r io.Reader) ([]string, error) {
+	ids := make([]string, 0)
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 0), 64*1024*1024)
+	for s.Scan() {
+		line := s.Bytes()
+		if len(line) == 0 || line[0] != '>' {
+			continue
+		}
+		if fields := strings.Fields(string(line[1:])); len(fields) > 0 {
+			ids = append(ids, fields[0])
+		}
+	}
+	return ids, s.Err()
+}
+
+// IndexNames returns the names of the records in a .fai index, in the order they are in the file
+func IndexNames(index map[string]FaiEntry) []string {
+	entries := make([]FaiEntry, 0, len(index))
+	for _, e := range index {
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Offset < entries[j].Offset })
+	names := make([]string, len(entries))
+	for i, e := range entries {
+		names[i] = e.Name
+	}
+	return names
+}
+
+// filter streams through the fasta file in and writes the records for which keep is true to out
+func filter(ctx context.Context, in io.Reader, keep func(string) bool, out io.Writer) error {
+	return fastaio.EachRecord(ctx, in, func(FR fastaio.FastaRecord) error {
+		if !keep(FR.ID) {
+			return nil
+		}
+		_, err := out.Write([]byte(">" + FR.Description + "\n" + FR.Seq + "\n"))
+		return err
+	})
+}
+
+// set returns names as a set
+func set(names []string) map[string]bool {
+	s := make(map[string]bool, len(names))
+	for _, name := range names {
+		s[name] = true
+	}
+	return s
+}
+
+// Subtract streams through the fasta file in and writes the records whose ID isn't in names to out
+func Subtract(ctx context.Context, in io.Reader, names []string, out io.Writer) error {
+	s := set(names)
+	return filter(ctx, in, func(id string) bool { return !s[id] }, out)
+}
+
+// Intersect streams through the fasta file in and writes the records whose ID is in names to out. Unlike
+// Extract, names that aren't in the file are expected, and aren't warned about
+func Intersect(ctx context.Context, in io.Reader, names []string, out io.Writer) error {
+	s := set(names)
+	return filter(ctx, in, func(id string) bool { return s[id] }, out)
+}
+
+// IntersectIndexed is as Intersect, using the .fai index of in to read only the records in names
+func IntersectIndexed(in io.ReadSeeker, index map[string]FaiEntry, names []string, out io.Writer) error {
+	shared := make([]string, 0, len(names))
+	for _, name := range names {
+		if _, ok := index[name]; ok {
+			shared = append(shared, name)
+		}
+	}
+	return ExtractIndexed(in, index, shared, false, out)
+}
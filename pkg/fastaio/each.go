@@ -0,0 +1,64 @@
+package fastaio
+
+import (
+	"context"
+	"io"
+
+	"github.com/virus-evolution/gofasta/internal/pipeline"
+)
+
+// Reader is the signature of the functions in this package that read records to a channel, such as ReadFasta
+// and ReadAlignment
+type Reader[T any] func(f io.Reader, chnl chan T, cErr chan error, cDone chan bool)
+
+// Each reads in with read, and passes every record to f, in order. It returns the first error from reading, from
+// f or from ctx. If it returns early, the reader is stopped at its next read from in and drained, so that it
+// isn't left blocked on a send
+func Each[T any](ctx context.Context, in io.Reader, read Reader[T], f func(T) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	c := make(chan T)
+	cErr := make(chan error)
+	cDone := make(chan bool)
+
+	go read(ContextReader(ctx, in), c, cErr, cDone)
+
+	return pipeline.FromChannels(c, cErr, cDone)(ctx, f)
+}
+
+// EachRecord passes every record in the fasta file in to f, in order, as Each does with ReadFasta
+func EachRecord(ctx context.Context, in io.Reader, f func(FastaRecord) error) error {
+	return Each(ctx, in, ReadFasta, f)
+}
+
+// EachAlignedRecord is EachRecord for an alignment, as Each does with ReadAlignment
+func EachAlignedRecord(ctx context.Context, in io.Reader, f func(FastaRecord) error) error {
+	return Each(ctx, in, ReadAlignment, f)
+}
+
+// EachEncodedRecord passes every record in the alignment in to f, in order, encoded as ReadEncodeAlignment does
+// with hardGaps
+func EachEncodedRecord(ctx context.Context, in io.Reader, hardGaps bool, f func(EncodedFastaRecord) error) error {
+	return Each(ctx, in, func(r io.Reader, c chan EncodedFastaRecord, cErr chan error, cDone chan bool) {
+		ReadEncodeAlignment(r, hardGaps, c, cErr, cDone)
+	}, f)
+}
+
+// ContextReader returns a reader that reads from r until ctx is done, and fails after that, which is what stops a
+// reader that nothing is listening to any more
+func ContextReader(ctx context.Context, r io.Reader) io.Reader {
+	return contextReader{ctx: ctx, r: r}
+}
+
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (cr contextReader) Read(p []byte) (int, error) {
+	if err := cr.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return cr.r.Read(p)
+}
@@ -0,0 +1,57 @@
+package fastaio
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+// endless is a fasta file that never ends
+type endless struct{}
+
+func (endless) Read(p []byte) (int, error) {
+	return copy(p, strings.Repeat(">a\nACGT\n", len(p)/8)), nil
+}
+
+func TestEach(t *testing.T) {
+	ids := make([]string, 0)
+	err := EachRecord(context.Background(), strings.NewReader(">a\nACGT\n>b\nAC\n"), func(FR FastaRecord) error {
+		ids = append(ids, FR.ID)
+		return nil
+	})
+	if err != nil {
+		t.Error(err)
+	}
+	if strings.Join(ids, ",") != "a,b" {
+		t.Errorf("problem in TestEach(): got %v", ids)
+	}
+
+	if err := EachAlignedRecord(context.Background(), strings.NewReader(">a\nACGT\n>b\nAC\n"), func(FastaRecord) error { return nil }); err == nil {
+		t.Errorf("problem in TestEach(): expected an error for records of different lengths")
+	}
+
+	// an error from f stops the reader, rather than leaving it to read the rest of the file
+	before := runtime.NumGoroutine()
+	stop := errors.New("stop")
+	n := 0
+	err = EachRecord(context.Background(), endless{}, func(FastaRecord) error {
+		n++
+		if n == 10 {
+			return stop
+		}
+		return nil
+	})
+	if err != stop {
+		t.Errorf("problem in TestEach(): expected the error from f, got %v", err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if runtime.NumGoroutine() > before {
+		t.Errorf("problem in TestEach(): the reader is still running")
+	}
+}
@@ -0,0 +1,65 @@
+package fastaio
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The errors that the readers find in a fasta file. They come wrapped in a RecordError that says where they
+// were found, so use errors.Is to test for them
+var (
+	ErrBadFormat         = errors.New("badly formatted fasta file")
+	ErrEmptyHeader       = errors.New("badly formatted fasta file: empty header")
+	ErrNotAlignment      = errors.New("different length sequences in input file: is this an alignment?")
+	ErrInvalidNucleotide = errors.New("invalid nucleotide in fasta file")
+	ErrEmpty             = errors.New("empty fasta file")
+)
+
+// RecordError is an error in one record of a fasta file. Record is the record's ID, if its header has been read,
+// and Index its 0-based index in the file. Line is the 1-based line of the file the error was found on (0 if
+// it isn't known), and Position, if it isn't 0, the 1-based site in the record's sequence
+type RecordError struct {
+	Record   string
+	Index    int
+	Line     int
+	Position int
+	Err      error
+}
+
+func (e *RecordError) Error() string {
+	where := make([]string, 0, 2)
+	if e.Line > 0 {
+		where = append(where, "line "+strconv.Itoa(e.Line))
+	}
+	if e.Position > 0 {
+		where = append(where, "position "+strconv.Itoa(e.Position))
+	}
+	switch {
+	case e.Record != "" && len(where) > 0:
+		return "record " + e.Record + " (" + strings.Join(where, ", ") + "): " + e.Err.Error()
+	case e.Record != "":
+		return "record " + e.Record + ": " + e.Err.Error()
+	case len(where) > 0:
+		return strings.Join(where, ", ") + ": " + e.Err.Error()
+	}
+	return "record " + strconv.Itoa(e.Index+1) + ": " + e.Err.Error()
+}
+
+func (e *RecordError) Unwrap() error {
+	return e.Err
+}
+
+// NotAlignment is the error for a record of an alignment, n sites wide, that isn't as wide as the first. line
+// is the line of its header, or 0 if that isn't known
+func NotAlignment(id string, idx, line, n, width int) error {
+	return &RecordError{Record: id, Index: idx, Line: line,
+		Err: fmt.Errorf("%w (%d sites, but the first record has %d)", ErrNotAlignment, n, width)}
+}
+
+// invalidNucleotide is the error for a character that isn't a nucleotide, at a 1-based position in a record
+func invalidNucleotide(id string, idx, line, position int, c byte) error {
+	return &RecordError{Record: id, Index: idx, Line: line, Position: position,
+		Err: fmt.Errorf("%w (\"%s\")", ErrInvalidNucleotide, string(c))}
+}
@@ -5,14 +5,13 @@ fasta format files
 package fastaio
 
 import (
-	"bufio"
-	"errors"
-	"fmt"
 	"io"
 	"strings"
+	"unicode"
 
 	"github.com/virus-evolution/gofasta/pkg/alphabet"
 	"github.com/virus-evolution/gofasta/pkg/encoding"
+	"github.com/virus-evolution/gofasta/pkg/summary"
 )
 
 // A struct for one Fasta record
@@ -134,17 +133,21 @@ func getAlignmentDims(f io.Reader) (int, int, error) {
 	n := 0
 	l := 0
 
-	s := bufio.NewScanner(f)
-	s.Buffer(make([]byte, 0), 1024*1024)
+	s, release := newScanner(f)
+	defer release()
 
 	for s.Scan() {
-		line := s.Text()
+		line := s.Bytes()
 
-		if string(line[0]) == ">" {
+		if len(line) == 0 {
+			continue
+		}
+
+		if line[0] == '>' {
 			n++
 		}
 
-		if n == 1 && string(line[0]) != ">" {
+		if n == 1 && line[0] != '>' {
 			l += len(line)
 		}
 	}
@@ -244,12 +247,23 @@ func Consensus(f io.Reader) (FastaRecord, error) {
 	return consensus, err
 }
 
+// parseHeader returns the ID and description in a fasta header line. The description is the only copy made of
+// the line: the ID is the first word of it, and shares its memory. The ID is empty if the header is
+func parseHeader(line []byte) (id, description string) {
+	description = string(line[1:])
+	id = strings.TrimLeftFunc(description, unicode.IsSpace)
+	if i := strings.IndexFunc(id, unicode.IsSpace); i != -1 {
+		id = id[:i]
+	}
+	return id, description
+}
+
 // ReadAlignment reads an alignment in fasta format to a channel of FastaRecord structs
 func ReadAlignment(f io.Reader, chnl chan FastaRecord, cErr chan error, cdone chan bool) {
 
 	var err error
-	s := bufio.NewScanner(f)
-	s.Buffer(make([]byte, 0), 1024*1024)
+	s, release := newScanner(f)
+	defer release()
 
 	counter := 0
 
@@ -257,74 +271,234 @@ func ReadAlignment(f io.Reader, chnl chan FastaRecord, cErr chan error, cdone ch
 
 	var id string
 	var description string
-	var seqBuffer string
+	seqBuffer := getBuffer()
+	defer putBuffer(seqBuffer)
 	var width int
 
+	lineNo := 0
+	headerLine := 0
+
 	for s.Scan() {
-		line := s.Text()
+		lineNo++
+		line := s.Bytes()
+
+		if len(line) == 0 {
+			continue
+		}
 
 		if first {
 
-			if len(line) == 0 || string(line[0]) != ">" {
-				cErr <- errors.New("badly formatted fasta file")
+			if line[0] != '>' {
+				cErr <- &RecordError{Line: lineNo, Err: ErrBadFormat}
 				return
 			}
 
-			description = line[1:]
-			id = strings.Fields(description)[0]
+			id, description = parseHeader(line)
+			headerLine = lineNo
+			if id == "" {
+				cErr <- &RecordError{Index: counter, Line: lineNo, Err: ErrEmptyHeader}
+				return
+			}
 
 			first = false
 
-		} else if string(line[0]) == ">" {
+		} else if line[0] == '>' {
 
 			if counter == 0 {
-				width = len(seqBuffer)
-			} else if len(seqBuffer) != width {
-				cErr <- errors.New("different length sequences in input file: is this an alignment?")
+				width = len(*seqBuffer)
+			} else if len(*seqBuffer) != width {
+				cErr <- NotAlignment(id, counter, headerLine, len(*seqBuffer), width)
 				return
 			}
 
-			fr := FastaRecord{ID: id, Description: description, Seq: seqBuffer, Idx: counter}
+			fr := FastaRecord{ID: id, Description: description, Seq: string(*seqBuffer), Idx: counter}
 			chnl <- fr
+			summary.Processed(1)
 			counter++
 
-			description = line[1:]
-			id = strings.Fields(description)[0]
-			seqBuffer = ""
+			id, description = parseHeader(line)
+			headerLine = lineNo
+			if id == "" {
+				cErr <- &RecordError{Index: counter, Line: lineNo, Err: ErrEmptyHeader}
+				return
+			}
+			*seqBuffer = (*seqBuffer)[:0]
 
 		} else {
-			seqBuffer = seqBuffer + strings.ToUpper(line)
+			*seqBuffer = appendUpper(*seqBuffer, line)
 		}
 
 	}
 
-	if len(seqBuffer) > 0 {
-		if counter > 0 && len(seqBuffer) != width {
-			cErr <- errors.New("different length sequences in input file: is this an alignment?")
+	if len(*seqBuffer) > 0 {
+		if counter > 0 && len(*seqBuffer) != width {
+			cErr <- NotAlignment(id, counter, headerLine, len(*seqBuffer), width)
 			return
 		}
-		fr := FastaRecord{ID: id, Description: description, Seq: seqBuffer, Idx: counter}
+		fr := FastaRecord{ID: id, Description: description, Seq: string(*seqBuffer), Idx: counter}
 		chnl <- fr
+		summary.Processed(1)
 		counter++
 	}
 
 	if counter == 0 {
-		cErr <- errors.New("empty fasta file")
+		cErr <- ErrEmpty
 		return
 	}
 
 	err = s.Err()
 	if err != nil {
-		cErr <- err
+		cErr <- &RecordError{Record: id, Index: counter, Line: lineNo + 1, Err: err}
 		return
 	}
 
 	cdone <- true
 }
 
+// ReadFasta reads a fasta format file to a channel of FastaRecord structs. Unlike ReadAlignment, the
+// sequences don't have to be the same length. Blank lines are skipped
+func ReadFasta(f io.Reader, chnl chan FastaRecord, cErr chan error, cdone chan bool) {
+	readFasta(f, chnl, cErr, cdone, summary.Processed)
+}
+
+// ReadFastaUncounted is ReadFasta for a pass over a file whose records are counted in the summary by another
+// pass, and so doesn't count them itself
+func ReadFastaUncounted(f io.Reader, chnl chan FastaRecord, cErr chan error, cdone chan bool) {
+	readFasta(f, chnl, cErr, cdone, func(int) {})
+}
+
+// readFasta is ReadFasta, passing 1 to processed for every record it reads
+func readFasta(f io.Reader, chnl chan FastaRecord, cErr chan error, cdone chan bool, processed func(n int)) {
+
+	var err error
+	s, release := newScanner(f)
+	defer release()
+
+	counter := 0
+
+	first := true
+
+	var id string
+	var description string
+	seqBuffer := getBuffer()
+	defer putBuffer(seqBuffer)
+
+	lineNo := 0
+
+	for s.Scan() {
+		lineNo++
+		line := s.Bytes()
+
+		if len(line) == 0 {
+			continue
+		}
+
+		if first {
+
+			if line[0] != '>' {
+				cErr <- &RecordError{Line: lineNo, Err: ErrBadFormat}
+				return
+			}
+
+			id, description = parseHeader(line)
+			if id == "" {
+				cErr <- &RecordError{Index: counter, Line: lineNo, Err: ErrEmptyHeader}
+				return
+			}
+
+			first = false
+
+		} else if line[0] == '>' {
+
+			fr := FastaRecord{ID: id, Description: description, Seq: string(*seqBuffer), Idx: counter}
+			chnl <- fr
+			processed(1)
+			counter++
+
+			id, description = parseHeader(line)
+			if id == "" {
+				cErr <- &RecordError{Index: counter, Line: lineNo, Err: ErrEmptyHeader}
+				return
+			}
+			*seqBuffer = (*seqBuffer)[:0]
+
+		} else {
+			*seqBuffer = appendUpper(*seqBuffer, line)
+		}
+	}
+
+	if !first {
+		fr := FastaRecord{ID: id, Description: description, Seq: string(*seqBuffer), Idx: counter}
+		chnl <- fr
+		processed(1)
+		counter++
+	}
+
+	if counter == 0 {
+		cErr <- ErrEmpty
+		return
+	}
+
+	err = s.Err()
+	if err != nil {
+		cErr <- &RecordError{Record: id, Index: counter, Line: lineNo + 1, Err: err}
+		return
+	}
+
+	cdone <- true
+}
+
+// ReadFastaToList is as ReadFasta but returns a slice of FastaRecords instead of passing each
+// FastaRecord down a channel
+func ReadFastaToList(f io.Reader) ([]FastaRecord, error) {
+	return readFastaToList(f, ReadFasta)
+}
+
+// ReadFastaToListUncounted is ReadFastaToList for a file that isn't the query input, such as a
+// reference, and so doesn't count its records in the summary
+func ReadFastaToListUncounted(f io.Reader) ([]FastaRecord, error) {
+	return readFastaToList(f, ReadFastaUncounted)
+}
+
+// readFastaToList collects the records that read sends into a slice
+func readFastaToList(f io.Reader, read Reader[FastaRecord]) ([]FastaRecord, error) {
+
+	records := make([]FastaRecord, 0)
+
+	cErr := make(chan error)
+	cFR := make(chan FastaRecord)
+	cDone := make(chan bool)
+
+	go read(f, cFR, cErr, cDone)
+
+	for n := 1; n > 0; {
+		select {
+		case err := <-cErr:
+			return nil, err
+		case FR := <-cFR:
+			records = append(records, FR)
+		case <-cDone:
+			n--
+		}
+	}
+
+	return records, nil
+}
+
 // ReadEncodeAlignment reads an alignment in fasta format to a channel
 // of EncodedFastaRecord structs - converting the nucleotide sequence to EP's bitwise coding scheme
 func ReadEncodeAlignment(f io.Reader, hardGaps bool, chnl chan EncodedFastaRecord, cErr chan error, cDone chan bool) {
+	readEncodeAlignment(f, hardGaps, chnl, cErr, cDone, summary.Processed)
+}
+
+// ReadEncodeAlignmentUncounted is ReadEncodeAlignment for a file that isn't the query input, such as a
+// set of targets, and so doesn't count its records in the summary
+func ReadEncodeAlignmentUncounted(f io.Reader, hardGaps bool, chnl chan EncodedFastaRecord, cErr chan error, cDone chan bool) {
+	readEncodeAlignment(f, hardGaps, chnl, cErr, cDone, func(int) {})
+}
+
+// readEncodeAlignment is ReadEncodeAlignment, passing 1 to processed for every record it reads
+func readEncodeAlignment(f io.Reader, hardGaps bool, chnl chan EncodedFastaRecord, cErr chan error, cDone chan bool, processed func(n int)) {
 
 	var err error
 
@@ -336,8 +510,8 @@ func ReadEncodeAlignment(f io.Reader, hardGaps bool, chnl chan EncodedFastaRecor
 		coding = encoding.MakeEncodingArray()
 	}
 
-	s := bufio.NewScanner(f)
-	s.Buffer(make([]byte, 0), 1024*1024)
+	s, release := newScanner(f)
+	defer release()
 
 	first := true
 
@@ -352,18 +526,30 @@ func ReadEncodeAlignment(f io.Reader, hardGaps bool, chnl chan EncodedFastaRecor
 
 	counter := 0
 
+	lineNo := 0
+	headerLine := 0
+
 	for s.Scan() {
+		lineNo++
 		line = s.Bytes()
 
+		if len(line) == 0 {
+			continue
+		}
+
 		if first {
 
-			if len(line) == 0 || line[0] != '>' {
-				cErr <- errors.New("badly formatted fasta file")
+			if line[0] != '>' {
+				cErr <- &RecordError{Line: lineNo, Err: ErrBadFormat}
 				return
 			}
 
-			description = string(line[1:])
-			id = strings.Fields(description)[0]
+			id, description = parseHeader(line)
+			headerLine = lineNo
+			if id == "" {
+				cErr <- &RecordError{Index: counter, Line: lineNo, Err: ErrEmptyHeader}
+				return
+			}
 
 			first = false
 
@@ -372,50 +558,56 @@ func ReadEncodeAlignment(f io.Reader, hardGaps bool, chnl chan EncodedFastaRecor
 			if counter == 0 {
 				width = len(seqBuffer)
 			} else if len(seqBuffer) != width {
-				cErr <- errors.New("different length sequences in input file: is this an alignment?")
+				cErr <- NotAlignment(id, counter, headerLine, len(seqBuffer), width)
 				return
 			}
 
 			fr = EncodedFastaRecord{ID: id, Description: description, Seq: seqBuffer, Idx: counter}
 			chnl <- fr
+			processed(1)
 			counter++
 
-			description = string(line[1:])
-			id = strings.Fields(description)[0]
-			seqBuffer = make([]byte, 0)
+			id, description = parseHeader(line)
+			headerLine = lineNo
+			if id == "" {
+				cErr <- &RecordError{Index: counter, Line: lineNo, Err: ErrEmptyHeader}
+				return
+			}
+			seqBuffer = make([]byte, 0, width)
 
 		} else {
-			encodedLine := make([]byte, len(line))
-			for i := range line {
-				nuc = coding[line[i]]
+			n := len(seqBuffer)
+			seqBuffer = append(seqBuffer, line...)
+			for i := n; i < len(seqBuffer); i++ {
+				nuc = coding[seqBuffer[i]]
 				if nuc == 0 {
-					cErr <- fmt.Errorf("invalid nucleotide in fasta file (\"%s\")", string(line[i]))
+					cErr <- invalidNucleotide(id, counter, lineNo, i+1, seqBuffer[i])
 					return
 				}
-				encodedLine[i] = nuc
+				seqBuffer[i] = nuc
 			}
-			seqBuffer = append(seqBuffer, encodedLine...)
 		}
 	}
 
 	if len(seqBuffer) > 0 {
 		if counter > 0 && len(seqBuffer) != width {
-			cErr <- errors.New("different length sequences in input file: is this an alignment?")
+			cErr <- NotAlignment(id, counter, headerLine, len(seqBuffer), width)
 			return
 		}
 		fr = EncodedFastaRecord{ID: id, Description: description, Seq: seqBuffer, Idx: counter}
 		chnl <- fr
+		processed(1)
 		counter++
 	}
 
 	if counter == 0 {
-		cErr <- errors.New("empty fasta file")
+		cErr <- ErrEmpty
 		return
 	}
 
 	err = s.Err()
 	if err != nil {
-		cErr <- err
+		cErr <- &RecordError{Record: id, Index: counter, Line: lineNo + 1, Err: err}
 		return
 	}
 
@@ -426,6 +618,17 @@ func ReadEncodeAlignment(f io.Reader, hardGaps bool, chnl chan EncodedFastaRecor
 // of EncodedFastaRecord structs - converting the nucleotide sequence to EP's bitwise coding scheme
 // and additionally scoring each record and getting ATGC counts
 func ReadEncodeScoreAlignment(f io.Reader, hardGaps bool, chnl chan EncodedFastaRecord, cErr chan error, cDone chan bool) {
+	readEncodeScoreAlignment(f, hardGaps, chnl, cErr, cDone, summary.Processed)
+}
+
+// ReadEncodeScoreAlignmentUncounted is ReadEncodeScoreAlignment for a set of targets rather than the query
+// input, and so doesn't count its records in the summary
+func ReadEncodeScoreAlignmentUncounted(f io.Reader, hardGaps bool, chnl chan EncodedFastaRecord, cErr chan error, cDone chan bool) {
+	readEncodeScoreAlignment(f, hardGaps, chnl, cErr, cDone, func(int) {})
+}
+
+// readEncodeScoreAlignment is ReadEncodeScoreAlignment, passing 1 to processed for every record it reads
+func readEncodeScoreAlignment(f io.Reader, hardGaps bool, chnl chan EncodedFastaRecord, cErr chan error, cDone chan bool, processed func(n int)) {
 
 	var err error
 
@@ -439,8 +642,8 @@ func ReadEncodeScoreAlignment(f io.Reader, hardGaps bool, chnl chan EncodedFasta
 
 	scoring := encoding.MakeEncodedScoreArray()
 
-	s := bufio.NewScanner(f)
-	s.Buffer(make([]byte, 0), 1024*1024)
+	s, release := newScanner(f)
+	defer release()
 
 	first := true
 
@@ -457,18 +660,30 @@ func ReadEncodeScoreAlignment(f io.Reader, hardGaps bool, chnl chan EncodedFasta
 
 	counter := 0
 
+	lineNo := 0
+	headerLine := 0
+
 	for s.Scan() {
+		lineNo++
 		line = s.Bytes()
 
+		if len(line) == 0 {
+			continue
+		}
+
 		if first {
 
-			if len(line) == 0 || line[0] != '>' {
-				cErr <- errors.New("badly formatted fasta file")
+			if line[0] != '>' {
+				cErr <- &RecordError{Line: lineNo, Err: ErrBadFormat}
 				return
 			}
 
-			description = string(line[1:])
-			id = strings.Fields(description)[0]
+			id, description = parseHeader(line)
+			headerLine = lineNo
+			if id == "" {
+				cErr <- &RecordError{Index: counter, Line: lineNo, Err: ErrEmptyHeader}
+				return
+			}
 
 			first = false
 
@@ -477,7 +692,7 @@ func ReadEncodeScoreAlignment(f io.Reader, hardGaps bool, chnl chan EncodedFasta
 			if counter == 0 {
 				width = len(seqBuffer)
 			} else if len(seqBuffer) != width {
-				cErr <- errors.New("different length sequences in input file: is this an alignment?")
+				cErr <- NotAlignment(id, counter, headerLine, len(seqBuffer), width)
 				return
 			}
 
@@ -487,35 +702,40 @@ func ReadEncodeScoreAlignment(f io.Reader, hardGaps bool, chnl chan EncodedFasta
 			fr.Count_G = counting[72]
 			fr.Count_C = counting[40]
 			chnl <- fr
+			processed(1)
 			counter++
 
-			description = string(line[1:])
-			id = strings.Fields(description)[0]
-			seqBuffer = make([]byte, 0)
+			id, description = parseHeader(line)
+			headerLine = lineNo
+			if id == "" {
+				cErr <- &RecordError{Index: counter, Line: lineNo, Err: ErrEmptyHeader}
+				return
+			}
+			seqBuffer = make([]byte, 0, width)
 			score = 0
 			for i := range counting {
 				counting[i] = 0
 			}
 
 		} else {
-			encodedLine := make([]byte, len(line))
-			for i := range line {
-				nuc = coding[line[i]]
+			n := len(seqBuffer)
+			seqBuffer = append(seqBuffer, line...)
+			for i := n; i < len(seqBuffer); i++ {
+				nuc = coding[seqBuffer[i]]
 				if nuc == 0 {
-					cErr <- fmt.Errorf("invalid nucleotide in fasta file (\"%s\")", string(line[i]))
+					cErr <- invalidNucleotide(id, counter, lineNo, i+1, seqBuffer[i])
 					return
 				}
-				encodedLine[i] = nuc
+				seqBuffer[i] = nuc
 				score += scoring[nuc]
 				counting[nuc]++
 			}
-			seqBuffer = append(seqBuffer, encodedLine...)
 		}
 	}
 
 	if len(seqBuffer) > 0 {
 		if counter > 0 && len(seqBuffer) != width {
-			cErr <- errors.New("different length sequences in input file: is this an alignment?")
+			cErr <- NotAlignment(id, counter, headerLine, len(seqBuffer), width)
 			return
 		}
 		fr = EncodedFastaRecord{ID: id, Description: description, Seq: seqBuffer, Idx: counter, Score: score}
@@ -524,17 +744,18 @@ func ReadEncodeScoreAlignment(f io.Reader, hardGaps bool, chnl chan EncodedFasta
 		fr.Count_G = counting[72]
 		fr.Count_C = counting[40]
 		chnl <- fr
+		processed(1)
 		counter++
 	}
 
 	if counter == 0 {
-		cErr <- errors.New("empty fasta file")
+		cErr <- ErrEmpty
 		return
 	}
 
 	err = s.Err()
 	if err != nil {
-		cErr <- err
+		cErr <- &RecordError{Record: id, Index: counter, Line: lineNo + 1, Err: err}
 		return
 	}
 
@@ -544,6 +765,17 @@ func ReadEncodeScoreAlignment(f io.Reader, hardGaps bool, chnl chan EncodedFasta
 // ReadEncodeAlignmentToList is as ReadEncodeAlignment but returns a slice of EncodedFastaRecords instead
 // of passing each EncodedFastaRecord down a channel
 func ReadEncodeAlignmentToList(f io.Reader, hardGaps bool) ([]EncodedFastaRecord, error) {
+	return readEncodeAlignmentToList(f, hardGaps, summary.Processed)
+}
+
+// ReadEncodeAlignmentToListUncounted is ReadEncodeAlignmentToList for a file that isn't the query input,
+// such as a reference, and so doesn't count its records in the summary
+func ReadEncodeAlignmentToListUncounted(f io.Reader, hardGaps bool) ([]EncodedFastaRecord, error) {
+	return readEncodeAlignmentToList(f, hardGaps, func(int) {})
+}
+
+// readEncodeAlignmentToList is ReadEncodeAlignmentToList, passing 1 to processed for every record it reads
+func readEncodeAlignmentToList(f io.Reader, hardGaps bool, processed func(n int)) ([]EncodedFastaRecord, error) {
 
 	var err error
 
@@ -557,8 +789,8 @@ func ReadEncodeAlignmentToList(f io.Reader, hardGaps bool) ([]EncodedFastaRecord
 		coding = encoding.MakeEncodingArray()
 	}
 
-	s := bufio.NewScanner(f)
-	s.Buffer(make([]byte, 0), 1024*1024)
+	s, release := newScanner(f)
+	defer release()
 
 	first := true
 
@@ -571,17 +803,28 @@ func ReadEncodeAlignmentToList(f io.Reader, hardGaps bool) ([]EncodedFastaRecord
 
 	counter := 0
 
+	lineNo := 0
+	headerLine := 0
+
 	for s.Scan() {
+		lineNo++
 		line = s.Bytes()
 
+		if len(line) == 0 {
+			continue
+		}
+
 		if first {
 
-			if len(line) == 0 || line[0] != '>' {
-				return []EncodedFastaRecord{}, errors.New("badly formatted fasta file")
+			if line[0] != '>' {
+				return []EncodedFastaRecord{}, &RecordError{Line: lineNo, Err: ErrBadFormat}
 			}
 
-			description = string(line[1:])
-			id = strings.Fields(description)[0]
+			id, description = parseHeader(line)
+			headerLine = lineNo
+			if id == "" {
+				return []EncodedFastaRecord{}, &RecordError{Index: counter, Line: lineNo, Err: ErrEmptyHeader}
+			}
 
 			first = false
 
@@ -590,46 +833,51 @@ func ReadEncodeAlignmentToList(f io.Reader, hardGaps bool) ([]EncodedFastaRecord
 			if counter == 0 {
 				width = len(seqBuffer)
 			} else if len(seqBuffer) != width {
-				return []EncodedFastaRecord{}, errors.New("different length sequences in input file: is this an alignment?")
+				return []EncodedFastaRecord{}, NotAlignment(id, counter, headerLine, len(seqBuffer), width)
 			}
 
 			fr := EncodedFastaRecord{ID: id, Description: description, Seq: seqBuffer, Idx: counter}
 			records = append(records, fr)
+			processed(1)
 			counter++
 
-			description = string(line[1:])
-			id = strings.Fields(description)[0]
-			seqBuffer = make([]byte, 0)
+			id, description = parseHeader(line)
+			headerLine = lineNo
+			if id == "" {
+				return []EncodedFastaRecord{}, &RecordError{Index: counter, Line: lineNo, Err: ErrEmptyHeader}
+			}
+			seqBuffer = make([]byte, 0, width)
 
 		} else {
-			encodedLine := make([]byte, len(line))
-			for i := range line {
-				nuc = coding[line[i]]
+			n := len(seqBuffer)
+			seqBuffer = append(seqBuffer, line...)
+			for i := n; i < len(seqBuffer); i++ {
+				nuc = coding[seqBuffer[i]]
 				if nuc == 0 {
-					return []EncodedFastaRecord{}, fmt.Errorf("invalid nucleotide in fasta file (\"%s\")", string(line[i]))
+					return []EncodedFastaRecord{}, invalidNucleotide(id, counter, lineNo, i+1, seqBuffer[i])
 				}
-				encodedLine[i] = nuc
+				seqBuffer[i] = nuc
 			}
-			seqBuffer = append(seqBuffer, encodedLine...)
 		}
 	}
 
 	if len(seqBuffer) > 0 {
 		if counter > 0 && len(seqBuffer) != width {
-			return []EncodedFastaRecord{}, errors.New("different length sequences in input file: is this an alignment?")
+			return []EncodedFastaRecord{}, NotAlignment(id, counter, headerLine, len(seqBuffer), width)
 		}
 		fr := EncodedFastaRecord{ID: id, Description: description, Seq: seqBuffer, Idx: counter}
 		records = append(records, fr)
+		processed(1)
 		counter++
 	}
 
 	if counter == 0 {
-		return []EncodedFastaRecord{}, errors.New("empty fasta file")
+		return []EncodedFastaRecord{}, ErrEmpty
 	}
 
 	err = s.Err()
 	if err != nil {
-		return []EncodedFastaRecord{}, err
+		return []EncodedFastaRecord{}, &RecordError{Record: id, Index: counter, Line: lineNo + 1, Err: err}
 	}
 
 	return records, nil
@@ -646,19 +894,60 @@ func WriteAlignment(ch chan FastaRecord, w io.Writer, cdone chan bool, cerr chan
 
 	var err error
 
+	buf := getBuffer()
+	defer putBuffer(buf)
+
 	for FR := range ch {
 
 		outputMap[FR.Idx] = FR
 
 		for {
 			if fastarecord, ok := outputMap[counter]; ok {
-				_, err = w.Write([]byte(">" + fastarecord.ID + "\n"))
+				*buf = appendRecord((*buf)[:0], fastarecord.ID, fastarecord.Seq)
+				_, err = w.Write(*buf)
 				if err != nil {
 					cerr <- err
 				}
-				_, err = w.Write([]byte(fastarecord.Seq + "\n"))
+				delete(outputMap, counter)
+				counter++
+			} else {
+				break
+			}
+		}
+
+	}
+
+	cdone <- true
+}
+
+// WriteFasta is as WriteAlignment, but writes the whole header line (FastaRecord.Description)
+// of each record rather than just its ID. Records must be indexed from 0 with no gaps
+func WriteFasta(ch chan FastaRecord, w io.Writer, cdone chan bool, cerr chan error) {
+
+	outputMap := make(map[int]FastaRecord)
+
+	counter := 0
+
+	var err error
+
+	buf := getBuffer()
+	defer putBuffer(buf)
+
+	for FR := range ch {
+
+		outputMap[FR.Idx] = FR
+
+		for {
+			if fastarecord, ok := outputMap[counter]; ok {
+				header := fastarecord.Description
+				if header == "" {
+					header = fastarecord.ID
+				}
+				*buf = appendRecord((*buf)[:0], header, fastarecord.Seq)
+				_, err = w.Write(*buf)
 				if err != nil {
 					cerr <- err
+					return
 				}
 				delete(outputMap, counter)
 				counter++
@@ -672,48 +961,33 @@ func WriteAlignment(ch chan FastaRecord, w io.Writer, cdone chan bool, cerr chan
 	cdone <- true
 }
 
-// TO DO - test
+// WriteWrapAlignment is as WriteAlignment, but wraps each sequence at wrap nucleotides a line
 func WriteWrapAlignment(ch chan FastaRecord, w io.Writer, wrap int, cdone chan bool, cerr chan error) {
 
 	outputMap := make(map[int]FastaRecord)
 
 	var (
-		counter, written int
-		err              error
+		counter int
+		err     error
 	)
 
+	buf := getBuffer()
+	defer putBuffer(buf)
+
 	for FR := range ch {
 
 		outputMap[FR.Idx] = FR
 
 		for {
 			if fastarecord, ok := outputMap[counter]; ok {
-				_, err = w.Write([]byte(">" + fastarecord.ID + "\n"))
+				// the whole record is assembled in buf, and written at once
+				*buf = AppendWrapped((*buf)[:0], fastarecord.ID, fastarecord.Seq, wrap)
+				_, err = w.Write(*buf)
 				if err != nil {
 					cerr <- err
 				}
-				for {
-					if written < len(fastarecord.Seq) {
-						if written+wrap >= len(fastarecord.Seq) {
-							_, err = w.Write([]byte(fastarecord.Seq[written:] + "\n"))
-							if err != nil {
-								cerr <- err
-							}
-							written = written + wrap
-						} else {
-							_, err = w.Write([]byte(fastarecord.Seq[written:written+wrap] + "\n"))
-							if err != nil {
-								cerr <- err
-							}
-							written = written + wrap
-						}
-					} else {
-						break
-					}
-				}
 				delete(outputMap, counter)
 				counter++
-				written = 0
 			} else {
 				break
 			}
@@ -723,3 +997,23 @@ func WriteWrapAlignment(ch chan FastaRecord, w io.Writer, wrap int, cdone chan b
 
 	cdone <- true
 }
+
+// AppendWrapped appends a fasta record with header and its sequence wrapped at wrap nucleotides a line to dst,
+// as WriteWrapAlignment writes it. If wrap is less than 1 the sequence isn't wrapped
+func AppendWrapped(dst []byte, header, seq string, wrap int) []byte {
+	if wrap < 1 {
+		return appendRecord(dst, header, seq)
+	}
+	dst = append(dst, '>')
+	dst = append(dst, header...)
+	dst = append(dst, '\n')
+	for written := 0; written < len(seq); written += wrap {
+		end := written + wrap
+		if end > len(seq) {
+			end = len(seq)
+		}
+		dst = append(dst, seq[written:end]...)
+		dst = append(dst, '\n')
+	}
+	return dst
+}
@@ -2,6 +2,7 @@ package fastaio
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
@@ -190,7 +191,7 @@ ATGATC
 	for n := 1; n > 0; {
 		select {
 		case err := <-cErr:
-			if err.Error() != "different length sequences in input file: is this an alignment?" {
+			if !errors.Is(err, ErrNotAlignment) || err.Error() != "record TargetShort1 (line 3): different length sequences in input file: is this an alignment? (5 sites, but the first record has 6)" {
 				t.Error(err)
 			}
 			n--
@@ -275,7 +276,7 @@ ATGATC
 	for n := 1; n > 0; {
 		select {
 		case err := <-cErr:
-			if err.Error() != "different length sequences in input file: is this an alignment?" {
+			if !errors.Is(err, ErrNotAlignment) || err.Error() != "record TargetShort1 (line 3): different length sequences in input file: is this an alignment? (5 sites, but the first record has 6)" {
 				t.Error(err)
 			}
 			n--
@@ -339,7 +340,7 @@ ATTTTC
 	alignment := bytes.NewReader(alignmentData)
 
 	_, err := ReadEncodeAlignmentToList(alignment, false)
-	if err.Error() != "different length sequences in input file: is this an alignment?" {
+	if !errors.Is(err, ErrNotAlignment) || err.Error() != "record Target2 (line 3): different length sequences in input file: is this an alignment? (5 sites, but the first record has 6)" {
 		t.Error(err)
 	}
 }
@@ -529,3 +530,142 @@ GATG
 		t.Errorf("Problem in TestWriteWrapAlignment()")
 	}
 }
+
+func TestReadFasta(t *testing.T) {
+	fastaData := []byte(`>Target1 some description
+ATGatc
+
+>Target2
+ATG
+>Target3
+ATTTTCA
+AA
+`)
+
+	cErr := make(chan error)
+	cFR := make(chan FastaRecord)
+	cReadDone := make(chan bool)
+
+	go ReadFasta(bytes.NewReader(fastaData), cFR, cErr, cReadDone)
+
+	out := new(bytes.Buffer)
+	cWriteDone := make(chan bool)
+
+	go WriteFasta(cFR, out, cWriteDone, cErr)
+
+	for n := 1; n > 0; {
+		select {
+		case err := <-cErr:
+			t.Error(err)
+			return
+		case <-cReadDone:
+			close(cFR)
+			n--
+		}
+	}
+
+	<-cWriteDone
+
+	if out.String() != `>Target1 some description
+ATGATC
+>Target2
+ATG
+>Target3
+ATTTTCAAA
+` {
+		t.Errorf("problem in TestReadFasta(): %s", out.String())
+	}
+}
+
+func TestReadFastaToList(t *testing.T) {
+	records, err := ReadFastaToList(bytes.NewReader([]byte(">seq1 desc\nacgt\n\n>seq2\nAT\nG\n")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 || records[0].Seq != "ACGT" || records[0].Description != "seq1 desc" || records[1].Seq != "ATG" || records[1].Idx != 1 {
+		t.Errorf("problem in TestReadFastaToList(): %v", records)
+	}
+}
+
+func TestPooledBuffers(t *testing.T) {
+	// records read with a pooled buffer mustn't change when the buffer is reused by the next file
+	first, err := ReadFastaToList(bytes.NewReader([]byte(">a\nacgt\n>b\nAAAA\n")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		if _, err := ReadFastaToList(bytes.NewReader([]byte(">c\nTTTTTTTT\n>d\nGG\n"))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if first[0].Seq != "ACGT" || first[1].Seq != "AAAA" {
+		t.Errorf("problem in TestPooledBuffers(): records changed after their buffer was reused: %v", first)
+	}
+
+	cFR := make(chan FastaRecord, 2)
+	cErr := make(chan error)
+	cDone := make(chan bool)
+	out := new(bytes.Buffer)
+	cFR <- FastaRecord{ID: "b", Description: "b desc", Seq: "GG", Idx: 1}
+	cFR <- FastaRecord{ID: "a", Seq: "ACGT", Idx: 0}
+	close(cFR)
+	go WriteFasta(cFR, out, cDone, cErr)
+	select {
+	case err := <-cErr:
+		t.Fatal(err)
+	case <-cDone:
+	}
+	if out.String() != ">a\nACGT\n>b desc\nGG\n" {
+		t.Errorf("problem in TestPooledBuffers(): WriteFasta() wrote\n%s", out.String())
+	}
+}
+
+func TestParseHeader(t *testing.T) {
+	tests := []struct {
+		line, id, description string
+	}{
+		{">seq1", "seq1", "seq1"},
+		{">seq1 a description", "seq1", "seq1 a description"},
+		{">  seq1\tx", "seq1", "  seq1\tx"},
+		{">", "", ""},
+		{">   ", "", "   "},
+	}
+	for _, test := range tests {
+		id, description := parseHeader([]byte(test.line))
+		if id != test.id || description != test.description {
+			t.Errorf("problem in TestParseHeader(): %q gave %q and %q", test.line, id, description)
+		}
+	}
+
+	cErr := make(chan error)
+	cFR := make(chan EncodedFastaRecord)
+	cDone := make(chan bool)
+	go ReadEncodeAlignment(bytes.NewReader([]byte(">seq1\nACGT\n>\nACGT\n")), false, cFR, cErr, cDone)
+	<-cFR
+	select {
+	case <-cErr:
+	case <-cFR:
+		t.Errorf("problem in TestParseHeader(): expected an error for an empty header")
+	case <-cDone:
+		t.Errorf("problem in TestParseHeader(): expected an error for an empty header")
+	}
+}
+
+func TestRecordError(t *testing.T) {
+	_, err := ReadEncodeAlignmentToList(bytes.NewReader([]byte(">seq1\nACGT\n\n>seq2\nAC\nG!\n")), false)
+	var re *RecordError
+	if !errors.As(err, &re) || !errors.Is(err, ErrInvalidNucleotide) {
+		t.Fatalf("problem in TestRecordError(): %v", err)
+	}
+	if re.Record != "seq2" || re.Index != 1 || re.Line != 6 || re.Position != 4 {
+		t.Errorf("problem in TestRecordError(): %+v", re)
+	}
+	if err.Error() != `record seq2 (line 6, position 4): invalid nucleotide in fasta file ("!")` {
+		t.Errorf("problem in TestRecordError(): %v", err)
+	}
+
+	_, err = ReadEncodeAlignmentToList(bytes.NewReader([]byte("ACGT\n")), false)
+	if !errors.Is(err, ErrBadFormat) || err.Error() != "line 1: badly formatted fasta file" {
+		t.Errorf("problem in TestRecordError(): %v", err)
+	}
+}
@@ -0,0 +1,68 @@
+package fastaio
+
+import (
+	"bufio"
+	"io"
+	"sync"
+)
+
+// Readers and writers take their scanner, sequence and output buffers from a pool, so that reading and writing
+// millions of records (or many files, one after another or at once) reuses the same memory instead of
+// allocating and collecting new buffers for every line and record. Nothing that is sent down a channel or
+// returned ever aliases a pooled buffer: sequences are copied out of them first
+
+// maxPooled is the capacity above which a buffer is left to the garbage collector rather than pooled, so that
+// one very long record doesn't pin its memory for the rest of the run
+const maxPooled = 4 * 1024 * 1024
+
+var bufferPool = sync.Pool{
+	New: func() any {
+		b := make([]byte, 0, 64*1024)
+		return &b
+	},
+}
+
+// getBuffer returns an empty buffer from the pool
+func getBuffer() *[]byte {
+	b := bufferPool.Get().(*[]byte)
+	*b = (*b)[:0]
+	return b
+}
+
+// putBuffer returns a buffer to the pool. It mustn't be used again afterwards
+func putBuffer(b *[]byte) {
+	if cap(*b) > maxPooled {
+		return
+	}
+	bufferPool.Put(b)
+}
+
+// newScanner returns a line scanner on f whose buffer comes from the pool, and a function to call once the
+// scanner is finished with that returns it
+func newScanner(f io.Reader) (*bufio.Scanner, func()) {
+	b := getBuffer()
+	s := bufio.NewScanner(f)
+	s.Buffer(*b, 1024*1024)
+	return s, func() { putBuffer(b) }
+}
+
+// appendUpper appends line to dst, with the ASCII lowercase letters in it made uppercase
+func appendUpper(dst, line []byte) []byte {
+	n := len(dst)
+	dst = append(dst, line...)
+	for i := n; i < len(dst); i++ {
+		if c := dst[i]; 'a' <= c && c <= 'z' {
+			dst[i] = c - ('a' - 'A')
+		}
+	}
+	return dst
+}
+
+// appendRecord appends a fasta record with header and an unwrapped sequence to dst
+func appendRecord(dst []byte, header, seq string) []byte {
+	dst = append(dst, '>')
+	dst = append(dst, header...)
+	dst = append(dst, '\n')
+	dst = append(dst, seq...)
+	return append(dst, '\n')
+}
@@ -0,0 +1,142 @@
+/*
+Package fastq implements the conversion of FASTQ files, plain or gzipped, to
+fasta format, with filters on read length and mean quality and the masking of
+low-quality bases to N.
+*/
+package fastq
+
+import (
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/summary"
+)
+
+// Record is one FASTQ record. Qual holds the Phred+33 quality characters
+type Record struct {
+	ID          string
+	Description string
+	Seq         string
+	Qual        string
+}
+
+// Options controls the conversion. Records shorter than MinLength, longer than MaxLength (if it isn't 0), or
+// with a mean quality below MinQuality are dropped. Bases with a quality below MaskQuality are written as N
+type Options struct {
+	MinLength   int
+	MaxLength   int
+	MinQuality  float64
+	MaskQuality int
+}
+
+// Decompress returns a reader of the contents of r, which is gunzipped if it starts with the gzip magic number
+func Decompress(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(2)
+	if err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		return gzip.NewReader(br)
+	}
+	return br, nil
+}
+
+// Read passes every record in the FASTQ file r, which may be gzipped, to f, in order. Each record must be four
+// lines: the header, the sequence, a line starting with +, and the qualities
+func Read(r io.Reader, f func(Record) error) error {
+	in, err := Decompress(r)
+	if err != nil {
+		return err
+	}
+
+	s := bufio.NewScanner(in)
+	s.Buffer(make([]byte, 0), 64*1024*1024)
+
+	lines := [4]string{}
+	lineN := 0
+	for s.Scan() {
+		line := strings.TrimRight(s.Text(), "\r")
+		if lineN%4 == 0 && line == "" {
+			continue
+		}
+		lines[lineN%4] = line
+		lineN++
+		if lineN%4 != 0 {
+			continue
+		}
+		if !strings.HasPrefix(lines[0], "@") || !strings.HasPrefix(lines[2], "+") {
+			return errors.New("badly formatted FASTQ record ending on line " + strconv.Itoa(lineN) + ": is this a four-line FASTQ file?")
+		}
+		if len(lines[1]) != len(lines[3]) {
+			return errors.New("the sequence and quality of " + lines[0][1:] + " are different lengths")
+		}
+		description := lines[0][1:]
+		fields := strings.Fields(description)
+		if len(fields) == 0 {
+			return errors.New("FASTQ record with no name ending on line " + strconv.Itoa(lineN))
+		}
+		if err := f(Record{ID: fields[0], Description: description, Seq: lines[1], Qual: lines[3]}); err != nil {
+			return err
+		}
+		summary.Processed(1)
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+	if lineN%4 != 0 {
+		return errors.New("truncated FASTQ file")
+	}
+
+	return nil
+}
+
+// MeanQuality returns the mean quality of qual, as the Phred score of the mean error probability (so that a few
+// very bad bases lower it more than an arithmetic mean of the scores would). It is 0 for an empty record
+func MeanQuality(qual string) float64 {
+	if len(qual) == 0 {
+		return 0
+	}
+	p := 0.0
+	for i := 0; i < len(qual); i++ {
+		p += math.Pow(10, -float64(int(qual[i])-33)/10)
+	}
+	return -10 * math.Log10(p/float64(len(qual)))
+}
+
+// Mask returns seq with the bases whose quality is below min written as N
+func Mask(seq, qual string, min int) string {
+	b := []byte(seq)
+	for i := range b {
+		if int(qual[i])-33 < min {
+			b[i] = 'N'
+		}
+	}
+	return string(b)
+}
+
+// ToFasta converts the FASTQ records in r, which may be gzipped, to fasta format and writes them to out, dropping
+// and masking records as set by o
+func ToFasta(r io.Reader, out io.Writer, o Options) error {
+	return Read(r, func(rec Record) error {
+		switch {
+		case len(rec.Seq) < o.MinLength:
+			summary.Skipped(rec.ID, "too short")
+			return nil
+		case o.MaxLength > 0 && len(rec.Seq) > o.MaxLength:
+			summary.Skipped(rec.ID, "too long")
+			return nil
+		case o.MinQuality > 0 && MeanQuality(rec.Qual) < o.MinQuality:
+			summary.Skipped(rec.ID, "low quality")
+			return nil
+		}
+		seq := rec.Seq
+		if o.MaskQuality > 0 {
+			seq = Mask(seq, rec.Qual, o.MaskQuality)
+		}
+		_, err := out.Write([]byte(">" + rec.Description + "\n" + seq + "\n"))
+		return err
+	})
+}
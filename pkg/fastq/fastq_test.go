@@ -0,0 +1,74 @@
+package fastq
+
+import (
+	"bytes"
+	"compress/gzip"
+	"math"
+	"strings"
+	"testing"
+)
+
+var fastqData = "@r1 first read\nACGTACGT\n+\nIIIIIII#\n@r2\nACG\n+\nIII\n\n@r3\nACGTACGTAA\n+r3\n##########\n"
+
+func TestToFasta(t *testing.T) {
+	out := new(bytes.Buffer)
+	if err := ToFasta(strings.NewReader(fastqData), out, Options{}); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != ">r1 first read\nACGTACGT\n>r2\nACG\n>r3\nACGTACGTAA\n" {
+		t.Errorf("problem in TestToFasta(): got\n%s", out.String())
+	}
+
+	out.Reset()
+	if err := ToFasta(strings.NewReader(fastqData), out, Options{MinLength: 4, MinQuality: 10, MaskQuality: 20}); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != ">r1 first read\nACGTACGN\n" {
+		t.Errorf("problem in TestToFasta(): got\n%s", out.String())
+	}
+
+	out.Reset()
+	if err := ToFasta(strings.NewReader(fastqData), out, Options{MaxLength: 8}); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != ">r1 first read\nACGTACGT\n>r2\nACG\n" {
+		t.Errorf("problem in TestToFasta(): got\n%s", out.String())
+	}
+}
+
+func TestReadGzip(t *testing.T) {
+	gz := new(bytes.Buffer)
+	w := gzip.NewWriter(gz)
+	w.Write([]byte(fastqData))
+	w.Close()
+
+	n := 0
+	if err := Read(gz, func(Record) error { n++; return nil }); err != nil {
+		t.Fatal(err)
+	}
+	if n != 3 {
+		t.Errorf("problem in TestReadGzip(): read %d records", n)
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	for _, bad := range []string{
+		"@r1\nACGT\n+\nIII\n",
+		"@r1\nACGT\nIIII\n+\n",
+		"@r1\nACGT\n+\n",
+	} {
+		if err := Read(strings.NewReader(bad), func(Record) error { return nil }); err == nil {
+			t.Errorf("problem in TestReadErrors(): expected an error for %q", bad)
+		}
+	}
+}
+
+func TestMeanQuality(t *testing.T) {
+	if q := MeanQuality("IIII"); math.Abs(q-40) > 1e-9 {
+		t.Errorf("problem in TestMeanQuality(): got %f", q)
+	}
+	// one base at Q0 has an error probability of 1, which dominates the mean
+	if q := MeanQuality("!III"); math.Abs(q-(-10*math.Log10((1+3e-4)/4))) > 1e-9 {
+		t.Errorf("problem in TestMeanQuality(): got %f", q)
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Package filter implements routines to select records from a fasta format file
+by their length, their content of Ns, ambiguities and gaps, and their header.
+*/
+package filter
+
+import (
+	"context"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+// Criteria are the conditions that a record must meet to be kept. Zero values for MinLength and MaxLength,
+// and values >= 1 for the fractions, mean that criterion isn't applied
+type Criteria struct {
+	MinLength    int            // minimum length, not counting gaps
+	MaxLength    int            // maximum length, not counting gaps
+	MaxN         float64        // maximum proportion of the sequence that is N
+	MaxAmbiguity float64        // maximum proportion of the sequence that is not A, T, G, C or a gap (including N)
+	MaxGap       float64        // maximum proportion of the sequence that is a gap
+	Header       *regexp.Regexp // if not nil, the header line must match this
+	Invert       bool           // keep the records which fail the criteria instead of those which pass
+}
+
+// DefaultCriteria returns Criteria which every record passes
+func DefaultCriteria() Criteria {
+	return Criteria{MaxN: 1.0, MaxAmbiguity: 1.0, MaxGap: 1.0}
+}
+
+// Check returns the reasons (if any) that a record fails the criteria. It doesn't take Invert into account
+func (c Criteria) Check(FR fastaio.FastaRecord) []string {
+
+	reasons := make([]string, 0)
+
+	var n, amb, gap int
+	for i := 0; i < len(FR.Seq); i++ {
+		switch FR.Seq[i] {
+		case 'A', 'T', 'G', 'C':
+		case '-':
+			gap++
+		case 'N':
+			n++
+			amb++
+		default:
+			amb++
+		}
+	}
+
+	length := len(FR.Seq) - gap
+	total := float64(len(FR.Seq))
+	if total == 0 {
+		total = 1
+	}
+
+	if c.MinLength > 0 && length < c.MinLength {
+		reasons = append(reasons, "length<"+strconv.Itoa(c.MinLength))
+	}
+	if c.MaxLength > 0 && length > c.MaxLength {
+		reasons = append(reasons, "length>"+strconv.Itoa(c.MaxLength))
+	}
+	if c.MaxN < 1.0 && float64(n)/total > c.MaxN {
+		reasons = append(reasons, "N>"+strconv.FormatFloat(c.MaxN, 'f', -1, 64))
+	}
+	if c.MaxAmbiguity < 1.0 && float64(amb)/total > c.MaxAmbiguity {
+		reasons = append(reasons, "ambiguity>"+strconv.FormatFloat(c.MaxAmbiguity, 'f', -1, 64))
+	}
+	if c.MaxGap < 1.0 && float64(gap)/total > c.MaxGap {
+		reasons = append(reasons, "gap>"+strconv.FormatFloat(c.MaxGap, 'f', -1, 64))
+	}
+	if c.Header != nil && !c.Header.MatchString(FR.Description) {
+		reasons = append(reasons, "header")
+	}
+
+	return reasons
+}
+
+func writeRecord(w io.Writer, FR fastaio.FastaRecord) error {
+	if w == nil {
+		return nil
+	}
+	_, err := w.Write([]byte(">" + FR.Description + "\n" + FR.Seq + "\n"))
+	return err
+}
+
+// Filter writes the records in the fasta file in that meet the criteria to kept, and those that don't to
+// rejected (if it isn't nil). If reasons isn't nil, a CSV file with the columns query, kept and reasons is
+// written to it, where reasons is a ";"-delimited list of the criteria that the record failed
+func Filter(ctx context.Context, in io.Reader, c Criteria, kept, rejected, reasons io.Writer) error {
+
+	var err error
+
+	if reasons != nil {
+		_, err = reasons.Write([]byte("query,kept,reasons\n"))
+		if err != nil {
+			return err
+		}
+	}
+
+	return fastaio.EachRecord(ctx, in, func(FR fastaio.FastaRecord) error {
+		failed := c.Check(FR)
+		keep := len(failed) == 0
+		if c.Invert {
+			keep = !keep
+		}
+
+		if keep {
+			err = writeRecord(kept, FR)
+		} else {
+			err = writeRecord(rejected, FR)
+		}
+		if err != nil {
+			return err
+		}
+
+		if reasons != nil {
+			_, err = reasons.Write([]byte(FR.ID + "," + strconv.FormatBool(keep) + "," + strings.Join(failed, ";") + "\n"))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
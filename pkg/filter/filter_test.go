@@ -0,0 +1,86 @@
+package filter
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"testing"
+)
+
+var fastaData = []byte(`>seq1 country=UK
+ATGATGATGA
+>seq2 country=UK
+ATGNNNNTGA
+>seq3 country=US
+ATG----TGA
+>seq4 country=US
+ATGRYATGAT
+>seq5 country=UK
+ATG
+`)
+
+func TestFilter(t *testing.T) {
+	c := DefaultCriteria()
+	c.MinLength = 5
+	c.MaxN = 0.2
+	c.MaxAmbiguity = 0.3
+	c.MaxGap = 0.5
+
+	kept := new(bytes.Buffer)
+	rejected := new(bytes.Buffer)
+	reasons := new(bytes.Buffer)
+
+	err := Filter(context.Background(), bytes.NewReader(fastaData), c, kept, rejected, reasons)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if kept.String() != `>seq1 country=UK
+ATGATGATGA
+>seq3 country=US
+ATG----TGA
+>seq4 country=US
+ATGRYATGAT
+` {
+		t.Errorf("problem in TestFilter() kept: %s", kept.String())
+	}
+	if rejected.String() != `>seq2 country=UK
+ATGNNNNTGA
+>seq5 country=UK
+ATG
+` {
+		t.Errorf("problem in TestFilter() rejected: %s", rejected.String())
+	}
+	if reasons.String() != `query,kept,reasons
+seq1,true,
+seq2,false,N>0.2;ambiguity>0.3
+seq3,true,
+seq4,true,
+seq5,false,length<5
+` {
+		t.Errorf("problem in TestFilter() reasons: %s", reasons.String())
+	}
+}
+
+func TestFilterHeaderInvert(t *testing.T) {
+	c := DefaultCriteria()
+	c.Header = regexp.MustCompile("country=US")
+	c.Invert = true
+
+	kept := new(bytes.Buffer)
+
+	err := Filter(context.Background(), bytes.NewReader(fastaData), c, kept, nil, nil)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if kept.String() != `>seq1 country=UK
+ATGATGATGA
+>seq2 country=UK
+ATGNNNNTGA
+>seq5 country=UK
+ATG
+` {
+		t.Errorf("problem in TestFilterHeaderInvert(): %s", kept.String())
+	}
+}
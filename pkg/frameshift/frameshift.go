@@ -0,0 +1,160 @@
+/*
+Package frameshift implements routines to find indels in protein-coding
+regions whose lengths aren't a multiple of three, and so shift the reading
+frame of the rest of the gene.
+
+Deletions are found as runs of gaps in sequences aligned to a reference (e.g.
+the output of gofasta sam toMultiAlign or gofasta align), in which insertions
+relative to the reference are no longer present, so insertions are read
+separately from the table that gofasta align --insertions writes.
+*/
+package frameshift
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+// Frameshift is one frameshifting indel in one protein-coding region. Position is the 1-based position of the
+// first deleted base, or of the base after which bases are inserted. Length is the length of the whole indel,
+// Codon is the (1-based) codon of the region where it starts (0 if the region has no positions), and Shift is the
+// change in reading frame: -1 or -2 for deletions, +1 or +2 for insertions
+type Frameshift struct {
+	Gene     string
+	Type     string
+	Position int
+	Length   int
+	Codon    int
+	Shift    int
+}
+
+// String returns the frameshift in the same notation as gofasta variants, e.g. S:del:21765:7
+func (f Frameshift) String() string {
+	return f.Gene + ":" + f.Type + ":" + strconv.Itoa(f.Position) + ":" + strconv.Itoa(f.Length)
+}
+
+// ReadInsertions reads the insertions CSV written by gofasta align (columns query,ref_position,insertion) into a
+// map from query name to insertions
+func ReadInsertions(r io.Reader) (map[string][]variants.Variant, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	if len(header) < 3 || header[0] != "query" || header[1] != "ref_position" || header[2] != "insertion" {
+		return nil, errors.New("insertions file should have the columns query,ref_position,insertion")
+	}
+	ins := make(map[string][]variants.Variant)
+	for {
+		row, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		pos, err := strconv.Atoi(row[1])
+		if err != nil {
+			return nil, errors.New("couldn't parse insertion position: " + row[1])
+		}
+		ins[row[0]] = append(ins[row[0]], variants.Variant{Changetype: "ins", Position: pos, Length: len(row[2])})
+	}
+	return ins, nil
+}
+
+// codon returns the 1-based codon of r that the first of its positions in [lo, hi] is in, reading in the
+// direction of the region's strand
+func codon(r variants.Region, lo, hi int) int {
+	for i, p := range r.Positions {
+		if p >= lo && p <= hi {
+			return i/3 + 1
+		}
+	}
+	return 0
+}
+
+// Find returns the frameshifting deletions in seq, which must be aligned to the reference that regions are
+// annotated on, and the frameshifting insertions in ins, in the order they occur. A deletion shifts the frame of
+// a region if the number of its bases inside the region isn't a multiple of three. Gaps at the ends of the
+// sequence are missing data rather than deletions, and are ignored
+func Find(seq string, ins []variants.Variant, regions []variants.Region) []Frameshift {
+
+	fs := make([]Frameshift, 0)
+
+	first, last := strings.IndexFunc(seq, func(r rune) bool { return r != '-' }), strings.LastIndexFunc(seq, func(r rune) bool { return r != '-' })
+	if first == -1 {
+		return fs
+	}
+
+	for i := first; i <= last; i++ {
+		if seq[i] != '-' {
+			continue
+		}
+		start := i
+		for i <= last && seq[i] == '-' {
+			i++
+		}
+		// the gap is at 1-based positions start+1 to i; count how much of it is in each region
+		for _, r := range regions {
+			lo, hi := start+1, i
+			if lo < r.Start {
+				lo = r.Start
+			}
+			if hi > r.Stop {
+				hi = r.Stop
+			}
+			if hi < lo || (hi-lo+1)%3 == 0 {
+				continue
+			}
+			fs = append(fs, Frameshift{Gene: r.Name, Type: "del", Position: start + 1, Length: i - start, Codon: codon(r, lo, hi), Shift: -((hi - lo + 1) % 3)})
+		}
+	}
+
+	for _, v := range ins {
+		if v.Length%3 == 0 {
+			continue
+		}
+		// an insertion after the last base of a region doesn't shift its frame
+		for _, r := range regions {
+			if v.Position >= r.Start && v.Position < r.Stop {
+				fs = append(fs, Frameshift{Gene: r.Name, Type: "ins", Position: v.Position, Length: v.Length, Codon: codon(r, v.Position, v.Position), Shift: v.Length % 3})
+			}
+		}
+	}
+
+	return fs
+}
+
+// Frameshifts finds the frameshifts in every record in the alignment msa, whose records must all be refLen long,
+// and writes a CSV file with the columns query,gene,type,position,length,codon,frame_shift to out. ins holds
+// the insertions in each record (see ReadInsertions), and can be nil
+func Frameshifts(ctx context.Context, msa io.Reader, regions []variants.Region, refLen int, ins map[string][]variants.Variant, out io.Writer) error {
+
+	if _, err := out.Write([]byte("query,gene,type,position,length,codon,frame_shift\n")); err != nil {
+		return err
+	}
+
+	return fastaio.EachAlignedRecord(ctx, msa, func(FR fastaio.FastaRecord) error {
+		if len(FR.Seq) != refLen {
+			return errors.New(FR.ID + " (" + strconv.Itoa(len(FR.Seq)) + " bases) is not the same length as the annotation's reference (" + strconv.Itoa(refLen) + " bases)")
+		}
+		var sb strings.Builder
+		for _, f := range Find(FR.Seq, ins[FR.ID], regions) {
+			shift := strconv.Itoa(f.Shift)
+			if f.Shift > 0 {
+				shift = "+" + shift
+			}
+			sb.WriteString(FR.ID + "," + f.Gene + "," + f.Type + "," + strconv.Itoa(f.Position) + "," + strconv.Itoa(f.Length) + "," +
+				strconv.Itoa(f.Codon) + "," + shift + "\n")
+		}
+		_, err := out.Write([]byte(sb.String()))
+		return err
+	})
+}
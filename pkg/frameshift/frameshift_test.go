@@ -0,0 +1,82 @@
+package frameshift
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+func positions(start, stop int, reverse bool) []int {
+	ps := make([]int, 0)
+	for p := start; p <= stop; p++ {
+		ps = append(ps, p)
+	}
+	if reverse {
+		for i, j := 0, len(ps)-1; i < j; i, j = i+1, j-1 {
+			ps[i], ps[j] = ps[j], ps[i]
+		}
+	}
+	return ps
+}
+
+var regions = []variants.Region{
+	{Name: "fwd", Start: 2, Stop: 10, Strand: 1, Positions: positions(2, 10, false)},
+	{Name: "rev", Start: 11, Stop: 19, Strand: -1, Positions: positions(11, 19, true)},
+}
+
+func TestFind(t *testing.T) {
+	tests := []struct {
+		seq  string
+		ins  []variants.Variant
+		want []Frameshift
+	}{
+		// terminal gaps and in-frame deletions are ignored
+		{"--TGAAACCC---AAAGGGT", nil, []Frameshift{}},
+		// a 1-base deletion in codon 2 of fwd
+		{"AATG-AACCCTTTAAAGGGT", nil, []Frameshift{{Gene: "fwd", Type: "del", Position: 5, Length: 1, Codon: 2, Shift: -1}}},
+		// a 4-base deletion spanning both genes: one base in fwd (its last codon), three in rev
+		{"AATGAAACC----AAAGGGT", nil, []Frameshift{{Gene: "fwd", Type: "del", Position: 10, Length: 4, Codon: 3, Shift: -1}}},
+		// a 2-base deletion at the 5' end of rev (which is on the reverse strand)
+		{"AATGAAACCCTTTAAAG--T", nil, []Frameshift{{Gene: "rev", Type: "del", Position: 18, Length: 2, Codon: 1, Shift: -2}}},
+		// insertions: in frame, after the last base of fwd, and a frameshift in rev
+		{"AATGAAACCCTTTAAAGGGT", []variants.Variant{{Position: 3, Length: 3}, {Position: 10, Length: 1}, {Position: 12, Length: 2}},
+			[]Frameshift{{Gene: "rev", Type: "ins", Position: 12, Length: 2, Codon: 3, Shift: 2}}},
+	}
+
+	for i, test := range tests {
+		got := Find(test.seq, test.ins, regions)
+		if len(got) != len(test.want) {
+			t.Errorf("problem in TestFind() %d: got %v", i, got)
+			continue
+		}
+		for j := range got {
+			if got[j] != test.want[j] {
+				t.Errorf("problem in TestFind() %d: got %v, want %v", i, got[j], test.want[j])
+			}
+		}
+	}
+}
+
+func TestFrameshifts(t *testing.T) {
+	ins, err := ReadInsertions(strings.NewReader("query,ref_position,insertion\nq2,12,AC\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	err = Frameshifts(context.Background(), strings.NewReader(">q1\nAATG-AACCCTTTAAAGGGT\n>q2\nAATGAAACCCTTTAAAGGGT\n"), regions, 20, ins, &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "query,gene,type,position,length,codon,frame_shift\nq1,fwd,del,5,1,2,-1\nq2,rev,ins,12,2,3,+2\n"
+	if out.String() != want {
+		t.Errorf("problem in TestFrameshifts(): %s", out.String())
+	}
+
+	if err = Frameshifts(context.Background(), strings.NewReader(">q1\nAATG\n"), regions, 20, nil, &out); err == nil {
+		t.Error("expected an error for a sequence of the wrong length")
+	}
+}
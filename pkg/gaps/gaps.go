@@ -0,0 +1,132 @@
+/*
+Package gaps implements a report of the runs of gaps and of N in each record
+of an alignment, and of how much of each alignment column is missing across
+the records, for looking at coverage and amplicon dropout.
+*/
+package gaps
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+// Output formats
+const (
+	FormatCSV = "csv"
+	FormatBED = "bed"
+)
+
+// Interval types
+const (
+	Gap = "gap"
+	N   = "N"
+)
+
+// Interval is a run of gaps or of N over the columns [Start, End) (0-based) of a record
+type Interval struct {
+	Start int
+	End   int
+	Type  string
+}
+
+// Find returns the runs of at least minLength gaps ('-') or Ns ('N' or 'n') in seq, in order
+func Find(seq string, minLength int) []Interval {
+	intervals := make([]Interval, 0)
+	for i := 0; i < len(seq); {
+		var t string
+		switch seq[i] {
+		case '-':
+			t = Gap
+		case 'N', 'n':
+			t = N
+		default:
+			i++
+			continue
+		}
+		j := i + 1
+		for j < len(seq) && (seq[j] == seq[i] || (t == N && (seq[j] == 'N' || seq[j] == 'n'))) {
+			j++
+		}
+		if j-i >= minLength {
+			intervals = append(intervals, Interval{Start: i, End: j, Type: t})
+		}
+		i = j
+	}
+	return intervals
+}
+
+// Gaps writes the runs of gaps and N in every record in the alignment msa to out, as CSV with the columns
+// record,start,end,length,type (1-based, inclusive) or as BED (0-based, half-open, with the type as the name).
+// Runs shorter than minLength are left out. If missingOut isn't nil, a CSV file with the columns
+// position,gaps,n,missing_fraction is written to it, counting the records with a gap or N at each column
+func Gaps(ctx context.Context, msa io.Reader, out, missingOut io.Writer, format string, minLength int) error {
+
+	switch format {
+	case FormatCSV:
+		if _, err := out.Write([]byte("record,start,end,length,type\n")); err != nil {
+			return err
+		}
+	case FormatBED:
+	default:
+		return errors.New("unknown format: " + format + " (choose one of csv or bed)")
+	}
+
+	var gapCounts, nCounts []int
+	records := 0
+	err := fastaio.EachAlignedRecord(ctx, msa, func(FR fastaio.FastaRecord) error {
+		if missingOut != nil {
+			if gapCounts == nil {
+				gapCounts, nCounts = make([]int, len(FR.Seq)), make([]int, len(FR.Seq))
+			}
+			if len(FR.Seq) != len(gapCounts) {
+				return errors.New(FR.ID + " is not the same length as the first record")
+			}
+		}
+		records++
+
+		var sb strings.Builder
+		for _, iv := range Find(FR.Seq, 1) {
+			if missingOut != nil {
+				counts := gapCounts
+				if iv.Type == N {
+					counts = nCounts
+				}
+				for i := iv.Start; i < iv.End; i++ {
+					counts[i]++
+				}
+			}
+			if iv.End-iv.Start < minLength {
+				continue
+			}
+			if format == FormatBED {
+				sb.WriteString(FR.ID + "\t" + strconv.Itoa(iv.Start) + "\t" + strconv.Itoa(iv.End) + "\t" + iv.Type + "\n")
+			} else {
+				sb.WriteString(FR.ID + "," + strconv.Itoa(iv.Start+1) + "," + strconv.Itoa(iv.End) + "," + strconv.Itoa(iv.End-iv.Start) + "," + iv.Type + "\n")
+			}
+		}
+		_, err := out.Write([]byte(sb.String()))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if missingOut == nil {
+		return nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("position,gaps,n,missing_fraction\n")
+	for i := range gapCounts {
+		sb.WriteString(strconv.Itoa(i+1) + "," + strconv.Itoa(gapCounts[i]) + "," + strconv.Itoa(nCounts[i]) + "," +
+			strconv.FormatFloat(float64(gapCounts[i]+nCounts[i])/float64(records), 'f', 4, 64) + "\n")
+	}
+	_, err = missingOut.Write([]byte(sb.String()))
+
+	return err
+}
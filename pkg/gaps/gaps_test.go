@@ -0,0 +1,47 @@
+package gaps
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestFind(t *testing.T) {
+	got := Find("--ACNnNT-G-", 1)
+	want := []Interval{{0, 2, Gap}, {4, 7, N}, {8, 9, Gap}, {10, 11, Gap}}
+	if len(got) != len(want) {
+		t.Fatalf("problem in TestFind(): got %v", got)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("problem in TestFind(): got %v, want %v", got[i], want[i])
+		}
+	}
+	if got := Find("--ACNnNT-G-", 2); len(got) != 2 {
+		t.Errorf("problem in TestFind(): got %v with a minimum length of 2", got)
+	}
+}
+
+func TestGaps(t *testing.T) {
+	msa := ">a\n--ACNN\n>b\nA-ACGT\n"
+	out, missing := new(bytes.Buffer), new(bytes.Buffer)
+	if err := Gaps(context.Background(), strings.NewReader(msa), out, missing, FormatCSV, 2); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "record,start,end,length,type\na,1,2,2,gap\na,5,6,2,N\n" {
+		t.Errorf("problem in TestGaps(): got\n%s", out.String())
+	}
+	want := "position,gaps,n,missing_fraction\n1,1,0,0.5000\n2,2,0,1.0000\n3,0,0,0.0000\n4,0,0,0.0000\n5,0,1,0.5000\n6,0,1,0.5000\n"
+	if missing.String() != want {
+		t.Errorf("problem in TestGaps(): got\n%s", missing.String())
+	}
+
+	out.Reset()
+	if err := Gaps(context.Background(), strings.NewReader(msa), out, nil, FormatBED, 1); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "a\t0\t2\tgap\na\t4\t6\tN\nb\t1\t2\tgap\n" {
+		t.Errorf("problem in TestGaps(): got\n%s", out.String())
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Package genestats implements per-gene summaries of an alignment in the
+coordinates of an annotated reference: how many sites vary, how many are
+parsimony-informative, how similar the sequences are to each other and how
+much of each gene is missing, to help choose loci for typing schemes.
+*/
+package genestats
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/encoding"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+// column holds the counts of each unambiguous nucleotide (A, G, C, T), gaps and missing data (N, ? and other
+// ambiguity codes) in one alignment column
+type column struct {
+	nucs    [4]int
+	gaps    int
+	missing int
+}
+
+func (c *column) add(nuc byte) {
+	switch {
+	case nuc == 244 || nuc == 4:
+		c.gaps++
+	case nuc&8 == 8:
+		switch nuc {
+		case 136:
+			c.nucs[0]++
+		case 72:
+			c.nucs[1]++
+		case 40:
+			c.nucs[2]++
+		case 24:
+			c.nucs[3]++
+		}
+	default:
+		c.missing++
+	}
+}
+
+// Stats are the statistics of one gene. Identity is the mean proportion of identical sites between pairs of
+// sequences, over the sites where both have an unambiguous nucleotide, and NaN if there are no such pairs
+type Stats struct {
+	Name        string
+	Length      int
+	Variable    int
+	Informative int
+	Identity    float64
+	Gaps        float64
+	Missing     float64
+}
+
+// summarise returns the statistics of the columns at positions (1-based) of an alignment of n records
+func summarise(name string, columns []column, positions []int, n int) Stats {
+	s := Stats{Name: name}
+	seen := make(map[int]bool, len(positions))
+	var same, pairs float64
+	gaps, missing := 0, 0
+	for _, p := range positions {
+		if seen[p] {
+			continue
+		}
+		seen[p] = true
+		c := columns[p-1]
+		s.Length++
+		gaps += c.gaps
+		missing += c.missing
+		states, informative, called := 0, 0, 0
+		for _, k := range c.nucs {
+			if k > 0 {
+				states++
+			}
+			if k > 1 {
+				informative++
+			}
+			called += k
+			same += float64(k) * float64(k-1) / 2
+		}
+		pairs += float64(called) * float64(called-1) / 2
+		if states > 1 {
+			s.Variable++
+		}
+		if informative > 1 {
+			s.Informative++
+		}
+	}
+	s.Identity, s.Gaps, s.Missing = math.NaN(), math.NaN(), math.NaN()
+	if pairs > 0 {
+		s.Identity = same / pairs
+	}
+	if cells := float64(s.Length) * float64(n); cells > 0 {
+		s.Gaps = float64(gaps) / cells
+		s.Missing = float64(missing) / cells
+	}
+	return s
+}
+
+// GeneStats summarises every CDS in regions across the records of the alignment msa, which must be in the
+// reference's coordinates, and writes a CSV file with the columns
+// gene,length,variable_sites,informative_sites,mean_pairwise_identity,gap_fraction,missing_fraction to out. If
+// genome is true, a line for the whole alignment, called "genome", is written first
+func GeneStats(ctx context.Context, msa io.Reader, regions []variants.Region, out io.Writer, genome bool) error {
+
+	var columns []column
+	n := 0
+	err := fastaio.EachEncodedRecord(ctx, msa, false, func(EFR fastaio.EncodedFastaRecord) error {
+		if columns == nil {
+			columns = make([]column, len(EFR.Seq))
+		}
+		for i, nuc := range EFR.Seq {
+			columns[i].add(nuc)
+		}
+		n++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errors.New("no records in the alignment")
+	}
+
+	stats := make([]Stats, 0, len(regions)+1)
+	if genome {
+		all := make([]int, len(columns))
+		for i := range all {
+			all[i] = i + 1
+		}
+		stats = append(stats, summarise("genome", columns, all, n))
+	}
+	for _, r := range regions {
+		for _, p := range r.Positions {
+			if p < 1 || p > len(columns) {
+				return errors.New(r.Name + " is outside the alignment: is it in the annotation's coordinates?")
+			}
+		}
+		stats = append(stats, summarise(r.Name, columns, r.Positions, n))
+	}
+
+	var sb strings.Builder
+	sb.WriteString("gene,length,variable_sites,informative_sites,mean_pairwise_identity,gap_fraction,missing_fraction\n")
+	for _, s := range stats {
+		sb.WriteString(s.Name + "," + strconv.Itoa(s.Length) + "," + strconv.Itoa(s.Variable) + "," + strconv.Itoa(s.Informative) + "," +
+			encoding.FormatFloat(s.Identity, 'f', 4) + "," + encoding.FormatFloat(s.Gaps, 'f', 4) + "," + encoding.FormatFloat(s.Missing, 'f', 4) + "\n")
+	}
+	_, err = out.Write([]byte(sb.String()))
+	return err
+}
@@ -0,0 +1,37 @@
+package genestats
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+func TestGeneStats(t *testing.T) {
+	// column 1 is invariant, 2 is variable but a singleton, 3 is parsimony-informative, and 4-6 have gaps and Ns
+	msa := ">a\nAAAA-A\n>b\nACAAN-\n>c\nAAGAAA\n>d\nAAGAAA\n"
+	regions := []variants.Region{
+		{Name: "g1", Start: 1, Stop: 3, Strand: 1, Positions: []int{1, 2, 3}},
+		{Name: "g2", Start: 4, Stop: 6, Strand: -1, Positions: []int{6, 5, 4}},
+	}
+
+	out := new(bytes.Buffer)
+	if err := GeneStats(context.Background(), strings.NewReader(msa), regions, out, true); err != nil {
+		t.Fatal(err)
+	}
+	// g1: 18 pairs over three columns, 6 + 3 + 2 identical
+	want := "gene,length,variable_sites,informative_sites,mean_pairwise_identity,gap_fraction,missing_fraction\n" +
+		"genome,6,2,1,0.7500,0.0833,0.0417\n" +
+		"g1,3,2,1,0.6111,0.0000,0.0000\n" +
+		"g2,3,0,0,1.0000,0.1667,0.0833\n"
+	if out.String() != want {
+		t.Errorf("problem in TestGeneStats(): got\n%s", out.String())
+	}
+
+	regions = append(regions, variants.Region{Name: "g3", Positions: []int{6, 7, 8}})
+	if err := GeneStats(context.Background(), strings.NewReader(msa), regions, out, false); err == nil {
+		t.Errorf("problem in TestGeneStats(): expected an error for a gene outside the alignment")
+	}
+}
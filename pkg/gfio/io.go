@@ -27,11 +27,9 @@ func parseInErr(err error, flagString string) error {
 }
 
 // OpenIn returns a pointer to a file object, which may be stdin, based on the argument provided
-// to a pflag flag on the command line.
+// to a pflag flag on the command line. If the argument is an http(s) URL, it is fetched first
+// according to the settings in Remote.
 func OpenIn(flag pflag.Flag) (*os.File, error) {
-	var err error
-	var f *os.File
-
 	inFile := flag.Value.String()
 	var flagString string
 
@@ -42,7 +40,24 @@ func OpenIn(flag pflag.Flag) (*os.File, error) {
 		flagString = "-" + flag.Shorthand + " / --" + flag.Name
 	}
 
-	if inFile != "stdin" {
+	return openIn(inFile, flagString)
+}
+
+// OpenInPath is like OpenIn, for a path that doesn't come from a single flag, such as
+// one of several positional arguments. what names the input in error messages
+func OpenInPath(path string, what string) (*os.File, error) {
+	return openIn(path, what)
+}
+
+func openIn(inFile string, flagString string) (*os.File, error) {
+	var err error
+	var f *os.File
+
+	if IsRemote(inFile) {
+		if f, err = Fetch(inFile, Remote); err != nil {
+			return f, errors.New(flagString + ": " + err.Error())
+		}
+	} else if inFile != "stdin" {
 		if f, err = os.Open(inFile); err != nil {
 			err = parseInErr(err, flagString)
 			return f, err
@@ -54,22 +69,59 @@ func OpenIn(flag pflag.Flag) (*os.File, error) {
 	return f, nil
 }
 
-// OpenOut returns a pointer to a file object, which may be stdout, based on the argument provided
-// to a pflag flag on the command line. If the file is not stdout, it is created.
-func OpenOut(flag pflag.Flag) (*os.File, error) {
-	var err error
-	var f *os.File
+// OpenOut returns an Output, which may be stdout, based on the argument provided to a pflag flag on the
+// command line. If the file is not stdout, it is created. Writes to it are buffered until it is closed, or
+// flushed by CloseAll or on an interrupt
+func OpenOut(flag pflag.Flag) (*Output, error) {
 
 	outFile := flag.Value.String()
 
-	if outFile != "stdout" {
-		f, err = os.Create(outFile)
-		if err != nil {
-			return f, err
-		}
-	} else {
-		f = os.Stdout
+	if outFile == "stdout" {
+		return newOutput(os.Stdout), nil
 	}
 
-	return f, nil
+	f, err := os.Create(outFile)
+	if err != nil {
+		return nil, err
+	}
+	return newOutput(f), nil
+}
+
+// OpenOutPath is like OpenOut, for a path that doesn't come from a single flag, such as one of the files that a
+// command writes one of per gene or per group. Its writes are buffered and flushed in the same way, with a
+// smaller buffer because there may be many of them open at once
+func OpenOutPath(outFile string) (*Output, error) {
+
+	if outFile == "stdout" {
+		return newOutputSize(os.Stdout, pathBufferSize), nil
+	}
+
+	f, err := os.Create(outFile)
+	if err != nil {
+		return nil, err
+	}
+	return newOutputSize(f, pathBufferSize), nil
+}
+
+// OpenOutPrivate is OpenOut for a file that only its owner can read or write, such as a mapping back to the
+// names of records that have been anonymized. A file that already exists is truncated and its permissions are
+// changed too
+func OpenOutPrivate(flag pflag.Flag) (*Output, error) {
+
+	outFile := flag.Value.String()
+
+	if outFile == "stdout" {
+		return newOutput(os.Stdout), nil
+	}
+
+	f, err := os.OpenFile(outFile, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return nil, err
+	}
+	// an existing file keeps its permissions when it is truncated
+	if err = f.Chmod(0600); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return newOutput(f), nil
 }
@@ -0,0 +1,158 @@
+package gfio
+
+import (
+	"bufio"
+	"os"
+	"os/signal"
+	"sync"
+)
+
+// outputBufferSize is the size of each output's buffer. Rows are written a few tens of bytes at a time, which
+// made small writes, and on network filesystems small round-trips, most of the cost of writing large CSVs
+const outputBufferSize = 256 * 1024
+
+// pathBufferSize is the size of the buffer of each output opened by OpenOutPath. Commands that write one file
+// per gene or per group can have hundreds of them open at once, so it is smaller
+const pathBufferSize = 32 * 1024
+
+// Output is a file (or stdout) opened for writing by OpenOut or OpenOutPath, with a buffer in front of it. It is safe to write
+// to from more than one goroutine, though the writes of different goroutines are interleaved however they fall.
+// Close flushes the buffer before closing the file
+type Output struct {
+	mu     sync.Mutex
+	f      *os.File
+	w      *bufio.Writer
+	closed bool
+	err    error
+}
+
+var (
+	outputsMu sync.Mutex
+	outputs   = make(map[*Output]bool)
+	// closeErr is the first error from flushing or closing any output, so that an error from a deferred Close
+	// that nobody checked is still reported by CloseAll
+	closeErr error
+)
+
+// newOutput wraps f in a buffer and keeps track of it until it is closed
+func newOutput(f *os.File) *Output {
+	return newOutputSize(f, outputBufferSize)
+}
+
+// newOutputSize is newOutput with a buffer of size bytes
+func newOutputSize(f *os.File, size int) *Output {
+	o := &Output{f: f, w: bufio.NewWriterSize(f, size)}
+	outputsMu.Lock()
+	outputs[o] = true
+	outputsMu.Unlock()
+	return o
+}
+
+// Write writes p to the buffer, which is written to the file whenever it fills
+func (o *Output) Write(p []byte) (int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.closed {
+		return 0, os.ErrClosed
+	}
+	return o.w.Write(p)
+}
+
+// WriteString writes s to the buffer, without converting it to a []byte first
+func (o *Output) WriteString(s string) (int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.closed {
+		return 0, os.ErrClosed
+	}
+	return o.w.WriteString(s)
+}
+
+// Flush writes anything in the buffer to the file
+func (o *Output) Flush() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.closed {
+		return o.err
+	}
+	return o.w.Flush()
+}
+
+// Name returns the name of the file, which is /dev/stdout for stdout
+func (o *Output) Name() string {
+	return o.f.Name()
+}
+
+// Close flushes the buffer and closes the file. Closing an output more than once returns the same error each
+// time. Stdout is flushed but left open
+func (o *Output) Close() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.close()
+}
+
+// close is Close. o.mu must be held
+func (o *Output) close() error {
+	if o.closed {
+		return o.err
+	}
+	o.closed = true
+	o.err = o.w.Flush()
+	if o.f != os.Stdout {
+		if err := o.f.Close(); o.err == nil {
+			o.err = err
+		}
+	}
+
+	outputsMu.Lock()
+	delete(outputs, o)
+	if closeErr == nil {
+		closeErr = o.err
+	}
+	outputsMu.Unlock()
+
+	return o.err
+}
+
+// CloseAll closes every output that is still open, and returns the first error from flushing or closing any
+// output since the program started, including those that have already been closed. Commands defer each
+// output's Close without checking it, so this is what reports a write that failed when the last of the buffer
+// was flushed
+func CloseAll() error {
+	outputsMu.Lock()
+	open := make([]*Output, 0, len(outputs))
+	for o := range outputs {
+		open = append(open, o)
+	}
+	outputsMu.Unlock()
+
+	for _, o := range open {
+		o.Close()
+	}
+
+	outputsMu.Lock()
+	defer outputsMu.Unlock()
+	return closeErr
+}
+
+// FlushOnInterrupt arranges for everything that has been written to every output to be flushed if the program
+// is interrupted (by Ctrl-C, say), before it exits with status 130, so that what was finished isn't lost
+func FlushOnInterrupt() {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt)
+	go func() {
+		<-c
+		outputsMu.Lock()
+		open := make([]*Output, 0, len(outputs))
+		for o := range outputs {
+			open = append(open, o)
+		}
+		outputsMu.Unlock()
+		// each output is locked and left locked, so that nothing else is written to it after it is flushed
+		for _, o := range open {
+			o.mu.Lock()
+			o.w.Flush()
+		}
+		os.Exit(130)
+	}()
+}
@@ -0,0 +1,94 @@
+package gfio
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+func TestOutput(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "out.csv")
+	f, err := os.Create(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	o := newOutput(f)
+	o.WriteString("query,closest\n")
+	o.Write([]byte("q1,t1\n"))
+
+	b, _ := os.ReadFile(name)
+	if len(b) != 0 {
+		t.Errorf("problem in TestOutput(): expected nothing to be written before a flush, got %q", b)
+	}
+
+	if err := CloseAll(); err != nil {
+		t.Error(err)
+	}
+	b, _ = os.ReadFile(name)
+	if string(b) != "query,closest\nq1,t1\n" {
+		t.Errorf("problem in TestOutput(): got %q", b)
+	}
+
+	if err := o.Close(); err != nil {
+		t.Error(err)
+	}
+	if _, err := o.WriteString("q2,t2\n"); !errors.Is(err, os.ErrClosed) {
+		t.Errorf("problem in TestOutput(): expected os.ErrClosed writing after Close, got %v", err)
+	}
+}
+
+func TestOpenOutPrivate(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "mapping.csv")
+	if err := os.WriteFile(name, []byte("old contents\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	flags.String("mapping", name, "")
+	o, err := OpenOutPrivate(*flags.Lookup("mapping"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	o.WriteString("new,old\n")
+	if err := o.Close(); err != nil {
+		t.Error(err)
+	}
+
+	b, _ := os.ReadFile(name)
+	if string(b) != "new,old\n" {
+		t.Errorf("problem in TestOpenOutPrivate(): got %q", b)
+	}
+	info, err := os.Stat(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("problem in TestOpenOutPrivate(): expected permissions 0600, got %o", info.Mode().Perm())
+	}
+}
+
+func TestOpenOutPath(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "gene.fasta")
+	o, err := OpenOutPath(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	o.WriteString(">a\nATG\n")
+
+	// it is one of the outputs that CloseAll (and an interrupt) flushes
+	if err := CloseAll(); err != nil {
+		t.Error(err)
+	}
+	b, _ := os.ReadFile(name)
+	if string(b) != ">a\nATG\n" {
+		t.Errorf("problem in TestOpenOutPath(): got %q", b)
+	}
+
+	if _, err := OpenOutPath(filepath.Join(t.TempDir(), "missing", "gene.fasta")); err == nil {
+		t.Errorf("problem in TestOpenOutPath(): expected an error for a directory that doesn't exist")
+	}
+}
@@ -0,0 +1,178 @@
+package gfio
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// RemoteOptions controls how inputs given as URLs are fetched
+type RemoteOptions struct {
+	Retries int           // number of extra attempts after the first failed one
+	Timeout time.Duration // timeout for each attempt (0 means no timeout)
+	Backoff time.Duration // wait before the first retry. Doubles after every failed attempt
+}
+
+// Remote is the set of options that OpenIn uses when its argument is a URL. It is set from the command line
+var Remote = RemoteOptions{Retries: 3, Timeout: 10 * time.Minute, Backoff: time.Second}
+
+// IsRemote returns true if the path looks like something that should be fetched over the network
+func IsRemote(path string) bool {
+	return strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://")
+}
+
+// splitChecksum separates an optional expected checksum from a URL. The checksum is given as a fragment
+// in the form "#sha256=<hex>" or "#md5=<hex>"
+func splitChecksum(url string) (string, string, string, error) {
+	i := strings.LastIndex(url, "#")
+	if i == -1 {
+		return url, "", "", nil
+	}
+	algo, sum, ok := strings.Cut(url[i+1:], "=")
+	if !ok {
+		return url, "", "", nil
+	}
+	algo = strings.ToLower(algo)
+	switch algo {
+	case "sha256", "md5":
+	default:
+		return "", "", "", errors.New("unsupported checksum algorithm in " + url + " (choose one of sha256 or md5)")
+	}
+	return url[:i], algo, strings.ToLower(sum), nil
+}
+
+func newHash(algo string) hash.Hash {
+	switch algo {
+	case "sha256":
+		return sha256.New()
+	case "md5":
+		return md5.New()
+	}
+	return nil
+}
+
+// statusError is returned by fetchOnce when the server answers with anything other than 200 OK
+type statusError struct {
+	url    string
+	code   int
+	status string
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("fetching %s: %s", e.url, e.status)
+}
+
+// retryable returns true if a failed attempt is worth trying again. Most 4xx responses (a missing file, a
+// bad request, no permission) will be the same next time, but a server error, 429 Too Many Requests, a
+// network error or a short or corrupt body might not be
+func retryable(err error) bool {
+	var se *statusError
+	if errors.As(err, &se) {
+		return se.code >= 500 || se.code == http.StatusTooManyRequests
+	}
+	return true
+}
+
+// fetchOnce makes one attempt at downloading url to w. It returns an error if the body is shorter than
+// the Content-Length the server reported, so that a dropped connection isn't mistaken for a small file
+func fetchOnce(url string, timeout time.Duration, w io.Writer) error {
+	client := &http.Client{Timeout: timeout}
+	resp, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return &statusError{url: url, code: resp.StatusCode, status: resp.Status}
+	}
+
+	n, err := io.Copy(w, resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.ContentLength >= 0 && n != resp.ContentLength {
+		return fmt.Errorf("fetching %s: truncated response (got %d of %d bytes)", url, n, resp.ContentLength)
+	}
+
+	return nil
+}
+
+// Fetch downloads url to a temporary file, retrying on failure, and returns the file rewound to its start.
+// If url ends in a fragment like "#sha256=<hex>", the contents are verified against it before returning.
+// The temporary file is unlinked straight away, so it is cleaned up when the returned file is closed. Only
+// network errors, server errors, 429s and bad downloads are retried. opts.Retries can't be negative
+func Fetch(url string, opts RemoteOptions) (*os.File, error) {
+
+	if opts.Retries < 0 {
+		return nil, fmt.Errorf("the number of retries can't be negative (got %d)", opts.Retries)
+	}
+
+	url, algo, expected, err := splitChecksum(url)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.CreateTemp("", "gofasta-remote-*")
+	if err != nil {
+		return nil, err
+	}
+	os.Remove(f.Name())
+
+	backoff := opts.Backoff
+
+	for attempt := 0; attempt <= opts.Retries; attempt++ {
+		if attempt > 0 {
+			fmt.Fprintf(os.Stderr, "retrying %s (attempt %d of %d): %v\n", url, attempt+1, opts.Retries+1, err)
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+
+		if _, err = f.Seek(0, io.SeekStart); err != nil {
+			break
+		}
+		if err = f.Truncate(0); err != nil {
+			break
+		}
+
+		var w io.Writer = f
+		var h hash.Hash
+		if algo != "" {
+			h = newHash(algo)
+			w = io.MultiWriter(f, h)
+		}
+
+		if err = fetchOnce(url, opts.Timeout, w); err != nil {
+			if !retryable(err) {
+				break
+			}
+			continue
+		}
+
+		if h != nil {
+			got := hex.EncodeToString(h.Sum(nil))
+			if got != expected {
+				err = fmt.Errorf("fetching %s: %s checksum mismatch (expected %s, got %s)", url, algo, expected, got)
+				continue
+			}
+		}
+
+		_, err = f.Seek(0, io.SeekStart)
+		if err != nil {
+			break
+		}
+
+		return f, nil
+	}
+
+	f.Close()
+	return nil, err
+}
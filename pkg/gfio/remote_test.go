@@ -0,0 +1,115 @@
+package gfio
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFetch(t *testing.T) {
+	body := ">seq1\nATGATG\n"
+
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		io.WriteString(w, body)
+	}))
+	defer ts.Close()
+
+	opts := RemoteOptions{Retries: 2, Timeout: time.Second, Backoff: time.Millisecond}
+
+	f, err := Fetch(ts.URL+"/a.fasta#sha256=c5e1e6ec3db3e254ee13e3e2dd3a7ef5d3dfc3e5665d43e5cf94a16ac2b8c60f", opts)
+	if err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Errorf("expected a checksum mismatch, got: %v", err)
+	}
+	if f != nil {
+		f.Close()
+	}
+
+	calls = 0
+	f, err = Fetch(ts.URL+"/a.fasta#sha256=3d340cb46a34814f231305425dc3080e1e66fecf0d9e1e4f40abb15dc12bb06a", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if calls != 2 {
+		t.Errorf("expected 2 attempts, got %d", calls)
+	}
+	got, _ := io.ReadAll(f)
+	if string(got) != body {
+		t.Errorf("problem in TestFetch(): got %q", string(got))
+	}
+}
+
+func TestFetchTruncated(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", "100")
+		io.WriteString(w, ">seq1\nATG")
+	}))
+	defer ts.Close()
+
+	_, err := Fetch(ts.URL, RemoteOptions{Retries: 1, Timeout: time.Second, Backoff: time.Millisecond})
+	if err == nil {
+		t.Errorf("expected an error for a truncated response")
+	}
+}
+
+func TestSplitChecksum(t *testing.T) {
+	url, algo, sum, err := splitChecksum("https://example.org/a.fasta#MD5=ABC")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if url != "https://example.org/a.fasta" || algo != "md5" || sum != "abc" {
+		t.Errorf("problem in TestSplitChecksum(): %s %s %s", url, algo, sum)
+	}
+	_, _, _, err = splitChecksum("https://example.org/a.fasta#crc=1")
+	if err == nil {
+		t.Errorf("expected an error for an unsupported algorithm")
+	}
+}
+
+func TestFetchRetries(t *testing.T) {
+	calls := 0
+	code := http.StatusNotFound
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(code)
+	}))
+	defer ts.Close()
+
+	opts := RemoteOptions{Retries: 2, Timeout: time.Second, Backoff: time.Millisecond}
+
+	// a 404 won't be any different next time
+	if _, err := Fetch(ts.URL, opts); err == nil {
+		t.Errorf("problem in TestFetchRetries(): expected an error for a 404")
+	}
+	if calls != 1 {
+		t.Errorf("problem in TestFetchRetries(): expected 1 attempt for a 404, got %d", calls)
+	}
+
+	// but a 429 might be
+	calls = 0
+	code = http.StatusTooManyRequests
+	if _, err := Fetch(ts.URL, opts); err == nil {
+		t.Errorf("problem in TestFetchRetries(): expected an error for a 429")
+	}
+	if calls != 3 {
+		t.Errorf("problem in TestFetchRetries(): expected 3 attempts for a 429, got %d", calls)
+	}
+
+	calls = 0
+	opts.Retries = -1
+	if _, err := Fetch(ts.URL, opts); err == nil {
+		t.Errorf("problem in TestFetchRetries(): expected an error for a negative number of retries")
+	}
+	if calls != 0 {
+		t.Errorf("problem in TestFetchRetries(): expected no attempts with a negative number of retries, got %d", calls)
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Package grep implements a search for short, possibly degenerate, motifs in
+every record of a fasta file, on both strands, with optional extraction of the
+matches and their flanking sequence.
+*/
+package grep
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/alphabet"
+	"github.com/virus-evolution/gofasta/pkg/encoding"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/summary"
+)
+
+var ep = encoding.MakeEncodingArray()
+
+// Motif is a sequence to search for, which may contain IUPAC ambiguity codes
+type Motif struct {
+	Name string
+	Seq  string
+}
+
+// Match is one occurrence of a motif in a record. Start and End are 1-based and inclusive, on the record's
+// forward strand. Seq is the record's sequence at the match, read in the motif's orientation
+type Match struct {
+	Record string
+	Motif  *Motif
+	Strand int
+	Start  int
+	End    int
+	Seq    string
+}
+
+// Options controls the search. Unless Ambiguous is set, only unambiguous nucleotides in a record can match,
+// so N never matches anything. Flank is the number of bases either side of each match to extract
+type Options struct {
+	Forward   bool // only search the forward strand
+	Ambiguous bool // let ambiguity codes in the records match any motif base they overlap with
+	Flank     int
+}
+
+// Check returns an error if any motif is empty or has a character that isn't an IUPAC nucleotide code
+func Check(motifs []Motif) error {
+	for _, m := range motifs {
+		if m.Seq == "" {
+			return errors.New("motif " + m.Name + " is empty")
+		}
+		for i := 0; i < len(m.Seq); i++ {
+			if ep[m.Seq[i]] == 0 || m.Seq[i] == '-' || m.Seq[i] == '?' {
+				return errors.New("motif " + m.Name + " has a character that isn't an IUPAC nucleotide code: " + string(m.Seq[i]))
+			}
+		}
+	}
+	return nil
+}
+
+// matchAt returns whether motif (already in the record's orientation) matches seq starting at i
+func matchAt(seq string, i int, motif string, ambiguous bool) bool {
+	for j := 0; j < len(motif); j++ {
+		b := ep[seq[i+j]]
+		// the lowest three bits are set for gaps and unknown characters, and the fourth for unambiguous bases
+		if ep[motif[j]]&b < 16 || b&7 != 0 || (!ambiguous && b&8 != 8) {
+			return false
+		}
+	}
+	return true
+}
+
+// Find returns every match of every motif in seq, sorted by motif and then by position, with forward
+// strand matches before reverse strand matches at the same position. A motif that is its own reverse
+// complement is only reported on the forward strand. Overlapping matches are all reported
+func Find(id, seq string, motifs []Motif, o Options) []Match {
+	matches := make([]Match, 0)
+	for k := range motifs {
+		m := &motifs[k]
+		fwd := strings.ToUpper(m.Seq)
+		rev := alphabet.ReverseComplement(fwd)
+		searchRev := !o.Forward && rev != fwd
+		for i := 0; i+len(fwd) <= len(seq); i++ {
+			if matchAt(seq, i, fwd, o.Ambiguous) {
+				matches = append(matches, Match{Record: id, Motif: m, Strand: 1, Start: i + 1, End: i + len(fwd), Seq: seq[i : i+len(fwd)]})
+			}
+			if searchRev && matchAt(seq, i, rev, o.Ambiguous) {
+				matches = append(matches, Match{Record: id, Motif: m, Strand: -1, Start: i + 1, End: i + len(fwd), Seq: alphabet.ReverseComplement(seq[i : i+len(fwd)])})
+			}
+		}
+	}
+	return matches
+}
+
+// extract returns the match and flank bases either side of it (as many as there are) as a fasta record, in
+// the motif's orientation
+func extract(seq string, m Match, flank int) string {
+	start, end := m.Start-1-flank, m.End+flank
+	if start < 0 {
+		start = 0
+	}
+	if end > len(seq) {
+		end = len(seq)
+	}
+	sub := seq[start:end]
+	strand := "+"
+	if m.Strand == -1 {
+		sub = alphabet.ReverseComplement(sub)
+		strand = "-"
+	}
+	return ">" + m.Record + ":" + strconv.Itoa(start+1) + "-" + strconv.Itoa(end) + "(" + strand + ") " + m.Motif.Name + "\n" + sub + "\n"
+}
+
+// Grep searches every record in the fasta file in for the motifs, and writes every match to out as CSV
+// with the columns record,motif,strand,start,end,match. If extractOut isn't nil, each match and o.Flank
+// bases either side of it are written to it in fasta format. Records that have gaps are searched as they are,
+// and a gap never matches
+func Grep(ctx context.Context, in io.Reader, motifs []Motif, out, extractOut io.Writer, o Options) error {
+
+	if len(motifs) == 0 {
+		return errors.New("no motifs to search for")
+	}
+	if err := Check(motifs); err != nil {
+		return err
+	}
+	if o.Flank < 0 {
+		return errors.New("the flank can't be negative")
+	}
+
+	if _, err := out.Write([]byte("record,motif,strand,start,end,match\n")); err != nil {
+		return err
+	}
+
+	n := 0
+	err := fastaio.EachRecord(ctx, in, func(FR fastaio.FastaRecord) error {
+		seq := strings.ToUpper(FR.Seq)
+		for _, m := range Find(FR.ID, seq, motifs, o) {
+			strand := "+"
+			if m.Strand == -1 {
+				strand = "-"
+			}
+			line := m.Record + "," + m.Motif.Name + "," + strand + "," + strconv.Itoa(m.Start) + "," + strconv.Itoa(m.End) + "," + m.Seq + "\n"
+			if _, err := out.Write([]byte(line)); err != nil {
+				return err
+			}
+			if extractOut != nil {
+				if _, err := extractOut.Write([]byte(extract(FR.Seq, m, o.Flank))); err != nil {
+					return err
+				}
+			}
+			n++
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		summary.Warn("no matches found")
+	}
+
+	return nil
+}
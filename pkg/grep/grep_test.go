@@ -0,0 +1,64 @@
+package grep
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestFind(t *testing.T) {
+	motifs := []Motif{{Name: "m1", Seq: "GANTC"}, {Name: "ecori", Seq: "GAATTC"}}
+
+	//          1234567890123456789
+	seq := "AGACTCTTGAGTCAGAATTCA"
+	matches := Find("r", seq, motifs, Options{})
+	got := make([]string, 0)
+	for _, m := range matches {
+		got = append(got, m.Motif.Name+":"+strings.Repeat("-", (1-m.Strand)/2)+m.Seq+"@"+string(rune('0'+m.Start/10))+string(rune('0'+m.Start%10)))
+	}
+	want := []string{"m1:GACTC@02", "m1:GAGTC@09", "ecori:GAATTC@15"}
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("problem in TestFind(): got %v, want %v", got, want)
+	}
+
+	// a non-palindromic motif on the reverse strand
+	matches = Find("r", "TTGATCCAA", []Motif{{Name: "m", Seq: "GGATC"}}, Options{})
+	if len(matches) != 1 || matches[0].Strand != -1 || matches[0].Start != 3 || matches[0].End != 7 || matches[0].Seq != "GGATC" {
+		t.Errorf("problem in TestFind(): got %+v", matches)
+	}
+	if matches = Find("r", "TTGATCCAA", []Motif{{Name: "m", Seq: "GGATC"}}, Options{Forward: true}); len(matches) != 0 {
+		t.Errorf("problem in TestFind(): got %+v with only the forward strand", matches)
+	}
+
+	// N in the record only matches with Ambiguous, and gaps never match
+	if matches = Find("r", "GANTC", []Motif{{Name: "m", Seq: "GAATC"}}, Options{Forward: true}); len(matches) != 0 {
+		t.Errorf("problem in TestFind(): got %+v", matches)
+	}
+	if matches = Find("r", "GANTC", []Motif{{Name: "m", Seq: "GAATC"}}, Options{Forward: true, Ambiguous: true}); len(matches) != 1 {
+		t.Errorf("problem in TestFind(): got %+v with Ambiguous", matches)
+	}
+	if matches = Find("r", "GA-TC", []Motif{{Name: "m", Seq: "GANTC"}}, Options{Ambiguous: true}); len(matches) != 0 {
+		t.Errorf("problem in TestFind(): got %+v", matches)
+	}
+}
+
+func TestGrep(t *testing.T) {
+	in := ">a desc\nTTTGGATCAAA\n>b\nCCGATCCG\n"
+	out, extracted := new(bytes.Buffer), new(bytes.Buffer)
+	if err := Grep(context.Background(), strings.NewReader(in), []Motif{{Name: "bam", Seq: "GGATC"}}, out, extracted, Options{Flank: 2}); err != nil {
+		t.Fatal(err)
+	}
+	want := "record,motif,strand,start,end,match\na,bam,+,4,8,GGATC\nb,bam,-,3,7,GGATC\n"
+	if out.String() != want {
+		t.Errorf("problem in TestGrep(): got\n%s", out.String())
+	}
+	wantExtracted := ">a:2-10(+) bam\nTTGGATCAA\n>b:1-8(-) bam\nCGGATCGG\n"
+	if extracted.String() != wantExtracted {
+		t.Errorf("problem in TestGrep(): got\n%s", extracted.String())
+	}
+
+	if err := Grep(context.Background(), strings.NewReader(in), []Motif{{Name: "bad", Seq: "GG-TC"}}, out, nil, Options{}); err == nil {
+		t.Errorf("problem in TestGrep(): expected an error for a motif with a gap")
+	}
+}
@@ -0,0 +1,137 @@
+/*
+Package homoplasy implements a screen for sites where the same allele appears
+in many sequences that are otherwise distantly related: either homoplasies or,
+more often in genomic surveillance data, recurrent sequencing or alignment
+artefacts, which are candidates to mask before building trees.
+
+Sequences are first grouped by single-linkage clustering at a SNP-distance
+threshold. An allele that is carried by members of many different clusters
+has arisen (or been called wrongly) more than once.
+*/
+package homoplasy
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/cluster"
+	"github.com/virus-evolution/gofasta/pkg/distance"
+	"github.com/virus-evolution/gofasta/pkg/encoding"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+// Options controls the screen. Sequences within Threshold SNPs of each other (in a chain) are one cluster, and an
+// allele is reported if its carriers are in at least MinClusters clusters, and make up at most MaxFrequency of the
+// sequences with an unambiguous nucleotide at the site
+type Options struct {
+	Threshold    int
+	MinClusters  int
+	MaxFrequency float64
+	Threads      int
+}
+
+// DefaultOptions returns options suited to a few thousand SARS-CoV-2 genomes
+func DefaultOptions() Options {
+	return Options{Threshold: 5, MinClusters: 3, MaxFrequency: 0.5}
+}
+
+// Site is an allele carried by members of several clusters. Position is 1-based
+type Site struct {
+	Position int
+	Ref      byte
+	Alt      byte
+	Carriers int
+	Clusters int
+	Called   int // the number of sequences with an unambiguous nucleotide at the site
+}
+
+// nucs are the unambiguous nucleotides, EP-encoded
+var nucs = [4]byte{136, 72, 40, 24} // A, G, C, T
+
+// Find returns the candidate sites in records (EP-encoded and aligned to ref, which is EP-encoded too), given the
+// cluster of each record, in order of position
+func Find(records []fastaio.EncodedFastaRecord, ref []byte, clusterOf []int, nClusters int, o Options) []Site {
+	sites := make([]Site, 0)
+	seen := make([][]int, 4)
+	for a := range seen {
+		seen[a] = make([]int, nClusters)
+	}
+	for j := range ref {
+		if ref[j]&8 != 8 {
+			continue
+		}
+		var carriers, clusters [4]int
+		called := 0
+		for i := range records {
+			nuc := records[i].Seq[j]
+			if nuc&8 != 8 {
+				continue
+			}
+			called++
+			if nuc == ref[j] {
+				continue
+			}
+			for a, n := range nucs {
+				if nuc != n {
+					continue
+				}
+				carriers[a]++
+				if seen[a][clusterOf[i]] != j+1 {
+					seen[a][clusterOf[i]] = j + 1
+					clusters[a]++
+				}
+			}
+		}
+		for a := range nucs {
+			if clusters[a] < o.MinClusters || float64(carriers[a]) > o.MaxFrequency*float64(called) {
+				continue
+			}
+			sites = append(sites, Site{Position: j + 1, Ref: ref[j], Alt: nucs[a], Carriers: carriers[a], Clusters: clusters[a], Called: called})
+		}
+	}
+	return sites
+}
+
+// Homoplasy clusters the records in the alignment msa, which is held in memory, and writes the sites where an
+// allele that differs from ref (EP-encoded, and aligned to msa) is carried by members of at least o.MinClusters
+// clusters to out, as a CSV file with the columns position,ref,alt,carriers,clusters,frequency. This can be
+// passed to gofasta mask as it is
+func Homoplasy(ctx context.Context, msa io.Reader, ref []byte, out io.Writer, o Options) error {
+
+	if o.Threshold < 0 || o.MinClusters < 1 {
+		return errors.New("the threshold must be at least 0, and the minimum number of clusters at least 1")
+	}
+
+	records, err := fastaio.ReadEncodeAlignmentToList(msa, false)
+	if err != nil {
+		return err
+	}
+	if len(records[0].Seq) != len(ref) {
+		return errors.New("the alignment is not the same length as the reference")
+	}
+
+	clusters, err := cluster.SingleLinkage(ctx, distance.PackRecords(records), o.Threshold, o.Threads)
+	if err != nil {
+		return err
+	}
+	clusterOf := make([]int, len(records))
+	for c, cl := range clusters {
+		for _, m := range cl.Members {
+			clusterOf[m] = c
+		}
+	}
+
+	decoding := encoding.MakeDecodingArray()
+
+	var sb strings.Builder
+	sb.WriteString("position,ref,alt,carriers,clusters,frequency\n")
+	for _, s := range Find(records, ref, clusterOf, len(clusters), o) {
+		sb.WriteString(strconv.Itoa(s.Position) + "," + decoding[s.Ref] + "," + decoding[s.Alt] + "," + strconv.Itoa(s.Carriers) + "," +
+			strconv.Itoa(s.Clusters) + "," + strconv.FormatFloat(float64(s.Carriers)/float64(s.Called), 'f', 4, 64) + "\n")
+	}
+	_, err = out.Write([]byte(sb.String()))
+	return err
+}
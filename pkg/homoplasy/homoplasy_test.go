@@ -0,0 +1,57 @@
+package homoplasy
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/encoding"
+)
+
+func encode(seq string) []byte {
+	ea := encoding.MakeEncodingArray()
+	b := make([]byte, len(seq))
+	for i := range seq {
+		b[i] = ea[seq[i]]
+	}
+	return b
+}
+
+func TestHomoplasy(t *testing.T) {
+	// three clusters, x, y and z, each of which has one member with T at position 10
+	msa := ">x1\nCCCCAAAAAA\n>x2\nCCCCAAAAAT\n>y1\nAAAAGGGGAA\n>y2\nAAAAGGGGAT\n>z1\nTTTTAAAAAT\n>z2\nTTTTAAAAAA\n"
+	ref := encode("AAAAAAAAAA")
+
+	out := new(bytes.Buffer)
+	o := Options{Threshold: 1, MinClusters: 3, MaxFrequency: 0.5}
+	if err := Homoplasy(context.Background(), strings.NewReader(msa), ref, out, o); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "position,ref,alt,carriers,clusters,frequency\n10,A,T,3,3,0.5000\n" {
+		t.Errorf("problem in TestHomoplasy(): got\n%s", out.String())
+	}
+
+	out.Reset()
+	o.MaxFrequency = 0.4
+	if err := Homoplasy(context.Background(), strings.NewReader(msa), ref, out, o); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "position,ref,alt,carriers,clusters,frequency\n" {
+		t.Errorf("problem in TestHomoplasy(): got\n%s", out.String())
+	}
+
+	// with a large enough threshold, everything is one cluster
+	out.Reset()
+	o = Options{Threshold: 10, MinClusters: 2, MaxFrequency: 1}
+	if err := Homoplasy(context.Background(), strings.NewReader(msa), ref, out, o); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "position,ref,alt,carriers,clusters,frequency\n" {
+		t.Errorf("problem in TestHomoplasy(): got\n%s", out.String())
+	}
+
+	if err := Homoplasy(context.Background(), strings.NewReader(msa), encode("AAAA"), out, o); err == nil {
+		t.Errorf("problem in TestHomoplasy(): expected an error for a reference of a different length")
+	}
+}
@@ -0,0 +1,137 @@
+/*
+Package join implements the merging of two alignments that both contain the
+same reference record, by lining up their columns through the reference and
+adding gap columns for each alignment's insertions relative to it, so that
+their records end up in one alignment without re-aligning anything.
+*/
+package join
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/summary"
+)
+
+// column is where one output column comes from in each alignment, or -1 for a gap
+type column struct {
+	a, b int
+}
+
+// find returns the index of the record called name in records, checking that it is there exactly once and that
+// every record is the same length
+func find(records []fastaio.FastaRecord, name, which string) (int, error) {
+	idx := -1
+	for i, FR := range records {
+		if len(FR.Seq) != len(records[0].Seq) {
+			return -1, errors.New(FR.ID + " is not the same length as " + records[0].ID + " in " + which + ": is it an alignment?")
+		}
+		if FR.ID == name {
+			if idx != -1 {
+				return -1, errors.New(name + " is in " + which + " more than once")
+			}
+			idx = i
+		}
+	}
+	if idx == -1 {
+		return -1, errors.New("couldn't find " + name + " in " + which)
+	}
+	return idx, nil
+}
+
+// plan lines up two gapped copies of the same sequence. Columns where both have a base are matched, and columns
+// where only one has a gap (insertions in that alignment) are given a column of their own, with those of a first
+func plan(refA, refB string) ([]column, error) {
+	cols := make([]column, 0, len(refA)+len(refB))
+	i, j := 0, 0
+	for i < len(refA) || j < len(refB) {
+		for i < len(refA) && refA[i] == '-' {
+			cols = append(cols, column{a: i, b: -1})
+			i++
+		}
+		for j < len(refB) && refB[j] == '-' {
+			cols = append(cols, column{a: -1, b: j})
+			j++
+		}
+		if i == len(refA) && j == len(refB) {
+			break
+		}
+		if i == len(refA) || j == len(refB) || !strings.EqualFold(refA[i:i+1], refB[j:j+1]) {
+			return nil, errors.New("the reference is not the same sequence in both alignments")
+		}
+		cols = append(cols, column{a: i, b: j})
+		i++
+		j++
+	}
+	return cols, nil
+}
+
+// Join merges the alignments a and b through their records called refName, and writes the result to out: the
+// records of a, then those of b apart from the reference. The reference sequence, without gaps, must be the
+// same in both. Insertions relative to the reference in one alignment become gaps in the other's records.
+// Both alignments are read into memory
+func Join(a, b io.Reader, refName string, out io.Writer) error {
+
+	recordsA, err := fastaio.ReadFastaToList(a)
+	if err != nil {
+		return err
+	}
+	recordsB, err := fastaio.ReadFastaToList(b)
+	if err != nil {
+		return err
+	}
+
+	refA, err := find(recordsA, refName, "the first alignment")
+	if err != nil {
+		return err
+	}
+	refB, err := find(recordsB, refName, "the second alignment")
+	if err != nil {
+		return err
+	}
+
+	cols, err := plan(recordsA[refA].Seq, recordsB[refB].Seq)
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+	write := func(FR fastaio.FastaRecord, fromA bool) error {
+		if seen[FR.ID] {
+			summary.Warn(FR.ID + " is in both alignments")
+		}
+		seen[FR.ID] = true
+		seq := make([]byte, len(cols))
+		for k, c := range cols {
+			i := c.b
+			if fromA {
+				i = c.a
+			}
+			if i == -1 {
+				seq[k] = '-'
+			} else {
+				seq[k] = FR.Seq[i]
+			}
+		}
+		_, err := out.Write([]byte(">" + FR.Description + "\n" + string(seq) + "\n"))
+		return err
+	}
+
+	for _, FR := range recordsA {
+		if err := write(FR, true); err != nil {
+			return err
+		}
+	}
+	for i, FR := range recordsB {
+		if i == refB {
+			continue
+		}
+		if err := write(FR, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package join
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestJoin(t *testing.T) {
+	a := ">ref\nAC-GTA\n>x\nACTGTA\n"
+	// ref is ACGTA in both: a has an insertion after the C, and b one before the first base and one after the last
+	b := ">y\nTACGTAG\n>ref\n-ACGTA-\n"
+	out := new(bytes.Buffer)
+	if err := Join(strings.NewReader(a), strings.NewReader(b), "ref", out); err != nil {
+		t.Fatal(err)
+	}
+	want := ">ref\n-AC-GTA-\n>x\n-ACTGTA-\n>y\nTAC-GTAG\n"
+	if out.String() != want {
+		t.Errorf("problem in TestJoin(): got\n%s", out.String())
+	}
+
+	if err := Join(strings.NewReader(a), strings.NewReader(">ref\nACGTT\n"), "ref", out); err == nil {
+		t.Errorf("problem in TestJoin(): expected an error for different references")
+	}
+	if err := Join(strings.NewReader(a), strings.NewReader(b), "missing", out); err == nil {
+		t.Errorf("problem in TestJoin(): expected an error for a missing reference")
+	}
+}
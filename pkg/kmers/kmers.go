@@ -0,0 +1,326 @@
+/*
+Package kmers implements k-mer counting of unaligned sequences, per record or
+over a whole file, on one strand or canonically (each k-mer counted together
+with its reverse complement), and alignment-free distances between records'
+k-mer profiles.
+*/
+package kmers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/distance"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+// Distance metrics
+const (
+	Jaccard    = "jaccard"
+	Mash       = "mash"
+	BrayCurtis = "bray-curtis"
+)
+
+// Options controls the counting. With Canonical, each k-mer is counted as the lesser (alphabetically) of
+// itself and its reverse complement, so that the counts don't depend on the strand of the records. With Float32,
+// the distance matrix is held as 32-bit floats, in half the memory
+type Options struct {
+	K         int
+	Canonical bool
+	PerRecord bool
+	Metric    string
+	Format    string
+	Float32   bool
+	Threads   int
+}
+
+// Profile is the k-mer counts of one record (or of every record together). K-mers are packed two bits per
+// base (A, C, G, T = 0, 1, 2, 3), so they sort alphabetically
+type Profile struct {
+	ID     string
+	K      int
+	Counts map[uint64]int
+	Total  int
+}
+
+var twoBit = func() [256]int8 {
+	var a [256]int8
+	for i := range a {
+		a[i] = -1
+	}
+	a['A'], a['C'], a['G'], a['T'] = 0, 1, 2, 3
+	a['a'], a['c'], a['g'], a['t'] = 0, 1, 2, 3
+	return a
+}()
+
+// NewProfile returns an empty profile
+func NewProfile(id string, k int) Profile {
+	return Profile{ID: id, K: k, Counts: make(map[uint64]int)}
+}
+
+// Add counts the k-mers of seq into p. K-mers with anything other than A, C, G or T in them (including gaps)
+// are skipped
+func (p *Profile) Add(seq string, canonical bool) {
+	k := uint(p.K)
+	mask := uint64(1)<<(2*k) - 1
+	var fwd, rev uint64
+	n := 0
+	for i := 0; i < len(seq); i++ {
+		b := twoBit[seq[i]]
+		if b < 0 {
+			n = 0
+			continue
+		}
+		fwd = (fwd<<2 | uint64(b)) & mask
+		rev = rev>>2 | uint64(3-b)<<(2*(k-1))
+		n++
+		if n < p.K {
+			continue
+		}
+		kmer := fwd
+		if canonical && rev < fwd {
+			kmer = rev
+		}
+		p.Counts[kmer]++
+		p.Total++
+	}
+}
+
+// Decode returns the nucleotide sequence of a packed k-mer
+func Decode(kmer uint64, k int) string {
+	b := make([]byte, k)
+	for i := k - 1; i >= 0; i-- {
+		b[i] = "ACGT"[kmer&3]
+		kmer >>= 2
+	}
+	return string(b)
+}
+
+// sorted returns the k-mers in p in alphabetical order
+func (p *Profile) sorted() []uint64 {
+	kmers := make([]uint64, 0, len(p.Counts))
+	for kmer := range p.Counts {
+		kmers = append(kmers, kmer)
+	}
+	sort.Slice(kmers, func(i, j int) bool { return kmers[i] < kmers[j] })
+	return kmers
+}
+
+// Distance returns the distance between two profiles: with Jaccard, one minus the Jaccard index of their sets
+// of k-mers; with Mash, the Mash estimate of the per-base mutation distance from the Jaccard index; and with
+// BrayCurtis, the Bray-Curtis dissimilarity of their counts
+func Distance(a, b *Profile, metric string) (float64, error) {
+	if len(a.Counts) > len(b.Counts) {
+		a, b = b, a
+	}
+	shared, sharedCount := 0, 0
+	for kmer, n := range a.Counts {
+		if m, ok := b.Counts[kmer]; ok {
+			shared++
+			if m < n {
+				n = m
+			}
+			sharedCount += n
+		}
+	}
+	union := len(a.Counts) + len(b.Counts) - shared
+
+	switch metric {
+	case Jaccard, Mash:
+		if union == 0 {
+			return math.NaN(), nil
+		}
+		j := float64(shared) / float64(union)
+		if metric == Jaccard {
+			return 1 - j, nil
+		}
+		if j == 0 {
+			return 1, nil
+		}
+		return -math.Log(2*j/(1+j)) / float64(a.K), nil
+	case BrayCurtis:
+		if a.Total+b.Total == 0 {
+			return math.NaN(), nil
+		}
+		return 1 - 2*float64(sharedCount)/float64(a.Total+b.Total), nil
+	}
+	return 0, errors.New("unknown distance metric: " + metric + " (choose one of jaccard, mash or bray-curtis)")
+}
+
+// Matrix calculates the distance between every pair of profiles, using threads goroutines. It stops and returns
+// ctx's error if ctx is cancelled
+func Matrix(ctx context.Context, profiles []Profile, metric string, threads int) ([][]float64, error) {
+	t, err := Triangle[float64](ctx, profiles, metric, threads)
+	if err != nil {
+		return nil, err
+	}
+	d := make([][]float64, t.Len())
+	for i := range d {
+		d[i] = make([]float64, t.Len())
+		for j := range d[i] {
+			d[i][j] = t.At(i, j)
+		}
+	}
+	return d, nil
+}
+
+// Triangle calculates the distance between every pair of profiles as Matrix does, but stores each pair only
+// once, as T. float32 is precise enough for the six decimal places the distances are written with, but for the
+// last digit of the odd distance that falls on a rounding boundary
+func Triangle[T float32 | float64](ctx context.Context, profiles []Profile, metric string, threads int) (*distance.Triangle[T], error) {
+	if _, err := Distance(&Profile{}, &Profile{}, metric); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(profiles))
+	for i := range profiles {
+		names[i] = profiles[i].ID
+	}
+	t := distance.NewTriangle[T](names)
+
+	err := t.Fill(ctx, threads, func(i, j int) T {
+		d, _ := Distance(&profiles[i], &profiles[j], metric)
+		return T(d)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func formatDistance(d float64) string {
+	return strconv.FormatFloat(d, 'f', 6, 64)
+}
+
+// writeTriangle writes a triangle of distances in format, as WriteMatrix does
+func writeTriangle[T float32 | float64](w io.Writer, t *distance.Triangle[T], format string) error {
+	return distance.WriteFormat(w, t.Names, func(i, j int) string {
+		return formatDistance(float64(t.At(i, j)))
+	}, format)
+}
+
+// WriteMatrix writes a distance matrix in format, which is one of "square", "lower" or "long", laid out in the
+// same way as gofasta matrix
+func WriteMatrix(w io.Writer, names []string, d [][]float64, format string) error {
+	var sb strings.Builder
+	switch format {
+	case "square":
+		sb.WriteString("," + strings.Join(names, ",") + "\n")
+		for i, row := range d {
+			sb.WriteString(names[i])
+			for _, x := range row {
+				sb.WriteString("," + formatDistance(x))
+			}
+			sb.WriteString("\n")
+		}
+	case "lower":
+		for i, row := range d {
+			sb.WriteString(names[i])
+			for j := 0; j < i; j++ {
+				sb.WriteString("," + formatDistance(row[j]))
+			}
+			sb.WriteString("\n")
+		}
+	case "long":
+		sb.WriteString("sequence1,sequence2,distance\n")
+		for i := range d {
+			for j := i + 1; j < len(d); j++ {
+				sb.WriteString(names[i] + "," + names[j] + "," + formatDistance(d[i][j]) + "\n")
+			}
+		}
+	default:
+		return errors.New("unknown matrix format: " + format + " (choose one of square, lower or long)")
+	}
+	_, err := w.Write([]byte(sb.String()))
+	return err
+}
+
+// Kmers counts the k-mers of every record in the fasta file in. If out isn't nil the counts are written
+// to it as CSV: with o.PerRecord in long format with the columns record,kmer,count, and otherwise summed over
+// every record with the columns kmer,count. If distOut isn't nil, the distance matrix between the records'
+// profiles is written to it. It stops and returns ctx's error if ctx is cancelled
+func Kmers(ctx context.Context, in io.Reader, out, distOut io.Writer, o Options) error {
+
+	if o.K < 1 || o.K > 32 {
+		return errors.New("k must be between 1 and 32")
+	}
+	if distOut != nil {
+		if _, err := Distance(&Profile{}, &Profile{}, o.Metric); err != nil {
+			return err
+		}
+	}
+
+	if out != nil {
+		header := "kmer,count\n"
+		if o.PerRecord {
+			header = "record,kmer,count\n"
+		}
+		if _, err := out.Write([]byte(header)); err != nil {
+			return err
+		}
+	}
+
+	total := NewProfile("", o.K)
+	profiles := make([]Profile, 0)
+
+	perRecord := out != nil && o.PerRecord
+	err := fastaio.EachRecord(ctx, in, func(FR fastaio.FastaRecord) error {
+		if out != nil && !o.PerRecord {
+			total.Add(FR.Seq, o.Canonical)
+		}
+		if !perRecord && distOut == nil {
+			return nil
+		}
+		p := NewProfile(FR.ID, o.K)
+		p.Add(FR.Seq, o.Canonical)
+		if perRecord {
+			var sb strings.Builder
+			for _, kmer := range p.sorted() {
+				sb.WriteString(FR.ID + "," + Decode(kmer, o.K) + "," + strconv.Itoa(p.Counts[kmer]) + "\n")
+			}
+			if _, err := out.Write([]byte(sb.String())); err != nil {
+				return err
+			}
+		}
+		if distOut != nil {
+			profiles = append(profiles, p)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if out != nil && !o.PerRecord {
+		var sb strings.Builder
+		for _, kmer := range total.sorted() {
+			sb.WriteString(Decode(kmer, o.K) + "," + strconv.Itoa(total.Counts[kmer]) + "\n")
+		}
+		if _, err := out.Write([]byte(sb.String())); err != nil {
+			return err
+		}
+	}
+
+	if distOut == nil {
+		return nil
+	}
+
+	if o.Float32 {
+		t, err := Triangle[float32](ctx, profiles, o.Metric, o.Threads)
+		if err != nil {
+			return err
+		}
+		return writeTriangle(distOut, t, o.Format)
+	}
+	t, err := Triangle[float64](ctx, profiles, o.Metric, o.Threads)
+	if err != nil {
+		return err
+	}
+	return writeTriangle(distOut, t, o.Format)
+}
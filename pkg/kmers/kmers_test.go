@@ -0,0 +1,86 @@
+package kmers
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestAdd(t *testing.T) {
+	p := NewProfile("a", 2)
+	p.Add("ACGTNAC-GT", false)
+	got := make([]string, 0)
+	for _, kmer := range p.sorted() {
+		got = append(got, Decode(kmer, 2)+":"+string(rune('0'+p.Counts[kmer])))
+	}
+	if strings.Join(got, " ") != "AC:2 CG:1 GT:2" || p.Total != 5 {
+		t.Errorf("problem in TestAdd(): got %v", got)
+	}
+
+	// canonically, a sequence and its reverse complement have the same profile
+	fwd, rev := NewProfile("f", 3), NewProfile("r", 3)
+	fwd.Add("AAGCTTTGA", true)
+	rev.Add("TCAAAGCTT", true)
+	if d, _ := Distance(&fwd, &rev, BrayCurtis); d != 0 {
+		t.Errorf("problem in TestAdd(): a sequence and its reverse complement have distance %f canonically", d)
+	}
+	if Decode(fwd.sorted()[0], 3) != "AAA" {
+		t.Errorf("problem in TestAdd(): TTT should be counted as AAA canonically")
+	}
+}
+
+func TestDistance(t *testing.T) {
+	a, b := NewProfile("a", 2), NewProfile("b", 2)
+	a.Add("AACC", false) // AA AC CC
+	b.Add("AACG", false) // AA AC CG
+	if d, _ := Distance(&a, &b, Jaccard); d != 0.5 {
+		t.Errorf("problem in TestDistance(): jaccard %f", d)
+	}
+	if d, _ := Distance(&a, &b, Mash); math.Abs(d-(-math.Log(2*0.5/1.5)/2)) > 1e-12 {
+		t.Errorf("problem in TestDistance(): mash %f", d)
+	}
+	if d, _ := Distance(&a, &b, BrayCurtis); math.Abs(d-1.0/3) > 1e-12 {
+		t.Errorf("problem in TestDistance(): bray-curtis %f", d)
+	}
+	if _, err := Distance(&a, &b, "euclid"); err == nil {
+		t.Errorf("problem in TestDistance(): expected an error for an unknown metric")
+	}
+}
+
+func TestKmers(t *testing.T) {
+	in := ">a\nAACC\n>b\nAACG\n"
+
+	out := new(bytes.Buffer)
+	if err := Kmers(context.Background(), strings.NewReader(in), out, nil, Options{K: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "kmer,count\nAA,2\nAC,2\nCC,1\nCG,1\n" {
+		t.Errorf("problem in TestKmers(): got\n%s", out.String())
+	}
+
+	out.Reset()
+	dist := new(bytes.Buffer)
+	if err := Kmers(context.Background(), strings.NewReader(in), out, dist, Options{K: 2, PerRecord: true, Metric: Jaccard, Format: "long"}); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "record,kmer,count\na,AA,1\na,AC,1\na,CC,1\nb,AA,1\nb,AC,1\nb,CG,1\n" {
+		t.Errorf("problem in TestKmers(): got\n%s", out.String())
+	}
+	if dist.String() != "sequence1,sequence2,distance\na,b,0.500000\n" {
+		t.Errorf("problem in TestKmers(): got\n%s", dist.String())
+	}
+
+	dist.Reset()
+	if err := Kmers(context.Background(), strings.NewReader(in+">c\nACGT\n"), nil, dist, Options{K: 2, Metric: Jaccard, Format: "square", Float32: true}); err != nil {
+		t.Fatal(err)
+	}
+	if dist.String() != ",a,b,c\na,0.000000,0.500000,0.800000\nb,0.500000,0.000000,0.500000\nc,0.800000,0.500000,0.000000\n" {
+		t.Errorf("problem in TestKmers(): got\n%s", dist.String())
+	}
+
+	if err := Kmers(context.Background(), strings.NewReader(in), out, nil, Options{K: 33}); err == nil {
+		t.Errorf("problem in TestKmers(): expected an error for k > 32")
+	}
+}
@@ -0,0 +1,261 @@
+/*
+Package liftover implements conversion of coordinates between the columns of
+a multiple sequence alignment and the ungapped positions of the reference it
+contains, and so between two alignments that contain the same reference.
+*/
+package liftover
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/summary"
+)
+
+// Map relates the columns of an alignment to the ungapped positions of one of its records. All coordinates
+// are 0-based
+type Map struct {
+	columns []int // the column of each reference base
+	before  []int // the number of reference bases before each column, and in the whole alignment at the end
+}
+
+// NewMap makes a map from the aligned reference sequence seq
+func NewMap(seq string) Map {
+	m := Map{columns: make([]int, 0, len(seq)), before: make([]int, len(seq)+1)}
+	for i := 0; i < len(seq); i++ {
+		m.before[i] = len(m.columns)
+		if seq[i] != '-' {
+			m.columns = append(m.columns, i)
+		}
+	}
+	m.before[len(seq)] = len(m.columns)
+	return m
+}
+
+// Width returns the number of columns in the alignment
+func (m Map) Width() int {
+	return len(m.before) - 1
+}
+
+// Length returns the ungapped length of the reference
+func (m Map) Length() int {
+	return len(m.columns)
+}
+
+// ToReference returns the reference position in column col. ok is false if col is out of range or the
+// reference has a gap there
+func (m Map) ToReference(col int) (int, bool) {
+	if col < 0 || col >= m.Width() || m.before[col+1] == m.before[col] {
+		return 0, false
+	}
+	return m.before[col], true
+}
+
+// ToColumn returns the column that reference position pos is in. ok is false if pos is out of range
+func (m Map) ToColumn(pos int) (int, bool) {
+	if pos < 0 || pos >= len(m.columns) {
+		return 0, false
+	}
+	return m.columns[pos], true
+}
+
+// IntervalToReference returns the half-open interval of reference positions in the half-open interval of
+// columns [start, end). ok is false if the interval is out of range or has no reference bases
+func (m Map) IntervalToReference(start, end int) (int, int, bool) {
+	if start < 0 || end > m.Width() || end <= start {
+		return 0, 0, false
+	}
+	s, e := m.before[start], m.before[end]
+	return s, e, e > s
+}
+
+// IntervalToColumns returns the half-open interval of columns that spans the half-open interval of reference
+// positions [start, end), including any insertion columns inside it. ok is false if the interval is out of range
+func (m Map) IntervalToColumns(start, end int) (int, int, bool) {
+	if start < 0 || end > len(m.columns) || end <= start {
+		return 0, 0, false
+	}
+	return m.columns[start], m.columns[end-1] + 1, true
+}
+
+// ReadMap makes a map from the record called name in the alignment msa, or from its first record if name is ""
+func ReadMap(ctx context.Context, msa io.Reader, name string) (Map, error) {
+	var seq string
+	found := false
+	err := fastaio.EachAlignedRecord(ctx, msa, func(FR fastaio.FastaRecord) error {
+		if !found && (name == "" || FR.ID == name) {
+			seq, found = FR.Seq, true
+		}
+		return nil
+	})
+	if err != nil {
+		return Map{}, err
+	}
+	if !found {
+		if name == "" {
+			return Map{}, errors.New("no records in alignment")
+		}
+		return Map{}, errors.New("couldn't find " + name + " in alignment")
+	}
+	return NewMap(seq), nil
+}
+
+// Converter converts coordinates from the columns of the alignment From to the columns of the alignment To,
+// via the reference both contain. If From is nil, the input is in reference coordinates, and if To is nil,
+// the output is
+type Converter struct {
+	From *Map
+	To   *Map
+}
+
+// NewConverter returns a converter, checking that from and to (if neither is nil) have the same reference
+func NewConverter(from, to *Map) (Converter, error) {
+	if from != nil && to != nil && from.Length() != to.Length() {
+		return Converter{}, errors.New("the reference is " + strconv.Itoa(from.Length()) + " bases long in one alignment and " + strconv.Itoa(to.Length()) + " in the other")
+	}
+	return Converter{From: from, To: to}, nil
+}
+
+// Point converts a 0-based position. ok is false if it has no equivalent, such as an insertion column
+// relative to the reference
+func (c Converter) Point(p int) (int, bool) {
+	ok := true
+	if c.From != nil {
+		if p, ok = c.From.ToReference(p); !ok {
+			return 0, false
+		}
+	}
+	if c.To != nil {
+		p, ok = c.To.ToColumn(p)
+	}
+	return p, ok
+}
+
+// Interval converts a 0-based, half-open interval. Insertion columns at either end are trimmed off
+func (c Converter) Interval(start, end int) (int, int, bool) {
+	ok := true
+	if c.From != nil {
+		if start, end, ok = c.From.IntervalToReference(start, end); !ok {
+			return 0, 0, false
+		}
+	}
+	if c.To != nil {
+		start, end, ok = c.To.IntervalToColumns(start, end)
+	}
+	return start, end, ok
+}
+
+// LiftBED converts the intervals in a BED file. Other columns, and header lines, are copied as they are.
+// Intervals that can't be converted are left out, with a warning
+func LiftBED(in io.Reader, out io.Writer, c Converter) error {
+	s := bufio.NewScanner(in)
+	s.Buffer(make([]byte, 0), 1024*1024)
+
+	w := bufio.NewWriter(out)
+
+	lineN := 0
+	for s.Scan() {
+		lineN++
+		line := s.Text()
+		if len(line) == 0 || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "track") || strings.HasPrefix(line, "browser") {
+			if _, err := w.WriteString(line + "\n"); err != nil {
+				return err
+			}
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) < 3 {
+			return errors.New("couldn't parse BED line " + strconv.Itoa(lineN) + ": fewer than three tab-separated columns")
+		}
+		start, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return errors.New("couldn't parse BED line " + strconv.Itoa(lineN) + ": " + err.Error())
+		}
+		end, err := strconv.Atoi(fields[2])
+		if err != nil {
+			return errors.New("couldn't parse BED line " + strconv.Itoa(lineN) + ": " + err.Error())
+		}
+		start, end, ok := c.Interval(start, end)
+		if !ok {
+			summary.Warn("couldn't convert BED line " + strconv.Itoa(lineN) + " (" + fields[1] + "-" + fields[2] + ")")
+			continue
+		}
+		fields[1], fields[2] = strconv.Itoa(start), strconv.Itoa(end)
+		if _, err := w.WriteString(strings.Join(fields, "\t") + "\n"); err != nil {
+			return err
+		}
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+
+	return w.Flush()
+}
+
+// LiftCSV converts the 1-based positions in the named columns of a CSV file with a header. Other columns are
+// copied as they are. A position that can't be converted is left empty, with a warning
+func LiftCSV(in io.Reader, out io.Writer, c Converter, columns []string) error {
+	cr := csv.NewReader(in)
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err != nil {
+		return err
+	}
+
+	idx := make([]int, 0, len(columns))
+	for _, col := range columns {
+		found := false
+		for i, h := range header {
+			if strings.TrimSpace(h) == col {
+				idx = append(idx, i)
+				found = true
+			}
+		}
+		if !found {
+			return errors.New("couldn't find column " + col + " in CSV header")
+		}
+	}
+
+	cw := csv.NewWriter(out)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	for lineN := 2; ; lineN++ {
+		row, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		for _, i := range idx {
+			if i >= len(row) || row[i] == "" {
+				continue
+			}
+			p, err := strconv.Atoi(row[i])
+			if err != nil {
+				return errors.New("couldn't parse CSV line " + strconv.Itoa(lineN) + ": bad position " + row[i])
+			}
+			if q, ok := c.Point(p - 1); ok {
+				row[i] = strconv.Itoa(q + 1)
+			} else {
+				summary.Warn("couldn't convert position " + row[i] + " on CSV line " + strconv.Itoa(lineN))
+				row[i] = ""
+			}
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
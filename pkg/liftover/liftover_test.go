@@ -0,0 +1,93 @@
+package liftover
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	// columns 2 and 5-6 are insertions relative to the reference
+	m := NewMap("AC-GT--A")
+	if m.Width() != 8 || m.Length() != 5 {
+		t.Errorf("problem in TestMap(): width %d length %d", m.Width(), m.Length())
+	}
+	if p, ok := m.ToReference(3); !ok || p != 2 {
+		t.Errorf("problem in TestMap(): column 3 is at reference %d", p)
+	}
+	if _, ok := m.ToReference(2); ok {
+		t.Errorf("problem in TestMap(): column 2 should have no reference position")
+	}
+	if c, ok := m.ToColumn(4); !ok || c != 7 {
+		t.Errorf("problem in TestMap(): reference 4 is in column %d", c)
+	}
+	if s, e, ok := m.IntervalToReference(2, 7); !ok || s != 2 || e != 4 {
+		t.Errorf("problem in TestMap(): columns 2-7 are reference %d-%d", s, e)
+	}
+	if _, _, ok := m.IntervalToReference(5, 7); ok {
+		t.Errorf("problem in TestMap(): columns 5-7 should have no reference positions")
+	}
+	if s, e, ok := m.IntervalToColumns(1, 5); !ok || s != 1 || e != 8 {
+		t.Errorf("problem in TestMap(): reference 1-5 is columns %d-%d", s, e)
+	}
+}
+
+func TestConverter(t *testing.T) {
+	from, to := NewMap("AC-GTA"), NewMap("A--CGTA")
+	c, err := NewConverter(&from, &to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p, ok := c.Point(3); !ok || p != 4 {
+		t.Errorf("problem in TestConverter(): got %d", p)
+	}
+	if _, ok := c.Point(2); ok {
+		t.Errorf("problem in TestConverter(): expected no conversion of an insertion column")
+	}
+	if s, e, ok := c.Interval(1, 4); !ok || s != 3 || e != 5 {
+		t.Errorf("problem in TestConverter(): got %d-%d", s, e)
+	}
+
+	short := NewMap("ACG")
+	if _, err := NewConverter(&from, &short); err == nil {
+		t.Errorf("problem in TestConverter(): expected an error for different references")
+	}
+}
+
+func TestReadMap(t *testing.T) {
+	msa := ">query\nACGTA\n>ref\nAC--A\n"
+	m, err := ReadMap(context.Background(), strings.NewReader(msa), "ref")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Length() != 3 {
+		t.Errorf("problem in TestReadMap(): got length %d", m.Length())
+	}
+	if _, err := ReadMap(context.Background(), strings.NewReader(msa), "missing"); err == nil {
+		t.Errorf("problem in TestReadMap(): expected an error for a missing record")
+	}
+}
+
+func TestLift(t *testing.T) {
+	m := NewMap("AC-GT--A")
+	c, _ := NewConverter(&m, nil)
+
+	out := new(bytes.Buffer)
+	bed := "track name=test\nref\t0\t3\tfirst\nref\t5\t7\tinsertion\n"
+	if err := LiftBED(strings.NewReader(bed), out, c); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "track name=test\nref\t0\t2\tfirst\n" {
+		t.Errorf("problem in TestLift(): got\n%s", out.String())
+	}
+
+	out.Reset()
+	table := "name,position\na,4\nb,3\nc,\n"
+	if err := LiftCSV(strings.NewReader(table), out, c, []string{"position"}); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "name,position\na,3\nb,\nc,\n" {
+		t.Errorf("problem in TestLift(): got\n%s", out.String())
+	}
+}
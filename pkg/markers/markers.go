@@ -0,0 +1,179 @@
+/*
+Package markers implements a search for the mutations, relative to a
+reference, that distinguish groups of records in an alignment: those found in
+most of one group's records and in few of the others', on which group-defining
+marker sets can be based.
+*/
+package markers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/encoding"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/summary"
+)
+
+// Options controls which mutations are reported for a group: those in at least MinFrequency of the group's
+// records and at most MaxOtherFrequency of every other record, counting only records with an unambiguous
+// nucleotide at the site. Groups with fewer than MinSize records aren't reported
+type Options struct {
+	MinFrequency      float64
+	MaxOtherFrequency float64
+	MinSize           int
+}
+
+// DefaultOptions returns options that report mutations enriched in a group, not only those fixed in it
+func DefaultOptions() Options {
+	return Options{MinFrequency: 0.5, MaxOtherFrequency: 0.05, MinSize: 1}
+}
+
+// bases are the unambiguous nucleotides, in EP encoding, in the order that counts are kept
+var bases = [4]byte{136, 72, 40, 24}
+
+// baseIndex returns the index of an unambiguous nucleotide in bases
+func baseIndex(nuc byte) int {
+	switch nuc {
+	case 136:
+		return 0
+	case 72:
+		return 1
+	case 40:
+		return 2
+	}
+	return 3
+}
+
+// counts are the number of records with each unambiguous nucleotide at each site
+type counts [][4]int
+
+func (c counts) called(i int) int {
+	return c[i][0] + c[i][1] + c[i][2] + c[i][3]
+}
+
+// Marker is one mutation that distinguishes a group. Called and OtherCalled are the number of the group's and
+// of the other records with an unambiguous nucleotide at the site, and Carriers and OtherCarriers the number of
+// those with the mutation
+type Marker struct {
+	Group         string
+	Mutation      string
+	Position      int
+	Records       int
+	Called        int
+	Carriers      int
+	OtherCalled   int
+	OtherCarriers int
+}
+
+func frequency(carriers, called int) float64 {
+	if called == 0 {
+		return 0
+	}
+	return float64(carriers) / float64(called)
+}
+
+// Frequency is the proportion of the group's records with a call at the site that have the mutation
+func (m Marker) Frequency() float64 {
+	return frequency(m.Carriers, m.Called)
+}
+
+// OtherFrequency is the proportion of the other records with a call at the site that have the mutation
+func (m Marker) OtherFrequency() float64 {
+	return frequency(m.OtherCarriers, m.OtherCalled)
+}
+
+// Find returns the markers of every group in order, given the nucleotide counts of each group and of every record
+// together, in order of position and then nucleotide
+func Find(refSeq []byte, order []string, groups map[string]counts, sizes map[string]int, total counts, o Options) []Marker {
+
+	DA := encoding.MakeDecodingArray()
+
+	markers := make([]Marker, 0)
+	for _, group := range order {
+		if sizes[group] < o.MinSize {
+			continue
+		}
+		c := groups[group]
+		for i, ref := range refSeq {
+			if ref&8 != 8 {
+				continue
+			}
+			called, allCalled := c.called(i), total.called(i)
+			if called == 0 {
+				continue
+			}
+			for b, alt := range bases {
+				if alt == ref || c[i][b] == 0 {
+					continue
+				}
+				m := Marker{Group: group, Mutation: DA[ref] + strconv.Itoa(i+1) + DA[alt], Position: i + 1, Records: sizes[group],
+					Called: called, Carriers: c[i][b], OtherCalled: allCalled - called, OtherCarriers: total[i][b] - c[i][b]}
+				if m.Frequency() < o.MinFrequency || m.OtherFrequency() > o.MaxOtherFrequency {
+					continue
+				}
+				markers = append(markers, m)
+			}
+		}
+	}
+
+	return markers
+}
+
+// Markers counts the nucleotides of each group's records, as returned by groupOf, in the alignment msa (records
+// without a group are skipped), and writes the mutations relative to ref that distinguish each group to out, as
+// a CSV file with the columns group,mutation,position,records,called,carriers,frequency,other_called,other_carriers,other_frequency.
+// Groups are in the order that they are first seen, and mutations in order of position
+func Markers(ctx context.Context, msa io.Reader, ref []byte, groupOf func(string) (string, bool), out io.Writer, o Options) error {
+
+	total := make(counts, len(ref))
+	groups := make(map[string]counts)
+	sizes := make(map[string]int)
+	order := make([]string, 0)
+
+	err := fastaio.EachEncodedRecord(ctx, msa, false, func(EFR fastaio.EncodedFastaRecord) error {
+		if len(EFR.Seq) != len(ref) {
+			return errors.New(EFR.ID + " is not the same length as the reference")
+		}
+		group, ok := groupOf(EFR.ID)
+		if !ok {
+			summary.Skipped(EFR.ID, "no group")
+			return nil
+		}
+		c, ok := groups[group]
+		if !ok {
+			c = make(counts, len(ref))
+			groups[group] = c
+			order = append(order, group)
+		}
+		sizes[group]++
+		for i, nuc := range EFR.Seq {
+			if nuc&8 == 8 {
+				b := baseIndex(nuc)
+				c[i][b]++
+				total[i][b]++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(order) == 0 {
+		return errors.New("no records with a group in the alignment")
+	}
+
+	var sb strings.Builder
+	sb.WriteString("group,mutation,position,records,called,carriers,frequency,other_called,other_carriers,other_frequency\n")
+	for _, m := range Find(ref, order, groups, sizes, total, o) {
+		sb.WriteString(m.Group + "," + m.Mutation + "," + strconv.Itoa(m.Position) + "," + strconv.Itoa(m.Records) + "," +
+			strconv.Itoa(m.Called) + "," + strconv.Itoa(m.Carriers) + "," + encoding.FormatFloat(m.Frequency(), 'f', 4) + "," +
+			strconv.Itoa(m.OtherCalled) + "," + strconv.Itoa(m.OtherCarriers) + "," + encoding.FormatFloat(m.OtherFrequency(), 'f', 4) + "\n")
+	}
+
+	_, err = out.Write([]byte(sb.String()))
+	return err
+}
@@ -0,0 +1,43 @@
+package markers
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/encoding"
+)
+
+func TestMarkers(t *testing.T) {
+	ea := encoding.MakeEncodingArray()
+	ref := []byte{ea['A'], ea['C'], ea['G'], ea['T']}
+
+	// x is fixed for C2T, which one of y also has; y has G3A in two of three and A1G in both with a call there
+	msa := ">x1\nATGT\n>x2\nATGT\n>y1\nGTAN\n>y2\nGCAT\n>y3\nNCGT\n>z1\nACGT\n"
+	groups := map[string]string{"x1": "x", "x2": "x", "y1": "y", "y2": "y", "y3": "y"}
+	groupOf := func(id string) (string, bool) {
+		g, ok := groups[id]
+		return g, ok
+	}
+
+	out := new(bytes.Buffer)
+	if err := Markers(context.Background(), strings.NewReader(msa), ref, groupOf, out, Options{MinFrequency: 0.5, MaxOtherFrequency: 0.4, MinSize: 1}); err != nil {
+		t.Fatal(err)
+	}
+	want := "group,mutation,position,records,called,carriers,frequency,other_called,other_carriers,other_frequency\n" +
+		"x,C2T,2,2,2,2,1.0000,3,1,0.3333\n" +
+		"y,A1G,1,3,2,2,1.0000,2,0,0.0000\n" +
+		"y,G3A,3,3,3,2,0.6667,2,0,0.0000\n"
+	if out.String() != want {
+		t.Errorf("problem in TestMarkers(): got\n%s", out.String())
+	}
+
+	out.Reset()
+	if err := Markers(context.Background(), strings.NewReader(msa), ref, groupOf, out, Options{MinFrequency: 0.5, MaxOtherFrequency: 0.4, MinSize: 3}); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(out.String(), "x,") || !strings.Contains(out.String(), "y,G3A") {
+		t.Errorf("problem in TestMarkers(): got\n%s", out.String())
+	}
+}
@@ -0,0 +1,249 @@
+/*
+Package mask implements routines to replace sites in a multiple sequence
+alignment with N (or some other character), for example to hide known
+problematic sites before downstream analysis.
+*/
+package mask
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+// Site is a range of alignment columns to mask, in 0-based half-open coordinates
+type Site struct {
+	Start int
+	End   int
+}
+
+// Masks holds the sites to mask in every record (Global) and those to mask only in particular
+// records (PerRecord, keyed by record ID)
+type Masks struct {
+	Global    []Site
+	PerRecord map[string][]Site
+}
+
+// NewMasks returns an empty set of masks
+func NewMasks() Masks {
+	return Masks{Global: make([]Site, 0), PerRecord: make(map[string][]Site)}
+}
+
+// ReadBED reads intervals from a BED format file. BED coordinates are 0-based and half-open. The
+// chromosome column is ignored and every interval is applied to every record
+func ReadBED(r io.Reader) (Masks, error) {
+	m := NewMasks()
+
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 0), 1024*1024)
+
+	lineN := 0
+	for s.Scan() {
+		lineN++
+		line := s.Text()
+		if len(line) == 0 || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "track") || strings.HasPrefix(line, "browser") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) < 3 {
+			return Masks{}, errors.New("couldn't parse BED line " + strconv.Itoa(lineN) + ": fewer than three tab-separated columns")
+		}
+		start, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return Masks{}, errors.New("couldn't parse BED line " + strconv.Itoa(lineN) + ": " + err.Error())
+		}
+		end, err := strconv.Atoi(fields[2])
+		if err != nil {
+			return Masks{}, errors.New("couldn't parse BED line " + strconv.Itoa(lineN) + ": " + err.Error())
+		}
+		if start < 0 || end < start {
+			return Masks{}, errors.New("couldn't parse BED line " + strconv.Itoa(lineN) + ": bad interval")
+		}
+		m.Global = append(m.Global, Site{Start: start, End: end})
+	}
+
+	return m, s.Err()
+}
+
+// ReadVCF reads sites from a VCF format file, such as the widely-used problematic sites file for SARS-CoV-2.
+// Each record masks the reference allele's span starting at POS (1-based). If filter is not empty, only
+// records whose FILTER column contains filter are used
+func ReadVCF(r io.Reader, filter string) (Masks, error) {
+	m := NewMasks()
+
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 0), 1024*1024)
+
+	lineN := 0
+	for s.Scan() {
+		lineN++
+		line := s.Text()
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) < 4 {
+			return Masks{}, errors.New("couldn't parse VCF line " + strconv.Itoa(lineN) + ": fewer than four tab-separated columns")
+		}
+		if filter != "" {
+			if len(fields) < 7 {
+				return Masks{}, errors.New("couldn't parse VCF line " + strconv.Itoa(lineN) + ": no FILTER column")
+			}
+			keep := false
+			for _, f := range strings.Split(fields[6], ";") {
+				if f == filter {
+					keep = true
+				}
+			}
+			if !keep {
+				continue
+			}
+		}
+		pos, err := strconv.Atoi(fields[1])
+		if err != nil || pos < 1 {
+			return Masks{}, errors.New("couldn't parse VCF line " + strconv.Itoa(lineN) + ": bad POS")
+		}
+		m.Global = append(m.Global, Site{Start: pos - 1, End: pos - 1 + len(fields[3])})
+	}
+
+	return m, s.Err()
+}
+
+// ReadCSV reads sites from a CSV file with a header. It must have a "start" column (1-based, inclusive) and
+// can have an "end" column (1-based, inclusive; the same as start if missing or empty) and a "record"
+// column. Rows with a record name are only applied to that record; rows without one are applied to all records
+func ReadCSV(r io.Reader) (Masks, error) {
+	m := NewMasks()
+
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err != nil {
+		return Masks{}, err
+	}
+
+	recordCol, startCol, endCol := -1, -1, -1
+	for i, h := range header {
+		switch strings.ToLower(strings.TrimSpace(h)) {
+		case "record", "sequence", "query":
+			recordCol = i
+		case "start", "position", "pos":
+			startCol = i
+		case "end":
+			endCol = i
+		}
+	}
+	if startCol == -1 {
+		return Masks{}, errors.New("no start column in mask CSV")
+	}
+
+	for lineN := 2; ; lineN++ {
+		row, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return Masks{}, err
+		}
+		start, err := strconv.Atoi(row[startCol])
+		if err != nil || start < 1 {
+			return Masks{}, errors.New("couldn't parse mask CSV line " + strconv.Itoa(lineN) + ": bad start")
+		}
+		end := start
+		if endCol != -1 && endCol < len(row) && row[endCol] != "" {
+			end, err = strconv.Atoi(row[endCol])
+			if err != nil || end < start {
+				return Masks{}, errors.New("couldn't parse mask CSV line " + strconv.Itoa(lineN) + ": bad end")
+			}
+		}
+		site := Site{Start: start - 1, End: end}
+		if recordCol != -1 && recordCol < len(row) && row[recordCol] != "" {
+			m.PerRecord[row[recordCol]] = append(m.PerRecord[row[recordCol]], site)
+		} else {
+			m.Global = append(m.Global, site)
+		}
+	}
+
+	return m, nil
+}
+
+// Read reads sites from r in the format given by the suffix of path: .bed, .vcf or .csv. vcfFilter is
+// passed to ReadVCF
+func Read(r io.Reader, path string, vcfFilter string) (Masks, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".bed":
+		return ReadBED(r)
+	case ".vcf":
+		return ReadVCF(r, vcfFilter)
+	case ".csv":
+		return ReadCSV(r)
+	}
+	return Masks{}, errors.New("couldn't tell if " + path + " was a .bed, .vcf or .csv file")
+}
+
+// applySites overwrites the sites in seq with char
+func applySites(seq []byte, sites []Site, char byte) error {
+	for _, site := range sites {
+		if site.End > len(seq) {
+			return errors.New("mask site " + strconv.Itoa(site.Start+1) + "-" + strconv.Itoa(site.End) + " is beyond the end of the alignment (" + strconv.Itoa(len(seq)) + " bases)")
+		}
+		for i := site.Start; i < site.End; i++ {
+			seq[i] = char
+		}
+	}
+	return nil
+}
+
+// Apply returns a copy of FR with the global sites and any of its own sites in m overwritten with char
+func (m Masks) Apply(FR fastaio.FastaRecord, char byte) (fastaio.FastaRecord, error) {
+	seq := []byte(FR.Seq)
+	if err := applySites(seq, m.Global, char); err != nil {
+		return FR, err
+	}
+	if sites, ok := m.PerRecord[FR.ID]; ok {
+		if err := applySites(seq, sites, char); err != nil {
+			return FR, errors.New(FR.ID + ": " + err.Error())
+		}
+	}
+	FR.Seq = string(seq)
+	return FR, nil
+}
+
+// ApplyEncoded returns a copy of EFR, an EP-encoded record, with the global sites and any of its own sites in m
+// overwritten with N
+func (m Masks) ApplyEncoded(EFR fastaio.EncodedFastaRecord) (fastaio.EncodedFastaRecord, error) {
+	seq := make([]byte, len(EFR.Seq))
+	copy(seq, EFR.Seq)
+	if err := applySites(seq, m.Global, 240); err != nil {
+		return EFR, err
+	}
+	if sites, ok := m.PerRecord[EFR.ID]; ok {
+		if err := applySites(seq, sites, 240); err != nil {
+			return EFR, errors.New(EFR.ID + ": " + err.Error())
+		}
+	}
+	EFR.Seq = seq
+	return EFR, nil
+}
+
+// Mask replaces the sites in masks with char in every record of the alignment in msa, and writes the
+// masked alignment to out
+func Mask(ctx context.Context, msa io.Reader, masks Masks, char byte, out io.Writer) error {
+
+	return fastaio.EachAlignedRecord(ctx, msa, func(FR fastaio.FastaRecord) error {
+		FR, err := masks.Apply(FR, char)
+		if err != nil {
+			return err
+		}
+		_, err = out.Write([]byte(">" + FR.ID + "\n" + FR.Seq + "\n"))
+		return err
+	})
+}
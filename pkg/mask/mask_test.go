@@ -0,0 +1,88 @@
+package mask
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+var msaData = []byte(`>seq1
+ATGATGATG
+>seq2
+ATGATCATG
+`)
+
+func TestReadBED(t *testing.T) {
+	m, err := ReadBED(strings.NewReader("#comment\nMN908947.3\t0\t2\tfirst\nMN908947.3\t5\t6\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Global) != 2 || m.Global[0] != (Site{0, 2}) || m.Global[1] != (Site{5, 6}) {
+		t.Errorf("problem in TestReadBED(): %v", m.Global)
+	}
+}
+
+func TestReadVCF(t *testing.T) {
+	vcf := `##fileformat=VCFv4.2
+#CHROM	POS	ID	REF	ALT	QUAL	FILTER	INFO
+MN908947.3	3	.	G	.	.	mask	.
+MN908947.3	7	.	AT	.	.	caution	.
+`
+	m, err := ReadVCF(strings.NewReader(vcf), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Global) != 2 || m.Global[0] != (Site{2, 3}) || m.Global[1] != (Site{6, 8}) {
+		t.Errorf("problem in TestReadVCF(): %v", m.Global)
+	}
+
+	m, err = ReadVCF(strings.NewReader(vcf), "mask")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Global) != 1 || m.Global[0] != (Site{2, 3}) {
+		t.Errorf("problem in TestReadVCF() with a filter: %v", m.Global)
+	}
+}
+
+func TestReadCSV(t *testing.T) {
+	m, err := ReadCSV(strings.NewReader("record,start,end\n,1,2\nseq2,6,\nseq2,8,9\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Global) != 1 || m.Global[0] != (Site{0, 2}) {
+		t.Errorf("problem in TestReadCSV(): %v", m.Global)
+	}
+	if len(m.PerRecord["seq2"]) != 2 || m.PerRecord["seq2"][0] != (Site{5, 6}) || m.PerRecord["seq2"][1] != (Site{7, 9}) {
+		t.Errorf("problem in TestReadCSV(): %v", m.PerRecord)
+	}
+}
+
+func TestMask(t *testing.T) {
+	m, err := ReadCSV(strings.NewReader("record,start,end\n,1,2\nseq2,6,\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := new(bytes.Buffer)
+	err = Mask(context.Background(), bytes.NewReader(msaData), m, 'N', out)
+	if err != nil {
+		t.Error(err)
+	}
+	if out.String() != `>seq1
+NNGATGATG
+>seq2
+NNGATNATG
+` {
+		t.Errorf("problem in TestMask(): %s", out.String())
+	}
+}
+
+func TestMaskOutOfRange(t *testing.T) {
+	m := NewMasks()
+	m.Global = append(m.Global, Site{8, 12})
+	err := Mask(context.Background(), bytes.NewReader(msaData), m, 'N', new(bytes.Buffer))
+	if err == nil {
+		t.Errorf("expected an error for a site beyond the alignment")
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Package merge implements routines to concatenate several fasta files into
+one, dealing with records that have the same name in more than one input.
+*/
+package merge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/summary"
+)
+
+// What to do with a record whose name has already been seen
+const (
+	DuplicateError  = "error"
+	DuplicateSkip   = "skip"
+	DuplicateSuffix = "suffix"
+)
+
+// Input is one of the fasta files to merge. Label is used in messages about it (e.g. its filename)
+type Input struct {
+	R     io.Reader
+	Label string
+}
+
+// rename replaces the ID at the start of a record's description with id
+func rename(description, oldID, id string) string {
+	return id + strings.TrimPrefix(description, oldID)
+}
+
+// Merge writes every record in ins to out, in order. onDuplicate says what to do with a record whose ID
+// has been seen before: DuplicateError stops with an error, DuplicateSkip keeps only the first, and DuplicateSuffix
+// renames later ones to ID_2, ID_3, etc. If alignment is true, every record in every input must be the same length
+func Merge(ctx context.Context, ins []Input, out io.Writer, onDuplicate string, alignment bool) error {
+
+	switch onDuplicate {
+	case DuplicateError, DuplicateSkip, DuplicateSuffix:
+	default:
+		return errors.New("unknown duplicate strategy: " + onDuplicate + " (choose one of error, skip or suffix)")
+	}
+
+	seen := make(map[string]string)
+	suffixes := make(map[string]int)
+	width := -1
+
+	for _, in := range ins {
+		err := fastaio.EachRecord(ctx, in.R, func(FR fastaio.FastaRecord) error {
+
+			if alignment {
+				if width == -1 {
+					width = len(FR.Seq)
+				} else if len(FR.Seq) != width {
+					return fmt.Errorf("%s: %s is %d sites long, but the alignment is %d sites wide", in.Label, FR.ID, len(FR.Seq), width)
+				}
+			}
+
+			if first, ok := seen[FR.ID]; ok {
+				switch onDuplicate {
+				case DuplicateError:
+					return fmt.Errorf("%s: duplicate record name %s (first seen in %s)", in.Label, FR.ID, first)
+				case DuplicateSkip:
+					os.Stderr.WriteString(in.Label + ": skipping duplicate record " + FR.ID + "\n")
+					summary.Skipped(FR.ID, "duplicate name")
+					return nil
+				case DuplicateSuffix:
+					id := FR.ID
+					for ok {
+						suffixes[FR.ID]++
+						id = FR.ID + "_" + strconv.Itoa(suffixes[FR.ID]+1)
+						_, ok = seen[id]
+					}
+					summary.Warn(in.Label + ": renamed duplicate record " + FR.ID + " to " + id)
+					FR.Description = rename(FR.Description, FR.ID, id)
+					FR.ID = id
+				}
+			}
+			seen[FR.ID] = in.Label
+
+			_, err := out.Write([]byte(">" + FR.Description + "\n" + FR.Seq + "\n"))
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
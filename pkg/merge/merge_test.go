@@ -0,0 +1,56 @@
+package merge
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+func inputs(contents ...string) []Input {
+	ins := make([]Input, len(contents))
+	for i, c := range contents {
+		ins[i] = Input{R: strings.NewReader(c), Label: "file" + string(rune('1'+i))}
+	}
+	return ins
+}
+
+func TestMerge(t *testing.T) {
+	a := ">seq1 first\nATG\n>seq2\nATG\n"
+	b := ">seq1 second\nCTG\n>seq2_2\nATT\n>seq3\nATGA\n"
+
+	var out bytes.Buffer
+	err := Merge(context.Background(), inputs(a, b), &out, DuplicateError, false)
+	if err == nil || !strings.Contains(err.Error(), "duplicate record name seq1") {
+		t.Errorf("problem in TestMerge() with DuplicateError: %v", err)
+	}
+
+	out.Reset()
+	err = Merge(context.Background(), inputs(a, b), &out, DuplicateSkip, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != ">seq1 first\nATG\n>seq2\nATG\n>seq2_2\nATT\n>seq3\nATGA\n" {
+		t.Errorf("problem in TestMerge() with DuplicateSkip: %s", out.String())
+	}
+
+	out.Reset()
+	err = Merge(context.Background(), inputs(a, b, ">seq2\nGGG\n"), &out, DuplicateSuffix, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != ">seq1 first\nATG\n>seq2\nATG\n>seq1_2 second\nCTG\n>seq2_2\nATT\n>seq3\nATGA\n>seq2_3\nGGG\n" {
+		t.Errorf("problem in TestMerge() with DuplicateSuffix: %s", out.String())
+	}
+
+	out.Reset()
+	err = Merge(context.Background(), inputs(a, b), &out, DuplicateSkip, true)
+	if err == nil || !strings.Contains(err.Error(), "file2: seq3") {
+		t.Errorf("problem in TestMerge() with alignment: %v", err)
+	}
+
+	err = Merge(context.Background(), inputs(a), &out, "rename", false)
+	if err == nil {
+		t.Errorf("expected an error for an unknown strategy")
+	}
+}
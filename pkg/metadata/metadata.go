@@ -0,0 +1,114 @@
+/*
+Package metadata provides a reader for CSV or TSV tables of per-sequence
+metadata, indexed by sequence name, so that they can be joined to the
+records of a fasta file.
+*/
+package metadata
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// Table is a metadata table with one row per sequence
+type Table struct {
+	Columns    []string
+	Rows       [][]string
+	NameColumn string
+	colIdx     map[string]int
+	rowIdx     map[string]int
+}
+
+// SepFromPath returns the field separator for a metadata file: tab if it has the suffix .tsv or .txt, otherwise comma
+func SepFromPath(path string) rune {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".tsv", ".txt":
+		return '\t'
+	}
+	return ','
+}
+
+// Read reads a metadata table with a header row. nameColumn is the column that holds the sequence names,
+// which must be unique
+func Read(r io.Reader, sep rune, nameColumn string) (Table, error) {
+
+	cr := csv.NewReader(r)
+	cr.Comma = sep
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+
+	header, err := cr.Read()
+	if err != nil {
+		if err == io.EOF {
+			return Table{}, errors.New("empty metadata file")
+		}
+		return Table{}, err
+	}
+
+	t := Table{Columns: header, NameColumn: nameColumn, colIdx: make(map[string]int), rowIdx: make(map[string]int)}
+	for i, c := range header {
+		t.colIdx[c] = i
+	}
+	nameIdx, ok := t.colIdx[nameColumn]
+	if !ok {
+		return Table{}, errors.New("no column called " + nameColumn + " in metadata")
+	}
+
+	for {
+		row, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return Table{}, err
+		}
+		for len(row) < len(header) {
+			row = append(row, "")
+		}
+		name := row[nameIdx]
+		if _, ok := t.rowIdx[name]; ok {
+			return Table{}, errors.New("duplicate name in metadata: " + name)
+		}
+		t.rowIdx[name] = len(t.Rows)
+		t.Rows = append(t.Rows, row)
+	}
+
+	return t, nil
+}
+
+// HasColumn returns true if the table has a column called column
+func (t Table) HasColumn(column string) bool {
+	_, ok := t.colIdx[column]
+	return ok
+}
+
+// Has returns true if name is in the table
+func (t Table) Has(name string) bool {
+	_, ok := t.rowIdx[name]
+	return ok
+}
+
+// Get returns the value of column for name. ok is false if either isn't in the table
+func (t Table) Get(name, column string) (string, bool) {
+	r, ok := t.rowIdx[name]
+	if !ok {
+		return "", false
+	}
+	c, ok := t.colIdx[column]
+	if !ok {
+		return "", false
+	}
+	return t.Rows[r][c], true
+}
+
+// Row returns the whole row for name
+func (t Table) Row(name string) ([]string, bool) {
+	r, ok := t.rowIdx[name]
+	if !ok {
+		return nil, false
+	}
+	return t.Rows[r], true
+}
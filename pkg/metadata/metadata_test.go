@@ -0,0 +1,42 @@
+package metadata
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRead(t *testing.T) {
+	data := "name,lineage,date\nseq1,B.1,2021-01-01\nseq2,B.1.1.7\n"
+	table, err := Read(strings.NewReader(data), ',', "name")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, ok := table.Get("seq1", "lineage"); !ok || v != "B.1" {
+		t.Errorf("problem in TestRead(): %s", v)
+	}
+	if v, ok := table.Get("seq2", "date"); !ok || v != "" {
+		t.Errorf("problem in TestRead() with a short row: %s", v)
+	}
+	if _, ok := table.Get("seq3", "date"); ok {
+		t.Errorf("problem in TestRead(): seq3 shouldn't be found")
+	}
+	if !table.HasColumn("date") || table.HasColumn("country") {
+		t.Errorf("problem in TestRead() columns")
+	}
+
+	_, err = Read(strings.NewReader(data), ',', "strain")
+	if err == nil {
+		t.Errorf("expected an error for a missing name column")
+	}
+
+	_, err = Read(strings.NewReader("name\nseq1\nseq1\n"), ',', "name")
+	if err == nil {
+		t.Errorf("expected an error for a duplicate name")
+	}
+}
+
+func TestSepFromPath(t *testing.T) {
+	if SepFromPath("meta.tsv") != '\t' || SepFromPath("meta.csv") != ',' {
+		t.Errorf("problem in TestSepFromPath()")
+	}
+}
@@ -0,0 +1,214 @@
+/*
+Package network implements routines to build a haplotype network from an
+alignment: the sequences are collapsed into haplotypes, which are joined by
+edges weighted by their SNP-distance.
+
+Two kinds of network can be built. A minimum spanning tree (MST) has the
+fewest, shortest edges that connect every haplotype, with ties broken by the
+order of the input. A minimum spanning network (MSN; Bandelt, Forster & Röhl
+1999) is the union of all minimum spanning trees, so shows every equally
+short alternative connection. Median vectors (unsampled ancestral
+haplotypes, as in median-joining networks) are not inferred.
+*/
+package network
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/collapse"
+	"github.com/virus-evolution/gofasta/pkg/distance"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+// The kinds of network that can be built
+const (
+	MST = "mst"
+	MSN = "msn"
+)
+
+// Edge joins two haplotypes, identified by their index in the network, that are Weight SNPs apart
+type Edge struct {
+	From   int
+	To     int
+	Weight int
+}
+
+// Network is a set of haplotypes and the edges between them
+type Network struct {
+	Haplotypes []collapse.Haplotype
+	Edges      []Edge
+}
+
+// unionFind is a disjoint-set forest over the haplotypes
+type unionFind struct {
+	parent []int
+}
+
+func newUnionFind(n int) *unionFind {
+	u := &unionFind{parent: make([]int, n)}
+	for i := range u.parent {
+		u.parent[i] = i
+	}
+	return u
+}
+
+func (u *unionFind) find(i int) int {
+	for u.parent[i] != i {
+		u.parent[i] = u.parent[u.parent[i]]
+		i = u.parent[i]
+	}
+	return i
+}
+
+func (u *unionFind) union(i, j int) {
+	u.parent[u.find(j)] = u.find(i)
+}
+
+// Build joins haplotypes into a network of the given kind (MST or MSN), calculating the distances between
+// their representatives using threads goroutines
+func Build(ctx context.Context, haplotypes []collapse.Haplotype, kind string, threads int) (Network, error) {
+
+	if kind != MST && kind != MSN {
+		return Network{}, errors.New("unknown network type: " + kind + " (choose one of msn or mst)")
+	}
+
+	records := make([]fastaio.EncodedFastaRecord, len(haplotypes))
+	for i, hap := range haplotypes {
+		records[i] = hap.Representative.Encode()
+		records[i].Idx = i
+	}
+	m, err := distance.SNPTriangle[uint32](ctx, distance.PackRecords(records), threads)
+	if err != nil {
+		return Network{}, err
+	}
+
+	n := len(haplotypes)
+
+	// the edges are taken from the triangle one distance at a time, closest first, rather than sorted into a
+	// list of every pair, which would take several times the memory of the triangle itself
+	var maxWeight uint32
+	for i := 0; i < n-1; i++ {
+		for _, d := range m.Row(i) {
+			if d > maxWeight {
+				maxWeight = d
+			}
+		}
+	}
+	present := make([]bool, maxWeight+1)
+	for i := 0; i < n-1; i++ {
+		for _, d := range m.Row(i) {
+			present[d] = true
+		}
+	}
+
+	u := newUnionFind(n)
+	edges := make([]Edge, 0, n)
+	components := n
+
+	// once every haplotype is joined, no edge at a longer distance joins two components
+	for w := uint32(0); w <= maxWeight && components > 1; w++ {
+		if !present[w] {
+			continue
+		}
+		level := len(edges)
+
+		for i := 0; i < n-1; i++ {
+			for k, d := range m.Row(i) {
+				if d != w {
+					continue
+				}
+				j := i + 1 + k
+				if u.find(i) == u.find(j) {
+					continue
+				}
+				edges = append(edges, Edge{From: i, To: j, Weight: int(w)})
+				// in an MST each edge joins two components straight away. In an MSN, every edge at this
+				// distance that joins two components (as they were before this distance) is in some
+				// minimum spanning tree, so they are only joined once the whole distance has been seen
+				if kind == MST {
+					u.union(i, j)
+					components--
+				}
+			}
+		}
+
+		if kind == MSN {
+			for _, e := range edges[level:] {
+				if u.find(e.From) != u.find(e.To) {
+					u.union(e.From, e.To)
+					components--
+				}
+			}
+		}
+	}
+
+	return Network{Haplotypes: haplotypes, Edges: edges}, nil
+}
+
+// WriteNodes writes a CSV file with the columns haplotype,representative,count,members to w
+func (nw Network) WriteNodes(w io.Writer) error {
+	return collapse.WriteTable(w, nw.Haplotypes)
+}
+
+// WriteEdges writes a CSV file with the columns source,target,weight to w
+func (nw Network) WriteEdges(w io.Writer) error {
+	_, err := w.Write([]byte("source,target,weight\n"))
+	if err != nil {
+		return err
+	}
+	for _, e := range nw.Edges {
+		_, err = w.Write([]byte(nw.Haplotypes[e.From].Name + "," + nw.Haplotypes[e.To].Name + "," + strconv.Itoa(e.Weight) + "\n"))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func escape(s string) string {
+	var sb strings.Builder
+	xml.EscapeText(&sb, []byte(s))
+	return sb.String()
+}
+
+// WriteGraphML writes the network to w as an undirected GraphML graph. Nodes have the attributes count and
+// members, and edges have the attribute weight
+func (nw Network) WriteGraphML(w io.Writer) error {
+	var sb strings.Builder
+	sb.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	sb.WriteString(`<graphml xmlns="http://graphml.graphdrawing.org/xmlns">` + "\n")
+	sb.WriteString(`  <key id="count" for="node" attr.name="count" attr.type="int"/>` + "\n")
+	sb.WriteString(`  <key id="members" for="node" attr.name="members" attr.type="string"/>` + "\n")
+	sb.WriteString(`  <key id="weight" for="edge" attr.name="weight" attr.type="int"/>` + "\n")
+	sb.WriteString(`  <graph id="haplotypes" edgedefault="undirected">` + "\n")
+	if _, err := w.Write([]byte(sb.String())); err != nil {
+		return err
+	}
+
+	for _, hap := range nw.Haplotypes {
+		sb.Reset()
+		sb.WriteString(`    <node id="` + escape(hap.Name) + `">` + "\n")
+		sb.WriteString(`      <data key="count">` + strconv.Itoa(len(hap.Members)) + `</data>` + "\n")
+		sb.WriteString(`      <data key="members">` + escape(strings.Join(hap.Members, ";")) + `</data>` + "\n")
+		sb.WriteString(`    </node>` + "\n")
+		if _, err := w.Write([]byte(sb.String())); err != nil {
+			return err
+		}
+	}
+
+	for _, e := range nw.Edges {
+		_, err := w.Write([]byte(`    <edge source="` + escape(nw.Haplotypes[e.From].Name) + `" target="` + escape(nw.Haplotypes[e.To].Name) + `">` +
+			`<data key="weight">` + strconv.Itoa(e.Weight) + `</data></edge>` + "\n"))
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := w.Write([]byte("  </graph>\n</graphml>\n"))
+	return err
+}
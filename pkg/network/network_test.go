@@ -0,0 +1,71 @@
+package network
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/collapse"
+)
+
+// four haplotypes in a square, each one SNP from its neighbours and two from the opposite corner
+var networkMSA = `>a
+AA
+>b
+AC
+>b2
+AC
+>c
+CC
+>d
+CA
+`
+
+func build(t *testing.T, kind string) Network {
+	t.Helper()
+	haps, err := collapse.Collapse(context.Background(), strings.NewReader(networkMSA), collapse.Options{Prefix: "h"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	nw, err := Build(context.Background(), haps, kind, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return nw
+}
+
+func TestBuild(t *testing.T) {
+	var edges bytes.Buffer
+
+	if err := build(t, MST).WriteEdges(&edges); err != nil {
+		t.Fatal(err)
+	}
+	if edges.String() != "source,target,weight\nh1,h2,1\nh1,h4,1\nh2,h3,1\n" {
+		t.Errorf("problem in TestBuild() (mst): %s", edges.String())
+	}
+
+	edges.Reset()
+	if err := build(t, MSN).WriteEdges(&edges); err != nil {
+		t.Fatal(err)
+	}
+	if edges.String() != "source,target,weight\nh1,h2,1\nh1,h4,1\nh2,h3,1\nh3,h4,1\n" {
+		t.Errorf("problem in TestBuild() (msn): %s", edges.String())
+	}
+
+	if _, err := Build(context.Background(), nil, "mj", 1); err == nil {
+		t.Error("expected an error for an unknown network type")
+	}
+}
+
+func TestWriteGraphML(t *testing.T) {
+	var out bytes.Buffer
+	if err := build(t, MST).WriteGraphML(&out); err != nil {
+		t.Fatal(err)
+	}
+	s := out.String()
+	if !strings.Contains(s, `<node id="h2">`) || !strings.Contains(s, `<data key="members">b;b2</data>`) ||
+		!strings.Contains(s, `<edge source="h1" target="h4"><data key="weight">1</data></edge>`) {
+		t.Errorf("problem in TestWriteGraphML(): %s", s)
+	}
+}
@@ -0,0 +1,169 @@
+/*
+Package orfs implements routines to find open reading frames (ORFs) in
+nucleotide sequences, on both strands and in all three frames.
+*/
+package orfs
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/alphabet"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+// Options controls which ORFs are found
+type Options struct {
+	MinLength   int      // minimum number of amino acids, not counting the stop codon
+	StartCodons []string // codons that can start an ORF. Empty means that ORFs run from stop codon to stop codon
+	Partial     bool     // also report ORFs that run off the end of the sequence without a stop codon
+	Table       int      // NCBI translation table
+}
+
+// ORF is one open reading frame. Start and End are 1-based, inclusive positions on the forward strand, and
+// include the stop codon if there is one. Frame is 1, 2 or 3, counted from the 5' end of the strand the ORF is on
+type ORF struct {
+	Start       int
+	End         int
+	Strand      int
+	Frame       int
+	Translation string
+	Complete    bool
+}
+
+// Length returns the length of the ORF in nucleotides
+func (o ORF) Length() int {
+	return o.End - o.Start + 1
+}
+
+// Finder finds ORFs according to its options
+type Finder struct {
+	o      Options
+	CD     map[string]string
+	starts map[string]bool
+}
+
+// NewFinder returns a Finder for o
+func NewFinder(o Options) (*Finder, error) {
+	CD, err := alphabet.MakeCodonDictFromTable(o.Table)
+	if err != nil {
+		return nil, err
+	}
+	f := &Finder{o: o, CD: CD, starts: make(map[string]bool)}
+	for _, s := range o.StartCodons {
+		f.starts[strings.ToUpper(s)] = true
+	}
+	return f, nil
+}
+
+// scan finds the ORFs in one strand of seq, returning them in that strand's 0-based half-open coordinates
+func (f *Finder) scan(seq string, strand int, report func(start, end, frame int, complete bool)) {
+	stopToStop := len(f.starts) == 0
+	for frame := 0; frame < 3; frame++ {
+		start := -1
+		if stopToStop {
+			start = frame
+		}
+		i := frame
+		for ; i+3 <= len(seq); i += 3 {
+			codon := seq[i : i+3]
+			if start == -1 && f.starts[codon] {
+				start = i
+			}
+			if f.CD[codon] != "*" {
+				continue
+			}
+			if start != -1 && (i-start)/3 >= f.o.MinLength {
+				report(start, i+3, frame, true)
+			}
+			start = -1
+			if stopToStop {
+				start = i + 3
+			}
+		}
+		if f.o.Partial && start != -1 && i > start && (i-start)/3 >= f.o.MinLength {
+			report(start, i, frame, false)
+		}
+	}
+}
+
+// Find returns the ORFs in seq (which is degapped first), sorted by their start position
+func (f *Finder) Find(seq string) []ORF {
+	seq = strings.ToUpper(strings.ReplaceAll(seq, "-", ""))
+	rc := alphabet.ReverseComplement(seq)
+	n := len(seq)
+
+	orfs := make([]ORF, 0)
+	for _, strand := range []int{1, -1} {
+		s := seq
+		if strand == -1 {
+			s = rc
+		}
+		f.scan(s, strand, func(start, end, frame int, complete bool) {
+			coding := end
+			if complete {
+				coding -= 3
+			}
+			aa, _ := alphabet.TranslateWithCode(s[start:coding], false, f.CD)
+			o := ORF{Strand: strand, Frame: frame + 1, Translation: aa, Complete: complete, Start: start + 1, End: end}
+			if strand == -1 {
+				o.Start, o.End = n-end+1, n-start
+			}
+			orfs = append(orfs, o)
+		})
+	}
+
+	sort.SliceStable(orfs, func(i, j int) bool {
+		if orfs[i].Start != orfs[j].Start {
+			return orfs[i].Start < orfs[j].Start
+		}
+		return orfs[i].Strand > orfs[j].Strand
+	})
+
+	return orfs
+}
+
+// FindAll writes the ORFs in every record in in to out as a CSV file with the columns
+// record,orf,strand,frame,start,end,length_nt,length_aa,complete. If proteins is not nil, the translation of
+// each ORF (without its stop codon) is written to it in fasta format, named record_orfN
+func FindAll(ctx context.Context, in io.Reader, out io.Writer, proteins io.Writer, o Options) error {
+
+	f, err := NewFinder(o)
+	if err != nil {
+		return err
+	}
+
+	if _, err := out.Write([]byte("record,orf,strand,frame,start,end,length_nt,length_aa,complete\n")); err != nil {
+		return err
+	}
+
+	return fastaio.EachRecord(ctx, in, func(FR fastaio.FastaRecord) error {
+		var sb strings.Builder
+		var pb strings.Builder
+		for i, orf := range f.Find(FR.Seq) {
+			name := FR.ID + "_orf" + strconv.Itoa(i+1)
+			strand := "+"
+			if orf.Strand == -1 {
+				strand = "-"
+			}
+			sb.WriteString(FR.ID + "," + name + "," + strand + "," + strconv.Itoa(orf.Frame) + "," + strconv.Itoa(orf.Start) + "," +
+				strconv.Itoa(orf.End) + "," + strconv.Itoa(orf.Length()) + "," + strconv.Itoa(len(orf.Translation)) + "," +
+				strconv.FormatBool(orf.Complete) + "\n")
+			if proteins != nil {
+				pb.WriteString(">" + name + " " + strconv.Itoa(orf.Start) + "-" + strconv.Itoa(orf.End) + "(" + strand + ")\n" + orf.Translation + "\n")
+			}
+		}
+		if _, err := out.Write([]byte(sb.String())); err != nil {
+			return err
+		}
+		if proteins != nil {
+			if _, err := proteins.Write([]byte(pb.String())); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
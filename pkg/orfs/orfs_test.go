@@ -0,0 +1,68 @@
+package orfs
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/alphabet"
+)
+
+func TestFind(t *testing.T) {
+	f, err := NewFinder(Options{MinLength: 2, StartCodons: []string{"ATG"}, Table: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a forward ORF at 3-14 (MKL*), and the reverse complement of ATGCCCTGA (MP*) at 16-24
+	fwd := "CCATGAAACTGTAAG"
+	rev := alphabet.ReverseComplement("ATGCCCTGA")
+	orfs := f.Find(fwd + rev + "CC")
+
+	if len(orfs) != 2 {
+		t.Fatalf("problem in TestFind(): %v", orfs)
+	}
+	if orfs[0] != (ORF{Start: 3, End: 14, Strand: 1, Frame: 3, Translation: "MKL", Complete: true}) {
+		t.Errorf("problem in TestFind(): %v", orfs[0])
+	}
+	if orfs[1] != (ORF{Start: 16, End: 24, Strand: -1, Frame: 3, Translation: "MP", Complete: true}) {
+		t.Errorf("problem in TestFind(): %v", orfs[1])
+	}
+
+	f, _ = NewFinder(Options{MinLength: 3, StartCodons: []string{"ATG"}, Table: 1})
+	if orfs = f.Find(fwd + rev + "CC"); len(orfs) != 1 {
+		t.Errorf("problem in TestFind() with a longer minimum length: %v", orfs)
+	}
+
+	f, _ = NewFinder(Options{MinLength: 2, StartCodons: []string{"ATG"}, Partial: true, Table: 1})
+	orfs = f.Find("ATGAAAAAA")
+	if len(orfs) != 1 || orfs[0].Complete || orfs[0].End != 9 || orfs[0].Translation != "MKK" {
+		t.Errorf("problem in TestFind() with partial ORFs: %v", orfs)
+	}
+}
+
+func TestFindStopToStop(t *testing.T) {
+	f, err := NewFinder(Options{MinLength: 3, Table: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	orfs := f.Find("TAAGGGCCCTTTTAA")
+	if len(orfs) != 1 || orfs[0].Start != 4 || orfs[0].End != 15 || orfs[0].Translation != "GPF" {
+		t.Errorf("problem in TestFindStopToStop(): %v", orfs)
+	}
+}
+
+func TestFindAll(t *testing.T) {
+	var out, proteins bytes.Buffer
+	err := FindAll(context.Background(), strings.NewReader(">s1\nCCATGAAACTGTAAG\n"), &out, &proteins, Options{MinLength: 2, StartCodons: []string{"ATG"}, Table: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "record,orf,strand,frame,start,end,length_nt,length_aa,complete\ns1,s1_orf1,+,3,3,14,12,3,true\n" {
+		t.Errorf("problem in TestFindAll(): %s", out.String())
+	}
+	if proteins.String() != ">s1_orf1 3-14(+)\nMKL\n" {
+		t.Errorf("problem in TestFindAll() proteins: %s", proteins.String())
+	}
+}
@@ -0,0 +1,180 @@
+/*
+Package outliers implements screening of an alignment for records that are
+unusually far from the rest, for example mis-assemblies, mislabelled samples
+or contaminants, by comparing each record to the alignment's consensus (or a
+reference) and flagging those beyond a robust threshold.
+*/
+package outliers
+
+import (
+	"errors"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/virus-evolution/gofasta/pkg/encoding"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+// mad is the factor that makes the median absolute deviation a consistent estimator of the standard
+// deviation for normally distributed data
+const mad = 1.4826
+
+// Result is one record's distance from the consensus. Distance is SNPs / Compared, where Compared is the
+// number of sites at which both have an unambiguous nucleotide. Z is the robust z-score of Distance
+type Result struct {
+	Query    string
+	SNPs     int
+	Compared int
+	Distance float64
+	Z        float64
+	Outlier  bool
+}
+
+// Consensus returns the majority unambiguous nucleotide in each column of records, with ties going to the
+// first of A, C, G and T, and N where no record has an unambiguous nucleotide
+func Consensus(records []fastaio.FastaRecord) string {
+	if len(records) == 0 {
+		return ""
+	}
+	counts := make([][4]int, len(records[0].Seq))
+	idx := [256]int{}
+	for i := range idx {
+		idx[i] = -1
+	}
+	idx['A'], idx['C'], idx['G'], idx['T'] = 0, 1, 2, 3
+	for _, FR := range records {
+		for i := 0; i < len(FR.Seq) && i < len(counts); i++ {
+			if b := idx[FR.Seq[i]]; b >= 0 {
+				counts[i][b]++
+			}
+		}
+	}
+	seq := make([]byte, len(counts))
+	for i, c := range counts {
+		seq[i] = 'N'
+		best := 0
+		for b := 0; b < 4; b++ {
+			if c[b] > best {
+				best, seq[i] = c[b], "ACGT"[b]
+			}
+		}
+	}
+	return string(seq)
+}
+
+// median returns the median of xs, which it sorts
+func median(xs []float64) float64 {
+	if len(xs) == 0 {
+		return 0
+	}
+	sort.Float64s(xs)
+	n := len(xs)
+	if n%2 == 1 {
+		return xs[n/2]
+	}
+	return (xs[n/2-1] + xs[n/2]) / 2
+}
+
+// Screen compares every record to target, and flags those whose robust z-score (the distance minus the
+// median distance, over the scaled median absolute deviation) is greater than threshold. The scale is never
+// less than one SNP at the median number of compared sites, so that an alignment of near-identical records
+// doesn't flag every record with a single difference
+func Screen(records []fastaio.FastaRecord, target string, threshold float64) ([]Result, error) {
+
+	results := make([]Result, len(records))
+	for k, FR := range records {
+		if len(FR.Seq) != len(target) {
+			return nil, errors.New(FR.ID + " (" + strconv.Itoa(len(FR.Seq)) + " bases) is not the same length as the reference (" + strconv.Itoa(len(target)) + " bases)")
+		}
+		r := Result{Query: FR.ID}
+		for i := 0; i < len(FR.Seq); i++ {
+			if encoding.Certain(FR.Seq[i]) && encoding.Certain(target[i]) {
+				r.Compared++
+				if FR.Seq[i] != target[i] {
+					r.SNPs++
+				}
+			}
+		}
+		if r.Compared > 0 {
+			r.Distance = float64(r.SNPs) / float64(r.Compared)
+		} else {
+			r.Distance = math.NaN()
+		}
+		results[k] = r
+	}
+
+	distances := make([]float64, 0, len(results))
+	compared := make([]float64, 0, len(results))
+	for _, r := range results {
+		if r.Compared > 0 {
+			distances = append(distances, r.Distance)
+			compared = append(compared, float64(r.Compared))
+		}
+	}
+	med := median(distances)
+	deviations := make([]float64, len(distances))
+	for i, d := range distances {
+		deviations[i] = math.Abs(d - med)
+	}
+	scale := mad * median(deviations)
+	if mc := median(compared); mc > 0 && scale < 1/mc {
+		scale = 1 / mc
+	}
+
+	for k := range results {
+		r := &results[k]
+		if r.Compared == 0 {
+			// nothing to compare, so an outlier by default
+			r.Z, r.Outlier = math.Inf(1), true
+			continue
+		}
+		r.Z = (r.Distance - med) / scale
+		r.Outlier = r.Z > threshold
+	}
+
+	return results, nil
+}
+
+// Outliers screens the alignment msa against its consensus, or against ref if it isn't empty, and writes a
+// CSV report to out with the columns query,snps,compared_sites,distance,z,outlier. The alignment is read
+// into memory
+func Outliers(msa io.Reader, ref string, threshold float64, out io.Writer) error {
+
+	records, err := fastaio.ReadFastaToList(msa)
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		return errors.New("no records in alignment")
+	}
+
+	target := ref
+	if target == "" {
+		for _, FR := range records {
+			if len(FR.Seq) != len(records[0].Seq) {
+				return errors.New(FR.ID + " is not the same length as " + records[0].ID + ": outliers needs an alignment")
+			}
+		}
+		target = Consensus(records)
+	}
+
+	results, err := Screen(records, target, threshold)
+	if err != nil {
+		return err
+	}
+
+	if _, err := out.Write([]byte("query,snps,compared_sites,distance,z,outlier\n")); err != nil {
+		return err
+	}
+	for _, r := range results {
+		line := r.Query + "," + strconv.Itoa(r.SNPs) + "," + strconv.Itoa(r.Compared) + "," +
+			strconv.FormatFloat(r.Distance, 'f', 6, 64) + "," + strconv.FormatFloat(r.Z, 'f', 2, 64) + "," + strconv.FormatBool(r.Outlier) + "\n"
+		if _, err := out.Write([]byte(line)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
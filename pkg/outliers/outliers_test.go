@@ -0,0 +1,63 @@
+package outliers
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+func TestConsensus(t *testing.T) {
+	records := []fastaio.FastaRecord{{Seq: "AC-N"}, {Seq: "AGTN"}, {Seq: "TGTN"}}
+	if c := Consensus(records); c != "AGTN" {
+		t.Errorf("problem in TestConsensus(): got %s", c)
+	}
+}
+
+func TestScreen(t *testing.T) {
+	base := strings.Repeat("ACGTTGCA", 25)
+	records := make([]fastaio.FastaRecord, 0)
+	for i := 0; i < 10; i++ {
+		seq := []byte(base)
+		seq[i] = 'N'
+		if i%2 == 0 {
+			seq[100+i] = 'A'
+		}
+		records = append(records, fastaio.FastaRecord{ID: "ok" + string(rune('0'+i)), Seq: string(seq)})
+	}
+	bad := []byte(base)
+	for i := 0; i < 200; i += 10 {
+		bad[i] = 'T'
+	}
+	records = append(records, fastaio.FastaRecord{ID: "bad", Seq: string(bad)})
+	records = append(records, fastaio.FastaRecord{ID: "empty", Seq: strings.Repeat("N", 200)})
+
+	results, err := Screen(records, Consensus(records), 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range results {
+		want := r.Query == "bad" || r.Query == "empty"
+		if r.Outlier != want {
+			t.Errorf("problem in TestScreen(): %+v", r)
+		}
+	}
+	if !math.IsInf(results[11].Z, 1) {
+		t.Errorf("problem in TestScreen(): a record with nothing to compare should have an infinite z")
+	}
+}
+
+func TestOutliers(t *testing.T) {
+	out := new(bytes.Buffer)
+	if err := Outliers(strings.NewReader(">a\nACGT\n>b\nACGA\n"), "ACGT", 5, out); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(out.String(), "query,snps,compared_sites,distance,z,outlier\na,0,4,0.000000,") {
+		t.Errorf("problem in TestOutliers(): got\n%s", out.String())
+	}
+	if err := Outliers(strings.NewReader(">a\nACGT\n"), "ACG", 5, out); err == nil {
+		t.Errorf("problem in TestOutliers(): expected an error for a reference of the wrong length")
+	}
+}
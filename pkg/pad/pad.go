@@ -0,0 +1,121 @@
+/*
+Package pad implements routines to coerce every record in a fasta file to the
+same length, usually the reference's, by padding short records with Ns and
+trimming long ones, so that files that are nearly alignments can be used where
+an alignment is needed.
+*/
+package pad
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/summary"
+)
+
+// What to do with records of the wrong length
+const (
+	Pad   = "pad"   // pad short records (only for Short)
+	Trim  = "trim"  // trim long records (only for Long)
+	Skip  = "skip"  // leave the record out of the output
+	Error = "error" // stop with an error
+)
+
+// Options controls the coercion. Records are anchored at their start (so padded or trimmed at their end) or, if
+// AnchorEnd is true, at their end. Short and Long are what to do with records that are shorter or longer than
+// Length: Pad, Skip or Error for Short, and Trim, Skip or Error for Long
+type Options struct {
+	Length    int
+	AnchorEnd bool
+	Char      byte
+	Short     string
+	Long      string
+}
+
+// DefaultOptions returns options that pad and trim every record at its end, with N
+func DefaultOptions() Options {
+	return Options{Char: 'N', Short: Pad, Long: Trim}
+}
+
+// Apply returns seq padded or trimmed to o.Length
+func Apply(seq string, o Options) string {
+	switch {
+	case len(seq) < o.Length:
+		padding := strings.Repeat(string(o.Char), o.Length-len(seq))
+		if o.AnchorEnd {
+			return padding + seq
+		}
+		return seq + padding
+	case len(seq) > o.Length:
+		if o.AnchorEnd {
+			return seq[len(seq)-o.Length:]
+		}
+		return seq[:o.Length]
+	}
+	return seq
+}
+
+// check checks that the options are valid
+func (o Options) check() error {
+	if o.Length < 1 {
+		return errors.New("the length to pad to must be at least 1")
+	}
+	if o.Short != Pad && o.Short != Skip && o.Short != Error {
+		return errors.New("short records can be padded, skipped or an error, not " + o.Short)
+	}
+	if o.Long != Trim && o.Long != Skip && o.Long != Error {
+		return errors.New("long records can be trimmed, skipped or an error, not " + o.Long)
+	}
+	return nil
+}
+
+// PadAll coerces every record in the fasta file in to o.Length and writes them to out, in order. If reportOut
+// is not nil, a CSV file with the columns record,length,action is written to it, with a line for every record
+// that wasn't already the right length, where action is padded, trimmed or skipped
+func PadAll(ctx context.Context, in io.Reader, out, reportOut io.Writer, o Options) error {
+
+	if err := o.check(); err != nil {
+		return err
+	}
+
+	if reportOut != nil {
+		if _, err := reportOut.Write([]byte("record,length,action\n")); err != nil {
+			return err
+		}
+	}
+
+	return fastaio.EachRecord(ctx, in, func(FR fastaio.FastaRecord) error {
+		action := ""
+		switch {
+		case len(FR.Seq) < o.Length:
+			action = o.Short
+		case len(FR.Seq) > o.Length:
+			action = o.Long
+		}
+		switch action {
+		case Error:
+			return errors.New(FR.ID + " is " + strconv.Itoa(len(FR.Seq)) + " bases long, not " + strconv.Itoa(o.Length))
+		case Skip:
+			summary.Skipped(FR.ID, "wrong length")
+			action = "skipped"
+		case Pad:
+			action = "padded"
+		case Trim:
+			action = "trimmed"
+		}
+		if reportOut != nil && action != "" {
+			if _, err := reportOut.Write([]byte(FR.ID + "," + strconv.Itoa(len(FR.Seq)) + "," + action + "\n")); err != nil {
+				return err
+			}
+		}
+		if action == "skipped" {
+			return nil
+		}
+		_, err := out.Write([]byte(">" + FR.Description + "\n" + Apply(FR.Seq, o) + "\n"))
+		return err
+	})
+}
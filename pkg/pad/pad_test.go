@@ -0,0 +1,56 @@
+package pad
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestApply(t *testing.T) {
+	o := DefaultOptions()
+	o.Length = 5
+	if s := Apply("ACG", o); s != "ACGNN" {
+		t.Errorf("problem in TestApply(): got %s", s)
+	}
+	if s := Apply("ACGTAC", o); s != "ACGTA" {
+		t.Errorf("problem in TestApply(): got %s", s)
+	}
+	o.AnchorEnd, o.Char = true, '-'
+	if s := Apply("ACG", o); s != "--ACG" {
+		t.Errorf("problem in TestApply(): got %s", s)
+	}
+	if s := Apply("ACGTAC", o); s != "CGTAC" {
+		t.Errorf("problem in TestApply(): got %s", s)
+	}
+}
+
+func TestPadAll(t *testing.T) {
+	fasta := ">a desc\nACGT\n>b\nAC\n>c\nACGTAC\n"
+
+	o := DefaultOptions()
+	o.Length = 4
+	out := new(bytes.Buffer)
+	report := new(bytes.Buffer)
+	if err := PadAll(context.Background(), strings.NewReader(fasta), out, report, o); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != ">a desc\nACGT\n>b\nACNN\n>c\nACGT\n" || report.String() != "record,length,action\nb,2,padded\nc,6,trimmed\n" {
+		t.Errorf("problem in TestPadAll(): got\n%s\n%s", out.String(), report.String())
+	}
+
+	o.Long = Skip
+	out.Reset()
+	report.Reset()
+	if err := PadAll(context.Background(), strings.NewReader(fasta), out, report, o); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != ">a desc\nACGT\n>b\nACNN\n" || !strings.HasSuffix(report.String(), "c,6,skipped\n") {
+		t.Errorf("problem in TestPadAll(): got\n%s\n%s", out.String(), report.String())
+	}
+
+	o.Short = Error
+	if err := PadAll(context.Background(), strings.NewReader(fasta), out, nil, o); err == nil {
+		t.Errorf("problem in TestPadAll(): expected an error for a short record")
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Package primers implements routines to check primer and probe binding sites
+in an alignment, reporting the mismatches, ambiguities and gaps in each
+record at each site, with particular attention to the primers' 3' ends.
+*/
+package primers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/alphabet"
+	"github.com/virus-evolution/gofasta/pkg/encoding"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+var ep = encoding.MakeEncodingArray()
+
+// Primer is a primer or probe and its binding site. Start and End are 0-based, half-open alignment
+// coordinates. Seq is 5' to 3', and is the reverse complement of the reference at the site if Strand is -1
+type Primer struct {
+	Name    string
+	Seq     string
+	Start   int
+	End     int
+	Strand  int
+	Located bool
+}
+
+// Site holds the differences between one record and one primer. Differences lists the mismatches and gaps as
+// position:expected>observed (1-based), where expected is the primer on the forward strand
+type Site struct {
+	Primer      *Primer
+	Mismatches  int
+	ThreePrime  int // mismatches and gaps within the 3' end
+	Ambiguities int
+	Gaps        int
+	Differences []string
+}
+
+// Check compares seq with primer's binding site. Mismatches are sites where the record's nucleotide is
+// unambiguous and can't pair with the primer (which may itself be degenerate), ambiguities are sites where the record
+// has an ambiguity code or N, and gaps are deletions in the record. threePrime is how many of the bases at the
+// primer's 3' end count towards ThreePrime
+func Check(seq string, primer *Primer, threePrime int) Site {
+	s := Site{Primer: primer, Differences: make([]string, 0)}
+	expected := primer.Seq
+	if primer.Strand == -1 {
+		expected = alphabet.ReverseComplement(expected)
+	}
+	for i := 0; i < len(expected); i++ {
+		pos := primer.Start + i
+		obs, exp := seq[pos], expected[i]
+
+		// distance from the 3' end, which is at End for forward primers and at Start for reverse ones
+		fromThree := len(expected) - 1 - i
+		if primer.Strand == -1 {
+			fromThree = i
+		}
+		atThree := fromThree < threePrime
+
+		switch {
+		case obs == '-':
+			s.Gaps++
+		case obs != 'A' && obs != 'C' && obs != 'G' && obs != 'T':
+			s.Ambiguities++
+			continue
+		case ep[obs]&ep[exp] < 16:
+			s.Mismatches++
+		default:
+			continue
+		}
+		if atThree {
+			s.ThreePrime++
+		}
+		s.Differences = append(s.Differences, strconv.Itoa(pos+1)+":"+string(exp)+">"+string(obs))
+	}
+	return s
+}
+
+// Options controls how the checks are reported
+type Options struct {
+	ThreePrime int  // number of bases at the 3' end of each primer to report separately
+	All        bool // report every record/primer pair, not just those with a difference
+}
+
+// Primers checks every primer in every record of the alignment msa, and writes a CSV file with the columns
+// query,primer,start,end,strand,mismatches,three_prime,ambiguities,gaps,differences to out, where start and end
+// are 1-based and inclusive. If summaryOut is not nil, a CSV file with the columns
+// primer,records,with_mismatches,with_three_prime,with_ambiguities,with_gaps is written to it
+func Primers(ctx context.Context, msa io.Reader, primers []Primer, out io.Writer, summaryOut io.Writer, o Options) error {
+
+	type counts struct{ mismatches, threePrime, ambiguities, gaps int }
+	totals := make([]counts, len(primers))
+	records := 0
+
+	if _, err := out.Write([]byte("query,primer,start,end,strand,mismatches,three_prime,ambiguities,gaps,differences\n")); err != nil {
+		return err
+	}
+
+	err := fastaio.EachAlignedRecord(ctx, msa, func(FR fastaio.FastaRecord) error {
+		records++
+		var sb strings.Builder
+		for i := range primers {
+			p := &primers[i]
+			if p.End > len(FR.Seq) {
+				return errors.New("primer " + p.Name + " ends beyond the end of " + FR.ID + " (is the alignment in the reference's coordinates?)")
+			}
+			s := Check(FR.Seq, p, o.ThreePrime)
+			if s.Mismatches > 0 {
+				totals[i].mismatches++
+			}
+			if s.ThreePrime > 0 {
+				totals[i].threePrime++
+			}
+			if s.Ambiguities > 0 {
+				totals[i].ambiguities++
+			}
+			if s.Gaps > 0 {
+				totals[i].gaps++
+			}
+			if !o.All && s.Mismatches == 0 && s.Ambiguities == 0 && s.Gaps == 0 {
+				continue
+			}
+			strand := "+"
+			if p.Strand == -1 {
+				strand = "-"
+			}
+			sb.WriteString(FR.ID + "," + p.Name + "," + strconv.Itoa(p.Start+1) + "," + strconv.Itoa(p.End) + "," + strand + "," +
+				strconv.Itoa(s.Mismatches) + "," + strconv.Itoa(s.ThreePrime) + "," + strconv.Itoa(s.Ambiguities) + "," +
+				strconv.Itoa(s.Gaps) + "," + strings.Join(s.Differences, ";") + "\n")
+		}
+		_, err := out.Write([]byte(sb.String()))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if summaryOut == nil {
+		return nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("primer,records,with_mismatches,with_three_prime,with_ambiguities,with_gaps\n")
+	for i, p := range primers {
+		t := totals[i]
+		sb.WriteString(p.Name + "," + strconv.Itoa(records) + "," + strconv.Itoa(t.mismatches) + "," + strconv.Itoa(t.threePrime) + "," +
+			strconv.Itoa(t.ambiguities) + "," + strconv.Itoa(t.gaps) + "\n")
+	}
+	_, err = summaryOut.Write([]byte(sb.String()))
+
+	return err
+}
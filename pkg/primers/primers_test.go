@@ -0,0 +1,83 @@
+package primers
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+//	1         2         3
+//
+// 123456789012345678901234567890
+var ref = "AAAACCCGGGTTTAAACCCGGGATCGATCG"
+
+func TestLocate(t *testing.T) {
+	ps, err := ReadTable(strings.NewReader("name\tseq\nfwd\tCCCGGGTTT\nrev\tCGATCGATCCC\ndeg\tCCCGGRATC\n"), '\t')
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = Locate(ps, ref, 1); err != nil {
+		t.Fatal(err)
+	}
+	if ps[0].Start != 4 || ps[0].End != 13 || ps[0].Strand != 1 {
+		t.Errorf("problem in TestLocate(): %+v", ps[0])
+	}
+	// the reverse complement of rev is GGGATCGATCG, at 20-30
+	if ps[1].Start != 19 || ps[1].End != 30 || ps[1].Strand != -1 {
+		t.Errorf("problem in TestLocate(): %+v", ps[1])
+	}
+	// degenerate primers match
+	if ps[2].Start != 16 || ps[2].Strand != 1 {
+		t.Errorf("problem in TestLocate(): %+v", ps[2])
+	}
+
+	ps = []Primer{{Name: "missing", Seq: "TTTTTTTT"}}
+	if err = Locate(ps, ref, 1); err == nil {
+		t.Error("expected an error for a primer with no binding site")
+	}
+}
+
+func TestReadBED(t *testing.T) {
+	ps, err := ReadBED(strings.NewReader("ref\t4\t13\tfwd\t1\t+\nref\t19\t30\trev\t1\t-\tCGATCGATCCC\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = Locate(ps, ref, 0); err != nil {
+		t.Fatal(err)
+	}
+	if ps[0].Seq != "CCCGGGTTT" || ps[1].Seq != "CGATCGATCCC" || ps[1].Strand != -1 {
+		t.Errorf("problem in TestReadBED(): %+v", ps)
+	}
+}
+
+func TestPrimers(t *testing.T) {
+	ps := []Primer{
+		{Name: "fwd", Seq: "CCCGGGTTT", Start: 4, End: 13, Strand: 1, Located: true},
+		{Name: "rev", Seq: "CGATCGATCCC", Start: 19, End: 30, Strand: -1, Located: true},
+	}
+	msa := ">ref\n" + ref + "\n" +
+		// a mismatch at the 3' end of fwd (position 13) and an N in rev
+		">q1\nAAAACCCGGGTTAAAACCCGGGATNGATCG\n" +
+		// a mismatch at the 5' end of fwd, and a gap at the 3' end of rev (position 20)
+		">q2\nAAAAGCCGGGTTTAAACCC-GGATCGATCG\n"
+
+	var out, summary bytes.Buffer
+	if err := Primers(context.Background(), strings.NewReader(msa), ps, &out, &summary, Options{ThreePrime: 3}); err != nil {
+		t.Fatal(err)
+	}
+	want := "query,primer,start,end,strand,mismatches,three_prime,ambiguities,gaps,differences\n" +
+		"q1,fwd,5,13,+,1,1,0,0,13:T>A\n" +
+		"q1,rev,20,30,-,0,0,1,0,\n" +
+		"q2,fwd,5,13,+,1,0,0,0,5:C>G\n" +
+		"q2,rev,20,30,-,0,1,0,1,20:G>-\n"
+	if out.String() != want {
+		t.Errorf("problem in TestPrimers(): got\n%s\nwant\n%s", out.String(), want)
+	}
+	wantSummary := "primer,records,with_mismatches,with_three_prime,with_ambiguities,with_gaps\n" +
+		"fwd,3,2,1,0,0\n" +
+		"rev,3,0,1,1,1\n"
+	if summary.String() != wantSummary {
+		t.Errorf("problem in TestPrimers() summary: got\n%s\nwant\n%s", summary.String(), wantSummary)
+	}
+}
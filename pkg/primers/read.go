@@ -0,0 +1,190 @@
+package primers
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/alphabet"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/summary"
+)
+
+// Read reads primers from r in the format given by the suffix of path: fasta (.fasta, .fa, .fna), BED (.bed),
+// or a table with a header and the columns name and seq (.tsv, .txt or .csv)
+func Read(r io.Reader, path string) ([]Primer, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".fasta", ".fa", ".fna", ".fas":
+		return ReadFasta(r)
+	case ".bed":
+		return ReadBED(r)
+	case ".tsv", ".txt":
+		return ReadTable(r, '\t')
+	case ".csv":
+		return ReadTable(r, ',')
+	}
+	return nil, errors.New("couldn't tell if " + path + " was a fasta, .bed, .tsv or .csv file")
+}
+
+// ReadFasta reads primers from a fasta file. Their positions must be found with Locate
+func ReadFasta(r io.Reader) ([]Primer, error) {
+	records, err := fastaio.ReadFastaToList(r)
+	if err != nil {
+		return nil, err
+	}
+	ps := make([]Primer, len(records))
+	for i, FR := range records {
+		ps[i] = Primer{Name: FR.ID, Seq: FR.Seq}
+	}
+	return ps, nil
+}
+
+// ReadTable reads primers from a table with a header and the columns name and seq (or sequence). Their
+// positions must be found with Locate
+func ReadTable(r io.Reader, sep rune) ([]Primer, error) {
+	cr := csv.NewReader(r)
+	cr.Comma = sep
+	cr.FieldsPerRecord = -1
+	rows, err := cr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, errors.New("empty primer file")
+	}
+	nameIdx, seqIdx := -1, -1
+	for i, c := range rows[0] {
+		switch strings.ToLower(c) {
+		case "name":
+			nameIdx = i
+		case "seq", "sequence":
+			seqIdx = i
+		}
+	}
+	if nameIdx == -1 || seqIdx == -1 {
+		return nil, errors.New("primer file should have the columns name and seq")
+	}
+	ps := make([]Primer, 0, len(rows)-1)
+	for _, row := range rows[1:] {
+		if len(row) <= nameIdx || len(row) <= seqIdx {
+			return nil, errors.New("short line in primer file")
+		}
+		ps = append(ps, Primer{Name: row[nameIdx], Seq: strings.ToUpper(row[seqIdx])})
+	}
+	return ps, nil
+}
+
+// ReadBED reads primers from a BED file in the style of primer scheme files: chrom, start, end, name, pool/score,
+// strand and, optionally, the primer sequence. If there is no sequence, it is filled in by Locate
+func ReadBED(r io.Reader) ([]Primer, error) {
+	ps := make([]Primer, 0)
+	s := bufio.NewScanner(r)
+	lineN := 0
+	for s.Scan() {
+		lineN++
+		line := s.Text()
+		if len(line) == 0 || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "track") || strings.HasPrefix(line, "browser") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) < 6 {
+			return nil, errors.New("couldn't parse BED line " + strconv.Itoa(lineN) + ": primers need at least six columns (chrom, start, end, name, score and strand)")
+		}
+		start, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return nil, errors.New("couldn't parse BED line " + strconv.Itoa(lineN) + ": " + err.Error())
+		}
+		end, err := strconv.Atoi(fields[2])
+		if err != nil {
+			return nil, errors.New("couldn't parse BED line " + strconv.Itoa(lineN) + ": " + err.Error())
+		}
+		if start < 0 || end <= start {
+			return nil, errors.New("couldn't parse BED line " + strconv.Itoa(lineN) + ": bad interval")
+		}
+		p := Primer{Name: fields[3], Start: start, End: end, Located: true}
+		switch fields[5] {
+		case "+":
+			p.Strand = 1
+		case "-":
+			p.Strand = -1
+		default:
+			return nil, errors.New("couldn't parse BED line " + strconv.Itoa(lineN) + ": strand should be + or -")
+		}
+		if len(fields) > 6 {
+			p.Seq = strings.ToUpper(fields[6])
+			if len(p.Seq) != end-start {
+				return nil, errors.New("BED line " + strconv.Itoa(lineN) + ": primer sequence is not the same length as its interval")
+			}
+		}
+		ps = append(ps, p)
+	}
+	return ps, s.Err()
+}
+
+// mismatches returns the number of sites at which primer (already in the reference's orientation) is
+// incompatible with ref starting at i, stopping early once there are more than max
+func mismatches(ref string, i int, primer string, max int) int {
+	n := 0
+	for j := 0; j < len(primer); j++ {
+		if ep[ref[i+j]]&ep[primer[j]] < 16 {
+			n++
+			if n > max {
+				break
+			}
+		}
+	}
+	return n
+}
+
+// Locate finds the binding site of every primer that doesn't have one on ref, which must be in the alignment's
+// coordinates, on either strand. The best match (the fewest mismatches, preferring the forward strand and then
+// the 5'-most position) is used, as long as it has at most maxMismatches mismatches. Primers from a BED file
+// with no sequence get the reference's sequence at their site
+func Locate(primers []Primer, ref string, maxMismatches int) error {
+	ref = strings.ToUpper(ref)
+	for i := range primers {
+		p := &primers[i]
+		if p.Located {
+			if p.End > len(ref) && ref != "" {
+				return errors.New("primer " + p.Name + " ends beyond the end of the reference")
+			}
+			if p.Seq == "" {
+				if ref == "" {
+					return errors.New("primer " + p.Name + " has no sequence, so a reference is needed")
+				}
+				p.Seq = ref[p.Start:p.End]
+				if p.Strand == -1 {
+					p.Seq = alphabet.ReverseComplement(p.Seq)
+				}
+			}
+			continue
+		}
+		if ref == "" {
+			return errors.New("primer " + p.Name + " has no position, so a reference is needed")
+		}
+		best, bestPos, bestStrand := maxMismatches+1, -1, 0
+		for _, strand := range []int{1, -1} {
+			site := p.Seq
+			if strand == -1 {
+				site = alphabet.ReverseComplement(p.Seq)
+			}
+			for j := 0; j+len(site) <= len(ref); j++ {
+				if m := mismatches(ref, j, site, best-1); m < best {
+					best, bestPos, bestStrand = m, j, strand
+				}
+			}
+		}
+		if bestPos == -1 {
+			return errors.New("couldn't find a binding site for primer " + p.Name + " with at most " + strconv.Itoa(maxMismatches) + " mismatches to the reference")
+		}
+		if best > 0 {
+			summary.Warn("primer " + p.Name + " has " + strconv.Itoa(best) + " mismatches to the reference at its best binding site")
+		}
+		p.Start, p.End, p.Strand, p.Located = bestPos, bestPos+len(p.Seq), bestStrand, true
+	}
+	return nil
+}
@@ -0,0 +1,250 @@
+/*
+Package pwm implements position frequency matrices built from the columns of
+an alignment, and the scanning of sequences against them, as log-odds
+position weight matrices, to find motifs or conserved regions.
+*/
+package pwm
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/alphabet"
+	"github.com/virus-evolution/gofasta/pkg/encoding"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+const bases = "ACGT"
+
+var ep = encoding.MakeEncodingArray()
+
+// Matrix holds the number of A, C, G and T at each position of a profile
+type Matrix [][4]float64
+
+// Build counts the unambiguous nucleotides in the columns start to end (1-based, inclusive) of every record
+// in the alignment msa. A start or end of -1 means the first or last column of the alignment
+func Build(ctx context.Context, msa io.Reader, start, end int) (Matrix, error) {
+	var m Matrix
+	if start == -1 {
+		start = 1
+	}
+	start--
+	err := fastaio.EachAlignedRecord(ctx, msa, func(FR fastaio.FastaRecord) error {
+		if m == nil {
+			if end == -1 {
+				end = len(FR.Seq)
+			}
+			if start < 0 || end > len(FR.Seq) || start >= end {
+				return errors.New("the region is outside the alignment, or empty")
+			}
+			m = make(Matrix, end-start)
+		}
+		for i := start; i < end; i++ {
+			switch FR.Seq[i] {
+			case 'A', 'a':
+				m[i-start][0]++
+			case 'C', 'c':
+				m[i-start][1]++
+			case 'G', 'g':
+				m[i-start][2]++
+			case 'T', 't':
+				m[i-start][3]++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, errors.New("no records in the alignment")
+	}
+	return m, nil
+}
+
+// Write writes the matrix as CSV with the columns position,A,C,G,T
+func (m Matrix) Write(w io.Writer) error {
+	var sb strings.Builder
+	sb.WriteString("position,A,C,G,T\n")
+	for i, row := range m {
+		sb.WriteString(strconv.Itoa(i + 1))
+		for _, c := range row {
+			sb.WriteString("," + strconv.FormatFloat(c, 'f', -1, 64))
+		}
+		sb.WriteString("\n")
+	}
+	_, err := w.Write([]byte(sb.String()))
+	return err
+}
+
+// Read reads a matrix written by Write. The values can be counts or frequencies
+func Read(r io.Reader) (Matrix, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	cols := [4]int{-1, -1, -1, -1}
+	for i, h := range header {
+		if j := strings.Index(bases, strings.ToUpper(strings.TrimSpace(h))); j >= 0 && len(strings.TrimSpace(h)) == 1 {
+			cols[j] = i
+		}
+	}
+	for _, c := range cols {
+		if c == -1 {
+			return nil, errors.New("matrix file should have the columns A, C, G and T")
+		}
+	}
+	m := make(Matrix, 0)
+	for {
+		row, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		var counts [4]float64
+		for j, c := range cols {
+			counts[j], err = strconv.ParseFloat(row[c], 64)
+			if err != nil || counts[j] < 0 {
+				return nil, errors.New("couldn't parse matrix value: " + row[c])
+			}
+		}
+		m = append(m, counts)
+	}
+	if len(m) == 0 {
+		return nil, errors.New("empty matrix")
+	}
+	return m, nil
+}
+
+// Weights returns the log2-odds of each nucleotide at each position against a uniform background, with
+// pseudocount added to each position's total (spread evenly over the four nucleotides)
+func (m Matrix) Weights(pseudocount float64) [][4]float64 {
+	ws := make([][4]float64, len(m))
+	for i, row := range m {
+		total := row[0] + row[1] + row[2] + row[3] + pseudocount
+		for b := 0; b < 4; b++ {
+			p := (row[b] + pseudocount/4) / total
+			if total == 0 {
+				p = 0.25
+			}
+			ws[i][b] = math.Log2(p / 0.25)
+		}
+	}
+	return ws
+}
+
+// Profile is a weight matrix ready for scanning, on both strands
+type Profile struct {
+	fwd, rev [][4]float64
+	min, max float64
+}
+
+// NewProfile makes a profile from weights
+func NewProfile(ws [][4]float64) Profile {
+	p := Profile{fwd: ws, rev: make([][4]float64, len(ws))}
+	for i, row := range ws {
+		// the reverse complement: the last position first, with A and T, and C and G, swapped
+		p.rev[len(ws)-1-i] = [4]float64{row[3], row[2], row[1], row[0]}
+		lo, hi := row[0], row[0]
+		for _, w := range row[1:] {
+			lo, hi = math.Min(lo, w), math.Max(hi, w)
+		}
+		p.min += lo
+		p.max += hi
+	}
+	return p
+}
+
+// score returns the score of ws at seq[i:], and false if the window has a gap or a character that isn't a
+// nucleotide. An ambiguity code scores the mean of the weights of the nucleotides it can be
+func score(ws [][4]float64, seq string, i int) (float64, bool) {
+	s := 0.0
+	for j, row := range ws {
+		c := ep[seq[i+j]]
+		if c < 16 || c&7 != 0 {
+			return 0, false
+		}
+		sum, n := 0.0, 0
+		for b := 0; b < 4; b++ {
+			if c&ep[bases[b]] >= 16 {
+				sum += row[b]
+				n++
+			}
+		}
+		s += sum / float64(n)
+	}
+	return s, true
+}
+
+// Hit is a window of a record that scores highly against a profile. Start and End are 1-based and inclusive,
+// and Relative is the score scaled between the lowest (0) and highest (1) that the profile can give
+type Hit struct {
+	Start    int
+	End      int
+	Strand   int
+	Score    float64
+	Relative float64
+}
+
+// Scan returns the windows of seq with a relative score of at least minRelative, on the forward strand and,
+// if bothStrands, the reverse strand too
+func (p Profile) Scan(seq string, minRelative float64, bothStrands bool) []Hit {
+	hits := make([]Hit, 0)
+	width := len(p.fwd)
+	span := p.max - p.min
+	for i := 0; i+width <= len(seq); i++ {
+		for _, strand := range []int{1, -1} {
+			if strand == -1 && !bothStrands {
+				break
+			}
+			ws := p.fwd
+			if strand == -1 {
+				ws = p.rev
+			}
+			s, ok := score(ws, seq, i)
+			if !ok {
+				break
+			}
+			rel := 1.0
+			if span > 0 {
+				rel = (s - p.min) / span
+			}
+			if rel >= minRelative {
+				hits = append(hits, Hit{Start: i + 1, End: i + width, Strand: strand, Score: s, Relative: rel})
+			}
+		}
+	}
+	return hits
+}
+
+// ScanAll scans every record in the fasta file in against p, and writes a CSV file with the columns
+// record,start,end,strand,score,relative_score,match to out, where match is the record's sequence at the hit
+// (reverse-complemented for the - strand). Windows with gaps aren't scored
+func ScanAll(ctx context.Context, in io.Reader, p Profile, out io.Writer, minRelative float64, bothStrands bool) error {
+	if _, err := out.Write([]byte("record,start,end,strand,score,relative_score,match\n")); err != nil {
+		return err
+	}
+	return fastaio.EachRecord(ctx, in, func(FR fastaio.FastaRecord) error {
+		var sb strings.Builder
+		for _, h := range p.Scan(FR.Seq, minRelative, bothStrands) {
+			match := strings.ToUpper(FR.Seq[h.Start-1 : h.End])
+			strand := "+"
+			if h.Strand == -1 {
+				match = alphabet.ReverseComplement(match)
+				strand = "-"
+			}
+			sb.WriteString(FR.ID + "," + strconv.Itoa(h.Start) + "," + strconv.Itoa(h.End) + "," + strand + "," +
+				strconv.FormatFloat(h.Score, 'f', 4, 64) + "," + strconv.FormatFloat(h.Relative, 'f', 4, 64) + "," + match + "\n")
+		}
+		_, err := out.Write([]byte(sb.String()))
+		return err
+	})
+}
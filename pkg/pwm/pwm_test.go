@@ -0,0 +1,101 @@
+package pwm
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestBuild(t *testing.T) {
+	msa := ">a\nACGTA\n>b\nACGAA\n>c\nACN-A\n"
+	m, err := Build(context.Background(), strings.NewReader(msa), 2, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Matrix{{0, 3, 0, 0}, {0, 0, 2, 0}, {1, 0, 0, 1}}
+	if len(m) != len(want) {
+		t.Fatalf("problem in TestBuild(): got %v", m)
+	}
+	for i := range m {
+		if m[i] != want[i] {
+			t.Errorf("problem in TestBuild(): position %d is %v, want %v", i+1, m[i], want[i])
+		}
+	}
+
+	if _, err := Build(context.Background(), strings.NewReader(msa), 4, 6); err == nil {
+		t.Errorf("problem in TestBuild(): expected an error for a region outside the alignment")
+	}
+}
+
+func TestReadWrite(t *testing.T) {
+	m := Matrix{{1, 2, 0, 0.5}, {0, 0, 4, 0}}
+	out := new(bytes.Buffer)
+	if err := m.Write(out); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "position,A,C,G,T\n1,1,2,0,0.5\n2,0,0,4,0\n" {
+		t.Errorf("problem in TestReadWrite(): got\n%s", out.String())
+	}
+	m2, err := Read(strings.NewReader(out.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m2) != 2 || m2[0] != m[0] || m2[1] != m[1] {
+		t.Errorf("problem in TestReadWrite(): read %v", m2)
+	}
+
+	if _, err := Read(strings.NewReader("position,A,C,G\n1,1,2,3\n")); err == nil {
+		t.Errorf("problem in TestReadWrite(): expected an error for a missing column")
+	}
+}
+
+func TestWeights(t *testing.T) {
+	ws := Matrix{{1, 0, 0, 0}, {0, 0, 0, 0}}.Weights(1)
+	if math.Abs(ws[0][0]-math.Log2(2.5)) > 1e-9 || math.Abs(ws[0][1]+1) > 1e-9 {
+		t.Errorf("problem in TestWeights(): got %v", ws[0])
+	}
+	if ws[1] != [4]float64{0, 0, 0, 0} {
+		t.Errorf("problem in TestWeights(): an empty position should have no weight, got %v", ws[1])
+	}
+}
+
+func TestScan(t *testing.T) {
+	p := NewProfile(Matrix{{1, 0, 0, 0}, {1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, 1, 0}}.Weights(1))
+
+	hits := p.Scan("GGAACGGGCGTTGG", 1, true)
+	if len(hits) != 2 || hits[0].Start != 3 || hits[0].End != 6 || hits[0].Strand != 1 ||
+		hits[1].Start != 9 || hits[1].End != 12 || hits[1].Strand != -1 || hits[1].Relative != 1 {
+		t.Errorf("problem in TestScan(): got %+v", hits)
+	}
+
+	if hits := p.Scan("GGAACGGGCGTTGG", 1, false); len(hits) != 1 {
+		t.Errorf("problem in TestScan(): expected one hit on the forward strand, got %+v", hits)
+	}
+
+	// R is A or G, so scores half way between the two
+	hits = p.Scan("RACG", 0, false)
+	want := (math.Log2(2.5)-1)/2 + 3*math.Log2(2.5)
+	if len(hits) != 1 || math.Abs(hits[0].Score-want) > 1e-9 {
+		t.Errorf("problem in TestScan(): got %+v, want a score of %f", hits, want)
+	}
+
+	if hits := p.Scan("AA-CG", 0, true); len(hits) != 0 {
+		t.Errorf("problem in TestScan(): windows with gaps shouldn't be scored, got %+v", hits)
+	}
+}
+
+func TestScanAll(t *testing.T) {
+	p := NewProfile(Matrix{{1, 0, 0, 0}, {1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, 1, 0}}.Weights(1))
+	out := new(bytes.Buffer)
+	if err := ScanAll(context.Background(), strings.NewReader(">a\nGGAACGGG\n>b\nCGTT\n"), p, out, 1, true); err != nil {
+		t.Fatal(err)
+	}
+	want := "record,start,end,strand,score,relative_score,match\n" +
+		"a,3,6,+,5.2877,1.0000,AACG\n" +
+		"b,1,4,-,5.2877,1.0000,AACG\n"
+	if out.String() != want {
+		t.Errorf("problem in TestScanAll(): got\n%s", out.String())
+	}
+}
@@ -0,0 +1,149 @@
+/*
+Package qc implements per-sequence quality control of an alignment in
+reference coordinates: completeness, runs of Ns, SNPs relative to the
+reference, and (given an annotation) indels that would cause frameshifts in
+protein-coding regions.
+*/
+package qc
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/encoding"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/frameshift"
+	"github.com/virus-evolution/gofasta/pkg/stats"
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+// Thresholds are the limits a sequence must be within to pass. A zero MaxNRun, MaxSNPs or MaxFrameshifts means no limit,
+// except that MaxFrameshifts is always enforced when CheckFrameshifts is true
+type Thresholds struct {
+	MinCompleteness  float64
+	MaxNRun          int
+	MaxSNPs          int
+	CheckFrameshifts bool
+	MaxFrameshifts   int
+}
+
+// DefaultThresholds returns thresholds that only check completeness
+func DefaultThresholds() Thresholds {
+	return Thresholds{MinCompleteness: 0.9}
+}
+
+// Result is the QC report for one sequence
+type Result struct {
+	Query        string   `json:"query"`
+	Pass         bool     `json:"pass"`
+	Completeness float64  `json:"completeness"`
+	LongestNRun  int      `json:"longest_n_run"`
+	SNPs         int      `json:"snps"`
+	Frameshifts  []string `json:"frameshifts"`
+	Reasons      []string `json:"reasons"`
+}
+
+// Checker runs the QC checks against a reference and (optionally) its protein-coding regions
+type Checker struct {
+	Ref        string
+	Regions    []variants.Region
+	Insertions map[string][]variants.Variant
+	Thresholds Thresholds
+}
+
+// Check runs every check on one aligned sequence
+func (c *Checker) Check(FR fastaio.FastaRecord) (Result, error) {
+
+	if len(FR.Seq) != len(c.Ref) {
+		return Result{}, errors.New(FR.ID + " (" + strconv.Itoa(len(FR.Seq)) + " bases) is not the same length as the reference (" + strconv.Itoa(len(c.Ref)) + " bases)")
+	}
+
+	s := stats.RecordStats(FR)
+	r := Result{Query: FR.ID, Completeness: float64(s.A+s.C+s.G+s.T) / float64(len(c.Ref)), LongestNRun: s.LongestNRun, Reasons: []string{}, Frameshifts: []string{}}
+
+	for i := 0; i < len(FR.Seq); i++ {
+		if encoding.Certain(FR.Seq[i]) && encoding.Certain(c.Ref[i]) && FR.Seq[i] != c.Ref[i] {
+			r.SNPs++
+		}
+	}
+
+	t := c.Thresholds
+	if r.Completeness < t.MinCompleteness {
+		r.Reasons = append(r.Reasons, "completeness "+strconv.FormatFloat(r.Completeness, 'f', 4, 64)+" < "+strconv.FormatFloat(t.MinCompleteness, 'f', -1, 64))
+	}
+	if t.MaxNRun > 0 && r.LongestNRun > t.MaxNRun {
+		r.Reasons = append(r.Reasons, "longest N run "+strconv.Itoa(r.LongestNRun)+" > "+strconv.Itoa(t.MaxNRun))
+	}
+	if t.MaxSNPs > 0 && r.SNPs > t.MaxSNPs {
+		r.Reasons = append(r.Reasons, "SNPs "+strconv.Itoa(r.SNPs)+" > "+strconv.Itoa(t.MaxSNPs))
+	}
+	if t.CheckFrameshifts {
+		for _, f := range frameshift.Find(FR.Seq, c.Insertions[FR.ID], c.Regions) {
+			r.Frameshifts = append(r.Frameshifts, f.String())
+		}
+		if len(r.Frameshifts) > t.MaxFrameshifts {
+			r.Reasons = append(r.Reasons, "frameshifts "+strconv.Itoa(len(r.Frameshifts))+" > "+strconv.Itoa(t.MaxFrameshifts))
+		}
+	}
+
+	r.Pass = len(r.Reasons) == 0
+
+	return r, nil
+}
+
+func (r Result) csvRow() []string {
+	qc := "fail"
+	if r.Pass {
+		qc = "pass"
+	}
+	return []string{r.Query, qc, strconv.FormatFloat(r.Completeness, 'f', 4, 64), strconv.Itoa(r.LongestNRun), strconv.Itoa(r.SNPs),
+		strings.Join(r.Frameshifts, ";"), strings.Join(r.Reasons, ";")}
+}
+
+// QC checks every record in the alignment msa, and writes a report to out in format, which is one
+// of "csv" or "json". The CSV has the columns query,qc,completeness,longest_n_run,snps,frameshifts,reasons
+func (c *Checker) QC(ctx context.Context, msa io.Reader, out io.Writer, format string) error {
+
+	var cw *csv.Writer
+	results := make([]Result, 0)
+
+	switch format {
+	case "csv":
+		cw = csv.NewWriter(out)
+		if err := cw.Write([]string{"query", "qc", "completeness", "longest_n_run", "snps", "frameshifts", "reasons"}); err != nil {
+			return err
+		}
+	case "json":
+	default:
+		return errors.New("unknown format: " + format + " (choose one of csv or json)")
+	}
+
+	err := fastaio.EachAlignedRecord(ctx, msa, func(FR fastaio.FastaRecord) error {
+		r, err := c.Check(FR)
+		if err != nil {
+			return err
+		}
+		if cw != nil {
+			return cw.Write(r.csvRow())
+		}
+		results = append(results, r)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if cw != nil {
+		cw.Flush()
+		return cw.Error()
+	}
+
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(results)
+}
@@ -0,0 +1,58 @@
+package qc
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+func TestCheck(t *testing.T) {
+	ref := "AATGAAACCCGGGTAA"
+	regions := []variants.Region{{Name: "gene1", Start: 2, Stop: 16, Strand: 1}}
+	ins := map[string][]variants.Variant{"q3": {{Changetype: "ins", Position: 5, Length: 1}}}
+	c := Checker{Ref: ref, Regions: regions, Insertions: ins,
+		Thresholds: Thresholds{MinCompleteness: 0.7, MaxNRun: 3, MaxSNPs: 1, CheckFrameshifts: true}}
+
+	tests := []struct {
+		seq     string
+		pass    bool
+		reasons int
+		fs      int
+	}{
+		{"--TGAAACCCGGGTAA", true, 0, 0},  // missing ends aren't deletions
+		{"AATGAAACC-GGGTAA", false, 1, 1}, // a 1-base deletion in gene1
+		{"AATGAAACCCGGGTAA", false, 1, 1}, // the 1-base insertion from ins
+		{"AATGNNNNCCGTTTAA", false, 2, 0}, // N-run and SNPs, but completeness 12/16 is fine
+		{"AATGAA---CGGGTAA", true, 0, 0},  // an in-frame deletion
+	}
+
+	for i, test := range tests {
+		name := "q" + string(rune('1'+i))
+		r, err := c.Check(fastaio.FastaRecord{ID: name, Seq: test.seq})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.Pass != test.pass || len(r.Reasons) != test.reasons || len(r.Frameshifts) != test.fs {
+			t.Errorf("problem in TestCheck() for %s: %+v", name, r)
+		}
+	}
+}
+
+func TestQC(t *testing.T) {
+	c := Checker{Ref: "ATGATG", Thresholds: DefaultThresholds()}
+	var out bytes.Buffer
+	err := c.QC(context.Background(), strings.NewReader(">q1\nATGATG\n>q2\nATNNNN\n"), &out, "csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "query,qc,completeness,longest_n_run,snps,frameshifts,reasons\n" +
+		"q1,pass,1.0000,0,0,,\n" +
+		"q2,fail,0.3333,4,0,,completeness 0.3333 < 0.9\n"
+	if out.String() != want {
+		t.Errorf("problem in TestQC(): got\n%s\nwant\n%s", out.String(), want)
+	}
+}
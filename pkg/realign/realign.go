@@ -0,0 +1,112 @@
+/*
+Package realign implements the reprojection of an alignment onto a different
+reference: either by re-aligning its records, without their gaps, to the new
+reference, or, when the new reference is itself one of the alignment's records,
+by keeping only the columns where it has a base.
+*/
+package realign
+
+import (
+	"context"
+	"errors"
+	"io"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/align"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+// Realign degaps every record in the alignment msa and aligns it to ref with s, using threads goroutines (all
+// CPUs if it is 0), writing the new alignment to out and any insertions relative to ref to insOut if it isn't
+// nil, as gofasta align does
+func Realign(ctx context.Context, msa io.Reader, ref string, out, insOut io.Writer, s align.Scoring, threads int) error {
+	if threads < 1 {
+		threads = runtime.NumCPU()
+	}
+	if strings.Trim(ref, "-") == "" {
+		return errors.New("the new reference is empty")
+	}
+	return s.AlignAll(ctx, ref, msa, out, insOut, threads)
+}
+
+// Project reprojects the alignment msa onto its record called refName, by removing every column where that
+// record has a gap, and writes the result to out, which is in the coordinates of the new reference without its
+// gaps. The bases that other records have in the removed columns are insertions relative to the new
+// reference; if insOut isn't nil they are written to it as CSV with the columns query,ref_position,insertion,
+// where ref_position is the (1-based) position that each insertion comes after. The alignment is read into memory
+func Project(msa io.Reader, refName string, out, insOut io.Writer) error {
+
+	records, err := fastaio.ReadFastaToList(msa)
+	if err != nil {
+		return err
+	}
+
+	ref := -1
+	for i, FR := range records {
+		if FR.ID == refName {
+			if ref != -1 {
+				return errors.New(refName + " is in the alignment more than once")
+			}
+			ref = i
+		}
+	}
+	if ref == -1 {
+		return errors.New("couldn't find " + refName + " in the alignment")
+	}
+	refSeq := records[ref].Seq
+
+	// keep[i] is whether column i is kept, and pos[i] the number of reference bases up to and including it
+	keep := make([]bool, len(refSeq))
+	pos := make([]int, len(refSeq))
+	n := 0
+	for i := 0; i < len(refSeq); i++ {
+		if refSeq[i] != '-' {
+			keep[i] = true
+			n++
+		}
+		pos[i] = n
+	}
+	if n == 0 {
+		return errors.New(refName + " has no bases")
+	}
+
+	if insOut != nil {
+		if _, err := insOut.Write([]byte("query,ref_position,insertion\n")); err != nil {
+			return err
+		}
+	}
+
+	for _, FR := range records {
+		if len(FR.Seq) != len(refSeq) {
+			return errors.New(FR.ID + " is not the same length as " + refName + ": is this an alignment?")
+		}
+		seq := make([]byte, 0, n)
+		var ins strings.Builder
+		var insLines strings.Builder
+		for i := 0; i < len(FR.Seq); i++ {
+			if keep[i] {
+				seq = append(seq, FR.Seq[i])
+				continue
+			}
+			if FR.Seq[i] != '-' {
+				ins.WriteByte(FR.Seq[i])
+			}
+			if ins.Len() > 0 && (i+1 == len(FR.Seq) || keep[i+1]) {
+				insLines.WriteString(FR.ID + "," + strconv.Itoa(pos[i]) + "," + ins.String() + "\n")
+				ins.Reset()
+			}
+		}
+		if _, err := out.Write([]byte(">" + FR.Description + "\n" + string(seq) + "\n")); err != nil {
+			return err
+		}
+		if insOut != nil {
+			if _, err := insOut.Write([]byte(insLines.String())); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package realign
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/align"
+)
+
+func TestProject(t *testing.T) {
+	msa := ">old\nACGTACGTAC\n>new\nAC--ACG-AC\n>q\nACTTAC-GA-\n"
+	out, ins := new(bytes.Buffer), new(bytes.Buffer)
+	if err := Project(strings.NewReader(msa), "new", out, ins); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != ">old\nACACGAC\n>new\nACACGAC\n>q\nACAC-A-\n" {
+		t.Errorf("problem in TestProject(): got\n%s", out.String())
+	}
+	if ins.String() != "query,ref_position,insertion\nold,2,GT\nold,5,T\nq,2,TT\nq,5,G\n" {
+		t.Errorf("problem in TestProject(): got\n%s", ins.String())
+	}
+
+	if err := Project(strings.NewReader(msa), "missing", out, nil); err == nil {
+		t.Errorf("problem in TestProject(): expected an error for a reference that isn't in the alignment")
+	}
+}
+
+func TestRealign(t *testing.T) {
+	ref := "ATGGCGTTAGCCTAGCTAGCCGATCGATCGGGCTAGCATCGACTACGGCATCGACT"
+	msa := ">x\n--" + ref[:20] + "---" + ref[20:] + "\n"
+	out := new(bytes.Buffer)
+	if err := Realign(context.Background(), strings.NewReader(msa), ref, out, nil, align.DefaultScoring(), 1); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != ">x\n"+ref+"\n" {
+		t.Errorf("problem in TestRealign(): got\n%s", out.String())
+	}
+}
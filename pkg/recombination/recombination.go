@@ -0,0 +1,323 @@
+/*
+Package recombination implements a first-pass screen for recombinant
+sequences: each query is compared to a panel of putative parents in sliding
+windows, and where the nearest parent switches between neighbouring stretches
+of windows, the breakpoint is placed and tested using the sites that tell the
+two parents apart.
+*/
+package recombination
+
+import (
+	"errors"
+	"io"
+	"math"
+	"runtime"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/virus-evolution/gofasta/pkg/distance"
+)
+
+// Options controls the scan. MaxP is the largest p-value of a switch that is reported
+type Options struct {
+	Window  int
+	Step    int
+	MaxP    float64
+	Threads int
+}
+
+// DefaultOptions returns options suited to viral genomes of a few tens of kilobases
+func DefaultOptions() Options {
+	return Options{Window: 1000, Step: 200, MaxP: 0.05}
+}
+
+// Window is the SNP-distance from a query to each parent over the alignment columns [Start, End)
+type Window struct {
+	Start     int
+	End       int
+	Distances []int
+	Nearest   int // the index of the single nearest parent, or -1 if the nearest are tied
+}
+
+// Switch is a change in the nearest parent from A to B. Region is the stretch of columns over the windows
+// nearest to A followed by those nearest to B ([RegionStart, RegionEnd), 0-based), and the breakpoint is
+// between BreakpointStart and BreakpointEnd (the sites either side of the split, 0-based). ALeft and BLeft
+// are the number of sites to the left of the breakpoint where the query matches only A or only B,
+// and ARight and BRight those to the right. P is the one-sided p-value for so many A sites being on the left
+// of the breakpoint by chance, from Fisher's exact test
+type Switch struct {
+	A               int
+	B               int
+	RegionStart     int
+	RegionEnd       int
+	BreakpointStart int
+	BreakpointEnd   int
+	ALeft           int
+	BLeft           int
+	ARight          int
+	BRight          int
+	P               float64
+}
+
+// Result is the scan of one query
+type Result struct {
+	Query    string
+	Windows  []Window
+	Switches []Switch
+}
+
+// count returns the number of sites in the sorted list sites that are in [start, end)
+func count(sites []int, start, end int) int {
+	return sort.SearchInts(sites, end) - sort.SearchInts(sites, start)
+}
+
+// lnChoose returns the natural log of n choose k
+func lnChoose(n, k int) float64 {
+	a, _ := math.Lgamma(float64(n + 1))
+	b, _ := math.Lgamma(float64(k + 1))
+	c, _ := math.Lgamma(float64(n - k + 1))
+	return a - b - c
+}
+
+// fisher returns the one-sided p-value of Fisher's exact test for the table [[a, b], [c, d]]: the
+// probability, given the margins, that the top left cell is at least a
+func fisher(a, b, c, d int) float64 {
+	row, col, n := a+b, a+c, a+b+c+d
+	denom := lnChoose(n, col)
+	p := 0.0
+	for x := a; x <= row && x <= col; x++ {
+		p += math.Exp(lnChoose(row, x) + lnChoose(n-row, col-x) - denom)
+	}
+	return math.Min(p, 1)
+}
+
+// informative returns the sites in [start, end) that tell parents a and b apart, given each one's sorted
+// differences from the query: true where the query matches only a, and false where it matches only b
+func informative(diffA, diffB []int, start, end int) ([]int, []bool) {
+	sites := make([]int, 0)
+	forA := make([]bool, 0)
+	i, j := sort.SearchInts(diffA, start), sort.SearchInts(diffB, start)
+	for (i < len(diffA) && diffA[i] < end) || (j < len(diffB) && diffB[j] < end) {
+		switch {
+		case j >= len(diffB) || diffB[j] >= end || (i < len(diffA) && diffA[i] < diffB[j]):
+			sites, forA = append(sites, diffA[i]), append(forA, false)
+			i++
+		case i >= len(diffA) || diffA[i] >= end || diffB[j] < diffA[i]:
+			sites, forA = append(sites, diffB[j]), append(forA, true)
+			j++
+		default:
+			// the query differs from both
+			i++
+			j++
+		}
+	}
+	return sites, forA
+}
+
+// split finds the breakpoint in a stretch of columns where parent a should be nearest to the left and b to
+// the right, as the split of its informative sites with the most agreeing sites either side
+func split(a, b int, diffA, diffB []int, start, end int) (Switch, bool) {
+	sites, forA := informative(diffA, diffB, start, end)
+
+	totalA := 0
+	for _, f := range forA {
+		if f {
+			totalA++
+		}
+	}
+	totalB := len(sites) - totalA
+
+	best, bestK := -1, 0
+	aLeft := 0
+	for k := 1; k < len(sites); k++ {
+		if forA[k-1] {
+			aLeft++
+		}
+		bRight := totalB - (k - aLeft)
+		if aLeft+bRight > best {
+			best, bestK = aLeft+bRight, k
+		}
+	}
+	if best < 0 {
+		return Switch{}, false
+	}
+
+	s := Switch{A: a, B: b, RegionStart: start, RegionEnd: end, BreakpointStart: sites[bestK-1], BreakpointEnd: sites[bestK]}
+	for k, f := range forA {
+		switch {
+		case k < bestK && f:
+			s.ALeft++
+		case k < bestK:
+			s.BLeft++
+		case f:
+			s.ARight++
+		default:
+			s.BRight++
+		}
+	}
+	s.P = fisher(s.ALeft, s.BLeft, s.ARight, s.BRight)
+
+	return s, true
+}
+
+// Scan compares query to every parent in windows, and finds the switches in its nearest parent
+func Scan(query *distance.Packed, parents []distance.Packed, o Options) Result {
+
+	diffs := make([][]int, len(parents))
+	for p := range parents {
+		if parents[p].ID == query.ID {
+			continue
+		}
+		diffs[p] = distance.DiffSites(query, &parents[p])
+	}
+
+	r := Result{Query: query.ID, Windows: make([]Window, 0), Switches: make([]Switch, 0)}
+
+	for start := 0; start < query.Len; start += o.Step {
+		end := start + o.Window
+		if end > query.Len {
+			end = query.Len
+		}
+		w := Window{Start: start, End: end, Distances: make([]int, len(parents)), Nearest: -1}
+		min := -1
+		for p := range parents {
+			if diffs[p] == nil {
+				w.Distances[p] = -1
+				continue
+			}
+			d := count(diffs[p], start, end)
+			w.Distances[p] = d
+			switch {
+			case min == -1 || d < min:
+				min, w.Nearest = d, p
+			case d == min:
+				w.Nearest = -1
+			}
+		}
+		r.Windows = append(r.Windows, w)
+		if end == query.Len {
+			break
+		}
+	}
+
+	// runs of windows with the same nearest parent, ignoring windows with a tie
+	type run struct{ parent, start, end int }
+	runs := make([]run, 0)
+	for _, w := range r.Windows {
+		if w.Nearest == -1 {
+			continue
+		}
+		if n := len(runs); n > 0 && runs[n-1].parent == w.Nearest {
+			runs[n-1].end = w.End
+		} else {
+			runs = append(runs, run{parent: w.Nearest, start: w.Start, end: w.End})
+		}
+	}
+
+	for k := 1; k < len(runs); k++ {
+		a, b := runs[k-1].parent, runs[k].parent
+		s, ok := split(a, b, diffs[a], diffs[b], runs[k-1].start, runs[k].end)
+		if ok && s.P <= o.MaxP {
+			r.Switches = append(r.Switches, s)
+		}
+	}
+
+	return r
+}
+
+// Recombination scans every query against the parents, using o.Threads goroutines (all CPUs if it is 0),
+// and writes the switches to out as CSV. If windowsOut isn't nil, the per-window distances are written to it
+// too. The queries and parents must all be aligned to each other. A query is never compared to a parent of
+// the same name
+func Recombination(queries, parents []distance.Packed, out, windowsOut io.Writer, o Options) error {
+
+	if len(parents) < 2 {
+		return errors.New("need at least two parents")
+	}
+	if o.Window < 1 || o.Step < 1 {
+		return errors.New("the window size and step must be at least 1")
+	}
+	for _, q := range queries {
+		if q.Len != parents[0].Len {
+			return errors.New(q.ID + " is not the same length as the parents")
+		}
+	}
+	for _, p := range parents {
+		if p.Len != parents[0].Len {
+			return errors.New("the parents are not all the same length")
+		}
+	}
+
+	threads := o.Threads
+	if threads == 0 {
+		threads = runtime.NumCPU()
+	}
+
+	results := make([]Result, len(queries))
+	cIdx := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(threads)
+	for t := 0; t < threads; t++ {
+		go func() {
+			defer wg.Done()
+			for i := range cIdx {
+				results[i] = Scan(&queries[i], parents, o)
+			}
+		}()
+	}
+	for i := range queries {
+		cIdx <- i
+	}
+	close(cIdx)
+	wg.Wait()
+
+	if _, err := out.Write([]byte("query,parent_a,parent_b,region_start,region_end,breakpoint_start,breakpoint_end,a_sites_left,b_sites_left,a_sites_right,b_sites_right,p_value\n")); err != nil {
+		return err
+	}
+	for _, r := range results {
+		for _, s := range r.Switches {
+			line := r.Query + "," + parents[s.A].ID + "," + parents[s.B].ID + "," +
+				strconv.Itoa(s.RegionStart+1) + "," + strconv.Itoa(s.RegionEnd) + "," +
+				strconv.Itoa(s.BreakpointStart+1) + "," + strconv.Itoa(s.BreakpointEnd+1) + "," +
+				strconv.Itoa(s.ALeft) + "," + strconv.Itoa(s.BLeft) + "," + strconv.Itoa(s.ARight) + "," + strconv.Itoa(s.BRight) + "," +
+				strconv.FormatFloat(s.P, 'g', 4, 64) + "\n"
+			if _, err := out.Write([]byte(line)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if windowsOut == nil {
+		return nil
+	}
+
+	header := "query,start,end"
+	for _, p := range parents {
+		header += "," + p.ID
+	}
+	if _, err := windowsOut.Write([]byte(header + ",nearest\n")); err != nil {
+		return err
+	}
+	for _, r := range results {
+		for _, w := range r.Windows {
+			line := r.Query + "," + strconv.Itoa(w.Start+1) + "," + strconv.Itoa(w.End)
+			for _, d := range w.Distances {
+				if d < 0 {
+					line += ","
+				} else {
+					line += "," + strconv.Itoa(d)
+				}
+			}
+			nearest := ""
+			if w.Nearest != -1 {
+				nearest = parents[w.Nearest].ID
+			}
+			if _, err := windowsOut.Write([]byte(line + "," + nearest + "\n")); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package recombination
+
+import (
+	"bytes"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/distance"
+	"github.com/virus-evolution/gofasta/pkg/encoding"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+func pack(id, seq string) distance.Packed {
+	ea := encoding.MakeEncodingArray()
+	b := make([]byte, len(seq))
+	for i := range seq {
+		b[i] = ea[seq[i]]
+	}
+	return distance.Pack(fastaio.EncodedFastaRecord{ID: id, Seq: b})
+}
+
+// mutate changes every site in seq with probability p
+func mutate(r *rand.Rand, seq string, p float64) string {
+	b := []byte(seq)
+	for i := range b {
+		if r.Float64() < p {
+			b[i] = "ACGT"[(strings.IndexByte("ACGT", b[i])+1+r.Intn(3))%4]
+		}
+	}
+	return string(b)
+}
+
+func TestFisher(t *testing.T) {
+	// the one-sided p-value for [[3, 0], [0, 3]] is 1/20
+	if p := fisher(3, 0, 0, 3); math.Abs(p-0.05) > 1e-9 {
+		t.Errorf("problem in TestFisher(): got %f", p)
+	}
+	if p := fisher(0, 3, 3, 0); math.Abs(p-1) > 1e-9 {
+		t.Errorf("problem in TestFisher(): got %f", p)
+	}
+}
+
+func TestScan(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	b := make([]byte, 3000)
+	for i := range b {
+		b[i] = "ACGT"[r.Intn(4)]
+	}
+	root := string(b)
+	a, c := mutate(r, root, 0.03), mutate(r, root, 0.03)
+
+	parents := []distance.Packed{pack("a", a), pack("c", c)}
+	query := pack("q", a[:1700]+c[1700:])
+
+	o := DefaultOptions()
+	o.Window, o.Step = 500, 100
+
+	res := Scan(&query, parents, o)
+	if len(res.Switches) != 1 {
+		t.Fatalf("problem in TestScan(): got %d switches", len(res.Switches))
+	}
+	s := res.Switches[0]
+	if s.A != 0 || s.B != 1 || s.BreakpointStart >= 1700 || s.BreakpointEnd < 1700 || s.BLeft != 0 || s.ARight != 0 || s.P > 1e-6 {
+		t.Errorf("problem in TestScan(): got %+v", s)
+	}
+
+	plain := pack("p", a)
+	if res = Scan(&plain, parents, o); len(res.Switches) != 0 {
+		t.Errorf("problem in TestScan(): got switches for a non-recombinant: %+v", res.Switches)
+	}
+}
+
+func TestRecombination(t *testing.T) {
+	parents := []distance.Packed{pack("a", "AAAAAAAAAA"), pack("c", "CCCCCCCCCC")}
+	queries := []distance.Packed{pack("q", "AAAAACCCCC")}
+	o := Options{Window: 2, Step: 2, MaxP: 1}
+
+	out, windowsOut := new(bytes.Buffer), new(bytes.Buffer)
+	if err := Recombination(queries, parents, out, windowsOut, o); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(out.String(), "\n")
+	if len(lines) != 3 || !strings.HasPrefix(lines[1], "q,a,c,1,10,5,6,5,0,0,5,") {
+		t.Errorf("problem in TestRecombination(): got\n%s", out.String())
+	}
+	if !strings.HasPrefix(windowsOut.String(), "query,start,end,a,c,nearest\nq,1,2,0,2,a\n") {
+		t.Errorf("problem in TestRecombination(): got\n%s", windowsOut.String())
+	}
+
+	if err := Recombination(queries, parents[:1], out, nil, o); err == nil {
+		t.Errorf("problem in TestRecombination(): expected an error with one parent")
+	}
+}
@@ -0,0 +1,98 @@
+package rename
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+// AnonymizeOptions controls the new names. They are Prefix followed by a number counting from 1, or with Key,
+// Prefix followed by the first HashLength hex digits of the HMAC-SHA256 of the old ID under Key, so that the same
+// record gets the same name in every file anonymized with the same key. Descriptions are dropped unless
+// KeepDescription is true, in which case StripDates removes anything that looks like a date from them
+type AnonymizeOptions struct {
+	Prefix          string
+	Key             string
+	HashLength      int
+	KeepDescription bool
+	StripDates      bool
+}
+
+// DefaultAnonymizeOptions returns options for sequential names, without descriptions
+func DefaultAnonymizeOptions() AnonymizeOptions {
+	return AnonymizeOptions{Prefix: "seq", HashLength: 16}
+}
+
+// dateLike matches dates such as 2021-01-31, 2021/01, 31/01/2021, 31.01.21 and 20210131
+var dateLike = regexp.MustCompile(`\b(\d{4}[-/.]\d{1,2}([-/.]\d{1,2})?|\d{1,2}[-/.]\d{1,2}[-/.]\d{2,4}|(19|20)\d{2}(0[1-9]|1[0-2])(0[1-9]|[12]\d|3[01]))\b`)
+
+// StripDates removes date-like tokens from s, and the extra whitespace they leave
+func StripDates(s string) string {
+	return strings.Join(strings.Fields(dateLike.ReplaceAllString(s, "")), " ")
+}
+
+// Anonymize writes every record in the fasta file in to out with a new, opaque name, and writes a new,old CSV
+// mapping to mappingOut, so that the renaming can be reversed by whoever holds it. It is an error for two records
+// to get the same name, which can happen with Key if two records have the same ID or HashLength is very short
+func Anonymize(ctx context.Context, in io.Reader, out, mappingOut io.Writer, o AnonymizeOptions) error {
+
+	if o.Key != "" && (o.HashLength < 1 || o.HashLength > 2*sha256.Size) {
+		return errors.New("the hash length must be between 1 and " + strconv.Itoa(2*sha256.Size))
+	}
+	if strings.ContainsAny(o.Prefix, " \t") {
+		return errors.New("the prefix for new names can't contain whitespace")
+	}
+
+	if _, err := mappingOut.Write([]byte("new,old\n")); err != nil {
+		return err
+	}
+
+	used := make(map[string]string)
+	n := 0
+	return fastaio.EachRecord(ctx, in, func(FR fastaio.FastaRecord) error {
+		n++
+		newName := o.Prefix + strconv.Itoa(n)
+		if o.Key != "" {
+			mac := hmac.New(sha256.New, []byte(o.Key))
+			mac.Write([]byte(FR.ID))
+			newName = o.Prefix + hex.EncodeToString(mac.Sum(nil))[:o.HashLength]
+		}
+		if old, ok := used[newName]; ok {
+			return errors.New("both " + old + " and " + FR.ID + " would be renamed to " + newName)
+		}
+		used[newName] = FR.ID
+
+		header := newName
+		if o.KeepDescription {
+			description := afterID(FR.Description)
+			if o.StripDates {
+				description = StripDates(description)
+			}
+			if description != "" {
+				header += " " + description
+			}
+		}
+
+		if _, err := out.Write([]byte(">" + header + "\n" + FR.Seq + "\n")); err != nil {
+			return err
+		}
+		_, err := mappingOut.Write([]byte(newName + "," + csvQuote(FR.ID) + "\n"))
+		return err
+	})
+}
+
+// csvQuote quotes s for a CSV field if it needs it
+func csvQuote(s string) string {
+	if strings.ContainsAny(s, ",\"\n") {
+		return "\"" + strings.ReplaceAll(s, "\"", "\"\"") + "\""
+	}
+	return s
+}
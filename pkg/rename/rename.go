@@ -0,0 +1,184 @@
+/*
+Package rename implements routines to change the names of records in a
+fasta format file, either from a mapping file or from a template built
+from fields parsed from the existing headers.
+*/
+package rename
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/summary"
+)
+
+// ReadMapping reads a two-column CSV file of old name to new name. A first row of "old,new" or "from,to"
+// is treated as a header and skipped
+func ReadMapping(r io.Reader) (map[string]string, error) {
+	m := make(map[string]string)
+
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	for lineN := 1; ; lineN++ {
+		row, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(row) < 2 {
+			return nil, errors.New("couldn't parse mapping line " + strconv.Itoa(lineN) + ": fewer than two columns")
+		}
+		if lineN == 1 {
+			h := strings.ToLower(row[0]) + "," + strings.ToLower(row[1])
+			if h == "old,new" || h == "from,to" {
+				continue
+			}
+		}
+		if _, ok := m[row[0]]; ok {
+			return nil, errors.New("duplicate name in mapping: " + row[0])
+		}
+		m[row[0]] = row[1]
+	}
+
+	return m, nil
+}
+
+var placeholder = regexp.MustCompile(`\{([^{}]*)\}`)
+
+// Template builds new names from the fields of the existing ID. In the template, {id} is the whole ID,
+// {header} is the whole header line with each run of whitespace replaced by an underscore, and {1}, {2}, ... are
+// the fields of the ID split on Delimiter
+type Template struct {
+	text      string
+	Delimiter string
+}
+
+// ParseTemplate checks a template string and returns it as a Template
+func ParseTemplate(s, delimiter string) (Template, error) {
+	for _, m := range placeholder.FindAllStringSubmatch(s, -1) {
+		switch m[1] {
+		case "id", "header":
+		default:
+			if i, err := strconv.Atoi(m[1]); err != nil || i < 1 {
+				return Template{}, errors.New("bad placeholder in template: {" + m[1] + "}")
+			}
+		}
+	}
+	if delimiter == "" {
+		return Template{}, errors.New("template delimiter can't be empty")
+	}
+	return Template{text: s, Delimiter: delimiter}, nil
+}
+
+// Apply returns the new name for a record
+func (t Template) Apply(FR fastaio.FastaRecord) (string, error) {
+	fields := strings.Split(FR.ID, t.Delimiter)
+	var err error
+	name := placeholder.ReplaceAllStringFunc(t.text, func(p string) string {
+		key := p[1 : len(p)-1]
+		switch key {
+		case "id":
+			return FR.ID
+		case "header":
+			return strings.Join(strings.Fields(FR.Description), "_")
+		}
+		i, _ := strconv.Atoi(key)
+		if i > len(fields) {
+			err = fmt.Errorf("%s has only %d fields, so can't fill {%d}", FR.ID, len(fields), i)
+			return ""
+		}
+		return fields[i-1]
+	})
+	return name, err
+}
+
+// Rename writes every record in the fasta file in to out with a new name, taken from mapping or, if tmpl
+// isn't nil, built from tmpl. Any description after the ID is kept. If strict is true, it is an error for a
+// record to have no new name, otherwise it keeps its old one. If backOut isn't nil, a new,old CSV is written
+// to it so that the renaming can be reversed
+func Rename(ctx context.Context, in io.Reader, out io.Writer, backOut io.Writer, mapping map[string]string, tmpl *Template, strict bool) error {
+
+	var err error
+	used := make(map[string]string)
+
+	if backOut != nil {
+		_, err = backOut.Write([]byte("new,old\n"))
+		if err != nil {
+			return err
+		}
+	}
+
+	return fastaio.EachRecord(ctx, in, func(FR fastaio.FastaRecord) error {
+		var newName string
+		if tmpl != nil {
+			newName, err = tmpl.Apply(FR)
+			if err != nil {
+				if strict {
+					return err
+				}
+				summary.Warn(err.Error() + ", keeping its name")
+				newName = FR.ID
+			}
+		} else {
+			var ok bool
+			newName, ok = mapping[FR.ID]
+			if !ok {
+				if strict {
+					return errors.New(FR.ID + " is not in the mapping")
+				}
+				newName = FR.ID
+			}
+		}
+
+		if newName == "" || strings.ContainsAny(newName, " \t") {
+			return errors.New("bad new name for " + FR.ID + ": \"" + newName + "\"")
+		}
+		if old, ok := used[newName]; ok {
+			return errors.New("both " + old + " and " + FR.ID + " would be renamed to " + newName)
+		}
+		used[newName] = FR.ID
+
+		header := newName
+		if tmpl == nil || !strings.Contains(tmpl.text, "{header}") {
+			if rest := afterID(FR.Description); rest != "" {
+				header += " " + rest
+			}
+		}
+
+		_, err = out.Write([]byte(">" + header + "\n" + FR.Seq + "\n"))
+		if err != nil {
+			return err
+		}
+
+		if backOut != nil {
+			_, err = backOut.Write([]byte(csvQuote(newName) + "," + csvQuote(FR.ID) + "\n"))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// afterID returns the rest of a header after its first whitespace-delimited field, which is the record's ID,
+// without the whitespace around it. The ID isn't always at the start of the header: there may be whitespace
+// between the > and the ID
+func afterID(description string) string {
+	d := strings.TrimSpace(description)
+	i := strings.IndexFunc(d, unicode.IsSpace)
+	if i == -1 {
+		return ""
+	}
+	return strings.TrimLeftFunc(d[i:], unicode.IsSpace)
+}
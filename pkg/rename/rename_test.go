@@ -0,0 +1,194 @@
+package rename
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+var fastaData = []byte(`>hCoV-19/England/ABC/2021|EPI_ISL_1|2021-01-01 some description
+ATGATG
+>hCoV-19/Wales/DEF/2021|EPI_ISL_2|2021-02-01
+ATGATC
+`)
+
+func TestRenameMapping(t *testing.T) {
+	mapping, err := ReadMapping(strings.NewReader("old,new\nhCoV-19/England/ABC/2021|EPI_ISL_1|2021-01-01,seq1\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := new(bytes.Buffer)
+	back := new(bytes.Buffer)
+	err = Rename(context.Background(), bytes.NewReader(fastaData), out, back, mapping, nil, false)
+	if err != nil {
+		t.Error(err)
+	}
+	if out.String() != `>seq1 some description
+ATGATG
+>hCoV-19/Wales/DEF/2021|EPI_ISL_2|2021-02-01
+ATGATC
+` {
+		t.Errorf("problem in TestRenameMapping(): %s", out.String())
+	}
+	if back.String() != `new,old
+seq1,hCoV-19/England/ABC/2021|EPI_ISL_1|2021-01-01
+hCoV-19/Wales/DEF/2021|EPI_ISL_2|2021-02-01,hCoV-19/Wales/DEF/2021|EPI_ISL_2|2021-02-01
+` {
+		t.Errorf("problem in TestRenameMapping() back-mapping: %s", back.String())
+	}
+
+	err = Rename(context.Background(), bytes.NewReader(fastaData), new(bytes.Buffer), nil, mapping, nil, true)
+	if err == nil {
+		t.Errorf("expected an error for an unmatched name in strict mode")
+	}
+}
+
+func TestRenameBackMapQuoted(t *testing.T) {
+	back := new(bytes.Buffer)
+	err := Rename(context.Background(), strings.NewReader(">a,b\nATG\n>\"c\"\nATG\n"), new(bytes.Buffer), back, map[string]string{"a,b": "x,y", "\"c\"": "z"}, nil, true)
+	if err != nil {
+		t.Error(err)
+	}
+	if back.String() != "new,old\n\"x,y\",\"a,b\"\nz,\"\"\"c\"\"\"\n" {
+		t.Errorf("problem in TestRenameBackMapQuoted(): %s", back.String())
+	}
+
+	// the back-mapping reads back as the reverse of the mapping
+	m, err := ReadMapping(bytes.NewReader(back.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m["x,y"] != "a,b" || m["z"] != "\"c\"" {
+		t.Errorf("problem in TestRenameBackMapQuoted(): %v", m)
+	}
+}
+
+func TestRenameLeadingSpace(t *testing.T) {
+	out := new(bytes.Buffer)
+	err := Rename(context.Background(), strings.NewReader("> sampleABC 2021-01-02 UK\nATG\n>\tsampleDEF\nATG\n"), out, nil, map[string]string{"sampleABC": "renamed", "sampleDEF": "other"}, nil, true)
+	if err != nil {
+		t.Error(err)
+	}
+	if out.String() != ">renamed 2021-01-02 UK\nATG\n>other\nATG\n" {
+		t.Errorf("problem in TestRenameLeadingSpace(): %s", out.String())
+	}
+}
+
+func TestRenameTemplate(t *testing.T) {
+	tmpl, err := ParseTemplate("{2}_{3}", "|")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := new(bytes.Buffer)
+	err = Rename(context.Background(), bytes.NewReader(fastaData), out, nil, nil, &tmpl, true)
+	if err != nil {
+		t.Error(err)
+	}
+	if out.String() != `>EPI_ISL_1_2021-01-01 some description
+ATGATG
+>EPI_ISL_2_2021-02-01
+ATGATC
+` {
+		t.Errorf("problem in TestRenameTemplate(): %s", out.String())
+	}
+
+	// the header has whitespace in it, which doesn't go in a name
+	tmpl, _ = ParseTemplate("x_{header}", "|")
+	out.Reset()
+	back := new(bytes.Buffer)
+	err = Rename(context.Background(), bytes.NewReader(fastaData), out, back, nil, &tmpl, true)
+	if err != nil {
+		t.Error(err)
+	}
+	if out.String() != `>x_hCoV-19/England/ABC/2021|EPI_ISL_1|2021-01-01_some_description
+ATGATG
+>x_hCoV-19/Wales/DEF/2021|EPI_ISL_2|2021-02-01
+ATGATC
+` {
+		t.Errorf("problem in TestRenameTemplate(): %s", out.String())
+	}
+	if back.String() != `new,old
+x_hCoV-19/England/ABC/2021|EPI_ISL_1|2021-01-01_some_description,hCoV-19/England/ABC/2021|EPI_ISL_1|2021-01-01
+x_hCoV-19/Wales/DEF/2021|EPI_ISL_2|2021-02-01,hCoV-19/Wales/DEF/2021|EPI_ISL_2|2021-02-01
+` {
+		t.Errorf("problem in TestRenameTemplate() back-mapping: %s", back.String())
+	}
+
+	tmpl, _ = ParseTemplate("{4}", "|")
+	err = Rename(context.Background(), bytes.NewReader(fastaData), new(bytes.Buffer), nil, nil, &tmpl, true)
+	if err == nil {
+		t.Errorf("expected an error for a missing field in strict mode")
+	}
+
+	_, err = ParseTemplate("{foo}", "|")
+	if err == nil {
+		t.Errorf("expected an error for a bad placeholder")
+	}
+}
+
+func TestRenameCollision(t *testing.T) {
+	mapping := map[string]string{
+		"hCoV-19/England/ABC/2021|EPI_ISL_1|2021-01-01": "same",
+		"hCoV-19/Wales/DEF/2021|EPI_ISL_2|2021-02-01":   "same",
+	}
+	err := Rename(context.Background(), bytes.NewReader(fastaData), new(bytes.Buffer), nil, mapping, nil, true)
+	if err == nil {
+		t.Errorf("expected an error when two records get the same name")
+	}
+}
+
+func TestAnonymize(t *testing.T) {
+	out := new(bytes.Buffer)
+	mapping := new(bytes.Buffer)
+	o := DefaultAnonymizeOptions()
+	if err := Anonymize(context.Background(), bytes.NewReader(fastaData), out, mapping, o); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != ">seq1\nATGATG\n>seq2\nATGATC\n" {
+		t.Errorf("problem in TestAnonymize(): %s", out.String())
+	}
+	if mapping.String() != "new,old\nseq1,hCoV-19/England/ABC/2021|EPI_ISL_1|2021-01-01\nseq2,hCoV-19/Wales/DEF/2021|EPI_ISL_2|2021-02-01\n" {
+		t.Errorf("problem in TestAnonymize() mapping: %s", mapping.String())
+	}
+
+	o.Key, o.HashLength, o.Prefix, o.KeepDescription, o.StripDates = "secret", 8, "s", true, true
+	first := new(bytes.Buffer)
+	if err := Anonymize(context.Background(), strings.NewReader(">a collected 2021-01-31 in x, 31/01/21\nA\n>b\nC\n"), first, new(bytes.Buffer), o); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(first.String(), "\n")
+	if name := strings.Fields(lines[0])[0]; len(name) != len(">s")+8 || !strings.HasPrefix(name, ">s") {
+		t.Errorf("problem in TestAnonymize(): %s", first.String())
+	}
+	if !strings.HasSuffix(lines[0], " collected in x,") {
+		t.Errorf("problem in TestAnonymize(): dates weren't stripped from %s", lines[0])
+	}
+
+	// the ID isn't always at the start of the header, and none of it should be left in the description
+	o = DefaultAnonymizeOptions()
+	o.KeepDescription = true
+	out.Reset()
+	if err := Anonymize(context.Background(), strings.NewReader("> sampleABC 2021-01-02 UK\nA\n"), out, new(bytes.Buffer), o); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != ">seq1 2021-01-02 UK\nA\n" {
+		t.Errorf("problem in TestAnonymize(): %s", out.String())
+	}
+	o.Key, o.HashLength, o.Prefix, o.StripDates = "secret", 8, "s", true
+
+	// the same key gives the same names
+	second := new(bytes.Buffer)
+	if err := Anonymize(context.Background(), strings.NewReader(">b\nC\n"), second, new(bytes.Buffer), o); err != nil {
+		t.Fatal(err)
+	}
+	if lines[2] != strings.Split(second.String(), "\n")[0] {
+		t.Errorf("problem in TestAnonymize(): hashed names differ between runs")
+	}
+
+	if err := Anonymize(context.Background(), strings.NewReader(">a\nA\n>a\nC\n"), out, mapping, o); err == nil {
+		t.Errorf("problem in TestAnonymize(): expected an error for a collision")
+	}
+}
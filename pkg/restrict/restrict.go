@@ -0,0 +1,91 @@
+/*
+Package restrict implements routines to cut an alignment in reference
+coordinates into one sub-alignment per gene, using an annotation.
+*/
+package restrict
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/alphabet"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/translate"
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+// What to write for each region
+const (
+	ModeExtent  = "extent"  // the alignment columns from the region's start to its stop, on the forward strand
+	ModeCodon   = "codon"   // the region's coding sequence, spliced and on its own strand, so that it is in frame
+	ModeProtein = "protein" // the translation of the coding sequence
+)
+
+// CodingSequence returns the bases of seq at the region's positions, in order and complemented if the region
+// is on the reverse strand, so that the result starts at the first base of the first codon
+func CodingSequence(seq string, region variants.Region) (string, error) {
+	var sb strings.Builder
+	sb.Grow(len(region.Positions))
+	for _, p := range region.Positions {
+		if p > len(seq) {
+			return "", errors.New("position " + strconv.Itoa(p) + " in " + region.Name + " is beyond the end of the sequence")
+		}
+		sb.WriteByte(seq[p-1])
+	}
+	nuc := sb.String()
+	if region.Strand == -1 {
+		nuc = alphabet.Complement(nuc)
+	}
+	return nuc, nil
+}
+
+// Restrict writes the part of every record in msa that corresponds to each region in regions to the
+// corresponding writer in outs. The records must be in the annotation's coordinates, so must all be refLen
+// long. mode is one of ModeExtent, ModeCodon or ModeProtein. table is the NCBI translation table for ModeProtein
+func Restrict(ctx context.Context, msa io.Reader, regions []variants.Region, refLen int, outs []io.Writer, mode string, table int) error {
+
+	if len(regions) != len(outs) {
+		return errors.New("need one output per region")
+	}
+
+	var CD map[string]string
+	switch mode {
+	case ModeExtent, ModeCodon:
+	case ModeProtein:
+		var err error
+		CD, err = alphabet.MakeCodonDictFromTable(table)
+		if err != nil {
+			return err
+		}
+	default:
+		return errors.New("unknown mode: " + mode + " (choose one of extent, codon or protein)")
+	}
+
+	return fastaio.EachAlignedRecord(ctx, msa, func(FR fastaio.FastaRecord) error {
+		if len(FR.Seq) != refLen {
+			return errors.New(FR.ID + " (" + strconv.Itoa(len(FR.Seq)) + " bases) is not the same length as the annotation's reference (" + strconv.Itoa(refLen) + " bases)")
+		}
+		for i, region := range regions {
+			var seq string
+			var err error
+			switch mode {
+			case ModeExtent:
+				seq = FR.Seq[region.Start-1 : region.Stop]
+			case ModeCodon:
+				seq, err = CodingSequence(FR.Seq, region)
+			case ModeProtein:
+				seq, err = translate.Region(FR.Seq, region, CD)
+			}
+			if err != nil {
+				return err
+			}
+			if _, err = outs[i].Write([]byte(">" + FR.ID + "\n" + seq + "\n")); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,42 @@
+package restrict
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+func TestRestrict(t *testing.T) {
+	// a forward gene at 2-7 and a reverse-strand gene at 8-13
+	msa := ">seq1\nAATGAAACTTCATT\n>seq2\nAATGA-ACTTCGTT\n"
+	regions := []variants.Region{
+		{Name: "fwd", Start: 2, Stop: 7, Strand: 1, Positions: []int{2, 3, 4, 5, 6, 7}},
+		{Name: "rev", Start: 8, Stop: 13, Strand: -1, Positions: []int{13, 12, 11, 10, 9, 8}},
+	}
+
+	tests := map[string][2]string{
+		ModeExtent:  {">seq1\nATGAAA\n>seq2\nATGA-A\n", ">seq1\nCTTCAT\n>seq2\nCTTCGT\n"},
+		ModeCodon:   {">seq1\nATGAAA\n>seq2\nATGA-A\n", ">seq1\nATGAAG\n>seq2\nACGAAG\n"},
+		ModeProtein: {">seq1\nMK\n>seq2\nMX\n", ">seq1\nMK\n>seq2\nTK\n"},
+	}
+
+	for mode, want := range tests {
+		var fwd, rev bytes.Buffer
+		err := Restrict(context.Background(), strings.NewReader(msa), regions, 14, []io.Writer{&fwd, &rev}, mode, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fwd.String() != want[0] || rev.String() != want[1] {
+			t.Errorf("problem in TestRestrict() (%s): got %q and %q", mode, fwd.String(), rev.String())
+		}
+	}
+
+	err := Restrict(context.Background(), strings.NewReader(msa), regions, 15, []io.Writer{io.Discard, io.Discard}, ModeExtent, 1)
+	if err == nil {
+		t.Errorf("expected an error in TestRestrict() for the wrong reference length")
+	}
+}
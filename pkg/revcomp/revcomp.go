@@ -0,0 +1,95 @@
+/*
+Package revcomp implements routines to reverse-complement records in a fasta
+file, either by name or by detecting which strand they are on relative to a
+reference.
+*/
+package revcomp
+
+import (
+	"context"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/alphabet"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+func writeRecord(w io.Writer, FR fastaio.FastaRecord) error {
+	_, err := w.Write([]byte(">" + FR.Description + "\n" + FR.Seq + "\n"))
+	return err
+}
+
+// RevComp reverse-complements records in in and writes every record to out. If names and re are both
+// empty, every record is reverse-complemented; otherwise only those whose ID is in names or whose header
+// matches re are
+func RevComp(ctx context.Context, in io.Reader, out io.Writer, names map[string]bool, re *regexp.Regexp) error {
+	all := len(names) == 0 && re == nil
+	return fastaio.EachRecord(ctx, in, func(FR fastaio.FastaRecord) error {
+		if all || names[FR.ID] || (re != nil && re.MatchString(FR.Description)) {
+			FR = FR.ReverseComplement()
+		}
+		return writeRecord(out, FR)
+	})
+}
+
+// kmerSet returns the set of k-mers in seq that don't contain a gap or an ambiguity code
+func kmerSet(seq string, k int) map[string]bool {
+	set := make(map[string]bool, len(seq))
+	run := 0
+	for i := 0; i < len(seq); i++ {
+		switch seq[i] {
+		case 'A', 'C', 'G', 'T':
+			run++
+		default:
+			run = 0
+		}
+		if run >= k {
+			set[seq[i-k+1:i+1]] = true
+		}
+	}
+	return set
+}
+
+// shared returns the number of k-mers in seq that are in set
+func shared(seq string, k int, set map[string]bool) int {
+	n := 0
+	for kmer := range kmerSet(seq, k) {
+		if set[kmer] {
+			n++
+		}
+	}
+	return n
+}
+
+// Orient writes every record in in to out on the same strand as ref: a record is reverse-complemented if it
+// shares more k-mers with the reverse complement of ref than with ref itself. If report is not nil, a CSV
+// file with the columns query,strand,forward_kmers,reverse_kmers is written to it
+func Orient(ctx context.Context, in io.Reader, out io.Writer, ref string, k int, report io.Writer) error {
+
+	set := kmerSet(strings.ToUpper(strings.ReplaceAll(ref, "-", "")), k)
+
+	if report != nil {
+		if _, err := report.Write([]byte("query,strand,forward_kmers,reverse_kmers\n")); err != nil {
+			return err
+		}
+	}
+
+	return fastaio.EachRecord(ctx, in, func(FR fastaio.FastaRecord) error {
+		seq := strings.ReplaceAll(FR.Seq, "-", "")
+		fwd := shared(seq, k, set)
+		rev := shared(alphabet.ReverseComplement(seq), k, set)
+		strand := "+"
+		if rev > fwd {
+			FR = FR.ReverseComplement()
+			strand = "-"
+		}
+		if report != nil {
+			if _, err := report.Write([]byte(FR.ID + "," + strand + "," + strconv.Itoa(fwd) + "," + strconv.Itoa(rev) + "\n")); err != nil {
+				return err
+			}
+		}
+		return writeRecord(out, FR)
+	})
+}
@@ -0,0 +1,53 @@
+package revcomp
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestRevComp(t *testing.T) {
+	in := ">seq1 a\nATGRN-C\n>seq2 b\nAAAC\n"
+
+	var out bytes.Buffer
+	if err := RevComp(context.Background(), strings.NewReader(in), &out, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != ">seq1 a\nG-NYCAT\n>seq2 b\nGTTT\n" {
+		t.Errorf("problem in TestRevComp(): %s", out.String())
+	}
+
+	out.Reset()
+	if err := RevComp(context.Background(), strings.NewReader(in), &out, map[string]bool{"seq2": true}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != ">seq1 a\nATGRN-C\n>seq2 b\nGTTT\n" {
+		t.Errorf("problem in TestRevComp() by name: %s", out.String())
+	}
+
+	out.Reset()
+	if err := RevComp(context.Background(), strings.NewReader(in), &out, nil, regexp.MustCompile(" a$")); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != ">seq1 a\nG-NYCAT\n>seq2 b\nAAAC\n" {
+		t.Errorf("problem in TestRevComp() by regex: %s", out.String())
+	}
+}
+
+func TestOrient(t *testing.T) {
+	ref := "ATGGCGTACGTTAGCCGATAGGCTAGCTAGGATCCGAT"
+	in := ">fwd\nGCGTACGTTAGCCGATAGG\n>rev\nCCTATCGGCTAACGTACGC\n"
+
+	var out, report bytes.Buffer
+	if err := Orient(context.Background(), strings.NewReader(in), &out, ref, 8, &report); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != ">fwd\nGCGTACGTTAGCCGATAGG\n>rev\nGCGTACGTTAGCCGATAGG\n" {
+		t.Errorf("problem in TestOrient(): %s", out.String())
+	}
+	if !strings.Contains(report.String(), "rev,-,0,12\n") {
+		t.Errorf("problem in TestOrient() report: %s", report.String())
+	}
+}
@@ -0,0 +1,187 @@
+/*
+Package roottotip implements a root-to-tip regression: the divergence of each
+sequence in an alignment from a root (the reference, or the consensus of the
+alignment) against its sampling date, as a quick check for temporal signal
+before a phylodynamic analysis.
+*/
+package roottotip
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/virus-evolution/gofasta/pkg/consensus"
+	"github.com/virus-evolution/gofasta/pkg/encoding"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/summary"
+)
+
+// Point is one record's divergence from the root. Distance is the number of differences at the Compared sites,
+// where both the record and the root have an unambiguous nucleotide
+type Point struct {
+	Name       string
+	Date       string
+	Decimal    float64
+	Distance   int
+	Compared   int
+	Divergence float64
+}
+
+// Regression is the least-squares fit of divergence against decimal date. Slope is the rate in substitutions
+// per site per year, and RootDate the date at which the fitted divergence is zero
+type Regression struct {
+	N         int
+	Slope     float64
+	Intercept float64
+	RootDate  float64
+	R2        float64
+}
+
+// DecimalDate converts a date in the form YYYY-MM-DD to a decimal year, taking the middle of the day. A number
+// is taken to already be a decimal year
+func DecimalDate(date string) (float64, error) {
+	if t, err := time.Parse("2006-01-02", date); err == nil {
+		start := time.Date(t.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+		days := start.AddDate(1, 0, 0).Sub(start).Hours() / 24
+		return float64(t.Year()) + (float64(t.YearDay())-0.5)/days, nil
+	}
+	if d, err := strconv.ParseFloat(date, 64); err == nil {
+		return d, nil
+	}
+	return 0, errors.New("couldn't parse date: " + date)
+}
+
+// divergence compares seq to root (both EP-encoded)
+func divergence(seq, root []byte) (int, int) {
+	d, n := 0, 0
+	for i, nuc := range seq {
+		if nuc&8 != 8 || root[i]&8 != 8 {
+			continue
+		}
+		n++
+		if nuc&root[i] < 16 {
+			d++
+		}
+	}
+	return d, n
+}
+
+// Fit returns the least-squares regression of divergence on decimal date over points
+func Fit(points []Point) Regression {
+	r := Regression{N: len(points), Slope: math.NaN(), Intercept: math.NaN(), RootDate: math.NaN(), R2: math.NaN()}
+	if len(points) < 2 {
+		return r
+	}
+	var mx, my float64
+	for _, p := range points {
+		mx += p.Decimal
+		my += p.Divergence
+	}
+	mx /= float64(len(points))
+	my /= float64(len(points))
+	var sxx, sxy, syy float64
+	for _, p := range points {
+		dx, dy := p.Decimal-mx, p.Divergence-my
+		sxx += dx * dx
+		sxy += dx * dy
+		syy += dy * dy
+	}
+	if sxx == 0 {
+		return r
+	}
+	r.Slope = sxy / sxx
+	r.Intercept = my - r.Slope*mx
+	if r.Slope != 0 {
+		r.RootDate = -r.Intercept / r.Slope
+	}
+	if syy > 0 {
+		r.R2 = sxy * sxy / (sxx * syy)
+	}
+	return r
+}
+
+// RootToTip measures the divergence of every record in the alignment msa from root (EP-encoded, and aligned to
+// msa), or from the majority consensus of the alignment if root is nil, and regresses it on the dates returned by
+// dateOf. Records without a date are skipped. A CSV file with the columns
+// record,date,decimal_date,distance,compared_sites,divergence,residual is written to out, and the regression,
+// with the columns n,rate,intercept,root_date,r_squared, to regressionOut if it isn't nil
+func RootToTip(ctx context.Context, msa io.Reader, root []byte, dateOf func(string) (string, bool), out, regressionOut io.Writer) (Regression, error) {
+
+	points := make([]Point, 0)
+	undated := 0
+	add := func(EFR fastaio.EncodedFastaRecord) error {
+		if len(EFR.Seq) != len(root) {
+			return errors.New(EFR.ID + " is not the same length as the root")
+		}
+		date, ok := dateOf(EFR.ID)
+		if !ok || date == "" {
+			undated++
+			return nil
+		}
+		decimal, err := DecimalDate(date)
+		if err != nil {
+			return errors.New(EFR.ID + ": " + err.Error())
+		}
+		p := Point{Name: EFR.ID, Date: date, Decimal: decimal}
+		p.Distance, p.Compared = divergence(EFR.Seq, root)
+		p.Divergence = math.NaN()
+		if p.Compared > 0 {
+			p.Divergence = float64(p.Distance) / float64(p.Compared)
+		}
+		points = append(points, p)
+		return nil
+	}
+
+	if root == nil {
+		records, err := fastaio.ReadEncodeAlignmentToList(msa, false)
+		if err != nil {
+			return Regression{}, err
+		}
+		root = consensus.Encoded(records, consensus.Options{})
+		for _, EFR := range records {
+			if err := add(EFR); err != nil {
+				return Regression{}, err
+			}
+		}
+	} else if err := fastaio.EachEncodedRecord(ctx, msa, false, add); err != nil {
+		return Regression{}, err
+	}
+
+	if undated > 0 {
+		summary.Warn(strconv.Itoa(undated) + " records had no date, and were skipped")
+	}
+
+	fitted := make([]Point, 0, len(points))
+	for _, p := range points {
+		if !math.IsNaN(p.Divergence) {
+			fitted = append(fitted, p)
+		}
+	}
+	r := Fit(fitted)
+
+	if _, err := out.Write([]byte("record,date,decimal_date,distance,compared_sites,divergence,residual\n")); err != nil {
+		return r, err
+	}
+	for _, p := range points {
+		residual := p.Divergence - (r.Intercept + r.Slope*p.Decimal)
+		line := p.Name + "," + p.Date + "," + encoding.FormatFloat(p.Decimal, 'f', 4) + "," + strconv.Itoa(p.Distance) + "," + strconv.Itoa(p.Compared) + "," +
+			encoding.FormatFloat(p.Divergence, 'f', 8) + "," + encoding.FormatFloat(residual, 'f', 8) + "\n"
+		if _, err := out.Write([]byte(line)); err != nil {
+			return r, err
+		}
+	}
+
+	if regressionOut != nil {
+		line := "n,rate,intercept,root_date,r_squared\n" + strconv.Itoa(r.N) + "," + encoding.FormatFloat(r.Slope, 'g', 6) + "," +
+			encoding.FormatFloat(r.Intercept, 'g', 6) + "," + encoding.FormatFloat(r.RootDate, 'f', 4) + "," + encoding.FormatFloat(r.R2, 'f', 4) + "\n"
+		if _, err := regressionOut.Write([]byte(line)); err != nil {
+			return r, err
+		}
+	}
+
+	return r, nil
+}
@@ -0,0 +1,79 @@
+package roottotip
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/encoding"
+)
+
+func TestDecimalDate(t *testing.T) {
+	d, err := DecimalDate("2021-01-01")
+	if err != nil || math.Abs(d-(2021+0.5/365)) > 1e-9 {
+		t.Errorf("problem in TestDecimalDate(): got %f, %v", d, err)
+	}
+	d, err = DecimalDate("2020.5")
+	if err != nil || d != 2020.5 {
+		t.Errorf("problem in TestDecimalDate(): got %f, %v", d, err)
+	}
+	if _, err := DecimalDate("2020-13"); err == nil {
+		t.Errorf("problem in TestDecimalDate(): expected an error for a bad date")
+	}
+}
+
+func TestFit(t *testing.T) {
+	points := []Point{{Decimal: 2020, Divergence: 0.001}, {Decimal: 2021, Divergence: 0.002}, {Decimal: 2022, Divergence: 0.003}}
+	r := Fit(points)
+	if math.Abs(r.Slope-0.001) > 1e-12 || math.Abs(r.RootDate-2019) > 1e-6 || math.Abs(r.R2-1) > 1e-9 {
+		t.Errorf("problem in TestFit(): got %+v", r)
+	}
+	if r := Fit(points[:1]); !math.IsNaN(r.Slope) {
+		t.Errorf("problem in TestFit(): expected no fit for one point, got %+v", r)
+	}
+}
+
+func TestRootToTip(t *testing.T) {
+	msa := ">a\nACGTACGTAC\n>b\nACGTACGTTC\n>c\nNCGTACGTTG\n>d\nACGTACGTAC\n"
+	dates := map[string]string{"a": "2020.0", "b": "2021.0", "c": "2022.0"}
+	dateOf := func(name string) (string, bool) {
+		d, ok := dates[name]
+		return d, ok
+	}
+
+	out := new(bytes.Buffer)
+	regression := new(bytes.Buffer)
+	root := encoding.MakeEncodingArray()
+	ref := []byte("ACGTACGTAC")
+	for i := range ref {
+		ref[i] = root[ref[i]]
+	}
+	r, err := RootToTip(context.Background(), strings.NewReader(msa), ref, dateOf, out, regression)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "record,date,decimal_date,distance,compared_sites,divergence,residual\n" +
+		"a,2020.0,2020.0000,0,10,0.00000000,0.00370370\n" +
+		"b,2021.0,2021.0000,1,10,0.10000000,-0.00740741\n" +
+		"c,2022.0,2022.0000,2,9,0.22222222,0.00370370\n"
+	if out.String() != want {
+		t.Errorf("problem in TestRootToTip(): got\n%s", out.String())
+	}
+	if r.N != 3 || math.Abs(r.Slope-0.11111111) > 1e-6 {
+		t.Errorf("problem in TestRootToTip(): got %+v", r)
+	}
+	if !strings.HasPrefix(regression.String(), "n,rate,intercept,root_date,r_squared\n3,0.111111,") {
+		t.Errorf("problem in TestRootToTip(): got\n%s", regression.String())
+	}
+
+	// the consensus of the alignment is ACGTACGTNC, since column 9 is a tie
+	out.Reset()
+	if _, err := RootToTip(context.Background(), strings.NewReader(msa), nil, dateOf, out, nil); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "\nb,2021.0,2021.0000,0,9,") {
+		t.Errorf("problem in TestRootToTip(): got\n%s", out.String())
+	}
+}
@@ -11,6 +11,8 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/virus-evolution/gofasta/pkg/summary"
+
 	biogosam "github.com/biogo/hts/sam"
 )
 
@@ -57,6 +59,7 @@ func getSamRecords(in io.Reader, chnl chan biogosam.Record, cdone chan bool, cer
 			// can use the rightshift method to check this:
 			if ((rec.Flags >> 2) & 1) == 1 {
 				os.Stderr.WriteString("skipping unmapped read: " + rec.Name + "\n")
+				summary.Skipped(rec.Name, "unmapped read")
 				continue
 			}
 
@@ -65,10 +68,12 @@ func getSamRecords(in io.Reader, chnl chan biogosam.Record, cdone chan bool, cer
 			// can use the rightshift method to check this:
 			if ((rec.Flags >> 8) & 1) == 1 {
 				os.Stderr.WriteString("ignoring secondary mapping: " + rec.Name + "\n")
+				summary.Skipped(rec.Name, "secondary mapping")
 				continue
 			}
 
 			chnl <- *rec
+			summary.Processed(1)
 
 		}
 	}
@@ -92,7 +97,7 @@ func getIndels(cSR chan biogosam.Record, cIns chan insOccurrence, cDel chan delO
 		POS := samLine.Pos
 
 		if POS < 0 {
-			cErr <- errors.New("unmapped read")
+			cErr <- errors.New("unmapped read: " + QNAME)
 			return
 		}
 
@@ -201,85 +206,116 @@ func populateDelMap(cDel chan delOccurrence, cDelMap chan map[int]map[int][]stri
 	cDelMap <- delMap
 }
 
-// writeInsMap writes the info in a map of insertion occurrences to file
-func writeInsMap(w io.Writer, insmap map[int]map[string][]string, threshold int) error {
+// Insertion is a sequence inserted after the 1-based position Start of the reference, and the reads that have it
+type Insertion struct {
+	Start   int      `json:"start"`
+	Seq     string   `json:"insertion"`
+	Samples []string `json:"samples"`
+}
 
-	var err error
+// Deletion is Length bases of the reference deleted from the 1-based position Start, and the reads that have it
+type Deletion struct {
+	Start   int      `json:"start"`
+	Length  int      `json:"length"`
+	Samples []string `json:"samples"`
+}
 
-	keys := make([]int, 0, len(insmap))
+// insertions returns the insertions in a map of insertion occurrences, in order of position and then sequence
+func insertions(insmap map[int]map[string][]string) []Insertion {
+	ins := make([]Insertion, 0)
 	for k := range insmap {
-		keys = append(keys, k)
+		for v, samples := range insmap[k] {
+			// k + 1 to get things in 1-based coordinates
+			ins = append(ins, Insertion{Start: k + 1, Seq: v, Samples: samples})
+		}
 	}
-	sort.Ints(keys)
+	sort.Slice(ins, func(i, j int) bool {
+		return ins[i].Start < ins[j].Start || (ins[i].Start == ins[j].Start && ins[i].Seq < ins[j].Seq)
+	})
+	return ins
+}
 
-	_, err = w.Write([]byte("ref_start\tinsertion\tsamples\n"))
+// deletions returns the deletions in a map of deletion occurrences, in order of position and then length
+func deletions(delmap map[int]map[int][]string) []Deletion {
+	dels := make([]Deletion, 0)
+	for k := range delmap {
+		for v, samples := range delmap[k] {
+			// k + 1 to get things in 1-based coordinates
+			dels = append(dels, Deletion{Start: k + 1, Length: v, Samples: samples})
+		}
+	}
+	sort.Slice(dels, func(i, j int) bool {
+		return dels[i].Start < dels[j].Start || (dels[i].Start == dels[j].Start && dels[i].Length < dels[j].Length)
+	})
+	return dels
+}
+
+// writeInsertions writes the insertions found in at least threshold reads to file
+func writeInsertions(w io.Writer, ins []Insertion, threshold int) error {
+
+	_, err := w.Write([]byte("ref_start\tinsertion\tsamples\n"))
 	if err != nil {
 		return err
 	}
 
-	for _, k := range keys {
-		for v := range insmap[k] {
-			if len(insmap[k][v]) < threshold {
-				continue
-			}
-			// k + 1 to get things in 1-based coordinates
-			c1 := strconv.Itoa(k + 1)
-			c2 := v
-			c3 := strings.Join(insmap[k][v], "|")
-
-			_, err = w.Write([]byte(c1 + "\t" + c2 + "\t" + c3 + "\n"))
-			if err != nil {
-				return err
-			}
+	for _, i := range ins {
+		if len(i.Samples) < threshold {
+			continue
+		}
+		_, err = w.Write([]byte(strconv.Itoa(i.Start) + "\t" + i.Seq + "\t" + strings.Join(i.Samples, "|") + "\n"))
+		if err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
-// writeDelMap writes the info in a map of deletions occurrences to file
-func writeDelMap(w io.Writer, delmap map[int]map[int][]string, threshold int) error {
-
-	var err error
-
-	keys := make([]int, 0, len(delmap))
-	for k := range delmap {
-		keys = append(keys, k)
-	}
-	sort.Ints(keys)
+// writeDeletions writes the deletions found in at least threshold reads to file
+func writeDeletions(w io.Writer, dels []Deletion, threshold int) error {
 
-	_, err = w.Write([]byte("ref_start\tlength\tsamples\n"))
+	_, err := w.Write([]byte("ref_start\tlength\tsamples\n"))
 	if err != nil {
 		return err
 	}
 
-	for _, k := range keys {
-		for v := range delmap[k] {
-			if len(delmap[k][v]) < threshold {
-				continue
-			}
-			// k + 1 to get things in 1-based coordinates
-			c1 := strconv.Itoa(k + 1)
-			c2 := strconv.Itoa(v)
-			c3 := strings.Join(delmap[k][v], "|")
-
-			_, err = w.Write([]byte(c1 + "\t" + c2 + "\t" + c3 + "\n"))
-			if err != nil {
-				return err
-			}
+	for _, d := range dels {
+		if len(d.Samples) < threshold {
+			continue
+		}
+		_, err = w.Write([]byte(strconv.Itoa(d.Start) + "\t" + strconv.Itoa(d.Length) + "\t" + strings.Join(d.Samples, "|") + "\n"))
+		if err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
-// Indels gets raw indel information from the cigar + sequence fields of a sam file
+// Indels gets raw indel information from the cigar + sequence fields of a sam file, and writes the insertions and
+// deletions found in at least threshold reads to insOut and delOut
 func Indels(samFile io.Reader, insOut, delOut io.Writer, threshold int) error {
 
 	fmt.Println("sam indels is deprecated and may be removed in a future version. Please use sam variants instead.")
 
-	cErr := make(chan error)
+	ins, dels, err := FindIndels(samFile)
+	if err != nil {
+		return err
+	}
+
+	err = writeInsertions(insOut, ins, threshold)
+	if err != nil {
+		return err
+	}
+
+	return writeDeletions(delOut, dels, threshold)
+}
 
+// FindIndels returns the insertions and deletions relative to the reference in the cigar + sequence fields of a sam
+// file, in order of position
+func FindIndels(samFile io.Reader) ([]Insertion, []Deletion, error) {
+
+	cErr := make(chan error)
 	cSR := make(chan biogosam.Record, runtime.NumCPU())
 
 	cIns := make(chan insOccurrence)
@@ -314,7 +350,7 @@ func Indels(samFile io.Reader, insOut, delOut io.Writer, threshold int) error {
 	for n := 1; n > 0; {
 		select {
 		case err := <-cErr:
-			return err
+			return nil, nil, err
 		case <-cReadDone:
 			close(cSR)
 			n--
@@ -324,7 +360,7 @@ func Indels(samFile io.Reader, insOut, delOut io.Writer, threshold int) error {
 	for n := 1; n > 0; {
 		select {
 		case err := <-cErr:
-			return err
+			return nil, nil, err
 		case <-cInDelsDone:
 			close(cIns)
 			close(cDel)
@@ -338,7 +374,7 @@ func Indels(samFile io.Reader, insOut, delOut io.Writer, threshold int) error {
 	for n := 2; n > 0; {
 		select {
 		case err := <-cErr:
-			return err
+			return nil, nil, err
 		case insertionmap = <-cInsMap:
 			// close(cInsMap)
 			n--
@@ -348,15 +384,5 @@ func Indels(samFile io.Reader, insOut, delOut io.Writer, threshold int) error {
 		}
 	}
 
-	err := writeInsMap(insOut, insertionmap, threshold)
-	if err != nil {
-		return err
-	}
-
-	err = writeDelMap(delOut, deletionmap, threshold)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return insertions(insertionmap), deletions(deletionmap), nil
 }
@@ -0,0 +1,25 @@
+package sam
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestFindIndels(t *testing.T) {
+	samData := []byte("@SQ\tSN:ref\tLN:20\n" +
+		"r1\t0\tref\t3\t60\t5M2I5M\t*\t0\t0\tACGTAGGCGTAC\t*\n" +
+		"r2\t0\tref\t3\t60\t4M3D6M\t*\t0\t0\tACGTCGTACG\t*\n" +
+		"r3\t0\tref\t1\t60\t2M1D4M\t*\t0\t0\tACGTAC\t*\n")
+
+	ins, dels, err := FindIndels(bytes.NewReader(samData))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ins, []Insertion{{Start: 8, Seq: "GG", Samples: []string{"r1"}}}) {
+		t.Errorf("problem in TestFindIndels(): insertions %v", ins)
+	}
+	if !reflect.DeepEqual(dels, []Deletion{{Start: 3, Length: 1, Samples: []string{"r3"}}, {Start: 7, Length: 3, Samples: []string{"r2"}}}) {
+		t.Errorf("problem in TestFindIndels(): deletions %v", dels)
+	}
+}
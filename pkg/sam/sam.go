@@ -10,13 +10,17 @@ to list mutations between pairs of sequences.
 package sam
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"io"
 	"os"
 	"strconv"
 	"unicode"
 	"unicode/utf8"
 
+	"github.com/virus-evolution/gofasta/pkg/summary"
+
 	biogosam "github.com/biogo/hts/sam"
 )
 
@@ -39,12 +43,10 @@ func getOneLine(samLine biogosam.Record, refLen int, includeInsertions bool) ([]
 		lambda_dict = getCigarOperationMapNoInsertions()
 	}
 
-	// QNAME := samLine.Name
-
 	POS := samLine.Pos
 
 	if POS < 0 {
-		return []byte{}, errors.New("unmapped read")
+		return []byte{}, errors.New("unmapped read: " + samLine.Name)
 	}
 
 	SEQ := samLine.Seq.Expand()
@@ -97,12 +99,10 @@ func getOneLinePlusRef(samLine biogosam.Record, reference []byte, includeInserti
 		lambda_dict = getCigarOperationMapNoInsertionsWithRef()
 	}
 
-	// QNAME := samLine.Name
-
 	POS := samLine.Pos
 
 	if POS < 0 {
-		return []byte{}, []byte{}, errors.New("unmapped read")
+		return []byte{}, []byte{}, errors.New("unmapped read: " + samLine.Name)
 	}
 
 	SEQ := samLine.Seq.Expand()
@@ -190,7 +190,7 @@ func getNucFromSite(s []byte, qname string, pos int) byte {
 	}
 
 	if check > 1 {
-		os.Stderr.WriteString("ambiguous overlapping alignment: " + qname + ": " + strconv.Itoa(pos+1) + ": " + string(ss) + "\n")
+		summary.Warn("ambiguous overlapping alignment: " + qname + ": " + strconv.Itoa(pos+1) + ": " + string(ss))
 		return 'N'
 	}
 
@@ -332,13 +332,14 @@ func swapInGapsNs(seq []byte) []byte {
 
 // groupSamRecords yields blocks of sam records that correspond to the same query
 // sequence (to a channel)
-func groupSamRecords(sam io.Reader, cHeader chan biogosam.Header, chnl chan samRecords, cdone chan bool, cerr chan error) {
+func groupSamRecords(ctx context.Context, sam io.Reader, cHeader chan biogosam.Header, chnl chan samRecords, cdone chan bool, cerr chan error) {
 
 	var err error
 
 	s, err := biogosam.NewReader(sam)
 	if err != nil {
-		cerr <- err
+		cerr <- fmt.Errorf("reading SAM header: %w", err)
+		return
 	}
 
 	cHeader <- *s.Header()
@@ -349,10 +350,17 @@ func groupSamRecords(sam io.Reader, cHeader chan biogosam.Header, chnl chan samR
 	first := true
 	samLineGroup := samRecords{idx: counter}
 	var previous string
+	n := 0
 
 	for {
 
+		// stop reading if the caller has been cancelled: the caller returns ctx's error itself
+		if ctx.Err() != nil {
+			return
+		}
+
 		rec, err := s.Read()
+		n++
 
 		if err == io.EOF {
 
@@ -360,7 +368,8 @@ func groupSamRecords(sam io.Reader, cHeader chan biogosam.Header, chnl chan samR
 
 		} else if err != nil {
 
-			cerr <- err
+			cerr <- fmt.Errorf("reading SAM record %d: %w", n, err)
+			return
 
 		} else {
 			// if this read is unmapped, then skip it.
@@ -368,6 +377,7 @@ func groupSamRecords(sam io.Reader, cHeader chan biogosam.Header, chnl chan samR
 			// can use the rightshift method to check this:
 			if ((rec.Flags >> 2) & 1) == 1 {
 				os.Stderr.WriteString("skipping unmapped read: " + rec.Name + "\n")
+				summary.Skipped(rec.Name, "unmapped read")
 				continue
 			}
 
@@ -376,6 +386,7 @@ func groupSamRecords(sam io.Reader, cHeader chan biogosam.Header, chnl chan samR
 			// can use the rightshift method to check this:
 			if ((rec.Flags >> 8) & 1) == 1 {
 				os.Stderr.WriteString("ignoring secondary mapping: " + rec.Name + "\n")
+				summary.Skipped(rec.Name, "secondary mapping")
 				continue
 			}
 
@@ -388,6 +399,7 @@ func groupSamRecords(sam io.Reader, cHeader chan biogosam.Header, chnl chan samR
 
 			if rec.Name != previous {
 				chnl <- samLineGroup
+				summary.Processed(1)
 				counter++
 
 				samLineGroup = samRecords{idx: counter}
@@ -405,6 +417,7 @@ func groupSamRecords(sam io.Reader, cHeader chan biogosam.Header, chnl chan samR
 
 	if len(samLineGroup.records) > 0 {
 		chnl <- samLineGroup
+		summary.Processed(1)
 	}
 
 	cdone <- true
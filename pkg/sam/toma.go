@@ -1,107 +1,90 @@
 package sam
 
 import (
+	"context"
 	"errors"
 	"io"
-	"sync"
+	"runtime"
 
+	"github.com/virus-evolution/gofasta/internal/pipeline"
 	"github.com/virus-evolution/gofasta/pkg/fastaio"
 
 	biogosam "github.com/biogo/hts/sam"
 )
 
+// MultiAlignOptions controls ToMultiAlign. The output is wrapped at Wrap nucleotides if it is greater than 0.
+// Start and End, if they are greater than 0, are the 1-based first and last reference positions to keep; with
+// Pad, the trimmed-out regions (or external deletions, if there is no trimming) are replaced with Ns instead.
+// Threads is the number of goroutines to use (0 means all available CPUs)
+type MultiAlignOptions struct {
+	Wrap    int
+	Start   int
+	End     int
+	Pad     bool
+	Threads int
+}
+
 // ToMultiAlign converts a SAM file containing pairwise alignments between assembled genomes to a fasta-format alignment.
-// Insertions relative to the reference are discarded, so all the sequences are the same (=reference) length
-func ToMultiAlign(samIn io.Reader, out io.Writer, wrap int, trimstart int, trimend int, pad bool, threads int) error {
+// Insertions relative to the reference are discarded, so all the sequences are the same (=reference) length.
+// The records are written in the order of the SAM file, unless ctx is unordered. If ctx is cancelled, no more SAM
+// records are read and ctx's error is returned
+func ToMultiAlign(ctx context.Context, samIn io.Reader, out io.Writer, o MultiAlignOptions) error {
+
+	threads := o.Threads
+	if threads < 1 {
+		threads = runtime.NumCPU()
+	}
 
 	cSR := make(chan samRecords, threads)
 	cReadDone := make(chan bool)
-
 	cSH := make(chan biogosam.Header)
-
-	cFR := make(chan fastaio.FastaRecord)
-	cWriteDone := make(chan bool)
-
 	cErr := make(chan error)
 
-	cWaitGroupDone := make(chan bool)
+	go groupSamRecords(ctx, samIn, cSH, cSR, cReadDone, cErr)
 
-	go groupSamRecords(samIn, cSH, cSR, cReadDone, cErr)
-
-	header := <-cSH
+	var header biogosam.Header
+	select {
+	case header = <-cSH:
+	case err := <-cErr:
+		return err
+	}
 	refLen := header.Refs()[0].Len()
 
-	trimstart, trimend, trim, err := checkArgs(refLen, trimstart, trimend)
+	trimstart, trimend, trim, err := checkArgs(refLen, o.Start, o.End)
 	if err != nil {
+		go pipeline.Drain(cSR, cErr, cReadDone)
 		return err
 	}
 
-	if wrap > 0 {
-		go fastaio.WriteWrapAlignment(cFR, out, wrap, cWriteDone, cErr)
-	} else {
-		go fastaio.WriteAlignment(cFR, out, cWriteDone, cErr)
-	}
-
-	var wg sync.WaitGroup
-	wg.Add(threads)
-
-	for n := 0; n < threads; n++ {
-		go func() {
-			blockToFastaRecord(cSR, cFR, cErr, refLen, trim, pad, trimstart, trimend, false)
-			wg.Done()
-		}()
-	}
-
-	go func() {
-		wg.Wait()
-		cWaitGroupDone <- true
-	}()
-
-	for n := 1; n > 0; {
-		select {
-		case err := <-cErr:
-			return err
-		case <-cReadDone:
-			close(cSR)
-			close(cSH)
-			n--
-		}
-	}
-
-	for n := 1; n > 0; {
-		select {
-		case err := <-cErr:
-			return err
-		case <-cWaitGroupDone:
-			close(cFR)
-			n--
-		}
-	}
-
-	for n := 1; n > 0; {
-		select {
-		case err := <-cErr:
-			return err
-		case <-cWriteDone:
-			n--
+	work := func(group samRecords) (fastaio.FastaRecord, error) {
+		rawseq, err := getSeqFromBlock(group.records, refLen, false)
+		if err != nil {
+			return fastaio.FastaRecord{}, err
 		}
+		return getFastaRecord(rawseq, group.records[0].Name, group.idx, trim, o.Pad, trimstart, trimend), nil
 	}
 
-	return nil
+	var buf []byte
+	return pipeline.Run(ctx, threads, 0, pipeline.FromChannels(cSR, cErr, cReadDone), work, func(FR fastaio.FastaRecord) error {
+		buf = fastaio.AppendWrapped(buf[:0], FR.ID, FR.Seq, o.Wrap)
+		_, err := out.Write(buf)
+		return err
+	})
 }
 
-// checkArgs sanity checks the trimming and padding arguments, given the length of the reference sequence
+// checkArgs sanity checks the trimming and padding arguments, given the length of the reference sequence.
+// A start or end that is less than 1 isn't set
 func checkArgs(refLen int, trimstart int, trimend int) (int, int, bool, error) {
 
 	trim := false
 
-	if trimstart == -1 {
+	if trimstart < 1 {
 		trimstart = 1
 	} else {
 		trim = true
 	}
 
-	if trimend == -1 {
+	if trimend < 1 {
 		trimend = refLen
 	} else {
 		trim = true
@@ -120,23 +103,6 @@ func checkArgs(refLen int, trimstart int, trimend int) (int, int, bool, error) {
 	return trimstart, trimend, trim, nil
 }
 
-// blockToFastaRecord is a worker function that takes items from a channel of sam block structs (with indices)
-// and writes the corresponding fasta records to a channel
-func blockToFastaRecord(ch_in chan samRecords, ch_out chan fastaio.FastaRecord, ch_err chan error,
-	refLen int, trim bool, pad bool, trimstart int, trimend int, includeInsertions bool) {
-
-	for group := range ch_in {
-
-		id := group.records[0].Name
-		rawseq, err := getSeqFromBlock(group.records, refLen, includeInsertions)
-		if err != nil {
-			ch_err <- err
-		}
-		ch_out <- getFastaRecord(rawseq, id, group.idx, trim, pad, trimstart, trimend)
-	}
-	return
-}
-
 // getFastaRecord returns a FastaRecord struct with a sequence ID and a sequence
 // that has been optionally trimmed and padded
 func getFastaRecord(rawseq []byte, id string, idx int, trim bool, pad bool, trimstart int,
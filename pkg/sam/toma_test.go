@@ -2,7 +2,13 @@ package sam
 
 import (
 	"bytes"
+	"context"
+	"reflect"
+	"sort"
+	"strings"
 	"testing"
+
+	"github.com/virus-evolution/gofasta/internal/pipeline"
 )
 
 func TestToMultiAlign(t *testing.T) {
@@ -34,7 +40,7 @@ England/PLYM-1FCEE8A/2021	0	MN908947.3	55	60	54S21974M6D6213M6D17M1D1563M69S	*	0
 
 	out := new(bytes.Buffer)
 
-	err := ToMultiAlign(sam, out, -1, -1, -1, false, 2)
+	err := ToMultiAlign(context.Background(), sam, out, MultiAlignOptions{Threads: 2})
 	if err != nil {
 		t.Error(err)
 	}
@@ -63,6 +69,19 @@ England/PLYM-1FCEE8A/2021	0	MN908947.3	55	60	54S21974M6D6213M6D17M1D1563M69S	*	0
 		t.Errorf("problem in TestToMultiAlign")
 	}
 
+	// unordered, the same records are written, in whatever order they are finished
+	unordered := new(bytes.Buffer)
+	err = ToMultiAlign(pipeline.Unordered(context.Background()), bytes.NewReader(samData), unordered, MultiAlignOptions{Threads: 2})
+	if err != nil {
+		t.Error(err)
+	}
+	want := strings.Split(out.String(), ">")
+	got := strings.Split(unordered.String(), ">")
+	sort.Strings(want)
+	sort.Strings(got)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("problem in TestToMultiAlign(): the unordered records don't match")
+	}
 }
 
 func TestToMultiAlignWrap(t *testing.T) {
@@ -94,7 +113,7 @@ England/PLYM-1FCEE8A/2021	0	MN908947.3	55	60	54S21974M6D6213M6D17M1D1563M69S	*	0
 
 	out := new(bytes.Buffer)
 
-	err := ToMultiAlign(sam, out, 80, -1, -1, false, 2)
+	err := ToMultiAlign(context.Background(), sam, out, MultiAlignOptions{Wrap: 80, Threads: 2})
 	if err != nil {
 		t.Error(err)
 	}
@@ -1,16 +1,19 @@
 package sam
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path"
+	"runtime"
 	"sort"
 	"strings"
 	"sync"
 
 	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
 
 	biogosam "github.com/biogo/hts/sam"
 )
@@ -347,31 +350,22 @@ func wrap(old string, wrap int) string {
 // file per query
 func writePairwiseAlignment(p string, w int, cPair chan alignPair, cWriteDone chan bool, cErr chan error, omitRef bool) {
 
-	_ = path.Join()
-
-	var err error
-
 	if p == "stdout" {
+		stdout, err := gfio.OpenOutPath("stdout")
+		if err != nil {
+			cErr <- err
+			return
+		}
 		for AP := range cPair {
-			if !omitRef {
-				_, err = fmt.Fprintln(os.Stdout, ">"+AP.refname)
-				if err != nil {
-					cErr <- err
-				}
-				_, err = fmt.Fprint(os.Stdout, wrap(string(AP.ref), w))
-				if err != nil {
-					cErr <- err
-				}
-			}
-			_, err = fmt.Fprintln(os.Stdout, ">"+AP.queryname)
-			if err != nil {
-				cErr <- err
-			}
-			_, err = fmt.Fprint(os.Stdout, wrap(string(AP.query), w))
-			if err != nil {
+			if err = writeAlignPair(stdout, AP, w, omitRef); err != nil {
 				cErr <- err
+				return
 			}
 		}
+		if err = stdout.Close(); err != nil {
+			cErr <- err
+			return
+		}
 	} else {
 		os.MkdirAll(p, 0755)
 
@@ -383,37 +377,56 @@ func writePairwiseAlignment(p string, w int, cPair chan alignPair, cWriteDone ch
 				fmt.Fprintf(os.Stderr, "Filename too long, truncating \"%s\" to: \"%s\"\n", des, des[0:249])
 				des = des[0:249]
 			}
-			f, err := os.Create(path.Join(p, des+".fasta"))
+			f, err := gfio.OpenOutPath(path.Join(p, des+".fasta"))
 			if err != nil {
 				cErr <- err
+				return
 			}
-			if !omitRef {
-				_, err = f.WriteString(">" + AP.refname + "\n")
-				if err != nil {
-					cErr <- err
-				}
-				_, err = f.WriteString(wrap(string(AP.ref), w))
-				if err != nil {
-					cErr <- err
-				}
+			err = writeAlignPair(f, AP, w, omitRef)
+			if cerr := f.Close(); err == nil {
+				err = cerr
 			}
-			_, err = f.WriteString(">" + AP.queryname + "\n")
 			if err != nil {
 				cErr <- err
+				return
 			}
-			_, err = f.WriteString(wrap(string(AP.query), w))
-			if err != nil {
-				cErr <- err
-			}
-			f.Close()
 		}
 	}
 	cWriteDone <- true
 }
 
+// writeAlignPair writes one pairwise alignment to out, wrapped at w, with or without its reference
+func writeAlignPair(out io.Writer, AP alignPair, w int, omitRef bool) error {
+	if !omitRef {
+		if _, err := fmt.Fprint(out, ">"+AP.refname+"\n"+wrap(string(AP.ref), w)); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprint(out, ">"+AP.queryname+"\n"+wrap(string(AP.query), w))
+	return err
+}
+
+// PairAlignOptions controls ToPairAlign. Wrap, Start, End and Threads are as for MultiAlignOptions (with Start and
+// End in degapped reference coordinates). OmitReference leaves the reference out of each output alignment, and
+// OmitInsertions leaves out insertions relative to it
+type PairAlignOptions struct {
+	Wrap           int
+	Start          int
+	End            int
+	OmitReference  bool
+	OmitInsertions bool
+	Threads        int
+}
+
 // ToPairAlign converts a SAM file containing pairwise alignments between assembled genomes into pairwise fasta-format alignments,
-// optionally including the reference sequence and insertions relative to it, optionally trimmed to coordinates in (degapped-)reference space
-func ToPairAlign(samIn, ref io.Reader, outpath string, wrap int, trimStart int, trimEnd int, omitRef bool, omitIns bool, threads int) error {
+// optionally including the reference sequence and insertions relative to it, optionally trimmed to coordinates in (degapped-)reference space.
+// It returns ctx's error, without reading any more of samIn, if ctx is cancelled
+func ToPairAlign(ctx context.Context, samIn, ref io.Reader, outpath string, o PairAlignOptions) error {
+
+	threads := o.Threads
+	if threads < 1 {
+		threads = runtime.NumCPU()
+	}
 
 	// NB probably uncomment the below and use it for checks (e.g. for
 	// reference length)
@@ -426,7 +439,7 @@ func ToPairAlign(samIn, ref io.Reader, outpath string, wrap int, trimStart int,
 
 	cErr := make(chan error)
 
-	refs, err := fastaio.ReadEncodeAlignmentToList(ref, false)
+	refs, err := fastaio.ReadEncodeAlignmentToListUncounted(ref, false)
 	if err != nil {
 		return err
 	}
@@ -435,7 +448,7 @@ func ToPairAlign(samIn, ref io.Reader, outpath string, wrap int, trimStart int,
 	}
 	refSeq := refs[0].Decode().Seq
 
-	trimStart, trimEnd, trim, err := checkArgs(len(refSeq), trimStart, trimEnd)
+	trimStart, trimEnd, trim, err := checkArgs(len(refSeq), o.Start, o.End)
 	if err != nil {
 		return err
 	}
@@ -451,11 +464,15 @@ func ToPairAlign(samIn, ref io.Reader, outpath string, wrap int, trimStart int,
 	cTrimWaitGroupDone := make(chan bool)
 	cWriteDone := make(chan bool)
 
-	go groupSamRecords(samIn, cSH, cSR, cReadDone, cErr)
+	go groupSamRecords(ctx, samIn, cSH, cSR, cReadDone, cErr)
 
-	_ = <-cSH
+	select {
+	case <-cSH:
+	case err := <-cErr:
+		return err
+	}
 
-	go writePairwiseAlignment(outpath, wrap, cPairTrim, cWriteDone, cErr, omitRef)
+	go writePairwiseAlignment(outpath, o.Wrap, cPairTrim, cWriteDone, cErr, o.OmitReference)
 
 	var wgAlign sync.WaitGroup
 	wgAlign.Add(threads)
@@ -465,7 +482,7 @@ func ToPairAlign(samIn, ref io.Reader, outpath string, wrap int, trimStart int,
 
 	for n := 0; n < threads; n++ {
 		go func() {
-			blockToPairwiseAlignment(cSR, cPairAlign, cErr, []byte(refSeq), omitIns)
+			blockToPairwiseAlignment(cSR, cPairAlign, cErr, []byte(refSeq), o.OmitInsertions)
 			wgAlign.Done()
 		}()
 	}
@@ -491,6 +508,8 @@ func ToPairAlign(samIn, ref io.Reader, outpath string, wrap int, trimStart int,
 		select {
 		case err := <-cErr:
 			return err
+		case <-ctx.Done():
+			return ctx.Err()
 		case <-cReadDone:
 			close(cSR)
 			close(cSH)
@@ -502,6 +521,8 @@ func ToPairAlign(samIn, ref io.Reader, outpath string, wrap int, trimStart int,
 		select {
 		case err := <-cErr:
 			return err
+		case <-ctx.Done():
+			return ctx.Err()
 		case <-cAlignWaitGroupDone:
 			close(cPairAlign)
 			n--
@@ -512,6 +533,8 @@ func ToPairAlign(samIn, ref io.Reader, outpath string, wrap int, trimStart int,
 		select {
 		case err := <-cErr:
 			return err
+		case <-ctx.Done():
+			return ctx.Err()
 		case <-cTrimWaitGroupDone:
 			close(cPairTrim)
 			n--
@@ -522,6 +545,8 @@ func ToPairAlign(samIn, ref io.Reader, outpath string, wrap int, trimStart int,
 		select {
 		case err := <-cErr:
 			return err
+		case <-ctx.Done():
+			return ctx.Err()
 		case <-cWriteDone:
 			n--
 		}
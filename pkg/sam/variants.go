@@ -1,9 +1,11 @@
 package sam
 
 import (
+	"context"
 	"errors"
 	"io"
 	"os"
+	"runtime"
 	"sync"
 
 	biogosam "github.com/biogo/hts/sam"
@@ -16,12 +18,35 @@ import (
 
 // Variants annotates amino acid, insertion, deletion, and nucleotide (anything outside of codons with an amino acid change)
 // mutations relative to a reference sequence from pairwise alignments in sam format. Genome annotations are derived from a annotation file
-// in genbank or gff version 3 format
-func Variants(samIn, refIn io.Reader, refFromFile bool, annoIn io.Reader, annoSuffix string, out io.Writer, start, end int, aggregate bool, threshold float64, appendSNP bool, threads int) error {
+// in genbank or gff version 3 format. The mutations are written to out as a variants.Writer does. Cancelling ctx stops it at the
+// next SAM record
+func Variants(ctx context.Context, samIn, refIn io.Reader, refFromFile bool, annoIn io.Reader, annoSuffix string, out io.Writer, o variants.Options) error {
+
+	vw, err := variants.NewWriter(out, "", o)
+	if err != nil {
+		return err
+	}
+
+	err = CallVariants(ctx, samIn, refIn, refFromFile, annoIn, annoSuffix, o.Threads, vw.Write)
+	if err != nil {
+		return err
+	}
+
+	return vw.Close()
+}
+
+// CallVariants annotates the mutations in each pairwise alignment in sam format as Variants does, and passes them to f in
+// the order of the sam file, leaving out any record with the reference's name, as variants.Call does. Threads is the number
+// of goroutines to use (0 means all available CPUs)
+func CallVariants(ctx context.Context, samIn, refIn io.Reader, refFromFile bool, annoIn io.Reader, annoSuffix string, threads int, f func(variants.AnnoStructs) error) error {
+
+	if threads < 1 {
+		threads = runtime.NumCPU()
+	}
 
 	var ref fastaio.EncodedFastaRecord
 	if refFromFile {
-		refs, err := fastaio.ReadEncodeAlignmentToList(refIn, false)
+		refs, err := fastaio.ReadEncodeAlignmentToListUncounted(refIn, false)
 		if err != nil {
 			return err
 		}
@@ -92,18 +117,23 @@ func Variants(samIn, refIn io.Reader, refFromFile bool, annoIn io.Reader, annoSu
 	cReadDone := make(chan bool)
 	cAlignWaitGroupDone := make(chan bool)
 	cVariantsDone := make(chan bool)
-	cWriteDone := make(chan bool)
+	cDeliverDone := make(chan bool)
 
-	switch aggregate {
-	case true:
-		go variants.AggregateWriteVariants(out, start, end, appendSNP, threshold, ref.ID, cVariants, cWriteDone, cErr)
-	case false:
-		go variants.WriteVariants(out, start, end, false, appendSNP, ref.ID, cVariants, cWriteDone, cErr)
+	deliver := func(AS variants.AnnoStructs) error {
+		if AS.Queryname == ref.ID {
+			return nil
+		}
+		return f(AS)
 	}
+	go variants.Deliver(deliver, false, cVariants, cDeliverDone, cErr)
 
-	go groupSamRecords(samIn, cSH, cSR, cReadDone, cErr)
+	go groupSamRecords(ctx, samIn, cSH, cSR, cReadDone, cErr)
 
-	_ = <-cSH
+	select {
+	case <-cSH:
+	case err := <-cErr:
+		return err
+	}
 
 	var wgAlign sync.WaitGroup
 	wgAlign.Add(threads)
@@ -139,6 +169,8 @@ func Variants(samIn, refIn io.Reader, refFromFile bool, annoIn io.Reader, annoSu
 		select {
 		case err := <-cErr:
 			return err
+		case <-ctx.Done():
+			return ctx.Err()
 		case <-cReadDone:
 			close(cSR)
 			close(cSH)
@@ -150,6 +182,8 @@ func Variants(samIn, refIn io.Reader, refFromFile bool, annoIn io.Reader, annoSu
 		select {
 		case err := <-cErr:
 			return err
+		case <-ctx.Done():
+			return ctx.Err()
 		case <-cAlignWaitGroupDone:
 			close(cPairAlign)
 			n--
@@ -160,6 +194,8 @@ func Variants(samIn, refIn io.Reader, refFromFile bool, annoIn io.Reader, annoSu
 		select {
 		case err := <-cErr:
 			return err
+		case <-ctx.Done():
+			return ctx.Err()
 		case <-cVariantsDone:
 			close(cVariants)
 			n--
@@ -170,7 +206,9 @@ func Variants(samIn, refIn io.Reader, refFromFile bool, annoIn io.Reader, annoSu
 		select {
 		case err := <-cErr:
 			return err
-		case <-cWriteDone:
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-cDeliverDone:
 			n--
 		}
 	}
@@ -2,8 +2,11 @@ package sam
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/variants"
 )
 
 func TestVariants(t *testing.T) {
@@ -28,7 +31,7 @@ aa:ORF7a:A8K	0	MN908947.3	1	60	29903M	*	0	0	ATTAAAGGTTTATACCTTCCCAGGTAACAAACCAAC
 
 	out := new(bytes.Buffer)
 
-	err := Variants(sam, ref, true, genbank, "gb", out, -1, -1, false, 0.0, false, 1)
+	err := Variants(context.Background(), sam, ref, true, genbank, "gb", out, variants.Options{Threads: 1})
 	if err != nil {
 		t.Error(err)
 	}
@@ -48,7 +51,7 @@ aa:ORF7a:A8K,aa:ORF7a:A8K
 
 	out = new(bytes.Buffer)
 
-	err = Variants(sam, ref, false, genbank, "gb", out, -1, -1, false, 0.0, false, 1)
+	err = Variants(context.Background(), sam, ref, false, genbank, "gb", out, variants.Options{Threads: 1})
 	if err != nil {
 		t.Error(err)
 	}
@@ -69,7 +72,7 @@ aa:ORF7a:A8K,aa:ORF7a:A8K
 
 	out = new(bytes.Buffer)
 
-	err = Variants(sam, ref, true, gff, "gff", out, -1, -1, false, 0.0, false, 1)
+	err = Variants(context.Background(), sam, ref, true, gff, "gff", out, variants.Options{Threads: 1})
 	if err != nil {
 		t.Error(err)
 	}
@@ -89,7 +92,7 @@ aa:ORF7a:A8K,aa:ORF7a:A8K
 
 	out = new(bytes.Buffer)
 
-	err = Variants(sam, ref, false, gff, "gff", out, -1, -1, false, 0.0, false, 1)
+	err = Variants(context.Background(), sam, ref, false, gff, "gff", out, variants.Options{Threads: 1})
 	if err != nil {
 		t.Error(err)
 	}
@@ -127,7 +130,7 @@ aa:ORF7a:A8K	0	MN908947.3	1	60	29903M	*	0	0	ATTAAAGGTTTATACCTTCCCAGGTAACAAACCAAC
 
 	out := new(bytes.Buffer)
 
-	err := Variants(sam, ref, true, genbank, "gb", out, -1, -1, false, 0.0, true, 1)
+	err := Variants(context.Background(), sam, ref, true, genbank, "gb", out, variants.Options{AppendSNP: true, Threads: 1})
 	if err != nil {
 		t.Error(err)
 	}
@@ -167,7 +170,7 @@ aa:ORF7a:A8K3	0	MN908947.3	1	60	29903M	*	0	0	ATTAAAGGTTTATACCTTCCCAGGTAACAAACCAA
 
 	out := new(bytes.Buffer)
 
-	err := Variants(sam, ref, true, genbank, "gb", out, -1, -1, true, 0.0, false, 1)
+	err := Variants(context.Background(), sam, ref, true, genbank, "gb", out, variants.Options{Aggregate: true, Threads: 1})
 	if err != nil {
 		t.Error(err)
 	}
@@ -207,7 +210,7 @@ aa:ORF7a:A8K3	0	MN908947.3	1	60	29903M	*	0	0	ATTAAAGGTTTATACCTTCCCAGGTAACAAACCAA
 
 	out := new(bytes.Buffer)
 
-	err := Variants(sam, ref, true, genbank, "gb", out, -1, -1, true, 0.0, true, 1)
+	err := Variants(context.Background(), sam, ref, true, genbank, "gb", out, variants.Options{Aggregate: true, AppendSNP: true, Threads: 1})
 	if err != nil {
 		t.Error(err)
 	}
@@ -247,7 +250,7 @@ aa:ORF7a:A8K3	0	MN908947.3	1	60	29903M	*	0	0	ATTAAAGGTTTATACCTTCCCAGGTAACAAACCAA
 
 	out := new(bytes.Buffer)
 
-	err := Variants(sam, ref, true, genbank, "gb", out, -1, -1, true, 0.5, false, 1)
+	err := Variants(context.Background(), sam, ref, true, genbank, "gb", out, variants.Options{Aggregate: true, Threshold: 0.5, Threads: 1})
 	if err != nil {
 		t.Error(err)
 	}
@@ -0,0 +1,172 @@
+/*
+Package sample implements routines to randomly subsample the records in a
+fasta file, either overall or within groups defined by metadata, in one pass
+over the input.
+*/
+package sample
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/metadata"
+	"github.com/virus-evolution/gofasta/pkg/summary"
+)
+
+// Options controls how records are sampled. Exactly one of Count or Fraction should be set.
+// If GroupBy or EpiWeekColumn are set, Count is the maximum number of records to keep from each group
+type Options struct {
+	Count         int
+	Fraction      float64
+	Table         *metadata.Table
+	GroupBy       []string // metadata columns whose values define the groups
+	EpiWeekColumn string   // metadata column of dates (YYYY-MM-DD) whose epi-week is added to the group
+	Seed          int64
+}
+
+// EpiWeek returns the (MMWR) epidemiological week of a date in the form YYYY-Www. Epi-weeks start on a
+// Sunday, and week 1 of a year is the first week with at least four days in that year
+func EpiWeek(date string) (string, error) {
+	t, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return "", errors.New("couldn't parse date: " + date)
+	}
+	// the week belongs to whichever year its Wednesday is in
+	wednesday := t.AddDate(0, 0, 3-int(t.Weekday()))
+	week := (wednesday.YearDay()-1)/7 + 1
+	return fmt.Sprintf("%d-W%02d", wednesday.Year(), week), nil
+}
+
+// groupKey returns the name of the group that the record called name belongs to. ok is false
+// if the record should be skipped
+func (o Options) groupKey(name string) (string, bool, error) {
+	if len(o.GroupBy) == 0 && o.EpiWeekColumn == "" {
+		return "", true, nil
+	}
+	if !o.Table.Has(name) {
+		return "", false, nil
+	}
+	fields := make([]string, 0, len(o.GroupBy)+1)
+	for _, c := range o.GroupBy {
+		v, _ := o.Table.Get(name, c)
+		fields = append(fields, v)
+	}
+	if o.EpiWeekColumn != "" {
+		date, _ := o.Table.Get(name, o.EpiWeekColumn)
+		week, err := EpiWeek(date)
+		if err != nil {
+			return "", false, errors.New(name + ": " + err.Error())
+		}
+		fields = append(fields, week)
+	}
+	return strings.Join(fields, "\x00"), true, nil
+}
+
+func (o Options) check() error {
+	if (o.Count > 0) == (o.Fraction > 0) {
+		return errors.New("choose exactly one of a count or a fraction to sample")
+	}
+	if o.Fraction > 1 {
+		return errors.New("fraction must be between 0 and 1")
+	}
+	grouped := len(o.GroupBy) > 0 || o.EpiWeekColumn != ""
+	if grouped {
+		if o.Count == 0 {
+			return errors.New("sampling by group needs a count per group, not a fraction")
+		}
+		if o.Table == nil {
+			return errors.New("sampling by group needs metadata")
+		}
+		for _, c := range append(append([]string{}, o.GroupBy...), o.EpiWeekColumn) {
+			if c != "" && !o.Table.HasColumn(c) {
+				return errors.New("no column called " + c + " in metadata")
+			}
+		}
+	}
+	return nil
+}
+
+// reservoir holds a uniform random sample of at most k of the records offered to it
+type reservoir struct {
+	records []fastaio.FastaRecord
+	seen    int
+}
+
+func (r *reservoir) offer(FR fastaio.FastaRecord, k int, rng *rand.Rand) {
+	r.seen++
+	if len(r.records) < k {
+		r.records = append(r.records, FR)
+		return
+	}
+	if j := rng.Intn(r.seen); j < k {
+		r.records[j] = FR
+	}
+}
+
+// Sample writes a random sample of the records in in to out, in their input order. With Fraction, each
+// record is kept independently with that probability and nothing is held in memory. With Count, reservoir
+// sampling keeps exactly that many records (or all of them, if there are fewer), overall or per group
+func Sample(ctx context.Context, in io.Reader, out io.Writer, o Options) error {
+
+	if err := o.check(); err != nil {
+		return err
+	}
+
+	rng := rand.New(rand.NewSource(o.Seed))
+
+	groups := make(map[string]*reservoir)
+
+	err := fastaio.EachRecord(ctx, in, func(FR fastaio.FastaRecord) error {
+		if o.Fraction > 0 {
+			if rng.Float64() < o.Fraction {
+				if _, err := out.Write([]byte(">" + FR.Description + "\n" + FR.Seq + "\n")); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		key, ok, err := o.groupKey(FR.ID)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			os.Stderr.WriteString("no metadata for " + FR.ID + ", skipping it\n")
+			summary.Skipped(FR.ID, "no metadata")
+			return nil
+		}
+		if _, ok := groups[key]; !ok {
+			groups[key] = &reservoir{}
+		}
+		groups[key].offer(FR, o.Count, rng)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if o.Fraction > 0 {
+		return nil
+	}
+
+	kept := make([]fastaio.FastaRecord, 0)
+	for _, r := range groups {
+		kept = append(kept, r.records...)
+	}
+	sort.Slice(kept, func(i, j int) bool { return kept[i].Idx < kept[j].Idx })
+
+	for _, FR := range kept {
+		if _, err := out.Write([]byte(">" + FR.Description + "\n" + FR.Seq + "\n")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
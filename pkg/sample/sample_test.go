@@ -0,0 +1,116 @@
+package sample
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/metadata"
+)
+
+func makeFasta(n int) string {
+	var sb strings.Builder
+	for i := 0; i < n; i++ {
+		sb.WriteString(">seq" + strconv.Itoa(i) + "\nATG\n")
+	}
+	return sb.String()
+}
+
+func names(fasta string) []string {
+	ns := make([]string, 0)
+	for _, line := range strings.Split(fasta, "\n") {
+		if strings.HasPrefix(line, ">") {
+			ns = append(ns, line[1:])
+		}
+	}
+	return ns
+}
+
+func TestSampleCount(t *testing.T) {
+	var out bytes.Buffer
+	err := Sample(context.Background(), strings.NewReader(makeFasta(100)), &out, Options{Count: 10, Seed: 42})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := names(out.String())
+	if len(got) != 10 {
+		t.Fatalf("problem in TestSampleCount(): %v", got)
+	}
+	// output is in input order
+	prev := -1
+	for _, n := range got {
+		i, _ := strconv.Atoi(strings.TrimPrefix(n, "seq"))
+		if i <= prev {
+			t.Errorf("problem in TestSampleCount(): out of order %v", got)
+		}
+		prev = i
+	}
+
+	// the same seed gives the same sample
+	var again bytes.Buffer
+	Sample(context.Background(), strings.NewReader(makeFasta(100)), &again, Options{Count: 10, Seed: 42})
+	if again.String() != out.String() {
+		t.Errorf("problem in TestSampleCount(): the sample isn't deterministic")
+	}
+
+	out.Reset()
+	Sample(context.Background(), strings.NewReader(makeFasta(5)), &out, Options{Count: 10, Seed: 42})
+	if len(names(out.String())) != 5 {
+		t.Errorf("problem in TestSampleCount() with fewer records than the count")
+	}
+}
+
+func TestSampleFraction(t *testing.T) {
+	var out bytes.Buffer
+	err := Sample(context.Background(), strings.NewReader(makeFasta(1000)), &out, Options{Fraction: 0.1, Seed: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := len(names(out.String()))
+	if n < 50 || n > 150 {
+		t.Errorf("problem in TestSampleFraction(): kept %d", n)
+	}
+}
+
+func TestSampleGrouped(t *testing.T) {
+	meta := "name,lineage,date\nseq0,A,2021-01-04\nseq1,A,2021-01-05\nseq2,A,2021-01-12\nseq3,B,2021-01-04\nseq4,B,2021-01-04\nseq5,B,2021-01-04\n"
+	table, err := metadata.Read(strings.NewReader(meta), ',', "name")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	err = Sample(context.Background(), strings.NewReader(makeFasta(7)), &out, Options{Count: 1, Table: &table, GroupBy: []string{"lineage"}, EpiWeekColumn: "date", Seed: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := names(out.String())
+	// groups are A/2021-W01, A/2021-W02 and B/2021-W01. seq6 has no metadata
+	if len(got) != 3 || got[1] != "seq2" {
+		t.Errorf("problem in TestSampleGrouped(): %v", got)
+	}
+
+	err = Sample(context.Background(), strings.NewReader(makeFasta(7)), &out, Options{Fraction: 0.5, Table: &table, GroupBy: []string{"lineage"}})
+	if err == nil {
+		t.Errorf("expected an error for a grouped fraction")
+	}
+}
+
+func TestEpiWeek(t *testing.T) {
+	tests := map[string]string{
+		"2021-01-01": "2020-W53",
+		"2021-01-03": "2021-W01",
+		"2021-01-09": "2021-W01",
+		"2020-01-01": "2020-W01",
+		"2022-12-31": "2022-W52",
+		"2023-01-01": "2023-W01",
+	}
+	for date, want := range tests {
+		got, err := EpiWeek(date)
+		if err != nil || got != want {
+			t.Errorf("problem in TestEpiWeek(): %s: got %s, want %s", date, got, want)
+		}
+	}
+}
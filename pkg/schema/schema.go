@@ -0,0 +1,313 @@
+/*
+Package schema declares the columns of gofasta's CSV outputs, so that programs
+that read them can check what they have been given against a declared layout,
+rather than guessing what each column means. The commands that write these
+files take their headers from here, so the declarations can't drift from the
+output.
+
+The declarations are versioned. Within a Version, columns may be added to the
+end of a format, but none is renamed, removed, reordered or changes type.
+*/
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Version is the version of the declarations
+const Version = 1
+
+// The types a column can have, named as they are in JSON Schema
+const (
+	String  = "string"
+	Integer = "integer"
+	Number  = "number"
+	Boolean = "boolean"
+)
+
+// Column is one column of a format. Type is one of String, Integer, Number or Boolean. A column that is Nullable
+// can be empty, which means that there is no value
+type Column struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+	Nullable    bool   `json:"nullable,omitempty"`
+}
+
+// Format is the layout of one CSV output: its Columns, in order, under a header line of their names. If Rest isn't
+// nil, the fixed columns are followed by any number of columns like it (one per record, say), and the header line
+// is as Rest.Name describes. If Header is false there is no header line at all
+type Format struct {
+	Name        string   `json:"name"`
+	Command     string   `json:"command"`
+	Description string   `json:"description"`
+	Header      bool     `json:"header"`
+	Columns     []Column `json:"columns"`
+	Rest        *Column  `json:"rest,omitempty"`
+}
+
+// HeaderLine returns the header line of a format that has a fixed set of columns, with its newline
+func (f Format) HeaderLine() string {
+	names := make([]string, len(f.Columns))
+	for i, c := range f.Columns {
+		names[i] = c.Name
+	}
+	return strings.Join(names, ",") + "\n"
+}
+
+// distanceDescription is how every distance column is formatted
+const distanceDescription = "by the --measure that was used: a whole number for snp, and nine decimal places otherwise"
+
+var (
+	// Closest is gofasta closest's output
+	Closest = Format{
+		Name:        "closest",
+		Command:     "gofasta closest",
+		Description: "the single closest target to each query",
+		Header:      true,
+		Columns: []Column{
+			{Name: "query", Type: String, Description: "the name of the query"},
+			{Name: "closest", Type: String, Description: "the name of the closest target"},
+			{Name: "distance", Type: Number, Description: "the distance between them, " + distanceDescription + ". Empty if it is undefined (the query has no unambiguous sites in common with any target, say)", Nullable: true},
+			{Name: "SNPs", Type: String, Description: "the sites at which they certainly differ, separated by ;, each as the 1-based position, the query's base and the target's base (e.g. 241CT)"},
+		},
+	}
+
+	// ClosestN is gofasta closest's output with --number or --max-dist
+	ClosestN = Format{
+		Name:        "closest-n",
+		Command:     "gofasta closest --number/--max-dist",
+		Description: "the closest targets to each query",
+		Header:      true,
+		Columns: []Column{
+			{Name: "query", Type: String, Description: "the name of the query"},
+			{Name: "closest", Type: String, Description: "the names of the closest targets, nearest first, separated by ;"},
+		},
+	}
+
+	// ClosestTable is gofasta closest's output with --table
+	ClosestTable = Format{
+		Name:        "closest-table",
+		Command:     "gofasta closest --number/--max-dist --table",
+		Description: "one line for each of the closest targets to each query",
+		Header:      true,
+		Columns: []Column{
+			{Name: "query", Type: String, Description: "the name of the query"},
+			{Name: "target", Type: String, Description: "the name of one of its closest targets"},
+			{Name: "distance", Type: Number, Description: "the distance between them, " + distanceDescription, Nullable: true},
+		},
+	}
+
+	// Assign is gofasta assign's output
+	Assign = Format{
+		Name:        "assign",
+		Command:     "gofasta assign",
+		Description: "the label of the nearest representative to each query",
+		Header:      true,
+		Columns: []Column{
+			{Name: "query", Type: String, Description: "the name of the query"},
+			{Name: "label", Type: String, Description: "the label it is assigned. Empty if its margin is less than --min-margin", Nullable: true},
+			{Name: "representative", Type: String, Description: "the name of the nearest representative"},
+			{Name: "distance", Type: Number, Description: "the distance to it, " + distanceDescription, Nullable: true},
+			{Name: "second_label", Type: String, Description: "the label of the nearest representative with another label. Empty if there is none", Nullable: true},
+			{Name: "second_distance", Type: Number, Description: "the distance to that representative", Nullable: true},
+			{Name: "margin", Type: Number, Description: "second_distance minus distance", Nullable: true},
+		},
+	}
+
+	// SNPs is gofasta snps's output
+	SNPs = Format{
+		Name:        "snps",
+		Command:     "gofasta snps",
+		Description: "the differences between each record and the reference",
+		Header:      true,
+		Columns: []Column{
+			{Name: "query", Type: String, Description: "the name of the record"},
+			{Name: "SNPs", Type: String, Description: "its differences from the reference in order of position, separated by |, each as the reference's base, the 1-based position and the record's base (e.g. C241T)"},
+		},
+	}
+
+	// SNPsAggregate is gofasta snps's output with --aggregate
+	SNPsAggregate = Format{
+		Name:        "snps-aggregate",
+		Command:     "gofasta snps --aggregate",
+		Description: "the proportion of the records that have each difference from the reference",
+		Header:      true,
+		Columns: []Column{
+			{Name: "SNP", Type: String, Description: "the difference, as the reference's base, the 1-based position and the alternative base (e.g. C241T)"},
+			{Name: "frequency", Type: Number, Description: "the proportion of the records that have it, to nine decimal places"},
+		},
+	}
+
+	// MatrixSquare is gofasta matrix's output with --format square
+	MatrixSquare = Format{
+		Name:        "matrix-square",
+		Command:     "gofasta matrix --format square",
+		Description: "the distance between every pair of records, as a symmetric matrix. The header line is an empty field followed by the names of the records",
+		Header:      true,
+		Columns: []Column{
+			{Name: "", Type: String, Description: "the name of the row's record"},
+		},
+		Rest: &Column{Name: "the name of a record", Type: Number, Description: "the distance from the row's record to the column's"},
+	}
+
+	// MatrixLower is gofasta matrix's output with --format lower
+	MatrixLower = Format{
+		Name:        "matrix-lower",
+		Command:     "gofasta matrix --format lower",
+		Description: "the lower triangle of the matrix, without the diagonal: the ith line has the ith record's distances to the records before it",
+		Header:      false,
+		Columns: []Column{
+			{Name: "", Type: String, Description: "the name of the row's record"},
+		},
+		Rest: &Column{Name: "", Type: Number, Description: "the distance from the row's record to each record before it, in order"},
+	}
+
+	// MatrixLong is gofasta matrix's output with --format long
+	MatrixLong = Format{
+		Name:        "matrix-long",
+		Command:     "gofasta matrix --format long",
+		Description: "one line for each pair of different records",
+		Header:      true,
+		Columns: []Column{
+			{Name: "sequence1", Type: String, Description: "the name of one record"},
+			{Name: "sequence2", Type: String, Description: "the name of a record that comes after it"},
+			{Name: "distance", Type: Number, Description: "the distance between them"},
+		},
+	}
+
+	// Stats is gofasta stats's output with --format csv
+	Stats = Format{
+		Name:        "stats",
+		Command:     "gofasta stats",
+		Description: "the length and base composition of each record",
+		Header:      true,
+		Columns: []Column{
+			{Name: "name", Type: String, Description: "the name of the record"},
+			{Name: "length", Type: Integer, Description: "its length, including gaps"},
+			{Name: "A", Type: Integer, Description: "the number of As"},
+			{Name: "C", Type: Integer, Description: "the number of Cs"},
+			{Name: "G", Type: Integer, Description: "the number of Gs"},
+			{Name: "T", Type: Integer, Description: "the number of Ts"},
+			{Name: "N", Type: Integer, Description: "the number of Ns"},
+			{Name: "gaps", Type: Integer, Description: "the number of gaps"},
+			{Name: "ambiguous", Type: Integer, Description: "the number of IUPAC ambiguity codes other than N"},
+			{Name: "gc_percent", Type: Number, Description: "the percentage of the unambiguous bases that are G or C, to two decimal places"},
+			{Name: "longest_n_run", Type: Integer, Description: "the length of the longest run of Ns"},
+		},
+	}
+
+	// StatsAlignment is gofasta stats's --alignment-summary
+	StatsAlignment = Format{
+		Name:        "stats-alignment",
+		Command:     "gofasta stats --alignment-summary",
+		Description: "the statistics of the file as a whole, one to a line: records, aligned, min_length and max_length, and if the records are aligned, width and variable_columns",
+		Header:      true,
+		Columns: []Column{
+			{Name: "stat", Type: String, Description: "the name of the statistic"},
+			{Name: "value", Type: String, Description: "its value: true or false for aligned, and a whole number otherwise"},
+		},
+	}
+)
+
+// formats is every format, in the order Formats returns them
+var formats = []Format{Closest, ClosestN, ClosestTable, Assign, SNPs, SNPsAggregate, MatrixSquare, MatrixLower, MatrixLong, Stats, StatsAlignment}
+
+// Formats returns every format
+func Formats() []Format {
+	return append([]Format(nil), formats...)
+}
+
+// Lookup returns the format called name
+func Lookup(name string) (Format, error) {
+	for _, f := range formats {
+		if f.Name == name {
+			return f, nil
+		}
+	}
+	names := make([]string, len(formats))
+	for i, f := range formats {
+		names[i] = f.Name
+	}
+	return Format{}, errors.New("unknown output format: " + name + " (choose one of " + strings.Join(names, ", ") + ")")
+}
+
+// JSONSchema returns a JSON Schema for one line of the format, as an object keyed by column name with each field
+// as its column's type, which is what a line parses to once the header has been matched against the columns.
+// Formats with a variable number of columns are described by their fixed columns, and allow others
+func (f Format) JSONSchema() ([]byte, error) {
+	properties := make(map[string]any, len(f.Columns))
+	required := make([]string, 0, len(f.Columns))
+	for _, c := range f.Columns {
+		name := c.Name
+		if name == "" {
+			name = "name"
+		}
+		var t any = c.Type
+		if c.Nullable {
+			t = []string{c.Type, "null"}
+		}
+		properties[name] = map[string]any{"type": t, "description": c.Description}
+		required = append(required, name)
+	}
+	s := map[string]any{
+		"$schema":              "https://json-schema.org/draft/2020-12/schema",
+		"title":                "gofasta " + f.Name + " (schema version " + strconv.Itoa(Version) + ")",
+		"description":          f.Description,
+		"type":                 "object",
+		"properties":           properties,
+		"required":             required,
+		"additionalProperties": f.Rest != nil,
+	}
+	return json.MarshalIndent(s, "", "  ")
+}
+
+// WriteList writes the name, command and description of every format as CSV
+func WriteList(w io.Writer) error {
+	rows := "format,command,description\n"
+	for _, f := range formats {
+		rows += f.Name + "," + f.Command + "," + quote(f.Description) + "\n"
+	}
+	_, err := w.Write([]byte(rows))
+	return err
+}
+
+// WriteColumns writes the columns of the format as CSV, with the columns column,type,nullable,description. The
+// repeated column of a format with a variable number of them is last, and named ...
+func (f Format) WriteColumns(w io.Writer) error {
+	rows := "column,type,nullable,description\n"
+	columns := f.Columns
+	if f.Rest != nil {
+		rest := *f.Rest
+		rest.Name = "..."
+		rest.Description = rest.Description + " (any number of columns)"
+		columns = append(append([]Column(nil), columns...), rest)
+	}
+	for _, c := range columns {
+		rows += quote(c.Name) + "," + c.Type + "," + strconv.FormatBool(c.Nullable) + "," + quote(c.Description) + "\n"
+	}
+	_, err := w.Write([]byte(rows))
+	return err
+}
+
+// WriteJSON writes every format, and the version of the declarations, as JSON
+func WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(struct {
+		Version int      `json:"version"`
+		Formats []Format `json:"formats"`
+	}{Version, formats})
+}
+
+// quote quotes a CSV field if it needs to be
+func quote(s string) string {
+	if !strings.ContainsAny(s, ",\"\n") {
+		return s
+	}
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
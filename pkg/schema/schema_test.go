@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestFormats(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, f := range Formats() {
+		if seen[f.Name] {
+			t.Errorf("problem in TestFormats(): two formats are called %s", f.Name)
+		}
+		seen[f.Name] = true
+
+		if g, err := Lookup(f.Name); err != nil || g.Name != f.Name {
+			t.Errorf("problem in TestFormats(): couldn't look up %s: %v", f.Name, err)
+		}
+		for _, c := range f.Columns {
+			switch c.Type {
+			case String, Integer, Number, Boolean:
+			default:
+				t.Errorf("problem in TestFormats(): %s's column %s has type %s", f.Name, c.Name, c.Type)
+			}
+		}
+
+		b, err := f.JSONSchema()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var s struct {
+			Properties map[string]any `json:"properties"`
+			Required   []string       `json:"required"`
+		}
+		if err := json.Unmarshal(b, &s); err != nil {
+			t.Errorf("problem in TestFormats(): %s's JSON Schema doesn't parse: %v", f.Name, err)
+		}
+		if len(s.Properties) != len(f.Columns) || len(s.Required) != len(f.Columns) {
+			t.Errorf("problem in TestFormats(): %s's JSON Schema has %d properties", f.Name, len(s.Properties))
+		}
+	}
+
+	if _, err := Lookup("closest.csv"); err == nil {
+		t.Errorf("problem in TestFormats(): expected an error for an unknown format")
+	}
+
+	if Closest.HeaderLine() != "query,closest,distance,SNPs\n" {
+		t.Errorf("problem in TestFormats(): %q", Closest.HeaderLine())
+	}
+
+	var out bytes.Buffer
+	if err := MatrixSquare.WriteColumns(&out); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(out.String(), "...,number,false,the distance from the row's record to the column's (any number of columns)\n") {
+		t.Errorf("problem in TestFormats(): %s", out.String())
+	}
+}
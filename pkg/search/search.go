@@ -0,0 +1,379 @@
+/*
+Package search implements a search for query fragments in a database of
+unaligned sequences, to find which database sequences contain (or nearly
+contain) each query, on either strand.
+
+The queries are indexed by their minimizers: the k-mer with the smallest hash
+in each window of w consecutive k-mers. Every database sequence is streamed
+past the index, and where enough of a query's minimizers are found on about the
+same diagonal, the query is aligned to that part of the database sequence to
+measure its identity and coverage.
+*/
+package search
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/virus-evolution/gofasta/internal/pipeline"
+	"github.com/virus-evolution/gofasta/pkg/align"
+	"github.com/virus-evolution/gofasta/pkg/alphabet"
+	"github.com/virus-evolution/gofasta/pkg/dedup"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/summary"
+)
+
+// Options controls the search. A hit is reported if the query is aligned with at least MinIdentity percent
+// identity over at least MinCoverage of its length. MinHits is the number of shared minimizers on about
+// the same diagonal that a query and a database sequence need before they are aligned
+type Options struct {
+	K           int
+	W           int
+	MinHits     int
+	MinIdentity float64
+	MinCoverage float64
+	Threads     int
+	Scoring     align.Scoring
+}
+
+// DefaultOptions returns options suited to finding fragments of a few hundred bases or more that are
+// near-identical to part of a database sequence
+func DefaultOptions() Options {
+	return Options{K: 15, W: 10, MinHits: 3, MinIdentity: 95, MinCoverage: 0.9, Scoring: align.DefaultScoring()}
+}
+
+// Hit is a query found in a database sequence. Coordinates are 1-based and inclusive, and the query's are
+// always on its own forward strand. Strand is -1 if the query's reverse complement was found
+type Hit struct {
+	Query       string
+	Target      string
+	Strand      int
+	TargetStart int
+	TargetEnd   int
+	QueryStart  int
+	QueryEnd    int
+	Identity    float64
+	Coverage    float64
+	Cigar       string
+}
+
+// minimizer is a k-mer's hash and its (0-based) position
+type minimizer struct {
+	hash uint64
+	pos  int
+}
+
+var twoBit = func() [256]int8 {
+	var a [256]int8
+	for i := range a {
+		a[i] = -1
+	}
+	a['A'], a['C'], a['G'], a['T'] = 0, 1, 2, 3
+	a['a'], a['c'], a['g'], a['t'] = 0, 1, 2, 3
+	return a
+}()
+
+// mix is an invertible hash of a packed k-mer, so that minimizers aren't biased towards poly-A
+func mix(x uint64) uint64 {
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return x
+}
+
+// minimizers returns the (w, k)-minimizers of seq. K-mers containing anything other than A, C, G or T are
+// skipped, and windows don't span them
+func minimizers(seq string, k, w int) []minimizer {
+	mask := uint64(1)<<(2*uint(k)) - 1
+	out := make([]minimizer, 0, 2*len(seq)/(w+1)+1)
+
+	run := make([]minimizer, 0, len(seq))
+	flush := func() {
+		last := -1
+		for start := 0; start+w <= len(run) || (start == 0 && len(run) > 0); start++ {
+			end := start + w
+			if end > len(run) {
+				end = len(run)
+			}
+			best := start
+			for i := start + 1; i < end; i++ {
+				if run[i].hash < run[best].hash {
+					best = i
+				}
+			}
+			if best != last {
+				out = append(out, run[best])
+				last = best
+			}
+			if end == len(run) {
+				break
+			}
+		}
+		run = run[:0]
+	}
+
+	var kmer uint64
+	n := 0
+	for i := 0; i < len(seq); i++ {
+		b := twoBit[seq[i]]
+		if b < 0 {
+			flush()
+			n = 0
+			continue
+		}
+		kmer = (kmer<<2 | uint64(b)) & mask
+		n++
+		if n >= k {
+			run = append(run, minimizer{hash: mix(kmer), pos: i - k + 1})
+		}
+	}
+	flush()
+
+	return out
+}
+
+// entry is where a minimizer is in one strand of one query
+type entry struct {
+	query  int
+	strand int
+	pos    int
+}
+
+// Index holds the minimizers of both strands of every query
+type Index struct {
+	k, w    int
+	names   []string
+	seqs    [2][]string // forward and reverse-complement sequences of each query
+	entries map[uint64][]entry
+}
+
+// NewIndex indexes the queries. They are uppercased and any gaps removed
+func NewIndex(queries []fastaio.FastaRecord, k, w int) (*Index, error) {
+	if k < 1 || k > 32 || w < 1 {
+		return nil, errors.New("k must be between 1 and 32, and w at least 1")
+	}
+	idx := &Index{k: k, w: w, entries: make(map[uint64][]entry)}
+	for q, FR := range queries {
+		seq := strings.ReplaceAll(strings.ToUpper(FR.Seq), "-", "")
+		if len(seq) < k {
+			return nil, errors.New(FR.ID + " is shorter than the k-mer length")
+		}
+		idx.names = append(idx.names, FR.ID)
+		idx.seqs[0] = append(idx.seqs[0], seq)
+		idx.seqs[1] = append(idx.seqs[1], alphabet.ReverseComplement(seq))
+		for s := 0; s < 2; s++ {
+			for _, m := range minimizers(idx.seqs[s][q], k, w) {
+				idx.entries[m.hash] = append(idx.entries[m.hash], entry{query: q, strand: s, pos: m.pos})
+			}
+		}
+	}
+	return idx, nil
+}
+
+// band returns the diagonal with the most diagonals within width of it, and how many there are
+func band(diags []int, width int) (int, int) {
+	sort.Ints(diags)
+	best, bestCount := 0, 0
+	j := 0
+	for i := range diags {
+		for diags[i]-diags[j] > width {
+			j++
+		}
+		if i-j+1 > bestCount {
+			bestCount = i - j + 1
+			best = diags[(i+j)/2]
+		}
+	}
+	return best, bestCount
+}
+
+// Search finds the queries in one database sequence, returning the best hit of each, in query order
+func (idx *Index) Search(target fastaio.FastaRecord, o Options) []Hit {
+
+	seq := strings.ReplaceAll(strings.ToUpper(target.Seq), "-", "")
+
+	// the diagonals (target position - query position) of the shared minimizers of each query strand
+	diags := make(map[[2]int][]int)
+	for _, m := range minimizers(seq, idx.k, idx.w) {
+		for _, e := range idx.entries[m.hash] {
+			key := [2]int{e.query, e.strand}
+			diags[key] = append(diags[key], m.pos-e.pos)
+		}
+	}
+
+	pad := o.Scoring.Band
+	best := make(map[int]Hit)
+	for key, ds := range diags {
+		q, s := key[0], key[1]
+		diag, count := band(ds, pad)
+		if count < o.MinHits {
+			continue
+		}
+
+		query := idx.seqs[s][q]
+		start, end := diag-pad, diag+len(query)+pad
+		if start < 0 {
+			start = 0
+		}
+		if end > len(seq) {
+			end = len(seq)
+		}
+		if end <= start {
+			continue
+		}
+
+		pw, err := o.Scoring.Pairwise(seq[start:end], query)
+		if err != nil {
+			continue
+		}
+
+		h := Hit{Query: idx.names[q], Target: target.ID, Strand: 1, TargetStart: start + pw.AStart, TargetEnd: start + pw.AEnd,
+			QueryStart: pw.BStart, QueryEnd: pw.BEnd, Identity: pw.Identity, Cigar: pw.Cigar}
+		h.Coverage = float64(pw.BEnd-pw.BStart+1) / float64(len(query))
+		if s == 1 {
+			h.Strand = -1
+			h.QueryStart, h.QueryEnd = len(query)-pw.BEnd+1, len(query)-pw.BStart+1
+		}
+		if h.Identity < o.MinIdentity || h.Coverage < o.MinCoverage {
+			continue
+		}
+		if prev, ok := best[q]; !ok || h.Identity*h.Coverage > prev.Identity*prev.Coverage {
+			best[q] = h
+		}
+	}
+
+	qs := make([]int, 0, len(best))
+	for q := range best {
+		qs = append(qs, q)
+	}
+	sort.Ints(qs)
+	hits := make([]Hit, len(qs))
+	for i, q := range qs {
+		hits[i] = best[q]
+	}
+
+	return hits
+}
+
+// writeHits writes one database sequence's hits to out as CSV
+func writeHits(out io.Writer, hits []Hit) error {
+	for _, h := range hits {
+		strand := "+"
+		if h.Strand == -1 {
+			strand = "-"
+		}
+		line := h.Query + "," + h.Target + "," + strand + "," + strconv.Itoa(h.TargetStart) + "," + strconv.Itoa(h.TargetEnd) + "," +
+			strconv.Itoa(h.QueryStart) + "," + strconv.Itoa(h.QueryEnd) + "," +
+			strconv.FormatFloat(h.Identity, 'f', 2, 64) + "," + strconv.FormatFloat(h.Coverage, 'f', 4, 64) + "," + h.Cigar + "\n"
+		if _, err := out.Write([]byte(line)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Search searches every record in the fasta file db for the queries, using o.Threads goroutines (all CPUs
+// if it is 0), and writes the hits to out as CSV with the columns
+// query,target,strand,target_start,target_end,query_start,query_end,identity,coverage,cigar. It stops and returns
+// ctx's error if ctx is cancelled
+func Search(ctx context.Context, queries []fastaio.FastaRecord, db io.Reader, out io.Writer, o Options) error {
+	return search(ctx, queries, db, out, o, nil)
+}
+
+// Prescreen is Search for databases in which many sequences are repeated, as in a large collection of genomes
+// from one outbreak. The database is read twice, so it is given as a function that opens it. The first pass finds
+// the (uppercased and degapped) sequences that are possibly repeated, as dedup.Candidates does, and in the second
+// each of those is only searched for the queries once, and its hits reused for the records that repeat it. The
+// output is the same as Search's
+func Prescreen(ctx context.Context, queries []fastaio.FastaRecord, open func() (io.ReadCloser, error), out io.Writer, o Options) error {
+
+	candidates, err := dedup.Candidates(ctx, open, func(FR fastaio.FastaRecord) ([32]byte, error) {
+		return digest(FR.Seq), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	db, err := open()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return search(ctx, queries, db, out, o, candidates)
+}
+
+// digest is the hash of a database sequence, as it is searched
+func digest(seq string) [32]byte {
+	return sha256.Sum256([]byte(strings.ReplaceAll(strings.ToUpper(seq), "-", "")))
+}
+
+// search is Search, but if candidates isn't nil, the hits of the sequences whose digests are in it are kept, and
+// reused for any later record with the same sequence
+func search(ctx context.Context, queries []fastaio.FastaRecord, db io.Reader, out io.Writer, o Options, candidates map[[32]byte]bool) error {
+
+	idx, err := NewIndex(queries, o.K, o.W)
+	if err != nil {
+		return err
+	}
+	for q, seq := range idx.seqs[0] {
+		if len(seq) < o.K+o.W-1 {
+			summary.Warn(idx.names[q] + " is shorter than one minimizer window, so may not be found")
+		}
+	}
+
+	threads := o.Threads
+	if threads < 1 {
+		threads = runtime.NumCPU()
+	}
+
+	if _, err := out.Write([]byte("query,target,strand,target_start,target_end,query_start,query_end,identity,coverage,cigar\n")); err != nil {
+		return err
+	}
+
+	source := func(ctx context.Context, emit func(fastaio.FastaRecord) error) error {
+		return fastaio.EachRecord(ctx, db, emit)
+	}
+
+	// two workers can both search a repeated sequence before either has kept its hits, which only costs time
+	var mu sync.Mutex
+	kept := make(map[[32]byte][]Hit)
+
+	work := func(FR fastaio.FastaRecord) ([]Hit, error) {
+		if candidates == nil {
+			return idx.Search(FR, o), nil
+		}
+		h := digest(FR.Seq)
+		if !candidates[h] {
+			return idx.Search(FR, o), nil
+		}
+		mu.Lock()
+		hits, ok := kept[h]
+		mu.Unlock()
+		if !ok {
+			hits = idx.Search(FR, o)
+			mu.Lock()
+			kept[h] = hits
+			mu.Unlock()
+		}
+		reused := make([]Hit, len(hits))
+		for i, hit := range hits {
+			hit.Target = FR.ID
+			reused[i] = hit
+		}
+		return reused, nil
+	}
+
+	return pipeline.Run(ctx, threads, 0, source, work, func(hits []Hit) error {
+		return writeHits(out, hits)
+	})
+}
@@ -0,0 +1,141 @@
+package search
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/alphabet"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+func randomSeq(r *rand.Rand, n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = "ACGT"[r.Intn(4)]
+	}
+	return string(b)
+}
+
+func TestMinimizers(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	seq := randomSeq(r, 500)
+	ms := minimizers(seq, 15, 10)
+	if len(ms) == 0 {
+		t.Fatal("problem in TestMinimizers(): no minimizers")
+	}
+	for i := 1; i < len(ms); i++ {
+		if ms[i].pos <= ms[i-1].pos || ms[i].pos-ms[i-1].pos > 10 {
+			t.Errorf("problem in TestMinimizers(): minimizers at %d then %d", ms[i-1].pos, ms[i].pos)
+		}
+	}
+
+	// an ambiguous base splits the sequence, and no k-mer spans it
+	ms = minimizers(seq[:100]+"N"+seq[101:], 15, 10)
+	for _, m := range ms {
+		if m.pos <= 100 && m.pos+15 > 100 {
+			t.Errorf("problem in TestMinimizers(): a minimizer at %d spans an N", m.pos)
+		}
+	}
+
+	if len(minimizers("ACGT", 15, 10)) != 0 {
+		t.Errorf("problem in TestMinimizers(): found minimizers in a sequence shorter than k")
+	}
+}
+
+func TestSearch(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	target := randomSeq(r, 5000)
+	other := randomSeq(r, 5000)
+
+	// a fragment of target with a SNP, and the reverse complement of another
+	fragment := []byte(target[1000:1400])
+	fragment[200] = "ACGT"[(strings.IndexByte("ACGT", fragment[200])+1)%4]
+	queries := []fastaio.FastaRecord{
+		{ID: "fwd", Seq: string(fragment)},
+		{ID: "rev", Seq: alphabet.ReverseComplement(target[3000:3300])},
+		{ID: "absent", Seq: randomSeq(r, 300)},
+	}
+
+	idx, err := NewIndex(queries, 15, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	o := DefaultOptions()
+
+	hits := idx.Search(fastaio.FastaRecord{ID: "target", Seq: target}, o)
+	if len(hits) != 2 {
+		t.Fatalf("problem in TestSearch(): expected 2 hits, got %+v", hits)
+	}
+	fwd, rev := hits[0], hits[1]
+	if fwd.Query != "fwd" || fwd.Strand != 1 || fwd.TargetStart != 1001 || fwd.TargetEnd != 1400 ||
+		fwd.QueryStart != 1 || fwd.QueryEnd != 400 || fwd.Identity != 99.75 || fwd.Coverage != 1 || fwd.Cigar != "200=1X199=" {
+		t.Errorf("problem in TestSearch(): got %+v", fwd)
+	}
+	if rev.Query != "rev" || rev.Strand != -1 || rev.TargetStart != 3001 || rev.TargetEnd != 3300 ||
+		rev.QueryStart != 1 || rev.QueryEnd != 300 || rev.Identity != 100 {
+		t.Errorf("problem in TestSearch(): got %+v", rev)
+	}
+
+	if hits := idx.Search(fastaio.FastaRecord{ID: "other", Seq: other}, o); len(hits) != 0 {
+		t.Errorf("problem in TestSearch(): expected no hits in an unrelated sequence, got %+v", hits)
+	}
+
+	// half of the query overhangs the end of the target
+	o.MinCoverage = 0.9
+	if hits := idx.Search(fastaio.FastaRecord{ID: "short", Seq: target[1200:2000]}, o); len(hits) != 0 {
+		t.Errorf("problem in TestSearch(): expected no hits with low coverage, got %+v", hits)
+	}
+	o.MinCoverage = 0.4
+	hits = idx.Search(fastaio.FastaRecord{ID: "short", Seq: target[1200:2000]}, o)
+	if len(hits) != 1 || hits[0].TargetStart != 1 || hits[0].QueryStart != 201 || hits[0].Coverage != 0.5 {
+		t.Errorf("problem in TestSearch(): got %+v", hits)
+	}
+
+	out := new(bytes.Buffer)
+	db := ">other\n" + other + "\n>target\n" + target + "\n"
+	if err := Search(context.Background(), queries, strings.NewReader(db), out, DefaultOptions()); err != nil {
+		t.Fatal(err)
+	}
+	want := "query,target,strand,target_start,target_end,query_start,query_end,identity,coverage,cigar\n" +
+		"fwd,target,+,1001,1400,1,400,99.75,1.0000,200=1X199=\n" +
+		"rev,target,-,3001,3300,1,300,100.00,1.0000,300=\n"
+	if out.String() != want {
+		t.Errorf("problem in TestSearch(): got\n%s", out.String())
+	}
+
+	if _, err := NewIndex([]fastaio.FastaRecord{{ID: "tiny", Seq: "ACGT"}}, 15, 10); err == nil {
+		t.Errorf("problem in TestSearch(): expected an error for a query shorter than k")
+	}
+}
+
+func TestPrescreen(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	target := randomSeq(r, 2000)
+	queries := []fastaio.FastaRecord{{ID: "q", Seq: target[500:900]}}
+
+	// target is repeated, once in lower case and with a gap, which are ignored
+	repeat := strings.ToLower(target[:100]) + "-" + target[100:]
+	db := ">t1\n" + target + "\n>other\n" + randomSeq(r, 2000) + "\n>t2\n" + repeat + "\n>t3\n" + target + "\n"
+	open := func() (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(db)), nil
+	}
+
+	want := new(bytes.Buffer)
+	if err := Search(context.Background(), queries, strings.NewReader(db), want, DefaultOptions()); err != nil {
+		t.Fatal(err)
+	}
+	out := new(bytes.Buffer)
+	if err := Prescreen(context.Background(), queries, open, out, DefaultOptions()); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != want.String() {
+		t.Errorf("problem in TestPrescreen(): got\n%s\nexpected\n%s", out.String(), want.String())
+	}
+	if strings.Count(out.String(), "\nq,t") != 3 {
+		t.Errorf("problem in TestPrescreen(): expected a hit in each of t1, t2 and t3, got\n%s", out.String())
+	}
+}
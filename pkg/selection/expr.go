@@ -0,0 +1,293 @@
+package selection
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Expr is a parsed filter expression. It is evaluated against one row of metadata, given as a function from
+// column name to value
+type Expr interface {
+	Eval(get func(column string) string) bool
+}
+
+type and struct{ l, r Expr }
+type or struct{ l, r Expr }
+type not struct{ e Expr }
+
+func (e and) Eval(get func(string) string) bool { return e.l.Eval(get) && e.r.Eval(get) }
+func (e or) Eval(get func(string) string) bool  { return e.l.Eval(get) || e.r.Eval(get) }
+func (e not) Eval(get func(string) string) bool { return !e.e.Eval(get) }
+
+// comparison compares a column to a literal value. Values are compared as numbers if both are numbers, and
+// otherwise as strings, so ISO 8601 dates (YYYY-MM-DD) compare correctly
+type comparison struct {
+	column string
+	op     string
+	value  string
+	re     *regexp.Regexp
+	values []string
+}
+
+func compare(a, b string) int {
+	x, errX := strconv.ParseFloat(a, 64)
+	y, errY := strconv.ParseFloat(b, 64)
+	if errX == nil && errY == nil {
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(a, b)
+}
+
+func (c comparison) Eval(get func(string) string) bool {
+	v := get(c.column)
+	switch c.op {
+	case "==":
+		return compare(v, c.value) == 0
+	case "!=":
+		return compare(v, c.value) != 0
+	case "<":
+		return compare(v, c.value) < 0
+	case "<=":
+		return compare(v, c.value) <= 0
+	case ">":
+		return compare(v, c.value) > 0
+	case ">=":
+		return compare(v, c.value) >= 0
+	case "~":
+		return c.re.MatchString(v)
+	case "!~":
+		return !c.re.MatchString(v)
+	case "in":
+		for _, x := range c.values {
+			if compare(v, x) == 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// token is a word (a column name or value), a quoted string, or an operator
+type token struct {
+	s      string
+	quoted bool
+}
+
+const special = "()<>=!~,&|\"'"
+
+func tokenise(s string) ([]token, error) {
+	tokens := make([]token, 0)
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+		case c == '"' || c == '\'':
+			j := strings.IndexByte(s[i+1:], c)
+			if j == -1 {
+				return nil, errors.New("unterminated string in expression: " + s[i:])
+			}
+			tokens = append(tokens, token{s: s[i+1 : i+1+j], quoted: true})
+			i += j + 2
+		case strings.HasPrefix(s[i:], "=="), strings.HasPrefix(s[i:], "!="), strings.HasPrefix(s[i:], "<="),
+			strings.HasPrefix(s[i:], ">="), strings.HasPrefix(s[i:], "!~"), strings.HasPrefix(s[i:], "&&"),
+			strings.HasPrefix(s[i:], "||"):
+			tokens = append(tokens, token{s: s[i : i+2]})
+			i += 2
+		case c == '&' || c == '|':
+			return nil, errors.New("unexpected " + string(c) + " in expression (use && or ||)")
+		case strings.IndexByte(special, c) != -1:
+			tokens = append(tokens, token{s: string(c)})
+			i++
+		default:
+			j := i
+			for j < len(s) && s[j] != ' ' && s[j] != '\t' && s[j] != '\n' && strings.IndexByte(special, s[j]) == -1 {
+				j++
+			}
+			tokens = append(tokens, token{s: s[i:j]})
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+type parser struct {
+	tokens  []token
+	pos     int
+	columns []string
+}
+
+func (p *parser) peek() (token, bool) {
+	if p.pos >= len(p.tokens) {
+		return token{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+// keyword reports whether the next token is one of words (unquoted, case-insensitively), and consumes it if so
+func (p *parser) keyword(words ...string) bool {
+	t, ok := p.peek()
+	if !ok || t.quoted {
+		return false
+	}
+	for _, w := range words {
+		if strings.EqualFold(t.s, w) {
+			p.pos++
+			return true
+		}
+	}
+	return false
+}
+
+func (p *parser) or() (Expr, error) {
+	l, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("or", "||") {
+		r, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		l = or{l, r}
+	}
+	return l, nil
+}
+
+func (p *parser) and() (Expr, error) {
+	l, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("and", "&&") {
+		r, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		l = and{l, r}
+	}
+	return l, nil
+}
+
+func (p *parser) unary() (Expr, error) {
+	if p.keyword("not", "!") {
+		e, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return not{e}, nil
+	}
+	if p.keyword("(") {
+		e, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if !p.keyword(")") {
+			return nil, errors.New("missing ) in expression")
+		}
+		return e, nil
+	}
+	return p.comparison()
+}
+
+// value returns the next token, which must be a word or a quoted string
+func (p *parser) value(what string) (string, error) {
+	t, ok := p.peek()
+	if !ok {
+		return "", errors.New("expression ended where a " + what + " was expected")
+	}
+	if !t.quoted && len(t.s) == 1 && strings.Contains(special, t.s) {
+		return "", errors.New("unexpected " + t.s + " in expression where a " + what + " was expected")
+	}
+	p.pos++
+	return t.s, nil
+}
+
+func (p *parser) comparison() (Expr, error) {
+	column, err := p.value("column name")
+	if err != nil {
+		return nil, err
+	}
+	p.columns = append(p.columns, column)
+
+	if p.keyword("in") {
+		if !p.keyword("(") {
+			return nil, errors.New("expected ( after in")
+		}
+		c := comparison{column: column, op: "in"}
+		for {
+			v, err := p.value("value")
+			if err != nil {
+				return nil, err
+			}
+			c.values = append(c.values, v)
+			if p.keyword(")") {
+				return c, nil
+			}
+			if !p.keyword(",") {
+				return nil, errors.New("expected , or ) in list of values")
+			}
+		}
+	}
+
+	t, ok := p.peek()
+	if !ok {
+		return nil, errors.New("expression ended after column " + column + " where an operator was expected")
+	}
+	op := t.s
+	switch op {
+	case "=":
+		op = "=="
+	case "==", "!=", "<", "<=", ">", ">=", "~", "!~":
+	default:
+		return nil, errors.New("expected an operator after " + column + ", got " + t.s)
+	}
+	p.pos++
+
+	v, err := p.value("value")
+	if err != nil {
+		return nil, err
+	}
+	c := comparison{column: column, op: op, value: v}
+	if op == "~" || op == "!~" {
+		c.re, err = regexp.Compile(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// Parse parses a filter expression, and returns it and the names of the columns that it uses. Expressions
+// are comparisons of a column to a value, combined with and, or, not and parentheses, for example:
+//
+//	country == England and date >= 2021-01-01 and not lineage ~ "^B\.1\.1\.7"
+//	lineage in (BA.1, BA.2) or (ct < 20 && country != "Northern Ireland")
+//
+// The operators are ==, =, !=, <, <=, >, >=, ~ and !~ (regular expression match and non-match) and in
+func Parse(s string) (Expr, []string, error) {
+	tokens, err := tokenise(s)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, nil, errors.New("empty expression")
+	}
+	p := &parser{tokens: tokens}
+	e, err := p.or()
+	if err != nil {
+		return nil, nil, err
+	}
+	if t, ok := p.peek(); ok {
+		return nil, nil, errors.New("unexpected " + t.s + " in expression")
+	}
+	return e, p.columns, nil
+}
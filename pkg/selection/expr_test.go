@@ -0,0 +1,50 @@
+package selection
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	row := map[string]string{"country": "Northern Ireland", "date": "2021-03-14", "lineage": "B.1.1.7", "ct": "18.5"}
+	get := func(c string) string { return row[c] }
+
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{`country == "Northern Ireland"`, true},
+		{`country = 'Northern Ireland' and date >= 2021-01-01`, true},
+		{`date < 2021-03-01`, false},
+		{`lineage ~ "^B\.1\.1\."`, true},
+		{`not lineage ~ ^B`, false},
+		{`lineage !~ ^A`, true},
+		{`lineage in (BA.1, B.1.1.7)`, true},
+		{`ct < 20 && ct > 9`, true},
+		{`ct > 9 and (country != "Northern Ireland" || lineage == B.1.1.7)`, true},
+		{`ct < 9 or !(date >= 2021-01-01)`, false},
+		{`ct == 18.50`, true},
+	}
+
+	for _, test := range tests {
+		e, _, err := Parse(test.expr)
+		if err != nil {
+			t.Errorf("problem in TestParse(): %s: %v", test.expr, err)
+			continue
+		}
+		if got := e.Eval(get); got != test.want {
+			t.Errorf("problem in TestParse(): %s gave %v", test.expr, got)
+		}
+	}
+
+	_, columns, err := Parse(`a == 1 or (b ~ x and not c in (1,2))`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(columns) != 3 || columns[0] != "a" || columns[2] != "c" {
+		t.Errorf("problem in TestParse() columns: %v", columns)
+	}
+
+	for _, bad := range []string{``, `a ==`, `a 1`, `(a == 1`, `a == 1 b`, `a in 1`, `a == "x`, `a & b`, `a ~ "("`} {
+		if _, _, err := Parse(bad); err == nil {
+			t.Errorf("problem in TestParse(): expected an error for %s", bad)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Package selection implements routines to select records from a fasta file
+using their metadata: the metadata table is joined to the records by name,
+and records are kept if their row matches a filter expression.
+*/
+package selection
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/metadata"
+	"github.com/virus-evolution/gofasta/pkg/summary"
+)
+
+// Options controls which records are selected and how they are written
+type Options struct {
+	Where       string   // filter expression (see Parse). Empty means every record in the metadata
+	Annotate    []string // columns whose values are added to the header of each selected record
+	AnnotateSep string   // separator between the record ID and the values of Annotate
+}
+
+// Select writes the records in in whose metadata in table matches o.Where to out, in the order of in. If
+// metaOut is not nil, the matching rows of table are written to it, in the same order, with the field
+// separator sep. Records that aren't in table are not selected
+func Select(ctx context.Context, in io.Reader, out io.Writer, metaOut io.Writer, table *metadata.Table, sep rune, o Options) error {
+
+	var expr Expr
+	if o.Where != "" {
+		var columns []string
+		var err error
+		expr, columns, err = Parse(o.Where)
+		if err != nil {
+			return err
+		}
+		for _, c := range columns {
+			if !table.HasColumn(c) {
+				return errors.New("no column called " + c + " in metadata")
+			}
+		}
+	}
+	for _, c := range o.Annotate {
+		if !table.HasColumn(c) {
+			return errors.New("no column called " + c + " in metadata")
+		}
+	}
+
+	var cw *csv.Writer
+	if metaOut != nil {
+		cw = csv.NewWriter(metaOut)
+		cw.Comma = sep
+		if err := cw.Write(table.Columns); err != nil {
+			return err
+		}
+	}
+
+	err := fastaio.EachRecord(ctx, in, func(FR fastaio.FastaRecord) error {
+		if !table.Has(FR.ID) {
+			summary.Skipped(FR.ID, "no metadata")
+			return nil
+		}
+		get := func(column string) string {
+			v, _ := table.Get(FR.ID, column)
+			return v
+		}
+		if expr != nil && !expr.Eval(get) {
+			return nil
+		}
+
+		header := FR.Description
+		if len(o.Annotate) > 0 {
+			values := make([]string, len(o.Annotate))
+			for i, c := range o.Annotate {
+				values[i] = get(c)
+			}
+			header = FR.ID + o.AnnotateSep + strings.Join(values, o.AnnotateSep)
+		}
+		if _, err := out.Write([]byte(">" + header + "\n" + FR.Seq + "\n")); err != nil {
+			return err
+		}
+
+		if cw != nil {
+			row, _ := table.Row(FR.ID)
+			return cw.Write(row)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if cw != nil {
+		cw.Flush()
+		return cw.Error()
+	}
+
+	return nil
+}
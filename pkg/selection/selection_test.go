@@ -0,0 +1,42 @@
+package selection
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/metadata"
+)
+
+func TestSelect(t *testing.T) {
+	table, err := metadata.Read(strings.NewReader("name,date,lineage\ns1,2021-01-05,B.1.1.7\ns2,2020-12-01,B.1.177\ns3,2021-02-01,B.1.1.7\n"), ',', "name")
+	if err != nil {
+		t.Fatal(err)
+	}
+	in := ">s3 x\nAAA\n>s4\nCCC\n>s1\nGGG\n>s2\nTTT\n"
+
+	var out, meta bytes.Buffer
+	err = Select(context.Background(), strings.NewReader(in), &out, &meta, &table, ',', Options{Where: "lineage == B.1.1.7", Annotate: []string{"lineage", "date"}, AnnotateSep: "|"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != ">s3|B.1.1.7|2021-02-01\nAAA\n>s1|B.1.1.7|2021-01-05\nGGG\n" {
+		t.Errorf("problem in TestSelect(): %s", out.String())
+	}
+	if meta.String() != "name,date,lineage\ns3,2021-02-01,B.1.1.7\ns1,2021-01-05,B.1.1.7\n" {
+		t.Errorf("problem in TestSelect() metadata: %s", meta.String())
+	}
+
+	out.Reset()
+	if err = Select(context.Background(), strings.NewReader(in), &out, nil, &table, ',', Options{}); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != ">s3 x\nAAA\n>s1\nGGG\n>s2\nTTT\n" {
+		t.Errorf("problem in TestSelect() without an expression: %s", out.String())
+	}
+
+	if err = Select(context.Background(), strings.NewReader(in), &out, nil, &table, ',', Options{Where: "country == UK"}); err == nil {
+		t.Error("expected an error for a missing column")
+	}
+}
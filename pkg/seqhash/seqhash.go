@@ -0,0 +1,102 @@
+/*
+Package seqhash implements routines to give sequences deterministic
+identifiers made from a hash of the sequence itself, so that the same
+sequence gets the same identifier wherever it comes from.
+*/
+package seqhash
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"io"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+// How the hash is written to the header
+const (
+	Replace = "replace"
+	Append  = "append"
+)
+
+// Options controls how sequences are hashed and how the hashes are written
+type Options struct {
+	Algorithm string // sha256, sha512, sha1 or md5
+	Length    int    // number of hex characters to keep (0 means all of them)
+	Prefix    string // written before the hash, e.g. "seq_"
+	Mode      string // Replace or Append
+}
+
+// newHash returns a hash.Hash for the named algorithm
+func newHash(algorithm string) (hash.Hash, error) {
+	switch strings.ToLower(algorithm) {
+	case "sha256":
+		return sha256.New(), nil
+	case "sha512":
+		return sha512.New(), nil
+	case "sha1":
+		return sha1.New(), nil
+	case "md5":
+		return md5.New(), nil
+	}
+	return nil, errors.New("unknown hash algorithm: " + algorithm + " (choose one of sha256, sha512, sha1 or md5)")
+}
+
+// Normalise returns seq as it is hashed: uppercased and without gaps
+func Normalise(seq string) string {
+	return strings.ReplaceAll(strings.ToUpper(seq), "-", "")
+}
+
+// Sum returns the hex-encoded hash of the normalised seq, truncated to length characters if length > 0
+func Sum(h hash.Hash, seq string, length int) string {
+	h.Reset()
+	h.Write([]byte(Normalise(seq)))
+	s := hex.EncodeToString(h.Sum(nil))
+	if length > 0 && length < len(s) {
+		s = s[:length]
+	}
+	return s
+}
+
+// SeqHash writes every record in in to out with its hash either replacing the header or appended to it (after a
+// space). If table is not nil, a CSV file with the columns name,seqhash is written to it
+func SeqHash(ctx context.Context, in io.Reader, out io.Writer, table io.Writer, o Options) error {
+
+	h, err := newHash(o.Algorithm)
+	if err != nil {
+		return err
+	}
+	if o.Mode != Replace && o.Mode != Append {
+		return errors.New("unknown mode: " + o.Mode + " (choose one of replace or append)")
+	}
+
+	if table != nil {
+		if _, err := table.Write([]byte("name,seqhash\n")); err != nil {
+			return err
+		}
+	}
+
+	return fastaio.EachRecord(ctx, in, func(FR fastaio.FastaRecord) error {
+		id := o.Prefix + Sum(h, FR.Seq, o.Length)
+		header := id
+		if o.Mode == Append {
+			header = FR.Description + " " + id
+		}
+		if _, err := out.Write([]byte(">" + header + "\n" + FR.Seq + "\n")); err != nil {
+			return err
+		}
+		if table != nil {
+			if _, err := table.Write([]byte(FR.ID + "," + id + "\n")); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
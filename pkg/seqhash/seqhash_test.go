@@ -0,0 +1,51 @@
+package seqhash
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"strings"
+	"testing"
+)
+
+func TestSum(t *testing.T) {
+	h := md5.New()
+	// md5 of "ACGT"
+	want := "f1f8f4bf413b16ad135722aa4591043e"
+	if got := Sum(h, "acg-t", 0); got != want {
+		t.Errorf("problem in TestSum(): %s", got)
+	}
+	if got := Sum(h, "ACGT", 10); got != want[:10] {
+		t.Errorf("problem in TestSum() with length: %s", got)
+	}
+}
+
+func TestSeqHash(t *testing.T) {
+	in := ">s1 desc\nACGT\n>s2\nAC-GT\n"
+
+	var out, table bytes.Buffer
+	err := SeqHash(context.Background(), strings.NewReader(in), &out, &table, Options{Algorithm: "md5", Length: 8, Prefix: "h_", Mode: Replace})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(out.String(), "\n")
+	if lines[0] != lines[2] || !strings.HasPrefix(lines[0], ">h_") || len(lines[0]) != 11 || lines[3] != "AC-GT" {
+		t.Errorf("problem in TestSeqHash(): %s", out.String())
+	}
+	if !strings.HasPrefix(table.String(), "name,seqhash\ns1,"+lines[0][1:]+"\ns2,") {
+		t.Errorf("problem in TestSeqHash() table: %s", table.String())
+	}
+
+	out.Reset()
+	err = SeqHash(context.Background(), strings.NewReader(in), &out, nil, Options{Algorithm: "md5", Length: 8, Prefix: "h_", Mode: Append})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(out.String(), ">s1 desc "+lines[0][1:]+"\n") {
+		t.Errorf("problem in TestSeqHash() appending: %s", out.String())
+	}
+
+	if err = SeqHash(context.Background(), strings.NewReader(in), &out, nil, Options{Algorithm: "crc", Mode: Append}); err == nil {
+		t.Error("expected an error for an unknown algorithm")
+	}
+}
@@ -0,0 +1,306 @@
+/*
+Package seqsort implements routines to sort the records in a fasta file by
+name, length, completeness, a metadata column or distance to a reference.
+
+Records are sorted in memory until they reach a limit, after which each
+sorted chunk is spilled to a temporary file and the chunks are merged, so
+files larger than memory can be sorted.
+*/
+package seqsort
+
+import (
+	"bufio"
+	"container/heap"
+	"context"
+	"encoding/gob"
+	"errors"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/distance"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/metadata"
+	"github.com/virus-evolution/gofasta/pkg/summary"
+)
+
+// The keys that records can be sorted by
+const (
+	ByName         = "name"
+	ByLength       = "length"
+	ByCompleteness = "completeness"
+	ByMetadata     = "metadata"
+	ByDistance     = "distance"
+)
+
+// Options controls how records are sorted
+type Options struct {
+	By        string          // one of the By* constants
+	Reverse   bool            // sort in descending order
+	Table     *metadata.Table // with ByMetadata, the table to look records up in
+	Column    string          // with ByMetadata, the column to sort by
+	Numeric   bool            // with ByMetadata, compare the column's values as numbers
+	Reference string          // with ByDistance, the aligned reference sequence
+	MaxMemory int64           // approximate number of bytes of sequence to hold in memory before spilling to disk
+	TempDir   string          // directory for temporary files ("" means the system default)
+}
+
+// Key is the value that a record is sorted by. Records with a missing key (e.g. not in the metadata)
+// always sort last, whatever the direction
+type Key struct {
+	Str     string
+	Num     float64
+	Missing bool
+}
+
+// item is a record and its key, as held in memory and written to temporary files
+type item struct {
+	Key         Key
+	Description string
+	Seq         string
+	Idx         int
+}
+
+type sorter struct {
+	key     func(fastaio.FastaRecord) (Key, error)
+	numeric bool
+	reverse bool
+}
+
+func newSorter(o Options) (sorter, error) {
+	s := sorter{reverse: o.Reverse}
+	switch o.By {
+	case ByName:
+		s.key = func(FR fastaio.FastaRecord) (Key, error) {
+			return Key{Str: FR.ID}, nil
+		}
+	case ByLength:
+		s.numeric = true
+		s.key = func(FR fastaio.FastaRecord) (Key, error) {
+			return Key{Num: float64(len(FR.Seq) - strings.Count(FR.Seq, "-"))}, nil
+		}
+	case ByCompleteness:
+		s.numeric = true
+		s.key = func(FR fastaio.FastaRecord) (Key, error) {
+			if len(FR.Seq) == 0 {
+				return Key{Num: 0}, nil
+			}
+			acgt := 0
+			for i := 0; i < len(FR.Seq); i++ {
+				switch FR.Seq[i] {
+				case 'A', 'C', 'G', 'T':
+					acgt++
+				}
+			}
+			return Key{Num: float64(acgt) / float64(len(FR.Seq))}, nil
+		}
+	case ByMetadata:
+		if o.Table == nil || o.Column == "" {
+			return s, errors.New("sorting by metadata needs a table and a column")
+		}
+		if !o.Table.HasColumn(o.Column) {
+			return s, errors.New("couldn't find column " + o.Column + " in metadata")
+		}
+		s.numeric = o.Numeric
+		s.key = func(FR fastaio.FastaRecord) (Key, error) {
+			v, ok := o.Table.Get(FR.ID, o.Column)
+			if !ok || v == "" {
+				summary.Warn(FR.ID + " has no " + o.Column + " in metadata, sorted last")
+				return Key{Missing: true}, nil
+			}
+			if !o.Numeric {
+				return Key{Str: v}, nil
+			}
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return Key{}, errors.New("couldn't parse " + o.Column + " for " + FR.ID + " as a number: " + v)
+			}
+			return Key{Num: f}, nil
+		}
+	case ByDistance:
+		if o.Reference == "" {
+			return s, errors.New("sorting by distance needs a reference")
+		}
+		ref := fastaio.FastaRecord{ID: "reference", Seq: strings.ToUpper(o.Reference)}
+		packedRef := distance.Pack(ref.Encode())
+		s.numeric = true
+		s.key = func(FR fastaio.FastaRecord) (Key, error) {
+			if len(FR.Seq) != len(ref.Seq) {
+				return Key{}, errors.New(FR.ID + " is not the same length as the reference (are the sequences aligned?)")
+			}
+			p := distance.Pack(FR.Encode())
+			return Key{Num: float64(distance.SNP(&packedRef, &p))}, nil
+		}
+	default:
+		return s, errors.New("unknown sort key: " + o.By + " (choose one of name, length, completeness, metadata or distance)")
+	}
+	return s, nil
+}
+
+// less reports whether a sorts strictly before b
+func (s sorter) less(a, b Key) bool {
+	if a.Missing || b.Missing {
+		return !a.Missing && b.Missing
+	}
+	if s.numeric {
+		if s.reverse {
+			return a.Num > b.Num
+		}
+		return a.Num < b.Num
+	}
+	if s.reverse {
+		return a.Str > b.Str
+	}
+	return a.Str < b.Str
+}
+
+func writeItem(w io.Writer, it item) error {
+	_, err := w.Write([]byte(">" + it.Description + "\n" + it.Seq + "\n"))
+	return err
+}
+
+// Sort writes the records in in to out, sorted according to o. Records with equal keys stay in input order
+func Sort(ctx context.Context, in io.Reader, out io.Writer, o Options) error {
+
+	s, err := newSorter(o)
+	if err != nil {
+		return err
+	}
+
+	sortChunk := func(items []item) {
+		sort.SliceStable(items, func(i, j int) bool { return s.less(items[i].Key, items[j].Key) })
+	}
+
+	var chunk []item
+	var size int64
+	var spills []*os.File
+	defer func() {
+		for _, f := range spills {
+			f.Close()
+		}
+	}()
+
+	spill := func() error {
+		sortChunk(chunk)
+		f, err := os.CreateTemp(o.TempDir, "gofasta-sort-*")
+		if err != nil {
+			return err
+		}
+		os.Remove(f.Name())
+		spills = append(spills, f)
+		bw := bufio.NewWriter(f)
+		enc := gob.NewEncoder(bw)
+		for _, it := range chunk {
+			if err := enc.Encode(it); err != nil {
+				return err
+			}
+		}
+		if err := bw.Flush(); err != nil {
+			return err
+		}
+		chunk = chunk[:0]
+		size = 0
+		return nil
+	}
+
+	err = fastaio.EachRecord(ctx, in, func(FR fastaio.FastaRecord) error {
+		k, err := s.key(FR)
+		if err != nil {
+			return err
+		}
+		chunk = append(chunk, item{Key: k, Description: FR.Description, Seq: FR.Seq, Idx: FR.Idx})
+		size += int64(len(FR.Seq) + len(FR.Description))
+		if o.MaxMemory > 0 && size >= o.MaxMemory {
+			return spill()
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(spills) == 0 {
+		sortChunk(chunk)
+		for _, it := range chunk {
+			if err := writeItem(out, it); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if len(chunk) > 0 {
+		if err := spill(); err != nil {
+			return err
+		}
+	}
+
+	return merge(spills, out, s)
+}
+
+// run is one sorted chunk being read back from its temporary file
+type run struct {
+	dec  *gob.Decoder
+	head item
+}
+
+type runHeap struct {
+	runs []*run
+	s    sorter
+}
+
+func (h runHeap) Len() int { return len(h.runs) }
+func (h runHeap) Less(i, j int) bool {
+	a, b := h.runs[i].head, h.runs[j].head
+	if h.s.less(a.Key, b.Key) {
+		return true
+	}
+	if h.s.less(b.Key, a.Key) {
+		return false
+	}
+	return a.Idx < b.Idx
+}
+func (h runHeap) Swap(i, j int)       { h.runs[i], h.runs[j] = h.runs[j], h.runs[i] }
+func (h *runHeap) Push(x interface{}) { h.runs = append(h.runs, x.(*run)) }
+func (h *runHeap) Pop() interface{} {
+	r := h.runs[len(h.runs)-1]
+	h.runs = h.runs[:len(h.runs)-1]
+	return r
+}
+
+// merge does a k-way merge of the sorted chunks in files to out
+func merge(files []*os.File, out io.Writer, s sorter) error {
+	h := &runHeap{s: s}
+	for _, f := range files {
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		r := &run{dec: gob.NewDecoder(bufio.NewReader(f))}
+		if err := r.dec.Decode(&r.head); err != nil {
+			return err
+		}
+		h.runs = append(h.runs, r)
+	}
+	heap.Init(h)
+
+	for h.Len() > 0 {
+		r := h.runs[0]
+		if err := writeItem(out, r.head); err != nil {
+			return err
+		}
+		r.head = item{}
+		err := r.dec.Decode(&r.head)
+		switch {
+		case err == io.EOF:
+			heap.Pop(h)
+		case err != nil:
+			return err
+		default:
+			heap.Fix(h, 0)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package seqsort
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/metadata"
+)
+
+var sortFasta = `>c
+ACGTNN
+>a
+ACG---
+>b
+ACGTAC
+>d
+TTGTAC
+`
+
+func ids(t *testing.T, fasta string) string {
+	t.Helper()
+	var names []string
+	for _, line := range strings.Split(fasta, "\n") {
+		if strings.HasPrefix(line, ">") {
+			names = append(names, line[1:])
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+func TestSort(t *testing.T) {
+	table, err := metadata.Read(strings.NewReader("name,date\na,2021-02-01\nb,2020-12-31\nc,2021-01-15\n"), ',', "name")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		o    Options
+		want string
+	}{
+		{Options{By: ByName}, "a,b,c,d"},
+		{Options{By: ByName, Reverse: true}, "d,c,b,a"},
+		{Options{By: ByLength}, "a,c,b,d"},
+		{Options{By: ByCompleteness, Reverse: true}, "b,d,c,a"},
+		{Options{By: ByMetadata, Table: &table, Column: "date"}, "b,c,a,d"},
+		{Options{By: ByMetadata, Table: &table, Column: "date", Reverse: true}, "a,c,b,d"},
+		{Options{By: ByDistance, Reference: "ACGTAC"}, "c,a,b,d"},
+	}
+
+	for _, test := range tests {
+		for _, maxMemory := range []int64{0, 1, 14} {
+			o := test.o
+			o.MaxMemory = maxMemory
+			var out bytes.Buffer
+			if err := Sort(context.Background(), strings.NewReader(sortFasta), &out, o); err != nil {
+				t.Fatal(err)
+			}
+			if got := ids(t, out.String()); got != test.want {
+				t.Errorf("problem in TestSort() (by %s, max memory %d): got %s, want %s", o.By, maxMemory, got, test.want)
+			}
+		}
+	}
+}
+
+func TestSortErrors(t *testing.T) {
+	var out bytes.Buffer
+	if err := Sort(context.Background(), strings.NewReader(sortFasta), &out, Options{By: "colour"}); err == nil {
+		t.Error("expected an error for an unknown key")
+	}
+	if err := Sort(context.Background(), strings.NewReader(sortFasta), &out, Options{By: ByDistance, Reference: "ACG"}); err == nil {
+		t.Error("expected an error for a reference of the wrong length")
+	}
+}
@@ -0,0 +1,314 @@
+package snps
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"unicode"
+
+	"github.com/virus-evolution/gofasta/internal/pipeline"
+	"github.com/virus-evolution/gofasta/pkg/distance"
+	"github.com/virus-evolution/gofasta/pkg/encoding"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/summary"
+)
+
+// Records are read and compared with the reference a block of columns at a time rather than whole, so that
+// the memory snps needs is proportional to the block size times the number of blocks in flight, however wide
+// the alignment is. Lines don't have to fit in a scanner's buffer either, so unwrapped bacterial
+// core-genome alignments (or whole chromosomes) can be read
+
+// defaultBlockSize is the number of columns in each block. Whole records of a typical viral genome fit in one
+const defaultBlockSize = 64 * 1024
+
+// block is up to one block size of encoded columns of the record'th record, starting at offset. The last block
+// of every record has last set, and may be empty
+type block struct {
+	query  string
+	record int
+	offset int
+	seq    *[]byte
+	last   bool
+}
+
+// part is the SNPs in one block
+type part struct {
+	query  string
+	record int
+	offset int
+	snps   []SNP
+	last   bool
+}
+
+// assembly is the parts of one record that have been called so far, by block. total is the number of blocks in
+// the record, once its last block has been
+type assembly struct {
+	parts      [][]SNP
+	got, total int
+}
+
+// readBlocks reads the records in a fasta file, encoding them with coding, and passes them to emit in blocks of up
+// to size columns, in order. If width isn't negative, every record must be width columns wide. The blocks' buffers
+// come from newBuffer, and belong to whoever emit hands them to
+func readBlocks(in io.Reader, coding *[256]byte, size, width int, newBuffer func() *[]byte, emit func(block) error) error {
+
+	br := bufio.NewReaderSize(in, 64*1024)
+
+	var id string
+	header := make([]byte, 0)
+	inHeader := false
+	inRecord := false
+	atLineStart := true
+	pendingCR := false
+
+	offset := 0
+	length := 0
+	records := 0
+	cur := newBuffer()
+
+	// the 1-based line being read, and the line the current record's header is on
+	lineNo := 1
+	headerLine := 0
+
+	addSeq := func(line []byte) error {
+		for _, c := range line {
+			nuc := coding[c]
+			if nuc == 0 {
+				return &fastaio.RecordError{Record: id, Index: records, Line: lineNo, Position: length + 1,
+					Err: fmt.Errorf("%w (\"%s\")", fastaio.ErrInvalidNucleotide, string(c))}
+			}
+			length++
+			// a record that is too wide is an error once its length is known, so there is no point keeping it
+			if width >= 0 && length > width {
+				continue
+			}
+			*cur = append(*cur, nuc)
+			if len(*cur) == size {
+				if err := emit(block{query: id, record: records, offset: offset, seq: cur}); err != nil {
+					return err
+				}
+				offset += size
+				cur = newBuffer()
+			}
+		}
+		return nil
+	}
+
+	endRecord := func() error {
+		if width >= 0 && length != width {
+			return &fastaio.RecordError{Record: id, Index: records, Line: headerLine,
+				Err: errors.New("the reference sequence is " + strconv.Itoa(width) + " bases, but this record is " + strconv.Itoa(length))}
+		}
+		if err := emit(block{query: id, record: records, offset: offset, seq: cur, last: true}); err != nil {
+			return err
+		}
+		records++
+		offset, length = 0, 0
+		cur = newBuffer()
+		return nil
+	}
+
+	for {
+		chunk, err := br.ReadSlice('\n')
+		if err != nil && err != bufio.ErrBufferFull && err != io.EOF {
+			return &fastaio.RecordError{Record: id, Index: records, Line: lineNo, Err: err}
+		}
+		// the line is complete unless it was longer than the reader's buffer
+		complete := err != bufio.ErrBufferFull
+		line := chunk
+		if len(line) > 0 && line[len(line)-1] == '\n' {
+			line = line[:len(line)-1]
+		}
+		if complete && len(line) > 0 && line[len(line)-1] == '\r' {
+			line = line[:len(line)-1]
+		}
+
+		if atLineStart && len(line) > 0 && line[0] == '>' {
+			if inRecord {
+				if err := endRecord(); err != nil {
+					return err
+				}
+			}
+			inHeader = true
+			inRecord = true
+			headerLine = lineNo
+			header = header[:0]
+			line = line[1:]
+		} else if atLineStart && len(line) > 0 && !inRecord {
+			return &fastaio.RecordError{Line: lineNo, Err: fastaio.ErrBadFormat}
+		}
+
+		switch {
+		case inHeader:
+			header = append(header, line...)
+			if complete {
+				id = headerID(header)
+				if id == "" {
+					return &fastaio.RecordError{Index: records, Line: headerLine, Err: fastaio.ErrEmptyHeader}
+				}
+				inHeader = false
+			}
+		default:
+			if pendingCR {
+				pendingCR = false
+				// a carriage return that turned out not to be part of a line ending
+				if !complete || len(line) > 0 {
+					if err := addSeq([]byte{'\r'}); err != nil {
+						return err
+					}
+				}
+			}
+			if !complete && len(line) > 0 && line[len(line)-1] == '\r' {
+				line = line[:len(line)-1]
+				pendingCR = true
+			}
+			if err := addSeq(line); err != nil {
+				return err
+			}
+		}
+
+		atLineStart = complete
+		if complete {
+			lineNo++
+		}
+		if err == io.EOF {
+			break
+		}
+	}
+
+	if inRecord {
+		if err := endRecord(); err != nil {
+			return err
+		}
+	}
+
+	if records == 0 {
+		return fastaio.ErrEmpty
+	}
+
+	return nil
+}
+
+// headerID returns the first word of a fasta header
+func headerID(header []byte) string {
+	start := 0
+	for start < len(header) && unicode.IsSpace(rune(header[start])) {
+		start++
+	}
+	end := start
+	for end < len(header) && !unicode.IsSpace(rune(header[end])) {
+		end++
+	}
+	return string(header[start:end])
+}
+
+// call is Call, comparing blocks of size columns at a time
+func call(ctx context.Context, ref, alignment io.Reader, hardGaps bool, size int, f func(SNPRecord) error) error {
+
+	var coding [256]byte
+	switch hardGaps {
+	case true:
+		coding = encoding.MakeEncodingArrayHardGaps()
+	case false:
+		coding = encoding.MakeEncodingArray()
+	}
+
+	// the reference is the one record that is held whole
+	refSeq := make([]byte, 0)
+	nRefs := 0
+	err := readBlocks(ref, &coding, size, -1, func() *[]byte {
+		b := make([]byte, 0, size)
+		return &b
+	}, func(b block) error {
+		if nRefs > 0 {
+			return errors.New("more than one record in --reference")
+		}
+		refSeq = append(refSeq, *b.seq...)
+		if b.last {
+			nRefs++
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	DA := encoding.MakeDecodingArray()
+
+	pool := sync.Pool{
+		New: func() any {
+			b := make([]byte, 0, size)
+			return &b
+		},
+	}
+	newBuffer := func() *[]byte {
+		b := pool.Get().(*[]byte)
+		*b = (*b)[:0]
+		return b
+	}
+
+	source := func(ctx context.Context, emit func(block) error) error {
+		// only the alignment's records are counted, not the reference
+		return readBlocks(alignment, &coding, size, len(refSeq), newBuffer, func(b block) error {
+			if err := emit(b); err != nil {
+				return err
+			}
+			if b.last {
+				summary.Processed(1)
+			}
+			return nil
+		})
+	}
+
+	work := func(b block) (part, error) {
+		seq := *b.seq
+		r := refSeq[b.offset : b.offset+len(seq)]
+		sites := distance.EncodedDiffSites(seq, r)
+		p := part{query: b.query, record: b.record, offset: b.offset, snps: make([]SNP, len(sites)), last: b.last}
+		for j, i := range sites {
+			p.snps[j] = SNP{Position: b.offset + i + 1, Ref: DA[r[i]], Alt: DA[seq[i]]}
+		}
+		pool.Put(b.seq)
+		return p, nil
+	}
+
+	// the parts of a record reach the sink in order, unless the pipeline is unordered, in which case they are
+	// put back together here and the records are passed on as they are finished
+	pending := make(map[int]*assembly)
+	sink := func(p part) error {
+		a, ok := pending[p.record]
+		if !ok {
+			a = &assembly{total: -1}
+			pending[p.record] = a
+		}
+		k := p.offset / size
+		for len(a.parts) <= k {
+			a.parts = append(a.parts, nil)
+		}
+		a.parts[k] = p.snps
+		a.got++
+		if p.last {
+			a.total = k + 1
+		}
+		if a.got != a.total {
+			return nil
+		}
+		delete(pending, p.record)
+
+		n := 0
+		for _, snps := range a.parts {
+			n += len(snps)
+		}
+		SR := SNPRecord{Query: p.query, SNPs: make([]SNP, 0, n)}
+		for _, snps := range a.parts {
+			SR.SNPs = append(SR.SNPs, snps...)
+		}
+		return f(SR)
+	}
+
+	return pipeline.Run(ctx, 0, 0, source, work, sink)
+}
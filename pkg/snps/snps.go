@@ -5,225 +5,190 @@ in a fasta format alignment and a reference sequence.
 package snps
 
 import (
+	"context"
 	"errors"
 	"io"
-	"runtime"
 	"sort"
 	"strconv"
-	"strings"
-	"sync"
 
+	"github.com/virus-evolution/gofasta/internal/pipeline"
+	"github.com/virus-evolution/gofasta/pkg/distance"
 	"github.com/virus-evolution/gofasta/pkg/encoding"
 	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/schema"
 )
 
-// snpLine is a struct for one fasta record's SNPs
-type snpLine struct {
-	queryname string
-	snps      []string
-	idx       int
+// SNP is one difference between a record and the reference, at a 1-based Position in the alignment
+type SNP struct {
+	Position int    `json:"position"`
+	Ref      string `json:"ref"`
+	Alt      string `json:"alt"`
 }
 
-// getSNPs gets the SNPs between the reference sequence and each fasta record from a channel
-func getSNPs(refSeq []byte, cFR chan fastaio.EncodedFastaRecord, cSNPs chan snpLine, cErr chan error) {
-
-	DA := encoding.MakeDecodingArray()
-
-	for FR := range cFR {
-		if len(FR.Seq) != len(refSeq) {
-			rl := strconv.Itoa(len(refSeq))
-			ql := strconv.Itoa(len(FR.Seq))
-			cErr <- errors.New("Reference sequence (" + rl + " bases) and " + FR.ID + " (" + ql + " bases) are different lengths")
-			break
-		}
-		SL := snpLine{}
-		SL.queryname = FR.ID
-		SL.idx = FR.Idx
-		SNPs := make([]string, 0)
-		for i, nuc := range FR.Seq {
-			if (refSeq[i] & nuc) < 16 {
-				snpLine := DA[refSeq[i]] + strconv.Itoa(i+1) + DA[nuc]
-				SNPs = append(SNPs, snpLine)
-			}
-		}
-		SL.snps = SNPs
-		cSNPs <- SL
-	}
+// String returns the SNP as it is written in the CSV output, e.g. C241T
+func (s SNP) String() string {
+	return string(s.appendTo(nil))
+}
 
-	return
+// appendTo appends the SNP as String formats it to b
+func (s SNP) appendTo(b []byte) []byte {
+	b = append(b, s.Ref...)
+	b = strconv.AppendInt(b, int64(s.Position), 10)
+	return append(b, s.Alt...)
 }
 
-// writeOutput writes the snps per record to stdout or a file as it arrives.
-// It uses a map to write things in the same order as they are in the input file.
-func writeOutput(w io.Writer, cSNPs chan snpLine, cErr chan error, cWriteDone chan bool) {
+// SNPRecord is one fasta record's SNPs, in order of position
+type SNPRecord struct {
+	Query string `json:"query"`
+	SNPs  []SNP  `json:"snps"`
+}
 
-	outputMap := make(map[int]snpLine)
+// SNPFrequency is the proportion of the records in an alignment that have a SNP
+type SNPFrequency struct {
+	SNP       SNP     `json:"snp"`
+	Frequency float64 `json:"frequency"`
+}
 
-	counter := 0
+// Call calls the SNPs between each record in a fasta-format alignment and a reference sequence, and passes
+// them to f in the order of the alignment, or as each record is finished if gofasta was run with --unordered.
+// With hardGaps, gaps are treated as a fifth character rather than as missing data. It stops, returning ctx's
+// error, if ctx is cancelled
+func Call(ctx context.Context, ref, alignment io.Reader, hardGaps bool, f func(SNPRecord) error) error {
+	return call(ctx, ref, alignment, hardGaps, defaultBlockSize, f)
+}
 
-	var err error
+// CallRecords is Call for a reference and records that have already been read and encoded, e.g. by a
+// cache.Cache. They are compared whole rather than in blocks
+func CallRecords(ctx context.Context, ref fastaio.EncodedFastaRecord, records []fastaio.EncodedFastaRecord, f func(SNPRecord) error) error {
 
-	_, err = w.Write([]byte("query,SNPs\n"))
-	if err != nil {
-		cErr <- err
-		return
-	}
+	DA := encoding.MakeDecodingArray()
 
-	for snpLine := range cSNPs {
-
-		outputMap[snpLine.idx] = snpLine
-
-		for {
-			if SL, ok := outputMap[counter]; ok {
-				_, err := w.Write([]byte(SL.queryname + "," + strings.Join(SL.snps, "|") + "\n"))
-				if err != nil {
-					cErr <- err
-					return
-				}
-				delete(outputMap, counter)
-				counter++
-			} else {
-				break
+	source := func(ctx context.Context, emit func(fastaio.EncodedFastaRecord) error) error {
+		for _, EFR := range records {
+			if err := emit(EFR); err != nil {
+				return err
 			}
 		}
+		return nil
+	}
 
+	work := func(EFR fastaio.EncodedFastaRecord) (SNPRecord, error) {
+		if len(EFR.Seq) != len(ref.Seq) {
+			return SNPRecord{}, &fastaio.RecordError{Record: EFR.ID, Index: EFR.Idx,
+				Err: errors.New("the reference sequence is " + strconv.Itoa(len(ref.Seq)) + " bases, but this record is " + strconv.Itoa(len(EFR.Seq)))}
+		}
+		sites := distance.EncodedDiffSites(ref.Seq, EFR.Seq)
+		SR := SNPRecord{Query: EFR.ID, SNPs: make([]SNP, len(sites))}
+		for j, i := range sites {
+			SR.SNPs[j] = SNP{Position: i + 1, Ref: DA[ref.Seq[i]], Alt: DA[EFR.Seq[i]]}
+		}
+		return SR, nil
 	}
 
-	cWriteDone <- true
+	return pipeline.Run(ctx, 0, 0, source, work, f)
 }
 
-// aggregateWriteOutput aggregates the SNPs that are present above a certain threshold in
-// the whole alignment, and writes their frequencies out to file or stdout
-func aggregateWriteOutput(w io.Writer, threshold float64, cSNPs chan snpLine, cErr chan error, cWriteDone chan bool) {
-
-	propMap := make(map[string]float64)
-
-	var err error
+// Aggregate returns the proportion of the records in a fasta-format alignment that have each SNP with respect
+// to a reference sequence, in order of position and then alternative nucleotide
+func Aggregate(ctx context.Context, ref, alignment io.Reader, hardGaps bool) ([]SNPFrequency, error) {
 
-	_, err = w.Write([]byte("SNP,frequency\n"))
-	if err != nil {
-		cErr <- err
-		return
-	}
+	propMap := make(map[SNP]float64)
 
 	counter := 0.0
 
-	for snpLine := range cSNPs {
+	err := Call(ctx, ref, alignment, hardGaps, func(SR SNPRecord) error {
 		counter++
-		for _, snp := range snpLine.snps {
-			if _, ok := propMap[snp]; ok {
-				propMap[snp]++
-			} else {
-				propMap[snp] = 1.0
-			}
+		for _, snp := range SR.SNPs {
+			propMap[snp]++
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	order := make([]string, 0)
-	for k, _ := range propMap {
-		order = append(order, k)
+	freqs := make([]SNPFrequency, 0, len(propMap))
+	for snp, n := range propMap {
+		freqs = append(freqs, SNPFrequency{SNP: snp, Frequency: n / counter})
 	}
 
-	sort.SliceStable(order, func(i, j int) bool {
-		pos_i, err := strconv.Atoi(order[i][1 : len(order[i])-1])
-		if err != nil {
-			cErr <- err
-		}
-		pos_j, err := strconv.Atoi(order[j][1 : len(order[j])-1])
-		if err != nil {
-			cErr <- err
-		}
-		alt_i := order[i][len(order[i])-1]
-		alt_j := order[j][len(order[j])-1]
-		return pos_i < pos_j || (pos_i == pos_j && alt_i < alt_j)
+	sort.Slice(freqs, func(i, j int) bool {
+		a, b := freqs[i].SNP, freqs[j].SNP
+		return a.Position < b.Position || (a.Position == b.Position && a.Alt < b.Alt)
 	})
 
-	for _, snp := range order {
-		if propMap[snp]/counter < threshold {
-			continue
-		}
-		_, err = w.Write([]byte(snp + "," + strconv.FormatFloat(propMap[snp]/counter, 'f', 9, 64) + "\n"))
-		if err != nil {
-			cErr <- err
-			return
-		}
-	}
-
-	cWriteDone <- true
+	return freqs, nil
 }
 
-// SNPs annotates snps for each record in a fasta-format alignment with respect to a reference sequence
-func SNPs(ref, alignment io.Reader, hardGaps bool, aggregate bool, threshold float64, w io.Writer) error {
-
-	cErr := make(chan error)
-
-	cFR := make(chan fastaio.EncodedFastaRecord)
-	cFRDone := make(chan bool)
+// Options controls SNPs. With HardGaps, gaps are treated as a fifth character rather than as missing data. With
+// Aggregate, the proportion of records with each SNP is reported instead, for the SNPs with a proportion of at
+// least Threshold
+type Options struct {
+	HardGaps  bool
+	Aggregate bool
+	Threshold float64
+}
 
-	cSNPs := make(chan snpLine, runtime.NumCPU())
-	cSNPsDone := make(chan bool)
+// SNPs annotates snps for each record in a fasta-format alignment with respect to a reference sequence, and
+// writes them as CSV
+func SNPs(ctx context.Context, ref, alignment io.Reader, w io.Writer, o Options) error {
 
-	cWriteDone := make(chan bool)
+	if o.Aggregate {
+		freqs, err := Aggregate(ctx, ref, alignment, o.HardGaps)
+		if err != nil {
+			return err
+		}
+		return writeAggregate(w, freqs, o.Threshold)
+	}
 
-	refs, err := fastaio.ReadEncodeAlignmentToList(ref, hardGaps)
+	_, err := w.Write([]byte(schema.SNPs.HeaderLine()))
 	if err != nil {
 		return err
 	}
-	if len(refs) > 1 {
-		return errors.New("more than one record in --reference")
-	}
-	refSeq := refs[0].Seq
 
-	go fastaio.ReadEncodeAlignment(alignment, hardGaps, cFR, cErr, cFRDone)
-
-	switch aggregate {
-	case true:
-		go aggregateWriteOutput(w, threshold, cSNPs, cErr, cWriteDone)
-	case false:
-		go writeOutput(w, cSNPs, cErr, cWriteDone)
-	}
+	var row []byte
+	return Call(ctx, ref, alignment, o.HardGaps, func(SR SNPRecord) error {
+		row = appendRecord(row[:0], SR)
+		_, err := w.Write(row)
+		return err
+	})
+}
 
-	var wgSNPs sync.WaitGroup
-	wgSNPs.Add(runtime.NumCPU())
+// writeRecord writes one record's SNPs as a line of CSV
+func writeRecord(w io.Writer, SR SNPRecord) error {
+	_, err := w.Write(appendRecord(nil, SR))
+	return err
+}
 
-	for n := 0; n < runtime.NumCPU(); n++ {
-		go func() {
-			getSNPs(refSeq, cFR, cSNPs, cErr)
-			wgSNPs.Done()
-		}()
+// appendRecord appends one record's SNPs as a line of CSV to b, so that a row is formatted in one buffer, which
+// can be reused for the next, rather than as a string per SNP
+func appendRecord(b []byte, SR SNPRecord) []byte {
+	b = append(b, SR.Query...)
+	b = append(b, ',')
+	for i, snp := range SR.SNPs {
+		if i > 0 {
+			b = append(b, '|')
+		}
+		b = snp.appendTo(b)
 	}
+	return append(b, '\n')
+}
 
-	go func() {
-		wgSNPs.Wait()
-		cSNPsDone <- true
-	}()
+// writeAggregate writes the frequencies of the SNPs that are present at at least threshold as CSV
+func writeAggregate(w io.Writer, freqs []SNPFrequency, threshold float64) error {
 
-	for n := 1; n > 0; {
-		select {
-		case err := <-cErr:
-			return err
-		case <-cFRDone:
-			close(cFR)
-			n--
-		}
+	_, err := w.Write([]byte(schema.SNPsAggregate.HeaderLine()))
+	if err != nil {
+		return err
 	}
 
-	for n := 1; n > 0; {
-		select {
-		case err := <-cErr:
-			return err
-		case <-cSNPsDone:
-			close(cSNPs)
-			n--
+	for _, f := range freqs {
+		if f.Frequency < threshold {
+			continue
 		}
-	}
-
-	for n := 1; n > 0; {
-		select {
-		case err := <-cErr:
+		_, err = w.Write([]byte(f.SNP.String() + "," + strconv.FormatFloat(f.Frequency, 'f', 9, 64) + "\n"))
+		if err != nil {
 			return err
-		case <-cWriteDone:
-			n--
 		}
 	}
 
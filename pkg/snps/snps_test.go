@@ -2,7 +2,17 @@ package snps
 
 import (
 	"bytes"
+	"context"
+	"errors"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
 	"testing"
+
+	"github.com/virus-evolution/gofasta/internal/pipeline"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/summary"
 )
 
 func TestSNPs(t *testing.T) {
@@ -23,7 +33,7 @@ ATTTTW
 
 	out := new(bytes.Buffer)
 
-	err := SNPs(ref, query, false, false, 0.0, out)
+	err := SNPs(context.Background(), ref, query, out, Options{})
 	if err != nil {
 		t.Error(err)
 	}
@@ -55,7 +65,7 @@ ATTTTW
 
 	out := new(bytes.Buffer)
 
-	err := SNPs(ref, query, true, false, 0.0, out)
+	err := SNPs(context.Background(), ref, query, out, Options{HardGaps: true})
 	if err != nil {
 		t.Error(err)
 	}
@@ -89,7 +99,7 @@ ATTTTG
 
 	out := new(bytes.Buffer)
 
-	err := SNPs(ref, query, false, true, 0.0, out)
+	err := SNPs(context.Background(), ref, query, out, Options{Aggregate: true})
 	if err != nil {
 		t.Error(err)
 	}
@@ -126,7 +136,7 @@ ATTTTG
 
 	out := new(bytes.Buffer)
 
-	err := SNPs(ref, query, false, true, 0.26, out)
+	err := SNPs(context.Background(), ref, query, out, Options{Aggregate: true, Threshold: 0.26})
 	if err != nil {
 		t.Error(err)
 	}
@@ -140,3 +150,170 @@ A4T,0.500000000
 		t.Errorf("problem in TestSNPsAggregateThresh()")
 	}
 }
+
+func TestSuggest(t *testing.T) {
+	ref := bytes.NewReader([]byte(">ref\nATGATGCA\n"))
+	// the snps at 3 are all next to an N, those at 6 are mostly ambiguous, and 8 is mostly missing
+	query := bytes.NewReader([]byte(`>q1
+ATTNTGCN
+>q2
+ANTATGCN
+>q3
+ATGATRCN
+>q4
+ATGATRCT
+>q5
+ATGATCCT
+`))
+
+	out := new(bytes.Buffer)
+	o := DefaultSuggestOptions()
+	o.Flank = 1
+	if err := Suggest(context.Background(), ref, query, out, o); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "position,ref,carriers,near_n,ambiguous,missing,frequency,reason\n" +
+		"3,G,2,2,0,0,0.4000,near_n\n" +
+		"6,G,1,0,2,0,0.3333,ambiguous\n" +
+		"8,A,2,0,0,3,1.0000,missing\n"
+	if out.String() != want {
+		t.Errorf("problem in TestSuggest(): got\n%s", out.String())
+	}
+}
+
+func TestCall(t *testing.T) {
+	ref := bytes.NewReader([]byte(">ref\nATGATG\n"))
+	query := bytes.NewReader([]byte(">Query1\nATGATG\n>Query2\nATTTTW\n"))
+
+	records := make([]SNPRecord, 0)
+	err := Call(context.Background(), ref, query, false, func(SR SNPRecord) error {
+		records = append(records, SR)
+		return nil
+	})
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(records) != 2 || records[0].Query != "Query1" || len(records[0].SNPs) != 0 || records[1].Query != "Query2" {
+		t.Fatalf("problem in TestCall(): %v", records)
+	}
+	want := []SNP{{3, "G", "T"}, {4, "A", "T"}, {6, "G", "W"}}
+	if len(records[1].SNPs) != len(want) {
+		t.Fatalf("problem in TestCall(): %v", records[1].SNPs)
+	}
+	for i := range want {
+		if records[1].SNPs[i] != want[i] {
+			t.Errorf("problem in TestCall(): expected %v, got %v", want[i], records[1].SNPs[i])
+		}
+	}
+}
+
+func TestCallProcessed(t *testing.T) {
+	ref := bytes.NewReader([]byte(">ref\nATGATG\n"))
+	query := bytes.NewReader([]byte(">Query1\nATGATG\n>Query2\nATTTTW\n"))
+
+	summary.Reset()
+	if err := Call(context.Background(), ref, query, false, func(SR SNPRecord) error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+	// the reference isn't counted, only the alignment's records
+	if got := summary.Collect().RecordsProcessed; got != 2 {
+		t.Errorf("problem in TestCallProcessed(): %d records processed, expected 2", got)
+	}
+}
+
+func TestCallBlocks(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	random := func(n int) string {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = "ACGTACGTN-R"[r.Intn(11)]
+		}
+		return string(b)
+	}
+
+	// wrapped records with CRLF line endings, and an unwrapped one longer than the reader's buffer
+	sizes := map[int][]int{20: {1, 3, 7, 64}, 200000: {4096, defaultBlockSize}}
+	for _, width := range []int{20, 200000} {
+		refData := ">ref\n" + random(width) + "\n"
+		var sb strings.Builder
+		for i := 0; i < 5; i++ {
+			seq := random(width)
+			sb.WriteString(">q" + strconv.Itoa(i) + " description\r\n")
+			for len(seq) > 7 && width < 100 {
+				sb.WriteString(seq[:7] + "\r\n")
+				seq = seq[7:]
+			}
+			sb.WriteString(seq + "\r\n")
+		}
+
+		collect := func(ctx context.Context, size int) string {
+			var out strings.Builder
+			err := call(ctx, strings.NewReader(refData), strings.NewReader(sb.String()), false, size, func(SR SNPRecord) error {
+				return writeRecord(&out, SR)
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return out.String()
+		}
+
+		want := collect(context.Background(), width)
+		if strings.Count(want, "\n") != 5 || !strings.HasPrefix(want, "q0,") {
+			t.Fatalf("problem in TestCallBlocks(): %q", want)
+		}
+		sorted := func(s string) string {
+			lines := strings.Split(s, "\n")
+			sort.Strings(lines)
+			return strings.Join(lines, "\n")
+		}
+		for _, size := range sizes[width] {
+			if got := collect(context.Background(), size); got != want {
+				t.Errorf("problem in TestCallBlocks(): width %d, block size %d doesn't match the whole record", width, size)
+			}
+			// unordered, the records can come in any order, but each one's blocks still have to be put back together
+			if got := collect(pipeline.Unordered(context.Background()), size); sorted(got) != sorted(want) {
+				t.Errorf("problem in TestCallBlocks(): width %d, block size %d, unordered doesn't match the whole records", width, size)
+			}
+		}
+	}
+
+	for _, query := range []string{">q\nACGTA\n", ">q\nACG\n", ">q\nAC!T\n", "ACGT\n", ""} {
+		err := call(context.Background(), strings.NewReader(">ref\nACGT\n"), strings.NewReader(query), false, 2, func(SR SNPRecord) error { return nil })
+		if err == nil {
+			t.Errorf("problem in TestCallBlocks(): expected an error for %q", query)
+		}
+	}
+}
+
+func TestCallCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	query := strings.Repeat(">q\nATGATC\n", 1000)
+	n := 0
+	err := Call(ctx, strings.NewReader(">ref\nATGATG\n"), strings.NewReader(query), false, func(SR SNPRecord) error {
+		n++
+		if n == 10 {
+			cancel()
+		}
+		return nil
+	})
+	if err != context.Canceled {
+		t.Errorf("problem in TestCallCancelled(): expected context.Canceled, got %v", err)
+	}
+	if n >= 1000 {
+		t.Errorf("problem in TestCallCancelled(): every record was called after cancelling")
+	}
+}
+
+func TestCallRecordErrors(t *testing.T) {
+	err := Call(context.Background(), strings.NewReader(">ref\nACGT\n"), strings.NewReader(">q1\nACGT\n>q2\nAC\nX\n"), false, func(SR SNPRecord) error { return nil })
+	if !errors.Is(err, fastaio.ErrInvalidNucleotide) || err.Error() != `record q2 (line 5, position 3): invalid nucleotide in fasta file ("X")` {
+		t.Errorf("problem in TestCallRecordErrors(): %v", err)
+	}
+
+	err = Call(context.Background(), strings.NewReader(">ref\nACGT\n"), strings.NewReader(">q1\nACGT\n>q2\nACG\n"), false, func(SR SNPRecord) error { return nil })
+	if err == nil || err.Error() != "record q2 (line 3): the reference sequence is 4 bases, but this record is 3" {
+		t.Errorf("problem in TestCallRecordErrors(): %v", err)
+	}
+}
@@ -0,0 +1,137 @@
+package snps
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/encoding"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+// SuggestOptions controls which sites are suggested for masking. A site is only considered if at least
+// MinRecords records have an snp or an ambiguity code there. It is suggested if at least NearN of the records with an
+// unambiguous snp there have an N within Flank bases of it, if at least MaxAmbiguous of the records with data
+// there have an ambiguity code (other than N), or if at least MaxMissing of all the records have N there
+type SuggestOptions struct {
+	Flank        int
+	NearN        float64
+	MaxAmbiguous float64
+	MaxMissing   float64
+	MinRecords   int
+}
+
+// DefaultSuggestOptions returns options that flag the commonest patterns of artefactual calls in amplicon data
+func DefaultSuggestOptions() SuggestOptions {
+	return SuggestOptions{Flank: 5, NearN: 0.8, MaxAmbiguous: 0.1, MaxMissing: 0.5, MinRecords: 2}
+}
+
+// siteCounts are the tallies at one alignment column: unambiguous snps (and how many of them are near an N),
+// ambiguity codes, unambiguous bases of any kind, and N
+type siteCounts struct {
+	carriers  int
+	nearN     int
+	ambiguous int
+	called    int
+	missing   int
+}
+
+// isN is true for N and ?
+func isN(nuc byte) bool {
+	return nuc == 240 || nuc == 242
+}
+
+// nearN is true if there is an N within flank bases either side of position i of seq
+func nearN(seq []byte, i, flank int) bool {
+	for j := i - flank; j <= i+flank; j++ {
+		if j < 0 || j >= len(seq) || j == i {
+			continue
+		}
+		if isN(seq[j]) {
+			return true
+		}
+	}
+	return false
+}
+
+// Suggest tallies the snps in every record of alignment relative to ref, and writes the sites whose calls look
+// artefactual (see SuggestOptions) as CSV with the columns position,ref,carriers,near_n,ambiguous,missing,frequency,reason,
+// which gofasta mask can read. frequency is the proportion of records with an unambiguous base at the site that
+// have an unambiguous snp there, and reason is a "|"-delimited list of near_n, ambiguous and missing
+func Suggest(ctx context.Context, ref, alignment io.Reader, w io.Writer, o SuggestOptions) error {
+
+	refs, err := fastaio.ReadEncodeAlignmentToListUncounted(ref, false)
+	if err != nil {
+		return err
+	}
+	if len(refs) != 1 {
+		return errors.New("there must be exactly one record in --reference")
+	}
+	refSeq := refs[0].Seq
+
+	sites := make([]siteCounts, len(refSeq))
+	records := 0
+
+	err = fastaio.EachEncodedRecord(ctx, alignment, false, func(FR fastaio.EncodedFastaRecord) error {
+		if len(FR.Seq) != len(refSeq) {
+			rl := strconv.Itoa(len(refSeq))
+			ql := strconv.Itoa(len(FR.Seq))
+			return errors.New("Reference sequence (" + rl + " bases) and " + FR.ID + " (" + ql + " bases) are different lengths")
+		}
+		records++
+		for i, nuc := range FR.Seq {
+			switch {
+			case isN(nuc):
+				sites[i].missing++
+			case nuc&8 == 8:
+				sites[i].called++
+				if refSeq[i]&nuc < 16 {
+					sites[i].carriers++
+					if nearN(FR.Seq, i, o.Flank) {
+						sites[i].nearN++
+					}
+				}
+			case nuc&7 == 0:
+				sites[i].ambiguous++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	DA := encoding.MakeDecodingArray()
+
+	var sb strings.Builder
+	sb.WriteString("position,ref,carriers,near_n,ambiguous,missing,frequency,reason\n")
+	for i, s := range sites {
+		if s.carriers+s.ambiguous < o.MinRecords {
+			continue
+		}
+		reasons := make([]string, 0)
+		if s.carriers > 0 && float64(s.nearN)/float64(s.carriers) >= o.NearN {
+			reasons = append(reasons, "near_n")
+		}
+		if s.called+s.ambiguous > 0 && float64(s.ambiguous)/float64(s.called+s.ambiguous) >= o.MaxAmbiguous {
+			reasons = append(reasons, "ambiguous")
+		}
+		if float64(s.missing)/float64(records) >= o.MaxMissing {
+			reasons = append(reasons, "missing")
+		}
+		if len(reasons) == 0 {
+			continue
+		}
+		frequency := ""
+		if s.called > 0 {
+			frequency = strconv.FormatFloat(float64(s.carriers)/float64(s.called), 'f', 4, 64)
+		}
+		sb.WriteString(strconv.Itoa(i+1) + "," + DA[refSeq[i]] + "," + strconv.Itoa(s.carriers) + "," + strconv.Itoa(s.nearN) + "," +
+			strconv.Itoa(s.ambiguous) + "," + strconv.Itoa(s.missing) + "," + frequency + "," + strings.Join(reasons, "|") + "\n")
+	}
+
+	_, err = w.Write([]byte(sb.String()))
+	return err
+}
@@ -0,0 +1,174 @@
+/*
+Package spectrum implements mutation spectra: the counts of each of the twelve
+types of nucleotide substitution between the records of an alignment and its
+reference, optionally in the context of the neighbouring reference bases, so
+that signatures of mutational processes such as APOBEC (C>T) or ADAR (A>G and
+T>C) editing can be seen.
+*/
+package spectrum
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+const bases = "ACGT"
+
+var index = func() [256]int {
+	var a [256]int
+	for i := range a {
+		a[i] = -1
+	}
+	for i := 0; i < 4; i++ {
+		a[bases[i]] = i
+		a[bases[i]+'a'-'A'] = i
+	}
+	return a
+}()
+
+// Spectrum holds substitution counts, indexed by [5' base][reference base][alternative base][3' base] with
+// 4 for a flanking base that isn't an unambiguous nucleotide (or is off the end of the reference)
+type Spectrum [5][4][4][5]int
+
+// Add counts the substitutions between seq and ref, which must be the same length, at sites where both have an
+// unambiguous nucleotide
+func (s *Spectrum) Add(seq, ref string) {
+	for i := 0; i < len(seq); i++ {
+		r, a := index[ref[i]], index[seq[i]]
+		if r < 0 || a < 0 || r == a {
+			continue
+		}
+		five, three := 4, 4
+		if i > 0 && index[ref[i-1]] >= 0 {
+			five = index[ref[i-1]]
+		}
+		if i+1 < len(ref) && index[ref[i+1]] >= 0 {
+			three = index[ref[i+1]]
+		}
+		s[five][r][a][three]++
+	}
+}
+
+// Count returns the number of ref>alt substitutions, summed over every context
+func (s *Spectrum) Count(ref, alt byte) int {
+	r, a := index[ref], index[alt]
+	if r < 0 || a < 0 {
+		return 0
+	}
+	n := 0
+	for five := 0; five < 5; five++ {
+		for three := 0; three < 5; three++ {
+			n += s[five][r][a][three]
+		}
+	}
+	return n
+}
+
+// Total returns the number of substitutions
+func (s *Spectrum) Total() int {
+	n := 0
+	for r := 0; r < 4; r++ {
+		for a := 0; a < 4; a++ {
+			n += s.Count(bases[r], bases[a])
+		}
+	}
+	return n
+}
+
+// row is one line of output: a substitution type (possibly in context) and its count
+type row struct {
+	name  string
+	count int
+}
+
+// rows returns the counts of every substitution type, in the order A>C, A>G, A>T, C>A, ... With context,
+// every type is split by its flanking reference bases (as 5'[ref>alt]3'), with N for a flank that isn't an
+// unambiguous nucleotide
+func (s *Spectrum) rows(context bool) []row {
+	flank := bases + "N"
+	rs := make([]row, 0, 12*25)
+	for r := 0; r < 4; r++ {
+		for a := 0; a < 4; a++ {
+			if r == a {
+				continue
+			}
+			sub := bases[r:r+1] + ">" + bases[a:a+1]
+			if !context {
+				rs = append(rs, row{name: sub, count: s.Count(bases[r], bases[a])})
+				continue
+			}
+			for five := 0; five < 5; five++ {
+				for three := 0; three < 5; three++ {
+					rs = append(rs, row{name: flank[five:five+1] + "[" + sub + "]" + flank[three:three+1], count: s[five][r][a][three]})
+				}
+			}
+		}
+	}
+	return rs
+}
+
+// proportion formats a/b, or returns an empty string if b is 0
+func proportion(a, b int) string {
+	if b == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(float64(a)/float64(b), 'f', 4, 64)
+}
+
+// Spectra counts the substitutions between every record in the alignment msa and ref. If out isn't nil, a
+// CSV file with the columns query,substitution,count,proportion is written to it, with a line for every
+// substitution type (including those with no count) for every record. If aggregateOut isn't nil, the counts
+// summed over every record are written to it, with the columns substitution,count,proportion. With inContext,
+// substitutions are split by their flanking reference bases
+func Spectra(ctx context.Context, msa io.Reader, ref string, out, aggregateOut io.Writer, inContext bool) error {
+
+	ref = strings.ToUpper(ref)
+
+	if out != nil {
+		if _, err := out.Write([]byte("query,substitution,count,proportion\n")); err != nil {
+			return err
+		}
+	}
+
+	var total Spectrum
+	err := fastaio.EachAlignedRecord(ctx, msa, func(FR fastaio.FastaRecord) error {
+		if len(FR.Seq) != len(ref) {
+			return errors.New(FR.ID + " is not the same length as the reference: are they aligned?")
+		}
+		var s Spectrum
+		s.Add(FR.Seq, ref)
+		total.Add(FR.Seq, ref)
+		if out == nil {
+			return nil
+		}
+		n := s.Total()
+		var sb strings.Builder
+		for _, r := range s.rows(inContext) {
+			sb.WriteString(FR.ID + "," + r.name + "," + strconv.Itoa(r.count) + "," + proportion(r.count, n) + "\n")
+		}
+		_, err := out.Write([]byte(sb.String()))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if aggregateOut == nil {
+		return nil
+	}
+
+	n := total.Total()
+	var sb strings.Builder
+	sb.WriteString("substitution,count,proportion\n")
+	for _, r := range total.rows(inContext) {
+		sb.WriteString(r.name + "," + strconv.Itoa(r.count) + "," + proportion(r.count, n) + "\n")
+	}
+	_, err = aggregateOut.Write([]byte(sb.String()))
+
+	return err
+}
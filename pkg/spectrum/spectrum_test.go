@@ -0,0 +1,51 @@
+package spectrum
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestAdd(t *testing.T) {
+	var s Spectrum
+	s.Add("TTGANT-A", "ACGATTGC")
+	// A>T at 1, C>T at 2 and C>A at 8; the N and the gap are ignored
+	if s.Count('A', 'T') != 1 || s.Count('C', 'T') != 1 || s.Count('C', 'A') != 1 || s.Total() != 3 {
+		t.Errorf("problem in TestAdd(): got %v", s)
+	}
+	// the C>T at 2 is in the context A[C>T]G, and the A>T at 1 has no 5' base
+	if s[0][1][3][2] != 1 || s[4][0][3][1] != 1 || s[2][1][0][4] != 1 {
+		t.Errorf("problem in TestAdd(): wrong contexts")
+	}
+}
+
+func TestSpectra(t *testing.T) {
+	msa := ">a\nATGT\n>b\nACAT\n"
+	out, agg := new(bytes.Buffer), new(bytes.Buffer)
+	if err := Spectra(context.Background(), strings.NewReader(msa), "ACGT", out, agg, false); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(out.String(), "\n")
+	if len(lines) != 1+24+1 || lines[0] != "query,substitution,count,proportion" || lines[1] != "a,A>C,0,0.0000" {
+		t.Errorf("problem in TestSpectra(): got\n%s", out.String())
+	}
+	if !strings.Contains(out.String(), "a,C>T,1,1.0000\n") || !strings.Contains(out.String(), "b,G>A,1,1.0000\n") {
+		t.Errorf("problem in TestSpectra(): got\n%s", out.String())
+	}
+	if !strings.Contains(agg.String(), "C>T,1,0.5000\n") || !strings.Contains(agg.String(), "G>A,1,0.5000\n") || !strings.HasPrefix(agg.String(), "substitution,count,proportion\nA>C,0,0.0000\n") {
+		t.Errorf("problem in TestSpectra(): got\n%s", agg.String())
+	}
+
+	agg.Reset()
+	if err := Spectra(context.Background(), strings.NewReader(msa), "ACGT", nil, agg, true); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Count(agg.String(), "\n") != 1+12*25 || !strings.Contains(agg.String(), "A[C>T]G,1,0.5000\n") || !strings.Contains(agg.String(), "C[G>A]T,1,0.5000\n") {
+		t.Errorf("problem in TestSpectra(): got\n%s", agg.String())
+	}
+
+	if err := Spectra(context.Background(), strings.NewReader(msa), "ACG", nil, agg, false); err == nil {
+		t.Errorf("problem in TestSpectra(): expected an error for a reference of a different length")
+	}
+}
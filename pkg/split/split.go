@@ -0,0 +1,237 @@
+/*
+Package split implements routines to divide a fasta file into several
+smaller ones: into a fixed number of files, into chunks of a fixed number of
+records or of a maximum size, or by the values in a metadata column.
+*/
+package split
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/gfio"
+	"github.com/virus-evolution/gofasta/pkg/metadata"
+	"github.com/virus-evolution/gofasta/pkg/summary"
+)
+
+// Template is an output filename template. "{n}" is replaced with the 1-based number of the
+// output file, and "{value}" with the metadata value of the records in it
+type Template string
+
+// Name returns the filename for output file number n with metadata value value
+func (t Template) Name(n int, value string) string {
+	s := strings.ReplaceAll(string(t), "{n}", strconv.Itoa(n))
+	return strings.ReplaceAll(s, "{value}", sanitise(value))
+}
+
+// sanitise makes a metadata value safe to use as part of a filename
+func sanitise(s string) string {
+	if s == "" {
+		return "NA"
+	}
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', ':', '*', '?', '"', '<', '>', '|', ' ', '\t':
+			return '_'
+		}
+		return r
+	}, s)
+}
+
+// recordSize is the number of bytes that writeRecord uses for FR
+func recordSize(FR fastaio.FastaRecord) int64 {
+	return int64(len(FR.Description) + len(FR.Seq) + 3)
+}
+
+func writeRecord(w io.Writer, FR fastaio.FastaRecord) error {
+	_, err := w.Write([]byte(">" + FR.Description + "\n" + FR.Seq + "\n"))
+	return err
+}
+
+// outputs keeps track of the files that have been opened, keyed by output filename
+type outputs struct {
+	files map[string]*gfio.Output
+	order []string
+}
+
+func newOutputs() *outputs {
+	return &outputs{files: make(map[string]*gfio.Output)}
+}
+
+// get returns the open file called name, creating it if this is the first time it has been asked for
+func (o *outputs) get(name string) (*gfio.Output, error) {
+	if f, ok := o.files[name]; ok {
+		return f, nil
+	}
+	f, err := gfio.OpenOutPath(name)
+	if err != nil {
+		return nil, err
+	}
+	o.files[name] = f
+	o.order = append(o.order, name)
+	return f, nil
+}
+
+// close closes the file called name, if it is open
+func (o *outputs) close(name string) error {
+	f, ok := o.files[name]
+	if !ok {
+		return nil
+	}
+	delete(o.files, name)
+	return f.Close()
+}
+
+func (o *outputs) closeAll() error {
+	var first error
+	for _, name := range o.order {
+		if err := o.close(name); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// split passes every record in in to choose, which returns the filename it should be written to and whether
+// the file written to before it is finished with and can be closed. An empty filename means the record is skipped.
+// It returns the names of the files that were written
+func split(ctx context.Context, in io.Reader, choose func(fastaio.FastaRecord) (string, bool, error)) ([]string, error) {
+
+	o := newOutputs()
+
+	var previous string
+	err := fastaio.EachRecord(ctx, in, func(FR fastaio.FastaRecord) error {
+		name, closePrevious, err := choose(FR)
+		if err != nil {
+			return err
+		}
+		if name == "" {
+			return nil
+		}
+		if closePrevious && previous != "" && previous != name {
+			if err = o.close(previous); err != nil {
+				return err
+			}
+		}
+		f, err := o.get(name)
+		if err != nil {
+			return err
+		}
+		if err = writeRecord(f, FR); err != nil {
+			return err
+		}
+		previous = name
+		return nil
+	})
+	if err != nil {
+		o.closeAll()
+		return nil, err
+	}
+
+	return o.order, o.closeAll()
+}
+
+// IntoFiles divides the records in in between n files, in turn, so that the files differ in size by at most one record.
+// The input only needs to be read once, but the records in each output file are not contiguous in the input
+func IntoFiles(ctx context.Context, in io.Reader, n int, tmpl Template) ([]string, error) {
+	if n < 1 {
+		return nil, errors.New("number of files must be at least 1")
+	}
+	i := 0
+	return split(ctx, in, func(FR fastaio.FastaRecord) (string, bool, error) {
+		name := tmpl.Name(i%n+1, "")
+		i++
+		return name, false, nil
+	})
+}
+
+// ByRecords writes the records in in to files of k records each (the last file may have fewer)
+func ByRecords(ctx context.Context, in io.Reader, k int, tmpl Template) ([]string, error) {
+	if k < 1 {
+		return nil, errors.New("number of records per file must be at least 1")
+	}
+	i := 0
+	return split(ctx, in, func(FR fastaio.FastaRecord) (string, bool, error) {
+		name := tmpl.Name(i/k+1, "")
+		i++
+		return name, true, nil
+	})
+}
+
+// BySize writes the records in in to files whose size is at most maxBytes. A record that is larger
+// than maxBytes on its own is written to a file by itself
+func BySize(ctx context.Context, in io.Reader, maxBytes int64, tmpl Template) ([]string, error) {
+	if maxBytes < 1 {
+		return nil, errors.New("maximum file size must be at least 1 byte")
+	}
+	n := 1
+	var size int64
+	return split(ctx, in, func(FR fastaio.FastaRecord) (string, bool, error) {
+		s := recordSize(FR)
+		if size > 0 && size+s > maxBytes {
+			n++
+			size = 0
+		}
+		size += s
+		return tmpl.Name(n, ""), true, nil
+	})
+}
+
+// ByMetadata writes the records in in to one file per value of column in the metadata table. Records that
+// aren't in the table are written to the file for the value missing, or are skipped if missing is empty
+func ByMetadata(ctx context.Context, in io.Reader, table metadata.Table, column string, missing string, tmpl Template) ([]string, error) {
+	if !table.HasColumn(column) {
+		return nil, errors.New("no column called " + column + " in metadata")
+	}
+	if !strings.Contains(string(tmpl), "{value}") {
+		return nil, errors.New("output template must contain {value} when splitting by metadata")
+	}
+
+	numbers := make(map[string]int)
+
+	return split(ctx, in, func(FR fastaio.FastaRecord) (string, bool, error) {
+		value, ok := table.Get(FR.ID, column)
+		if !ok {
+			if missing == "" {
+				os.Stderr.WriteString("no metadata for " + FR.ID + ", skipping it\n")
+				summary.Skipped(FR.ID, "no metadata")
+				return "", false, nil
+			}
+			value = missing
+		}
+		if _, ok := numbers[value]; !ok {
+			numbers[value] = len(numbers) + 1
+		}
+		return tmpl.Name(numbers[value], value), false, nil
+	})
+}
+
+// ParseSize parses a file size like "500", "64K", "10M" or "2G" (powers of 1024) as a number of bytes
+func ParseSize(s string) (int64, error) {
+	s = strings.TrimSpace(strings.ToUpper(s))
+	s = strings.TrimSuffix(s, "B")
+	mult := int64(1)
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'K':
+			mult = 1 << 10
+		case 'M':
+			mult = 1 << 20
+		case 'G':
+			mult = 1 << 30
+		}
+		if mult > 1 {
+			s = s[:len(s)-1]
+		}
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, errors.New("couldn't parse file size: " + s)
+	}
+	return n * mult, nil
+}
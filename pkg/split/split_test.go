@@ -0,0 +1,117 @@
+package split
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/metadata"
+)
+
+var testFasta = `>seq1 first
+ATGATG
+>seq2
+ATGATGATG
+>seq3
+ATG
+>seq4
+ATGA
+>seq5
+AT
+`
+
+func readFiles(t *testing.T, names []string) []string {
+	contents := make([]string, len(names))
+	for i, name := range names {
+		b, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		contents[i] = string(b)
+	}
+	return contents
+}
+
+func TestIntoFiles(t *testing.T) {
+	dir := t.TempDir()
+	names, err := IntoFiles(context.Background(), strings.NewReader(testFasta), 2, Template(filepath.Join(dir, "part_{n}.fasta")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 2 {
+		t.Fatalf("problem in TestIntoFiles(): %v", names)
+	}
+	got := readFiles(t, names)
+	if got[0] != ">seq1 first\nATGATG\n>seq3\nATG\n>seq5\nAT\n" || got[1] != ">seq2\nATGATGATG\n>seq4\nATGA\n" {
+		t.Errorf("problem in TestIntoFiles(): %v", got)
+	}
+}
+
+func TestByRecords(t *testing.T) {
+	dir := t.TempDir()
+	names, err := ByRecords(context.Background(), strings.NewReader(testFasta), 2, Template(filepath.Join(dir, "part_{n}.fasta")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := readFiles(t, names)
+	if len(got) != 3 || got[0] != ">seq1 first\nATGATG\n>seq2\nATGATGATG\n" || got[2] != ">seq5\nAT\n" {
+		t.Errorf("problem in TestByRecords(): %v", got)
+	}
+}
+
+func TestBySize(t *testing.T) {
+	dir := t.TempDir()
+	// record sizes are 20, 16, 10, 11, 9
+	names, err := BySize(context.Background(), strings.NewReader(testFasta), 21, Template(filepath.Join(dir, "part_{n}.fasta")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := readFiles(t, names)
+	if len(got) != 4 || got[2] != ">seq3\nATG\n>seq4\nATGA\n" || got[3] != ">seq5\nAT\n" {
+		t.Errorf("problem in TestBySize(): %v", got)
+	}
+}
+
+func TestByMetadata(t *testing.T) {
+	dir := t.TempDir()
+	table, err := metadata.Read(strings.NewReader("name,lineage\nseq1,B.1\nseq2,A\nseq3,B.1\nseq5,A\n"), ',', "name")
+	if err != nil {
+		t.Fatal(err)
+	}
+	names, err := ByMetadata(context.Background(), strings.NewReader(testFasta), table, "lineage", "", Template(filepath.Join(dir, "{value}.fasta")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 2 || filepath.Base(names[0]) != "B.1.fasta" || filepath.Base(names[1]) != "A.fasta" {
+		t.Fatalf("problem in TestByMetadata(): %v", names)
+	}
+	got := readFiles(t, names)
+	if got[0] != ">seq1 first\nATGATG\n>seq3\nATG\n" || got[1] != ">seq2\nATGATGATG\n>seq5\nAT\n" {
+		t.Errorf("problem in TestByMetadata(): %v", got)
+	}
+
+	_, err = ByMetadata(context.Background(), strings.NewReader(testFasta), table, "lineage", "", Template(filepath.Join(dir, "part_{n}.fasta")))
+	if err == nil {
+		t.Errorf("expected an error for a template without {value}")
+	}
+}
+
+func TestTemplate(t *testing.T) {
+	if Template("{value}_{n}.fa").Name(3, "B.1/x") != "B.1_x_3.fa" {
+		t.Errorf("problem in TestTemplate()")
+	}
+}
+
+func TestParseSize(t *testing.T) {
+	for s, want := range map[string]int64{"500": 500, "64K": 65536, "10M": 10485760, "1gb": 1073741824} {
+		got, err := ParseSize(s)
+		if err != nil || got != want {
+			t.Errorf("problem in TestParseSize(): %s: %d %v", s, got, err)
+		}
+	}
+	if _, err := ParseSize("lots"); err == nil {
+		t.Errorf("expected an error in TestParseSize()")
+	}
+}
@@ -0,0 +1,240 @@
+/*
+Package stats implements routines to summarise the records in a fasta
+file: their length and base composition, and for alignments, the number of
+variable columns.
+*/
+package stats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/schema"
+)
+
+// Record holds the statistics for one sequence
+type Record struct {
+	Name        string  `json:"name"`
+	Length      int     `json:"length"`
+	A           int     `json:"A"`
+	C           int     `json:"C"`
+	G           int     `json:"G"`
+	T           int     `json:"T"`
+	N           int     `json:"N"`
+	Gaps        int     `json:"gaps"`
+	Ambiguous   int     `json:"ambiguous"`
+	GC          float64 `json:"gc_percent"`
+	LongestNRun int     `json:"longest_n_run"`
+}
+
+// Alignment holds the statistics for the whole file. Width and VariableColumns are only
+// meaningful if Aligned is true
+type Alignment struct {
+	Records         int  `json:"records"`
+	Aligned         bool `json:"aligned"`
+	MinLength       int  `json:"min_length"`
+	MaxLength       int  `json:"max_length"`
+	Width           int  `json:"width,omitempty"`
+	VariableColumns int  `json:"variable_columns,omitempty"`
+}
+
+// RecordStats returns the statistics for one sequence. Ambiguous counts IUPAC ambiguity codes other than N.
+// GC is the percentage of unambiguous bases that are G or C
+func RecordStats(FR fastaio.FastaRecord) Record {
+	r := Record{Name: FR.ID, Length: len(FR.Seq)}
+	run := 0
+	for i := 0; i < len(FR.Seq); i++ {
+		c := FR.Seq[i]
+		if c == 'N' {
+			r.N++
+			run++
+			if run > r.LongestNRun {
+				r.LongestNRun = run
+			}
+			continue
+		}
+		run = 0
+		switch c {
+		case 'A':
+			r.A++
+		case 'C':
+			r.C++
+		case 'G':
+			r.G++
+		case 'T', 'U':
+			r.T++
+		case '-':
+			r.Gaps++
+		case 'R', 'Y', 'S', 'W', 'K', 'M', 'B', 'D', 'H', 'V', '?':
+			r.Ambiguous++
+		}
+	}
+	if acgt := r.A + r.C + r.G + r.T; acgt > 0 {
+		r.GC = 100 * float64(r.G+r.C) / float64(acgt)
+	}
+	return r
+}
+
+// baseBits returns a bit for each unambiguous nucleotide, and 0 for anything else
+func baseBits(c byte) byte {
+	switch c {
+	case 'A':
+		return 1
+	case 'C':
+		return 2
+	case 'G':
+		return 4
+	case 'T', 'U':
+		return 8
+	}
+	return 0
+}
+
+// columns keeps track of which unambiguous nucleotides have been seen in each column of an alignment
+type columns struct {
+	seen    []byte
+	aligned bool
+}
+
+func (c *columns) add(seq string) {
+	if !c.aligned {
+		return
+	}
+	if c.seen == nil {
+		c.seen = make([]byte, len(seq))
+	}
+	if len(seq) != len(c.seen) {
+		c.aligned = false
+		c.seen = nil
+		return
+	}
+	for i := 0; i < len(seq); i++ {
+		c.seen[i] |= baseBits(seq[i])
+	}
+}
+
+// variable returns the number of columns in which more than one unambiguous nucleotide was seen
+func (c *columns) variable() int {
+	n := 0
+	for _, b := range c.seen {
+		if b&(b-1) != 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// Stats calculates the statistics for every record in in, and for the file as a whole. If perRecord is not
+// nil, it is called with each record's statistics in input order
+func Stats(ctx context.Context, in io.Reader, perRecord func(Record) error) (Alignment, error) {
+
+	a := Alignment{}
+	cols := columns{aligned: true}
+
+	err := fastaio.EachRecord(ctx, in, func(FR fastaio.FastaRecord) error {
+		l := len(FR.Seq)
+		if a.Records == 0 || l < a.MinLength {
+			a.MinLength = l
+		}
+		if l > a.MaxLength {
+			a.MaxLength = l
+		}
+		a.Records++
+		cols.add(FR.Seq)
+		if perRecord != nil {
+			return perRecord(RecordStats(FR))
+		}
+		return nil
+	})
+	if err != nil {
+		return Alignment{}, err
+	}
+
+	if a.Records > 0 && cols.aligned {
+		a.Aligned = true
+		a.Width = a.MinLength
+		a.VariableColumns = cols.variable()
+	}
+
+	return a, nil
+}
+
+func (r Record) csvRow() string {
+	return r.Name + "," + strconv.Itoa(r.Length) + "," + strconv.Itoa(r.A) + "," + strconv.Itoa(r.C) + "," +
+		strconv.Itoa(r.G) + "," + strconv.Itoa(r.T) + "," + strconv.Itoa(r.N) + "," + strconv.Itoa(r.Gaps) + "," +
+		strconv.Itoa(r.Ambiguous) + "," + strconv.FormatFloat(r.GC, 'f', 2, 64) + "," + strconv.Itoa(r.LongestNRun) + "\n"
+}
+
+// WriteCSV writes the per-record statistics for in to out as CSV, and the alignment-level statistics to
+// summaryOut (if it is not nil) as a two-column CSV of stat,value
+func WriteCSV(ctx context.Context, in io.Reader, out io.Writer, summaryOut io.Writer) error {
+
+	if _, err := out.Write([]byte(schema.Stats.HeaderLine())); err != nil {
+		return err
+	}
+
+	a, err := Stats(ctx, in, func(r Record) error {
+		_, err := out.Write([]byte(r.csvRow()))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if summaryOut == nil {
+		return nil
+	}
+
+	rows := schema.StatsAlignment.HeaderLine() +
+		"records," + strconv.Itoa(a.Records) + "\n" +
+		"aligned," + strconv.FormatBool(a.Aligned) + "\n" +
+		"min_length," + strconv.Itoa(a.MinLength) + "\n" +
+		"max_length," + strconv.Itoa(a.MaxLength) + "\n"
+	if a.Aligned {
+		rows += "width," + strconv.Itoa(a.Width) + "\n" +
+			"variable_columns," + strconv.Itoa(a.VariableColumns) + "\n"
+	}
+	_, err = summaryOut.Write([]byte(rows))
+
+	return err
+}
+
+// WriteJSON writes the per-record and alignment-level statistics for in to out as one JSON object,
+// with the keys "records" and "alignment"
+func WriteJSON(ctx context.Context, in io.Reader, out io.Writer) error {
+
+	records := make([]Record, 0)
+	a, err := Stats(ctx, in, func(r Record) error {
+		records = append(records, r)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(struct {
+		Records   []Record  `json:"records"`
+		Alignment Alignment `json:"alignment"`
+	}{records, a})
+}
+
+// Write writes the statistics for in to out in format, which is one of "csv" or "json"
+func Write(ctx context.Context, in io.Reader, out io.Writer, summaryOut io.Writer, format string) error {
+	switch format {
+	case "csv":
+		return WriteCSV(ctx, in, out, summaryOut)
+	case "json":
+		if summaryOut != nil {
+			return errors.New("the alignment summary is part of the JSON output, so there is no separate summary file")
+		}
+		return WriteJSON(ctx, in, out)
+	}
+	return errors.New("unknown format: " + format + " (choose one of csv or json)")
+}
@@ -0,0 +1,54 @@
+package stats
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+func TestRecordStats(t *testing.T) {
+	r := RecordStats(fastaio.FastaRecord{ID: "seq1", Seq: "ATGCNNN-RNNNNGC"})
+	want := Record{Name: "seq1", Length: 15, A: 1, C: 2, G: 2, T: 1, N: 7, Gaps: 1, Ambiguous: 1, GC: 100 * 4.0 / 6.0, LongestNRun: 4}
+	if r != want {
+		t.Errorf("problem in TestRecordStats(): got %+v, want %+v", r, want)
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	in := ">seq1\nATGC\n>seq2\nATNC\n>seq3\nACGC\n"
+	var out, summaryOut bytes.Buffer
+	err := WriteCSV(context.Background(), strings.NewReader(in), &out, &summaryOut)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(out.String(), "\n")
+	if lines[2] != "seq2,4,1,1,0,1,1,0,0,33.33,1" {
+		t.Errorf("problem in TestWriteCSV(): %s", lines[2])
+	}
+	if summaryOut.String() != "stat,value\nrecords,3\naligned,true\nmin_length,4\nmax_length,4\nwidth,4\nvariable_columns,1\n" {
+		t.Errorf("problem in TestWriteCSV() summary: %s", summaryOut.String())
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	in := ">seq1\nATGC\n>seq2\nATG\n"
+	var out bytes.Buffer
+	err := WriteJSON(context.Background(), strings.NewReader(in), &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got struct {
+		Records   []Record
+		Alignment Alignment
+	}
+	if err := json.Unmarshal(out.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Records) != 2 || got.Alignment.Aligned || got.Alignment.MinLength != 3 || got.Alignment.MaxLength != 4 {
+		t.Errorf("problem in TestWriteJSON(): %s", out.String())
+	}
+}
@@ -0,0 +1,13 @@
+//go:build !(linux || darwin || freebsd || netbsd || openbsd)
+
+package summary
+
+import "runtime"
+
+// resourceUsage falls back to the Go runtime's view of memory where getrusage isn't available.
+// CPU time isn't reported
+func resourceUsage() (float64, int64) {
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	return 0, int64(m.Sys)
+}
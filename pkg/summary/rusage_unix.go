@@ -0,0 +1,25 @@
+//go:build linux || darwin || freebsd || netbsd || openbsd
+
+package summary
+
+import (
+	"runtime"
+	"syscall"
+)
+
+// resourceUsage returns the user+system CPU time and the peak resident set size of this process
+func resourceUsage() (float64, int64) {
+	var ru syscall.Rusage
+	if err := syscall.Getrusage(syscall.RUSAGE_SELF, &ru); err != nil {
+		return 0, 0
+	}
+	cpu := float64(ru.Utime.Sec+ru.Stime.Sec) + float64(ru.Utime.Usec+ru.Stime.Usec)/1e6
+
+	// Maxrss is in bytes on darwin, and kilobytes elsewhere
+	peak := int64(ru.Maxrss)
+	if runtime.GOOS != "darwin" {
+		peak *= 1024
+	}
+
+	return cpu, peak
+}
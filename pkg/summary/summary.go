@@ -0,0 +1,222 @@
+/*
+Package summary collects counts of records processed and skipped, and any warnings, over the course
+of one run of gofasta, so that they can be written out as a machine-readable summary at the end.
+
+The parsing and processing layers report each record they leave out, and anything else that is wrong but
+not fatal, here rather than dropping it silently. Programs that embed gofasta can also have each of these
+notices sent to a channel as it happens, with Notify.
+*/
+package summary
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxListed is the most skipped records that are listed by name, and the most warnings that are kept, in a
+// Summary. The counts are always complete
+const maxListed = 1000
+
+// maxShown is the most warnings that WriteWarnings writes out in full
+const maxShown = 10
+
+// Notice is one non-fatal issue: a record that was left out of the output, and why, or a warning (with Skipped
+// false), which may or may not be about one record
+type Notice struct {
+	Record  string `json:"record,omitempty"`
+	Message string `json:"message"`
+	Skipped bool   `json:"-"`
+}
+
+// Summary is the machine-readable record of one run
+type Summary struct {
+	Command          string           `json:"command"`
+	Args             []string         `json:"args"`
+	Success          bool             `json:"success"`
+	Error            string           `json:"error,omitempty"`
+	Seed             *int64           `json:"seed,omitempty"`
+	RecordsProcessed int64            `json:"records_processed"`
+	RecordsSkipped   int64            `json:"records_skipped"`
+	SkipReasons      map[string]int64 `json:"skip_reasons"`
+	SkippedRecords   []Notice         `json:"skipped_records"`
+	Warnings         []string         `json:"warnings"`
+	WarningsTotal    int64            `json:"warnings_total"`
+	WallSeconds      float64          `json:"wall_seconds"`
+	CPUSeconds       float64          `json:"cpu_seconds"`
+	PeakMemoryBytes  int64            `json:"peak_memory_bytes"`
+}
+
+// collector is the package-level accumulator that the processing layers report to
+type collector struct {
+	mu        sync.Mutex
+	start     time.Time
+	processed int64
+	skipped   int64
+	reasons   map[string]int64
+	listed    []Notice
+	warnings  []string
+	warned    int64
+	notify    map[chan<- Notice]bool
+}
+
+var c = newCollector()
+
+func newCollector() *collector {
+	return &collector{start: time.Now(), reasons: make(map[string]int64), listed: make([]Notice, 0), warnings: make([]string, 0), notify: make(map[chan<- Notice]bool)}
+}
+
+// Reset clears all counts and restarts the wall clock
+func Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.start = time.Now()
+	c.processed = 0
+	c.skipped = 0
+	c.reasons = make(map[string]int64)
+	c.listed = make([]Notice, 0)
+	c.warnings = make([]string, 0)
+	c.warned = 0
+}
+
+// Notify sends every notice from now on to ch, as well as counting it, until Stop is called with ch. Sending
+// doesn't block: if ch isn't ready a notice isn't sent to it (though it is still counted), so ch should be
+// buffered and read promptly
+func Notify(ch chan<- Notice) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.notify[ch] = true
+}
+
+// Stop stops sending notices to ch. None are sent to it once Stop has returned
+func Stop(ch chan<- Notice) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.notify, ch)
+}
+
+// send passes n to every channel given to Notify. c.mu must be held
+func send(n Notice) {
+	for ch := range c.notify {
+		select {
+		case ch <- n:
+		default:
+		}
+	}
+}
+
+// Processed records that n more records have been read
+func Processed(n int) {
+	c.mu.Lock()
+	c.processed += int64(n)
+	c.mu.Unlock()
+}
+
+// Skipped records that the record called id has been left out of the output, and why
+func Skipped(id, reason string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.skipped++
+	c.reasons[reason]++
+	n := Notice{Record: id, Message: reason, Skipped: true}
+	if len(c.listed) < maxListed {
+		c.listed = append(c.listed, n)
+	}
+	send(n)
+}
+
+// Warn records a non-fatal warning. The first maxListed are kept, and the rest are only counted. Warnings are
+// written out at the end of the run by WriteWarnings, so there is no need to print them as well
+func Warn(msg string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.warned++
+	if len(c.warnings) < maxListed {
+		c.warnings = append(c.warnings, msg)
+	}
+	send(Notice{Message: msg})
+}
+
+// Collect returns the current state of the run as a Summary
+func Collect() Summary {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s := Summary{
+		RecordsProcessed: c.processed,
+		RecordsSkipped:   c.skipped,
+		SkipReasons:      make(map[string]int64, len(c.reasons)),
+		SkippedRecords:   append([]Notice{}, c.listed...),
+		Warnings:         append([]string{}, c.warnings...),
+		WarningsTotal:    c.warned,
+		WallSeconds:      time.Since(c.start).Seconds(),
+	}
+	for k, v := range c.reasons {
+		s.SkipReasons[k] = v
+	}
+	s.CPUSeconds, s.PeakMemoryBytes = resourceUsage()
+
+	return s
+}
+
+// WriteWarnings writes a short account of the records s skipped and the warnings it has (the first few in full)
+// to w, for a person to read at the end of a run, or nothing if there are none
+func (s Summary) WriteWarnings(w io.Writer) error {
+	var sb strings.Builder
+	if s.RecordsSkipped > 0 {
+		reasons := make([]string, 0, len(s.SkipReasons))
+		for reason := range s.SkipReasons {
+			reasons = append(reasons, reason)
+		}
+		sort.Slice(reasons, func(i, j int) bool {
+			if s.SkipReasons[reasons[i]] != s.SkipReasons[reasons[j]] {
+				return s.SkipReasons[reasons[i]] > s.SkipReasons[reasons[j]]
+			}
+			return reasons[i] < reasons[j]
+		})
+		for i, reason := range reasons {
+			reasons[i] = reason + ": " + strconv.FormatInt(s.SkipReasons[reason], 10)
+		}
+		sb.WriteString(plural(s.RecordsSkipped, "record was", "records were") + " skipped (" + strings.Join(reasons, ", ") + ")\n")
+	}
+	total := s.WarningsTotal
+	if total < int64(len(s.Warnings)) {
+		total = int64(len(s.Warnings))
+	}
+	if total > 0 {
+		sb.WriteString(plural(total, "warning was", "warnings were") + " raised:\n")
+		shown := s.Warnings
+		if len(shown) > maxShown {
+			shown = shown[:maxShown]
+		}
+		for _, msg := range shown {
+			sb.WriteString("  " + msg + "\n")
+		}
+		if total > int64(len(shown)) {
+			sb.WriteString("  ... and " + strconv.FormatInt(total-int64(len(shown)), 10) + " more\n")
+		}
+	}
+	if sb.Len() == 0 {
+		return nil
+	}
+	_, err := w.Write([]byte(sb.String()))
+	return err
+}
+
+func plural(n int64, one, many string) string {
+	if n == 1 {
+		return "1 " + one
+	}
+	return strconv.FormatInt(n, 10) + " " + many
+}
+
+// Write writes s to w as indented JSON
+func (s Summary) Write(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(s)
+}
@@ -0,0 +1,113 @@
+package summary
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestCollect(t *testing.T) {
+	Reset()
+
+	Processed(3)
+	Processed(2)
+	Skipped("r1", "unmapped read")
+	Skipped("r2", "unmapped read")
+	Skipped("r3", "secondary mapping")
+	Warn("something odd")
+
+	s := Collect()
+
+	if s.RecordsProcessed != 5 {
+		t.Errorf("expected 5 records processed, got %d", s.RecordsProcessed)
+	}
+	if s.RecordsSkipped != 3 || s.SkipReasons["unmapped read"] != 2 || s.SkipReasons["secondary mapping"] != 1 {
+		t.Errorf("problem with skipped records in TestCollect(): %v", s.SkipReasons)
+	}
+	if len(s.SkippedRecords) != 3 || s.SkippedRecords[2] != (Notice{Record: "r3", Message: "secondary mapping", Skipped: true}) {
+		t.Errorf("problem with skipped records in TestCollect(): %v", s.SkippedRecords)
+	}
+	if len(s.Warnings) != 1 || s.Warnings[0] != "something odd" {
+		t.Errorf("problem with warnings in TestCollect(): %v", s.Warnings)
+	}
+
+	warnings := new(bytes.Buffer)
+	if err := s.WriteWarnings(warnings); err != nil {
+		t.Error(err)
+	}
+	if warnings.String() != "3 records were skipped (unmapped read: 2, secondary mapping: 1)\n1 warning was raised:\n  something odd\n" {
+		t.Errorf("problem with WriteWarnings() in TestCollect(): %s", warnings.String())
+	}
+
+	out := new(bytes.Buffer)
+	if err := s.Write(out); err != nil {
+		t.Error(err)
+	}
+	var back Summary
+	if err := json.Unmarshal(out.Bytes(), &back); err != nil {
+		t.Error(err)
+	}
+	if back.RecordsProcessed != 5 {
+		t.Errorf("problem round-tripping summary JSON")
+	}
+
+	Reset()
+	if Collect().RecordsProcessed != 0 {
+		t.Errorf("Reset() didn't clear the counts")
+	}
+	warnings.Reset()
+	Collect().WriteWarnings(warnings)
+	if warnings.Len() != 0 {
+		t.Errorf("problem with WriteWarnings() in TestCollect(): %s", warnings.String())
+	}
+}
+
+func TestNotify(t *testing.T) {
+	Reset()
+
+	ch := make(chan Notice, 2)
+	Notify(ch)
+	Skipped("r1", "too short")
+	Warn("something odd")
+	// the channel is full, so this one is only counted
+	Skipped("r2", "too short")
+	Stop(ch)
+	Skipped("r3", "too short")
+
+	close(ch)
+	notices := make([]Notice, 0)
+	for n := range ch {
+		notices = append(notices, n)
+	}
+	if len(notices) != 2 || notices[0] != (Notice{Record: "r1", Message: "too short", Skipped: true}) || notices[1] != (Notice{Message: "something odd"}) {
+		t.Errorf("problem in TestNotify(): %v", notices)
+	}
+	if Collect().RecordsSkipped != 3 {
+		t.Errorf("problem in TestNotify(): %d records skipped", Collect().RecordsSkipped)
+	}
+}
+
+func TestWarnCapped(t *testing.T) {
+	Reset()
+	defer Reset()
+
+	for i := 0; i < maxListed+5; i++ {
+		Warn("warning " + strconv.Itoa(i))
+	}
+
+	s := Collect()
+	if len(s.Warnings) != maxListed || s.WarningsTotal != maxListed+5 {
+		t.Errorf("problem in TestWarnCapped(): %d warnings kept of %d", len(s.Warnings), s.WarningsTotal)
+	}
+
+	warnings := new(bytes.Buffer)
+	if err := s.WriteWarnings(warnings); err != nil {
+		t.Error(err)
+	}
+	if !strings.HasPrefix(warnings.String(), strconv.Itoa(maxListed+5)+" warnings were raised:\n") ||
+		!strings.HasSuffix(warnings.String(), "  ... and "+strconv.Itoa(maxListed+5-maxShown)+" more\n") {
+		t.Errorf("problem in TestWarnCapped(): %s", warnings.String())
+	}
+}
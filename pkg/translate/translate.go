@@ -0,0 +1,102 @@
+/*
+Package translate implements routines to translate nucleotide sequences in
+fasta format to protein, either in a fixed reading frame or per gene using
+an annotation.
+*/
+package translate
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/alphabet"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+// InFrame returns the translation of seq starting at (1-based) frame, optionally from the reverse strand.
+// A trailing partial codon is ignored
+func InFrame(seq string, frame int, reverse bool, CD map[string]string) (string, error) {
+	if frame < 1 || frame > 3 {
+		return "", errors.New("reading frame must be 1, 2 or 3")
+	}
+	if reverse {
+		seq = alphabet.ReverseComplement(seq)
+	}
+	if len(seq) < frame {
+		return "", nil
+	}
+	return alphabet.TranslateWithCode(seq[frame-1:], false, CD)
+}
+
+// Region returns the translation of one protein-coding region of seq, which must be in the same
+// coordinates as the annotation that the region came from
+func Region(seq string, region variants.Region, CD map[string]string) (string, error) {
+	var sb strings.Builder
+	sb.Grow(len(region.Positions))
+	for _, p := range region.Positions {
+		if p > len(seq) {
+			return "", errors.New("position " + strconv.Itoa(p) + " in " + region.Name + " is beyond the end of the sequence")
+		}
+		sb.WriteByte(seq[p-1])
+	}
+	nuc := sb.String()
+	if region.Strand == -1 {
+		nuc = alphabet.Complement(nuc)
+	}
+	return alphabet.TranslateWithCode(nuc, false, CD)
+}
+
+// Translate writes the translation of every record in the fasta file in to out, in the given reading frame
+// and strand, using NCBI translation table number table
+func Translate(ctx context.Context, in io.Reader, out io.Writer, frame int, reverse bool, table int) error {
+
+	CD, err := alphabet.MakeCodonDictFromTable(table)
+	if err != nil {
+		return err
+	}
+
+	return fastaio.EachRecord(ctx, in, func(FR fastaio.FastaRecord) error {
+		aa, err := InFrame(FR.Seq, frame, reverse, CD)
+		if err != nil {
+			return err
+		}
+		_, err = out.Write([]byte(">" + FR.Description + "\n" + aa + "\n"))
+		return err
+	})
+}
+
+// TranslateRegions writes the translation of every protein-coding region in regions, for every record in
+// the fasta file in, to the corresponding writer in outs. The records must be in the annotation's coordinates
+// (e.g. the output of gofasta sam toMultiAlign), so must all be refLen long
+func TranslateRegions(ctx context.Context, in io.Reader, regions []variants.Region, refLen int, outs []io.Writer, table int) error {
+
+	if len(regions) != len(outs) {
+		return errors.New("need one output per region")
+	}
+
+	CD, err := alphabet.MakeCodonDictFromTable(table)
+	if err != nil {
+		return err
+	}
+
+	return fastaio.EachRecord(ctx, in, func(FR fastaio.FastaRecord) error {
+		if len(FR.Seq) != refLen {
+			return errors.New(FR.ID + " (" + strconv.Itoa(len(FR.Seq)) + " bases) is not the same length as the annotation's reference (" + strconv.Itoa(refLen) + " bases)")
+		}
+		for i, region := range regions {
+			aa, err := Region(FR.Seq, region, CD)
+			if err != nil {
+				return err
+			}
+			_, err = outs[i].Write([]byte(">" + FR.ID + "\n" + aa + "\n"))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,75 @@
+package translate
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+var fastaData = []byte(`>seq1 desc
+ATGAAATGACC
+>seq2
+CATTTCAT
+`)
+
+func TestTranslate(t *testing.T) {
+	out := new(bytes.Buffer)
+	err := Translate(context.Background(), bytes.NewReader(fastaData), out, 1, false, 1)
+	if err != nil {
+		t.Error(err)
+	}
+	if out.String() != ">seq1 desc\nMK*\n>seq2\nHF\n" {
+		t.Errorf("problem in TestTranslate(): %s", out.String())
+	}
+
+	out = new(bytes.Buffer)
+	err = Translate(context.Background(), bytes.NewReader(fastaData), out, 1, true, 1)
+	if err != nil {
+		t.Error(err)
+	}
+	if out.String() != ">seq1 desc\nGHF\n>seq2\nMK\n" {
+		t.Errorf("problem in TestTranslate() reverse: %s", out.String())
+	}
+
+	out = new(bytes.Buffer)
+	err = Translate(context.Background(), bytes.NewReader(fastaData), out, 2, false, 2)
+	if err != nil {
+		t.Error(err)
+	}
+	// frame 2 of seq1 is TGA AAT GAC C; TGA is W in the vertebrate mitochondrial code
+	if out.String() != ">seq1 desc\nWND\n>seq2\nIS\n" {
+		t.Errorf("problem in TestTranslate() frame 2: %s", out.String())
+	}
+}
+
+func TestTranslateRegions(t *testing.T) {
+	msa := []byte(`>seq1
+ATGAAATTTCAT
+>seq2
+ATGAAGTTTCAT
+`)
+	regions := []variants.Region{
+		{Name: "fwd", Strand: 1, Positions: []int{1, 2, 3, 4, 5, 6}},
+		{Name: "rev", Strand: -1, Positions: []int{12, 11, 10, 9, 8, 7}},
+	}
+	fwd := new(bytes.Buffer)
+	rev := new(bytes.Buffer)
+	err := TranslateRegions(context.Background(), bytes.NewReader(msa), regions, 12, []io.Writer{fwd, rev}, 1)
+	if err != nil {
+		t.Error(err)
+	}
+	if fwd.String() != ">seq1\nMK\n>seq2\nMK\n" {
+		t.Errorf("problem in TestTranslateRegions(): %s", fwd.String())
+	}
+	if rev.String() != ">seq1\nMK\n>seq2\nMK\n" {
+		t.Errorf("problem in TestTranslateRegions() reverse: %s", rev.String())
+	}
+
+	err = TranslateRegions(context.Background(), bytes.NewReader(msa), regions, 13, []io.Writer{fwd, rev}, 1)
+	if err == nil {
+		t.Errorf("expected an error for the wrong reference length")
+	}
+}
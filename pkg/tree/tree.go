@@ -0,0 +1,204 @@
+/*
+Package tree implements distance-based phylogenetic tree building by
+neighbour-joining (Saitou & Nei, 1987) and BIONJ (Gascuel, 1997), and
+writing trees in Newick format.
+*/
+package tree
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/distance"
+)
+
+// Node is a node in an unrooted tree. Leaves have a Name and no Children. Lengths[i] is the length of
+// the branch to Children[i]
+type Node struct {
+	Name     string
+	Children []*Node
+	Lengths  []float64
+}
+
+// toFloat converts a matrix of integer distances to float64s
+func toFloat(m distance.Matrix) [][]float64 {
+	D := make([][]float64, len(m.D))
+	for i := range m.D {
+		D[i] = make([]float64, len(m.D[i]))
+		for j := range m.D[i] {
+			D[i][j] = float64(m.D[i][j])
+		}
+	}
+	return D
+}
+
+// nonNegative returns l, or 0 if l is negative. Neighbour-joining can give slightly negative branch
+// lengths, which aren't meaningful
+func nonNegative(l float64) float64 {
+	if l < 0 {
+		return 0
+	}
+	return l
+}
+
+// Build makes a tree from a distance matrix. If bionj is true, the BIONJ variance-weighted update is used
+// to calculate the distances to new nodes, otherwise they are the NJ average. It stops and returns ctx's error
+// if ctx is cancelled
+func Build(ctx context.Context, m distance.Matrix, bionj bool) (*Node, error) {
+
+	n := len(m.Names)
+	if n == 0 {
+		return nil, errors.New("can't build a tree with no sequences")
+	}
+
+	D := toFloat(m)
+	var V [][]float64
+	if bionj {
+		V = toFloat(m)
+	}
+
+	nodes := make([]*Node, n)
+	for i, name := range m.Names {
+		nodes[i] = &Node{Name: name}
+	}
+
+	switch n {
+	case 1:
+		return nodes[0], nil
+	case 2:
+		return &Node{Children: nodes, Lengths: []float64{D[0][1] / 2, D[0][1] / 2}}, nil
+	}
+
+	// the rows/columns of D that are still in use
+	active := make([]int, n)
+	for i := range active {
+		active[i] = i
+	}
+
+	S := make([]float64, n)
+
+	for r := n; r > 3; r-- {
+
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		for _, i := range active {
+			S[i] = 0
+			for _, k := range active {
+				S[i] += D[i][k]
+			}
+		}
+
+		// find the pair that minimises the Q-criterion
+		bi, bj := -1, -1
+		var best float64
+		for a, i := range active {
+			for _, j := range active[a+1:] {
+				q := float64(r-2)*D[i][j] - S[i] - S[j]
+				if bi == -1 || q < best {
+					best, bi, bj = q, i, j
+				}
+			}
+		}
+		i, j := bi, bj
+
+		li := 0.5 * (D[i][j] + (S[i]-S[j])/float64(r-2))
+		lj := D[i][j] - li
+
+		lambda := 0.5
+		if bionj {
+			if V[i][j] > 0 {
+				sum := 0.0
+				for _, k := range active {
+					if k != i && k != j {
+						sum += V[j][k] - V[i][k]
+					}
+				}
+				lambda = 0.5 + sum/(2*float64(r-2)*V[i][j])
+			}
+			if lambda < 0 {
+				lambda = 0
+			} else if lambda > 1 {
+				lambda = 1
+			}
+		}
+
+		// the new node takes the place of i, and j is removed
+		for _, k := range active {
+			if k == i || k == j {
+				continue
+			}
+			dk := lambda*(D[i][k]-li) + (1-lambda)*(D[j][k]-lj)
+			D[i][k], D[k][i] = dk, dk
+			if bionj {
+				vk := lambda*V[i][k] + (1-lambda)*V[j][k] - lambda*(1-lambda)*V[i][j]
+				V[i][k], V[k][i] = vk, vk
+			}
+		}
+		D[i][i] = 0
+
+		nodes[i] = &Node{Children: []*Node{nodes[i], nodes[j]}, Lengths: []float64{nonNegative(li), nonNegative(lj)}}
+		nodes[j] = nil
+
+		for a, k := range active {
+			if k == j {
+				active = append(active[:a], active[a+1:]...)
+				break
+			}
+		}
+	}
+
+	// join the last three at a central node
+	i, j, k := active[0], active[1], active[2]
+	li := (D[i][j] + D[i][k] - D[j][k]) / 2
+	lj := D[i][j] - li
+	lk := D[i][k] - li
+
+	return &Node{
+		Children: []*Node{nodes[i], nodes[j], nodes[k]},
+		Lengths:  []float64{nonNegative(li), nonNegative(lj), nonNegative(lk)},
+	}, nil
+}
+
+// quoteName quotes a leaf name if it has characters that are special in Newick
+func quoteName(name string) string {
+	if !strings.ContainsAny(name, " \t()[]',:;") {
+		return name
+	}
+	return "'" + strings.ReplaceAll(name, "'", "''") + "'"
+}
+
+func (nd *Node) newick(sb *strings.Builder) {
+	if len(nd.Children) == 0 {
+		sb.WriteString(quoteName(nd.Name))
+		return
+	}
+	sb.WriteByte('(')
+	for c, child := range nd.Children {
+		if c > 0 {
+			sb.WriteByte(',')
+		}
+		child.newick(sb)
+		sb.WriteByte(':')
+		sb.WriteString(strconv.FormatFloat(nd.Lengths[c], 'g', -1, 64))
+	}
+	sb.WriteByte(')')
+}
+
+// Newick returns the tree in Newick format
+func (nd *Node) Newick() string {
+	var sb strings.Builder
+	nd.newick(&sb)
+	sb.WriteString(";")
+	return sb.String()
+}
+
+// WriteNewick writes the tree to w in Newick format, followed by a newline
+func (nd *Node) WriteNewick(w io.Writer) error {
+	_, err := w.Write([]byte(nd.Newick() + "\n"))
+	return err
+}
@@ -0,0 +1,48 @@
+package tree
+
+import (
+	"context"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/distance"
+)
+
+func TestBuild(t *testing.T) {
+	// the additive matrix from the neighbour-joining article on Wikipedia
+	m := distance.Matrix{
+		Names: []string{"a", "b", "c", "d", "e"},
+		D: [][]int{
+			{0, 5, 9, 9, 8},
+			{5, 0, 10, 10, 9},
+			{9, 10, 0, 8, 7},
+			{9, 10, 8, 0, 3},
+			{8, 9, 7, 3, 0},
+		},
+	}
+
+	for _, bionj := range []bool{false, true} {
+		tr, err := Build(context.Background(), m, bionj)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// the matrix is additive, so both methods recover the tree exactly
+		want := "(((a:2,b:3):3,c:4):2,d:2,e:1);"
+		if got := tr.Newick(); got != want {
+			t.Errorf("problem in TestBuild() (bionj %v): got %s, want %s", bionj, got, want)
+		}
+	}
+}
+
+func TestBuildSmall(t *testing.T) {
+	tr, _ := Build(context.Background(), distance.Matrix{Names: []string{"a"}, D: [][]int{{0}}}, false)
+	if tr.Newick() != "a;" {
+		t.Errorf("problem in TestBuildSmall(): %s", tr.Newick())
+	}
+	tr, _ = Build(context.Background(), distance.Matrix{Names: []string{"a", "b c"}, D: [][]int{{0, 3}, {3, 0}}}, false)
+	if tr.Newick() != "(a:1.5,'b c':1.5);" {
+		t.Errorf("problem in TestBuildSmall(): %s", tr.Newick())
+	}
+	if _, err := Build(context.Background(), distance.Matrix{}, false); err == nil {
+		t.Errorf("expected an error for an empty matrix")
+	}
+}
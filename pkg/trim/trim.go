@@ -0,0 +1,59 @@
+/*
+Package trim implements routines to cut an alignment down to a range of
+columns, optionally keeping its width by masking the removed columns with Ns.
+*/
+package trim
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+// Apply trims one sequence to the 1-based, inclusive range start..end. If pad is true, the sites outside
+// the range are replaced with Ns instead of being removed
+func Apply(seq string, start, end int, pad bool) string {
+	if !pad {
+		return seq[start-1 : end]
+	}
+	return strings.Repeat("N", start-1) + seq[start-1:end] + strings.Repeat("N", len(seq)-end)
+}
+
+// checkRange checks that start..end is a valid range in an alignment of width columns
+func checkRange(width, start, end int) error {
+	if start < 1 || start > width {
+		return errors.New("--start must be between 1 and the alignment width (" + strconv.Itoa(width) + ")")
+	}
+	if end < 1 || end > width {
+		return errors.New("--end must be between 1 and the alignment width (" + strconv.Itoa(width) + ")")
+	}
+	if start > end {
+		return errors.New("--start must be <= --end")
+	}
+	return nil
+}
+
+// Trim trims every record in the alignment msa to the 1-based, inclusive range start..end and writes them to out.
+// If end is -1, the range continues to the end of the alignment. If pad is true, the sites outside the range are
+// replaced with Ns instead of being removed, as in sam toMultiAlign --pad
+func Trim(ctx context.Context, msa io.Reader, out io.Writer, start, end int, pad bool) error {
+
+	first := true
+	return fastaio.EachAlignedRecord(ctx, msa, func(FR fastaio.FastaRecord) error {
+		if first {
+			if end == -1 {
+				end = len(FR.Seq)
+			}
+			if err := checkRange(len(FR.Seq), start, end); err != nil {
+				return err
+			}
+			first = false
+		}
+		_, err := out.Write([]byte(">" + FR.Description + "\n" + Apply(FR.Seq, start, end, pad) + "\n"))
+		return err
+	})
+}
@@ -0,0 +1,46 @@
+package trim
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestTrim(t *testing.T) {
+	msa := ">seq1 first\nATGATGCC\n>seq2\nATGCTGCA\n"
+
+	var out bytes.Buffer
+	err := Trim(context.Background(), strings.NewReader(msa), &out, 2, 5, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != ">seq1 first\nTGAT\n>seq2\nTGCT\n" {
+		t.Errorf("problem in TestTrim(): %s", out.String())
+	}
+
+	out.Reset()
+	err = Trim(context.Background(), strings.NewReader(msa), &out, 2, 5, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != ">seq1 first\nNTGATNNN\n>seq2\nNTGCTNNN\n" {
+		t.Errorf("problem in TestTrim() with pad: %s", out.String())
+	}
+
+	out.Reset()
+	err = Trim(context.Background(), strings.NewReader(msa), &out, 7, -1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != ">seq1 first\nCC\n>seq2\nCA\n" {
+		t.Errorf("problem in TestTrim() to the end: %s", out.String())
+	}
+
+	for _, r := range [][2]int{{0, 4}, {2, 9}, {5, 4}} {
+		err = Trim(context.Background(), strings.NewReader(msa), &out, r[0], r[1], false)
+		if err == nil {
+			t.Errorf("expected an error in TestTrim() for range %v", r)
+		}
+	}
+}
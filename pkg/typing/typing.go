@@ -0,0 +1,357 @@
+/*
+Package typing implements rule-based genotyping of aligned sequences
+against definitions made of lists of mutations, in the manner of scorpio
+constellations.
+
+A definition is a JSON object like:
+
+	{
+	  "name": "B.1.1.7",
+	  "sites": ["nuc:C3267T", "aa:S:N501Y", "del:21765:6"],
+	  "rules": {"min_alt": 2, "max_ref": 1}
+	}
+
+Sites use the same notation as the output of gofasta variants. By default
+every site must have the alternative allele and none may have the reference.
+*/
+package typing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/alphabet"
+	"github.com/virus-evolution/gofasta/pkg/encoding"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+// Site is one mutation in a definition
+type Site struct {
+	Text     string // as written in the definition
+	Type     string // "nuc", "aa" or "del"
+	Feature  string // the CDS, for "aa"
+	Position int    // the 1-based nucleotide position, or the residue, for "aa"
+	Length   int    // the number of deleted bases, for "del"
+	Ref      string
+	Alt      string
+}
+
+// Rules say how many of a definition's sites must have the alternative allele, and how many may
+// have the reference allele, for the definition to be satisfied
+type Rules struct {
+	MinAlt *int `json:"min_alt"`
+	MaxRef *int `json:"max_ref"`
+}
+
+// Definition is a named set of mutations
+type Definition struct {
+	Name   string
+	Sites  []Site
+	MinAlt int
+	MaxRef int
+}
+
+var nucRegex = regexp.MustCompile(`^nuc:([ACGTacgt])(\d+)([ACGTacgt])$`)
+var aaRegex = regexp.MustCompile(`^aa:([^:]+):([A-Z*])(\d+)([A-Z*])$`)
+var delRegex = regexp.MustCompile(`^del:(\d+):(\d+)$`)
+
+// ParseSite parses one mutation, in the form nuc:C241T, aa:S:D614G or del:21765:6
+func ParseSite(s string) (Site, error) {
+	s = strings.TrimSpace(s)
+	if m := nucRegex.FindStringSubmatch(s); m != nil {
+		pos, _ := strconv.Atoi(m[2])
+		return Site{Text: s, Type: "nuc", Position: pos, Ref: strings.ToUpper(m[1]), Alt: strings.ToUpper(m[3])}, nil
+	}
+	if m := aaRegex.FindStringSubmatch(s); m != nil {
+		pos, _ := strconv.Atoi(m[3])
+		return Site{Text: s, Type: "aa", Feature: m[1], Position: pos, Ref: m[2], Alt: m[4]}, nil
+	}
+	if m := delRegex.FindStringSubmatch(s); m != nil {
+		pos, _ := strconv.Atoi(m[1])
+		l, _ := strconv.Atoi(m[2])
+		if l < 1 {
+			return Site{}, errors.New("bad deletion length in " + s)
+		}
+		return Site{Text: s, Type: "del", Position: pos, Length: l}, nil
+	}
+	return Site{}, errors.New("couldn't parse mutation: " + s)
+}
+
+// ReadDefinitions reads one definition, or a JSON array of them, from r
+func ReadDefinitions(r io.Reader) ([]Definition, error) {
+
+	type rawDefinition struct {
+		Name  string   `json:"name"`
+		Sites []string `json:"sites"`
+		Rules Rules    `json:"rules"`
+	}
+
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	raws := make([]rawDefinition, 0)
+	if trimmed := strings.TrimSpace(string(b)); strings.HasPrefix(trimmed, "[") {
+		err = json.Unmarshal(b, &raws)
+	} else {
+		var raw rawDefinition
+		err = json.Unmarshal(b, &raw)
+		raws = append(raws, raw)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	defs := make([]Definition, 0, len(raws))
+	for _, raw := range raws {
+		if raw.Name == "" {
+			return nil, errors.New("definition with no name")
+		}
+		if len(raw.Sites) == 0 {
+			return nil, errors.New("definition " + raw.Name + " has no sites")
+		}
+		d := Definition{Name: raw.Name, MinAlt: len(raw.Sites)}
+		for _, s := range raw.Sites {
+			site, err := ParseSite(s)
+			if err != nil {
+				return nil, errors.New(raw.Name + ": " + err.Error())
+			}
+			d.Sites = append(d.Sites, site)
+		}
+		if raw.Rules.MinAlt != nil {
+			d.MinAlt = *raw.Rules.MinAlt
+		}
+		if raw.Rules.MaxRef != nil {
+			d.MaxRef = *raw.Rules.MaxRef
+		}
+		defs = append(defs, d)
+	}
+
+	return defs, nil
+}
+
+// What a query has at a site
+const (
+	stateAlt = iota
+	stateRef
+	stateOther
+	stateMissing
+)
+
+// Typer calls definitions for aligned sequences in the coordinates of an annotation
+type Typer struct {
+	defs    []Definition
+	regions map[string]variants.Region
+	CD      map[string]string
+	RefLen  int
+}
+
+// NewTyper returns a Typer for defs. regions are the annotation's CDS, which are only needed if any
+// of the definitions have amino acid sites. If refLen is 0, it is taken from the first sequence typed
+func NewTyper(defs []Definition, regions []variants.Region, refLen int) (*Typer, error) {
+	t := &Typer{defs: defs, regions: make(map[string]variants.Region), CD: alphabet.MakeCodonDict()}
+	for _, r := range regions {
+		// keep the first CDS with each name
+		if _, ok := t.regions[r.Name]; !ok {
+			t.regions[r.Name] = r
+		}
+	}
+	for _, d := range defs {
+		for _, s := range d.Sites {
+			if s.Type == "aa" {
+				r, ok := t.regions[s.Feature]
+				if !ok {
+					return nil, errors.New(d.Name + ": couldn't find CDS " + s.Feature + " in the annotation")
+				}
+				if s.Position*3 > len(r.Positions) {
+					return nil, errors.New(d.Name + ": " + s.Text + " is beyond the end of " + s.Feature)
+				}
+			}
+		}
+	}
+	if refLen > 0 {
+		if err := t.setRefLen(refLen); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// setRefLen sets the length of the reference, and checks that every site is within it
+func (t *Typer) setRefLen(refLen int) error {
+	for _, d := range t.defs {
+		for _, s := range d.Sites {
+			end := s.Position
+			switch s.Type {
+			case "del":
+				end = s.Position + s.Length - 1
+			case "aa":
+				end = 0
+				for _, p := range t.regions[s.Feature].Positions {
+					if p > end {
+						end = p
+					}
+				}
+			}
+			if end > refLen {
+				return errors.New(d.Name + ": " + s.Text + " is beyond the end of the reference")
+			}
+		}
+	}
+	t.RefLen = refLen
+	return nil
+}
+
+// state returns what seq has at site
+func (t *Typer) state(seq string, site Site) int {
+	switch site.Type {
+	case "nuc":
+		c := seq[site.Position-1]
+		switch {
+		case !encoding.Certain(c):
+			return stateMissing
+		case c == site.Alt[0]:
+			return stateAlt
+		case c == site.Ref[0]:
+			return stateRef
+		}
+		return stateOther
+
+	case "del":
+		gaps, bases := 0, 0
+		for i := site.Position - 1; i < site.Position-1+site.Length; i++ {
+			if seq[i] == '-' {
+				gaps++
+			} else if encoding.Certain(seq[i]) {
+				bases++
+			}
+		}
+		switch {
+		case gaps == site.Length:
+			return stateAlt
+		case bases == site.Length:
+			return stateRef
+		case gaps > 0 && bases > 0:
+			return stateOther
+		}
+		return stateMissing
+
+	case "aa":
+		r := t.regions[site.Feature]
+		codon := make([]byte, 3)
+		for i := 0; i < 3; i++ {
+			codon[i] = seq[r.Positions[(site.Position-1)*3+i]-1]
+		}
+		nuc := string(codon)
+		if r.Strand == -1 {
+			nuc = alphabet.Complement(nuc)
+		}
+		aa, ok := t.CD[nuc]
+		if !ok || aa == "X" {
+			return stateMissing
+		}
+		switch aa {
+		case site.Alt:
+			return stateAlt
+		case site.Ref:
+			return stateRef
+		}
+		return stateOther
+	}
+
+	return stateMissing
+}
+
+// Call is the result of checking one definition against one query
+type Call struct {
+	Query      string
+	Definition string
+	Call       string // "satisfied", "partial" or "conflicted"
+	Alt        int
+	Ref        int
+	Other      int
+	Missing    int
+}
+
+// Proportion is the proportion of the definition's sites at which the query has the alternative allele
+func (c Call) Proportion() float64 {
+	n := c.Alt + c.Ref + c.Other + c.Missing
+	if n == 0 {
+		return 0
+	}
+	return float64(c.Alt) / float64(n)
+}
+
+// CallAll checks every definition against seq. A definition is satisfied if enough sites have the alternative
+// allele and few enough have the reference; conflicted if too many have the reference; and otherwise partial
+func (t *Typer) CallAll(name, seq string) ([]Call, error) {
+	if t.RefLen == 0 {
+		if err := t.setRefLen(len(seq)); err != nil {
+			return nil, err
+		}
+	}
+	if len(seq) != t.RefLen {
+		return nil, errors.New(name + " (" + strconv.Itoa(len(seq)) + " bases) is not the same length as the reference (" + strconv.Itoa(t.RefLen) + " bases)")
+	}
+	calls := make([]Call, len(t.defs))
+	for i, d := range t.defs {
+		c := Call{Query: name, Definition: d.Name}
+		for _, s := range d.Sites {
+			switch t.state(seq, s) {
+			case stateAlt:
+				c.Alt++
+			case stateRef:
+				c.Ref++
+			case stateOther:
+				c.Other++
+			default:
+				c.Missing++
+			}
+		}
+		switch {
+		case c.Ref > d.MaxRef:
+			c.Call = "conflicted"
+		case c.Alt >= d.MinAlt:
+			c.Call = "satisfied"
+		default:
+			c.Call = "partial"
+		}
+		calls[i] = c
+	}
+	return calls, nil
+}
+
+// Type calls every definition for every record in the alignment msa, and writes the results to out as CSV with
+// the columns query,definition,call,alt,ref,other,missing,proportion. If satisfiedOnly is true, only
+// satisfied definitions are written
+func (t *Typer) Type(ctx context.Context, msa io.Reader, out io.Writer, satisfiedOnly bool) error {
+
+	if _, err := out.Write([]byte("query,definition,call,alt,ref,other,missing,proportion\n")); err != nil {
+		return err
+	}
+
+	return fastaio.EachAlignedRecord(ctx, msa, func(FR fastaio.FastaRecord) error {
+		calls, err := t.CallAll(FR.ID, FR.Seq)
+		if err != nil {
+			return err
+		}
+		for _, c := range calls {
+			if satisfiedOnly && c.Call != "satisfied" {
+				continue
+			}
+			line := c.Query + "," + c.Definition + "," + c.Call + "," + strconv.Itoa(c.Alt) + "," + strconv.Itoa(c.Ref) + "," +
+				strconv.Itoa(c.Other) + "," + strconv.Itoa(c.Missing) + "," + strconv.FormatFloat(c.Proportion(), 'f', 3, 64) + "\n"
+			if _, err = out.Write([]byte(line)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,81 @@
+package typing
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/variants"
+)
+
+func TestParseSite(t *testing.T) {
+	s, err := ParseSite("nuc:C241T")
+	if err != nil || s.Type != "nuc" || s.Position != 241 || s.Ref != "C" || s.Alt != "T" {
+		t.Errorf("problem in TestParseSite(): %+v %v", s, err)
+	}
+	s, err = ParseSite("aa:S:D614G")
+	if err != nil || s.Type != "aa" || s.Feature != "S" || s.Position != 614 || s.Ref != "D" || s.Alt != "G" {
+		t.Errorf("problem in TestParseSite(): %+v %v", s, err)
+	}
+	s, err = ParseSite("del:21765:6")
+	if err != nil || s.Type != "del" || s.Position != 21765 || s.Length != 6 {
+		t.Errorf("problem in TestParseSite(): %+v %v", s, err)
+	}
+	if _, err = ParseSite("ins:1:2"); err == nil {
+		t.Errorf("expected an error in TestParseSite()")
+	}
+}
+
+func TestType(t *testing.T) {
+	defs := `[
+	{"name": "var1", "sites": ["nuc:A1T", "aa:gene1:M1L", "del:8:2"]},
+	{"name": "var2", "sites": ["nuc:A1T", "nuc:T3C"], "rules": {"min_alt": 1, "max_ref": 1}}
+]`
+	d, err := ReadDefinitions(strings.NewReader(defs))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// gene1 is ATGAAA at positions 2-7
+	regions := []variants.Region{{Name: "gene1", Start: 2, Stop: 7, Strand: 1, Positions: []int{2, 3, 4, 5, 6, 7}}}
+	typer, err := NewTyper(d, regions, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msa := ">q1\nTTTGAAA--C\n>q2\nTATGAAANNC\n>q3\nAATGAAAAAC\n"
+	var out bytes.Buffer
+	err = typer.Type(context.Background(), strings.NewReader(msa), &out, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "query,definition,call,alt,ref,other,missing,proportion\n" +
+		"q1,var1,satisfied,3,0,0,0,1.000\n" +
+		"q1,var2,satisfied,1,1,0,0,0.500\n" +
+		"q2,var1,conflicted,1,1,0,1,0.333\n" +
+		"q2,var2,satisfied,1,1,0,0,0.500\n" +
+		"q3,var1,conflicted,0,3,0,0,0.000\n" +
+		"q3,var2,conflicted,0,2,0,0,0.000\n"
+	if out.String() != want {
+		t.Errorf("problem in TestType(): got\n%s\nwant\n%s", out.String(), want)
+	}
+
+	if _, err = NewTyper(d, nil, 10); err == nil {
+		t.Errorf("expected an error in TestType() without the annotation")
+	}
+}
+
+func TestTypeNoRefLen(t *testing.T) {
+	d, err := ReadDefinitions(strings.NewReader(`{"name": "var1", "sites": ["nuc:A1T", "nuc:A12T"]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	typer, err := NewTyper(d, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = typer.CallAll("q1", "TTTGAAA--C"); err == nil {
+		t.Errorf("expected an error in TestTypeNoRefLen() for a site beyond the end of the sequence")
+	}
+}
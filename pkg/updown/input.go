@@ -282,7 +282,7 @@ func readFastaToUDLChan(target io.Reader, refSeq []byte, cudL chan updownLine, c
 
 	cudLsDone := make(chan bool)
 
-	go fastaio.ReadEncodeAlignment(target, false, cFR, cInternalErr, cFRDone)
+	go fastaio.ReadEncodeAlignmentUncounted(target, false, cFR, cInternalErr, cFRDone)
 
 	var wgudLs sync.WaitGroup
 	wgudLs.Add(runtime.NumCPU())
@@ -2,9 +2,7 @@ package updown
 
 import (
 	"errors"
-	"fmt"
 	"io"
-	"os"
 	"runtime"
 	"strconv"
 	"strings"
@@ -12,6 +10,7 @@ import (
 
 	"github.com/virus-evolution/gofasta/pkg/encoding"
 	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/summary"
 )
 
 // updownLine is a struct for one records snps relative to a reference sequence and ambiguity tracts.
@@ -27,23 +26,32 @@ type updownLine struct {
 	ambCount   int   // total number of sites that are not ATGC
 }
 
-// writeOutput writes the output to stdout or a file as it arrives.
-// It uses a map to write things in the same order as they are in the input file.
-func writeOutput(w io.Writer, cudLs chan updownLine, cErr chan error, cWriteDone chan bool) {
-
-	outputMap := make(map[int]updownLine)
+// Profile is one record's SNPs relative to the reference (e.g. G3T), in order of position, and the 1-based inclusive
+// start and end of each tract of sites that aren't A, C, G or T, with the number of those sites, as gofasta updown
+// list writes them
+type Profile struct {
+	Query          string   `json:"query"`
+	SNPs           []string `json:"snps"`
+	Ambiguities    [][2]int `json:"ambiguities"`
+	AmbiguityCount int      `json:"ambcount"`
+}
 
-	counter := 0
+// profile returns the public form of an updownLine
+func (udL updownLine) profile() Profile {
+	P := Profile{Query: udL.id, SNPs: udL.snps, Ambiguities: make([][2]int, 0, len(udL.ambs)/2), AmbiguityCount: udL.ambCount}
+	for i := 0; i < len(udL.ambs); i += 2 {
+		P.Ambiguities = append(P.Ambiguities, [2]int{udL.ambs[i], udL.ambs[i+1]})
+	}
+	return P
+}
 
-	var err error
+// deliver passes the profiles from a channel to f as they arrive, in the same order as they are in the input
+// file, using a map to hold those that arrive early
+func deliver(f func(Profile) error, cudLs chan updownLine, cErr chan error, cDone chan bool) {
 
-	_, err = w.Write([]byte("query,SNPs,ambiguities,SNPcount,ambcount\n"))
-	if err != nil {
-		cErr <- err
-		return
-	}
+	outputMap := make(map[int]updownLine)
 
-	var ambstrings []string
+	counter := 0
 
 	for udL := range cudLs {
 
@@ -51,16 +59,7 @@ func writeOutput(w io.Writer, cudLs chan updownLine, cErr chan error, cWriteDone
 
 		for {
 			if udLine, ok := outputMap[counter]; ok {
-				ambstrings = make([]string, 0)
-				for i := 0; i < len(udLine.ambs); i += 2 {
-					if udLine.ambs[i] == udLine.ambs[i+1] {
-						ambstrings = append(ambstrings, strconv.Itoa(udLine.ambs[i]))
-					} else {
-						ambstrings = append(ambstrings, strconv.Itoa(udLine.ambs[i])+"-"+strconv.Itoa(udLine.ambs[i+1]))
-					}
-				}
-				_, err := w.Write([]byte(udLine.id + "," + strings.Join(udLine.snps, "|") + "," + strings.Join(ambstrings, "|") + "," + strconv.Itoa(udLine.snpCount) + "," + strconv.Itoa(udLine.ambCount) + "\n"))
-				if err != nil {
+				if err := f(udLine.profile()); err != nil {
 					cErr <- err
 					return
 				}
@@ -73,13 +72,41 @@ func writeOutput(w io.Writer, cudLs chan updownLine, cErr chan error, cWriteDone
 
 	}
 
-	cWriteDone <- true
+	cDone <- true
+}
+
+// writeProfile writes one profile as a line of CSV
+func writeProfile(w io.Writer, P Profile) error {
+	ambstrings := make([]string, len(P.Ambiguities))
+	for i, tract := range P.Ambiguities {
+		if tract[0] == tract[1] {
+			ambstrings[i] = strconv.Itoa(tract[0])
+		} else {
+			ambstrings[i] = strconv.Itoa(tract[0]) + "-" + strconv.Itoa(tract[1])
+		}
+	}
+	_, err := w.Write([]byte(P.Query + "," + strings.Join(P.SNPs, "|") + "," + strings.Join(ambstrings, "|") + "," + strconv.Itoa(len(P.SNPs)) + "," + strconv.Itoa(P.AmbiguityCount) + "\n"))
+	return err
 }
 
 // List gets a list of ATGC SNPs with respect to reference + ambiguous sites for each query sequence in a fasta-format
 // alignment, and writes it to file
 func List(reference, alignment io.Reader, out io.Writer) error {
 
+	_, err := out.Write([]byte("query,SNPs,ambiguities,SNPcount,ambcount\n"))
+	if err != nil {
+		return err
+	}
+
+	return Profiles(reference, alignment, func(P Profile) error {
+		return writeProfile(out, P)
+	})
+}
+
+// Profiles gets the profile of each query sequence in a fasta-format alignment with respect to the reference, as List
+// does, and passes them to f in the order of the alignment
+func Profiles(reference, alignment io.Reader, f func(Profile) error) error {
+
 	cErr := make(chan error)
 
 	cFR := make(chan fastaio.EncodedFastaRecord, runtime.NumCPU()+50)
@@ -88,9 +115,9 @@ func List(reference, alignment io.Reader, out io.Writer) error {
 	cudLs := make(chan updownLine, runtime.NumCPU()+50)
 	cudLsDone := make(chan bool)
 
-	cWriteDone := make(chan bool)
+	cDeliverDone := make(chan bool)
 
-	temp, err := fastaio.ReadEncodeAlignmentToList(reference, false)
+	temp, err := fastaio.ReadEncodeAlignmentToListUncounted(reference, false)
 	if err != nil {
 		return err
 	}
@@ -104,14 +131,14 @@ func List(reference, alignment io.Reader, out io.Writer) error {
 	DA := encoding.MakeDecodingArray()
 	for _, nuc := range refSeq {
 		if !(nuc&8 == 8) {
-			fmt.Fprintf(os.Stderr, "Warning: there is at least one ambiguous nucleotide in the --reference sequence: %s. This isn't recommended\n", DA[nuc])
+			summary.Warn("ambiguous nucleotide in the --reference sequence: " + DA[nuc])
 			break
 		}
 	}
 
 	go fastaio.ReadEncodeAlignment(alignment, false, cFR, cErr, cFRDone)
 
-	go writeOutput(out, cudLs, cErr, cWriteDone)
+	go deliver(f, cudLs, cErr, cDeliverDone)
 
 	var wgudLs sync.WaitGroup
 	wgudLs.Add(runtime.NumCPU())
@@ -152,7 +179,7 @@ func List(reference, alignment io.Reader, out io.Writer) error {
 		select {
 		case err := <-cErr:
 			return err
-		case <-cWriteDone:
+		case <-cDeliverDone:
 			n--
 		}
 	}
@@ -2,7 +2,10 @@ package updown
 
 import (
 	"bytes"
+	"strings"
 	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/summary"
 )
 
 func TestList(t *testing.T) {
@@ -36,3 +39,37 @@ Target3,G3T|A4T,6,2,1
 		t.Errorf("problem in TestList()")
 	}
 }
+
+func TestProfiles(t *testing.T) {
+	ref := bytes.NewReader([]byte(">ref\nATGATG\n"))
+	query := bytes.NewReader([]byte(">Target1\nATGATG\n>Target3\nNNTTTW\n"))
+
+	profiles := make([]Profile, 0)
+	err := Profiles(ref, query, func(P Profile) error {
+		profiles = append(profiles, P)
+		return nil
+	})
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(profiles) != 2 || profiles[0].Query != "Target1" || len(profiles[0].SNPs) != 0 || len(profiles[0].Ambiguities) != 0 ||
+		profiles[1].Query != "Target3" || strings.Join(profiles[1].SNPs, "|") != "G3T|A4T" || profiles[1].AmbiguityCount != 3 ||
+		len(profiles[1].Ambiguities) != 2 || profiles[1].Ambiguities[0] != [2]int{1, 2} || profiles[1].Ambiguities[1] != [2]int{6, 6} {
+		t.Errorf("problem in TestProfiles(): %v", profiles)
+	}
+}
+
+func TestProfilesProcessed(t *testing.T) {
+	ref := bytes.NewReader([]byte(">ref\nATGATG\n"))
+	query := bytes.NewReader([]byte(">Target1\nATGATG\n>Target3\nNNTTTW\n"))
+
+	summary.Reset()
+	if err := Profiles(ref, query, func(P Profile) error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+	// the reference isn't counted, only the alignment's records
+	if got := summary.Collect().RecordsProcessed; got != 2 {
+		t.Errorf("problem in TestProfilesProcessed(): %d records processed, expected 2", got)
+	}
+}
@@ -11,12 +11,12 @@ import (
 	"errors"
 	"io"
 	"math"
-	"os"
 	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/summary"
 )
 
 /*
@@ -649,56 +649,55 @@ func balance(sizetotal int, sizeIdeal [4]int, sizeObserved [4]int, nofill bool)
 	return size
 }
 
-// writeUpDownCatchment writes the catchments for each query to a file/stdout
-func writeUpDownCatchment(w io.Writer, results []updownCatchmentStruct) error {
+// Neighbour is a target in a query's catchment, at an SNP-distance from it
+type Neighbour struct {
+	Target   string `json:"target"`
+	Distance int    `json:"distance"`
+}
 
-	var err error
-	temp := make([]string, 0)
+// Catchment is the neighbours of one query in each direction: targets with the same sequence, likely
+// ancestors (Up), likely descendants (Down), and those to the side, each closest first
+type Catchment struct {
+	Query string      `json:"query"`
+	Same  []Neighbour `json:"same"`
+	Up    []Neighbour `json:"up"`
+	Down  []Neighbour `json:"down"`
+	Side  []Neighbour `json:"side"`
+}
 
-	_, err = w.Write([]byte("query,closestsame,closestup,closestdown,closestside\n"))
-	if err != nil {
-		return err
+// neighbours returns the public form of the targets in one direction of a catchment
+func (nS updownCatchmentSubStruct) neighbours() []Neighbour {
+	ns := make([]Neighbour, len(nS.catchment))
+	for i, rS := range nS.catchment {
+		ns[i] = Neighbour{Target: rS.tname, Distance: rS.distance}
 	}
+	return ns
+}
 
-	for _, result := range results {
-
-		_, err = w.Write([]byte(result.qname + ","))
-		if err != nil {
-			return err
-		}
+// catchment returns the public form of a query's catchment
+func (uC updownCatchmentStruct) catchment() Catchment {
+	return Catchment{Query: uC.qname, Same: uC.same.neighbours(), Up: uC.up.neighbours(), Down: uC.down.neighbours(), Side: uC.side.neighbours()}
+}
 
-		temp = make([]string, 0)
-		for _, hit := range result.same.catchment {
-			temp = append(temp, hit.tname)
-		}
-		_, err = w.Write([]byte(strings.Join(temp, ";") + ","))
-		if err != nil {
-			return err
-		}
+// targets returns the names of neighbours, ";"-delimited
+func targets(ns []Neighbour) string {
+	names := make([]string, len(ns))
+	for i, n := range ns {
+		names[i] = n.Target
+	}
+	return strings.Join(names, ";")
+}
 
-		temp = make([]string, 0)
-		for _, hit := range result.up.catchment {
-			temp = append(temp, hit.tname)
-		}
-		_, err = w.Write([]byte(strings.Join(temp, ";") + ","))
-		if err != nil {
-			return err
-		}
+// writeUpDownCatchment writes the catchments for each query to a file/stdout
+func writeUpDownCatchment(w io.Writer, catchments []Catchment) error {
 
-		temp = make([]string, 0)
-		for _, hit := range result.down.catchment {
-			temp = append(temp, hit.tname)
-		}
-		_, err = w.Write([]byte(strings.Join(temp, ";") + ","))
-		if err != nil {
-			return err
-		}
+	_, err := w.Write([]byte("query,closestsame,closestup,closestdown,closestside\n"))
+	if err != nil {
+		return err
+	}
 
-		temp = make([]string, 0)
-		for _, hit := range result.side.catchment {
-			temp = append(temp, hit.tname)
-		}
-		_, err = w.Write([]byte(strings.Join(temp, ";") + "\n"))
+	for _, c := range catchments {
+		_, err = w.Write([]byte(c.Query + "," + targets(c.Same) + "," + targets(c.Up) + "," + targets(c.Down) + "," + targets(c.Side) + "\n"))
 		if err != nil {
 			return err
 		}
@@ -708,31 +707,21 @@ func writeUpDownCatchment(w io.Writer, results []updownCatchmentStruct) error {
 }
 
 // writeUpdownTable writes the output in table format, including SNP-distances
-func writeUpdownTable(w io.Writer, results []updownCatchmentStruct) error {
-	w.Write([]byte("query,direction,distance,target\n"))
-	for _, result := range results {
-		for _, neighbour := range result.same.catchment {
-			_, err := w.Write([]byte(strings.Join([]string{result.qname, "same", strconv.Itoa(neighbour.distance), neighbour.tname}, ",") + "\n"))
-			if err != nil {
-				return err
-			}
-		}
-		for _, neighbour := range result.up.catchment {
-			_, err := w.Write([]byte(strings.Join([]string{result.qname, "up", strconv.Itoa(neighbour.distance), neighbour.tname}, ",") + "\n"))
-			if err != nil {
-				return err
-			}
-		}
-		for _, neighbour := range result.down.catchment {
-			_, err := w.Write([]byte(strings.Join([]string{result.qname, "down", strconv.Itoa(neighbour.distance), neighbour.tname}, ",") + "\n"))
-			if err != nil {
-				return err
-			}
-		}
-		for _, neighbour := range result.side.catchment {
-			_, err := w.Write([]byte(strings.Join([]string{result.qname, "side", strconv.Itoa(neighbour.distance), neighbour.tname}, ",") + "\n"))
-			if err != nil {
-				return err
+func writeUpdownTable(w io.Writer, catchments []Catchment) error {
+	_, err := w.Write([]byte("query,direction,distance,target\n"))
+	if err != nil {
+		return err
+	}
+	for _, c := range catchments {
+		for _, direction := range []struct {
+			name       string
+			neighbours []Neighbour
+		}{{"same", c.Same}, {"up", c.Up}, {"down", c.Down}, {"side", c.Side}} {
+			for _, n := range direction.neighbours {
+				_, err := w.Write([]byte(strings.Join([]string{c.Query, direction.name, strconv.Itoa(n.Distance), n.Target}, ",") + "\n"))
+				if err != nil {
+					return err
+				}
 			}
 		}
 	}
@@ -771,11 +760,11 @@ or to --dist-push`)
 	}
 
 	if sizetotal != 0 && !allZero([]int{sizeup, sizedown, sizeside, sizesame}) {
-		os.Stderr.WriteString("warning: setting --size-total overrides --size-up, --size-down, --size-side and --size-same")
+		summary.Warn("setting --size-total overrides --size-up, --size-down, --size-side and --size-same")
 	}
 
 	if distall != 0 && !allZero([]int{distup, distdown, distside}) {
-		os.Stderr.WriteString("warning: setting --dist-all overrides --dist-up, --dist-down and --dist-side")
+		summary.Warn("setting --dist-all overrides --dist-up, --dist-down and --dist-side")
 	}
 
 	// bucket sizes for same,up,down,side,total respectively
@@ -841,28 +830,101 @@ func sum4(a [4]int) int {
 	return t
 }
 
+// TopRankingOptions controls TopRanking. QueryType and TargetType are the formats of the query and target
+// files, "csv" (the output of gofasta updown list) or "fasta" ("" means fasta). Targets whose IDs are in Ignore
+// are skipped. SizeTotal is the total number of neighbours to find for each query, split as evenly as possible
+// between the bins, and otherwise SizeUp, SizeDown, SizeSide and SizeSame are the number for each bin. DistAll
+// is the largest SNP-distance to a neighbour in any bin, and otherwise DistUp, DistDown and DistSide are the
+// largest for each bin. DistPush pushes the distance limits outwards until each bin has neighbours at at least
+// that many distinct distances. With HasThresholdPair, ThresholdPair is the largest proportion of consequential
+// sites that can be ambiguous in either sequence of a pair, and with HasThresholdTarget, ThresholdTarget is the
+// largest number of ambiguities that a target can have. Without them the thresholds are those of gofasta updown
+// topranking, 0.1 and 10000, so a threshold of 0 has to be asked for. NoFill stops a shortfall in one bin being
+// made up from the others. With Table, the output is a long-form table
+type TopRankingOptions struct {
+	QueryType          string
+	TargetType         string
+	Ignore             []string
+	Table              bool
+	SizeTotal          int
+	SizeUp             int
+	SizeDown           int
+	SizeSide           int
+	SizeSame           int
+	DistAll            int
+	DistUp             int
+	DistDown           int
+	DistSide           int
+	DistPush           int
+	ThresholdPair      float32
+	HasThresholdPair   bool
+	ThresholdTarget    int
+	HasThresholdTarget bool
+	NoFill             bool
+}
+
+// DefaultTopRankingOptions returns options with the same thresholds as gofasta updown topranking, and no sizes or
+// distances set, which must be added
+func DefaultTopRankingOptions() TopRankingOptions {
+	return TopRankingOptions{QueryType: "fasta", TargetType: "fasta"}
+}
+
+// thresholds returns the pair and target thresholds that TopRanking uses, which are the defaults unless they
+// have been set
+func (o TopRankingOptions) thresholds() (float32, int) {
+	pair, target := float32(0.1), 10000
+	if o.HasThresholdPair {
+		pair = o.ThresholdPair
+	}
+	if o.HasThresholdTarget {
+		target = o.ThresholdTarget
+	}
+	return pair, target
+}
+
 // TopRanking finds pseudo-tree-aware catchments for query sequences, given a large database of target sequences, the closest
 // of which should be returned in the output. Targets are split into bins depending on whether they are likely direct ancestors of,
 // direct descendants of, polyphyletic with, or exactly the same as, the query
-func TopRanking(query, target, reference io.Reader, out io.Writer, table bool,
-	q_in_type, t_in_type string, ignoreArray []string,
-	sizetotal int, sizeup int, sizedown int, sizeside int, sizesame int,
-	distall int, distup int, distdown int, distside int,
-	threshpair float32, threshtarg int, nofill bool, distpush int) error {
+func TopRanking(query, target, reference io.Reader, out io.Writer, o TopRankingOptions) error {
 
-	sizeArray, distArray, err := checkArgs(sizetotal, sizeup, sizedown, sizeside, sizesame, distall, distup, distdown, distside, distpush)
+	catchments, err := Catchments(query, target, reference, o)
 	if err != nil {
 		return err
 	}
 
+	if o.Table {
+		return writeUpdownTable(out, catchments)
+	}
+	return writeUpDownCatchment(out, catchments)
+}
+
+// Catchments returns the catchment of each query, in query order, as TopRanking finds them. o.Table is ignored
+func Catchments(query, target, reference io.Reader, o TopRankingOptions) ([]Catchment, error) {
+
+	q_in_type, t_in_type := o.QueryType, o.TargetType
+	if q_in_type == "" {
+		q_in_type = "fasta"
+	}
+	if t_in_type == "" {
+		t_in_type = "fasta"
+	}
+	ignoreArray := o.Ignore
+	nofill, distpush := o.NoFill, o.DistPush
+	threshpair, threshtarg := o.thresholds()
+
+	sizeArray, distArray, err := checkArgs(o.SizeTotal, o.SizeUp, o.SizeDown, o.SizeSide, o.SizeSame, o.DistAll, o.DistUp, o.DistDown, o.DistSide, o.DistPush)
+	if err != nil {
+		return nil, err
+	}
+
 	var refSeq []byte
 	if q_in_type == "fasta" || t_in_type == "fasta" {
-		temp, err := fastaio.ReadEncodeAlignmentToList(reference, false)
+		temp, err := fastaio.ReadEncodeAlignmentToListUncounted(reference, false)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if len(temp) > 1 {
-			return errors.New("More than one record in --reference")
+			return nil, errors.New("More than one record in --reference")
 		}
 		refSeq = temp[0].Seq
 	}
@@ -874,12 +936,12 @@ func TopRanking(query, target, reference io.Reader, out io.Writer, table bool,
 	case "csv":
 		queries, err = readCSVToUDLList(query)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	case "fasta":
 		queries, err = fastaToUDLList(query, refSeq)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 	}
@@ -907,7 +969,7 @@ func TopRanking(query, target, reference io.Reader, out io.Writer, table bool,
 	for n := 1; n > 0; {
 		select {
 		case err := <-cErr:
-			return err
+			return nil, err
 		case <-cReadDone:
 			close(cudL)
 			n--
@@ -917,7 +979,7 @@ func TopRanking(query, target, reference io.Reader, out io.Writer, table bool,
 	for n := 1; n > 0; {
 		select {
 		case err := <-cErr:
-			return err
+			return nil, err
 		case <-cSplitDone:
 			n--
 		}
@@ -928,14 +990,10 @@ func TopRanking(query, target, reference io.Reader, out io.Writer, table bool,
 		QResultsArray[result.qidx] = result
 	}
 
-	if table {
-		err = writeUpdownTable(out, QResultsArray)
-	} else {
-		err = writeUpDownCatchment(out, QResultsArray)
-	}
-	if err != nil {
-		return err
+	catchments := make([]Catchment, nQ)
+	for i, result := range QResultsArray {
+		catchments[i] = result.catchment()
 	}
 
-	return nil
+	return catchments, nil
 }
@@ -50,11 +50,27 @@ ATGCTT
 	TRnofill := false
 	TRdistpush := 0
 
-	err := TopRanking(query, target, ref, out, table,
-		qtype, ttype, ignoreArray,
-		TRsizetotal, TRsizeup, TRsizedown, TRsizeside, TRsizesame,
-		TRdistall, TRdistup, TRdistdown, TRdistside,
-		TRthresholdpair, TRthresholdtarget, TRnofill, TRdistpush)
+	err := TopRanking(query, target, ref, out, TopRankingOptions{
+		Table:              table,
+		QueryType:          qtype,
+		TargetType:         ttype,
+		Ignore:             ignoreArray,
+		SizeTotal:          TRsizetotal,
+		SizeUp:             TRsizeup,
+		SizeDown:           TRsizedown,
+		SizeSide:           TRsizeside,
+		SizeSame:           TRsizesame,
+		DistAll:            TRdistall,
+		DistUp:             TRdistup,
+		DistDown:           TRdistdown,
+		DistSide:           TRdistside,
+		ThresholdPair:      TRthresholdpair,
+		HasThresholdPair:   true,
+		ThresholdTarget:    TRthresholdtarget,
+		HasThresholdTarget: true,
+		NoFill:             TRnofill,
+		DistPush:           TRdistpush,
+	})
 	if err != nil {
 		t.Error(err)
 	}
@@ -87,11 +103,27 @@ Query1,TargetSame1,TargetUp2;TargetUp1,TargetDown1,TargetSide2
 	ttype = "csv"
 	out = new(bytes.Buffer)
 
-	err = TopRanking(queryList, targetList, ref, out, table,
-		qtype, ttype, ignoreArray,
-		TRsizetotal, TRsizeup, TRsizedown, TRsizeside, TRsizesame,
-		TRdistall, TRdistup, TRdistdown, TRdistside,
-		TRthresholdpair, TRthresholdtarget, TRnofill, TRdistpush)
+	err = TopRanking(queryList, targetList, ref, out, TopRankingOptions{
+		Table:              table,
+		QueryType:          qtype,
+		TargetType:         ttype,
+		Ignore:             ignoreArray,
+		SizeTotal:          TRsizetotal,
+		SizeUp:             TRsizeup,
+		SizeDown:           TRsizedown,
+		SizeSide:           TRsizeside,
+		SizeSame:           TRsizesame,
+		DistAll:            TRdistall,
+		DistUp:             TRdistup,
+		DistDown:           TRdistdown,
+		DistSide:           TRdistside,
+		ThresholdPair:      TRthresholdpair,
+		HasThresholdPair:   true,
+		ThresholdTarget:    TRthresholdtarget,
+		HasThresholdTarget: true,
+		NoFill:             TRnofill,
+		DistPush:           TRdistpush,
+	})
 	if err != nil {
 		t.Error(err)
 	}
@@ -145,11 +177,27 @@ ATGCTT
 	TRnofill := false
 	TRdistpush := 0
 
-	err := TopRanking(query, target, ref, out, table,
-		qtype, ttype, ignoreArray,
-		TRsizetotal, TRsizeup, TRsizedown, TRsizeside, TRsizesame,
-		TRdistall, TRdistup, TRdistdown, TRdistside,
-		TRthresholdpair, TRthresholdtarget, TRnofill, TRdistpush)
+	err := TopRanking(query, target, ref, out, TopRankingOptions{
+		Table:              table,
+		QueryType:          qtype,
+		TargetType:         ttype,
+		Ignore:             ignoreArray,
+		SizeTotal:          TRsizetotal,
+		SizeUp:             TRsizeup,
+		SizeDown:           TRsizedown,
+		SizeSide:           TRsizeside,
+		SizeSame:           TRsizesame,
+		DistAll:            TRdistall,
+		DistUp:             TRdistup,
+		DistDown:           TRdistdown,
+		DistSide:           TRdistside,
+		ThresholdPair:      TRthresholdpair,
+		HasThresholdPair:   true,
+		ThresholdTarget:    TRthresholdtarget,
+		HasThresholdTarget: true,
+		NoFill:             TRnofill,
+		DistPush:           TRdistpush,
+	})
 	if err != nil {
 		t.Error(err)
 	}
@@ -182,11 +230,27 @@ Query1,TargetSame1,TargetUp2,TargetDown1,TargetSide2
 	ttype = "csv"
 	out = new(bytes.Buffer)
 
-	err = TopRanking(queryList, targetList, ref, out, table,
-		qtype, ttype, ignoreArray,
-		TRsizetotal, TRsizeup, TRsizedown, TRsizeside, TRsizesame,
-		TRdistall, TRdistup, TRdistdown, TRdistside,
-		TRthresholdpair, TRthresholdtarget, TRnofill, TRdistpush)
+	err = TopRanking(queryList, targetList, ref, out, TopRankingOptions{
+		Table:              table,
+		QueryType:          qtype,
+		TargetType:         ttype,
+		Ignore:             ignoreArray,
+		SizeTotal:          TRsizetotal,
+		SizeUp:             TRsizeup,
+		SizeDown:           TRsizedown,
+		SizeSide:           TRsizeside,
+		SizeSame:           TRsizesame,
+		DistAll:            TRdistall,
+		DistUp:             TRdistup,
+		DistDown:           TRdistdown,
+		DistSide:           TRdistside,
+		ThresholdPair:      TRthresholdpair,
+		HasThresholdPair:   true,
+		ThresholdTarget:    TRthresholdtarget,
+		HasThresholdTarget: true,
+		NoFill:             TRnofill,
+		DistPush:           TRdistpush,
+	})
 	if err != nil {
 		t.Error(err)
 	}
@@ -240,11 +304,27 @@ ATGCTT
 	TRnofill := false
 	TRdistpush := 2
 
-	err := TopRanking(query, target, ref, out, table,
-		qtype, ttype, ignoreArray,
-		TRsizetotal, TRsizeup, TRsizedown, TRsizeside, TRsizesame,
-		TRdistall, TRdistup, TRdistdown, TRdistside,
-		TRthresholdpair, TRthresholdtarget, TRnofill, TRdistpush)
+	err := TopRanking(query, target, ref, out, TopRankingOptions{
+		Table:              table,
+		QueryType:          qtype,
+		TargetType:         ttype,
+		Ignore:             ignoreArray,
+		SizeTotal:          TRsizetotal,
+		SizeUp:             TRsizeup,
+		SizeDown:           TRsizedown,
+		SizeSide:           TRsizeside,
+		SizeSame:           TRsizesame,
+		DistAll:            TRdistall,
+		DistUp:             TRdistup,
+		DistDown:           TRdistdown,
+		DistSide:           TRdistside,
+		ThresholdPair:      TRthresholdpair,
+		HasThresholdPair:   true,
+		ThresholdTarget:    TRthresholdtarget,
+		HasThresholdTarget: true,
+		NoFill:             TRnofill,
+		DistPush:           TRdistpush,
+	})
 	if err != nil {
 		t.Error(err)
 	}
@@ -277,11 +357,27 @@ Query1,TargetSame1,TargetUp2;TargetUp1,TargetDown1,TargetSide2;TargetSide1
 	ttype = "csv"
 	out = new(bytes.Buffer)
 
-	err = TopRanking(queryList, targetList, ref, out, table,
-		qtype, ttype, ignoreArray,
-		TRsizetotal, TRsizeup, TRsizedown, TRsizeside, TRsizesame,
-		TRdistall, TRdistup, TRdistdown, TRdistside,
-		TRthresholdpair, TRthresholdtarget, TRnofill, TRdistpush)
+	err = TopRanking(queryList, targetList, ref, out, TopRankingOptions{
+		Table:              table,
+		QueryType:          qtype,
+		TargetType:         ttype,
+		Ignore:             ignoreArray,
+		SizeTotal:          TRsizetotal,
+		SizeUp:             TRsizeup,
+		SizeDown:           TRsizedown,
+		SizeSide:           TRsizeside,
+		SizeSame:           TRsizesame,
+		DistAll:            TRdistall,
+		DistUp:             TRdistup,
+		DistDown:           TRdistdown,
+		DistSide:           TRdistside,
+		ThresholdPair:      TRthresholdpair,
+		HasThresholdPair:   true,
+		ThresholdTarget:    TRthresholdtarget,
+		HasThresholdTarget: true,
+		NoFill:             TRnofill,
+		DistPush:           TRdistpush,
+	})
 	if err != nil {
 		t.Error(err)
 	}
@@ -337,11 +433,27 @@ ATNATC
 	TRnofill := false
 	TRdistpush := 0
 
-	err := TopRanking(query, target, ref, out, table,
-		qtype, ttype, ignoreArray,
-		TRsizetotal, TRsizeup, TRsizedown, TRsizeside, TRsizesame,
-		TRdistall, TRdistup, TRdistdown, TRdistside,
-		TRthresholdpair, TRthresholdtarget, TRnofill, TRdistpush)
+	err := TopRanking(query, target, ref, out, TopRankingOptions{
+		Table:              table,
+		QueryType:          qtype,
+		TargetType:         ttype,
+		Ignore:             ignoreArray,
+		SizeTotal:          TRsizetotal,
+		SizeUp:             TRsizeup,
+		SizeDown:           TRsizedown,
+		SizeSide:           TRsizeside,
+		SizeSame:           TRsizesame,
+		DistAll:            TRdistall,
+		DistUp:             TRdistup,
+		DistDown:           TRdistdown,
+		DistSide:           TRdistside,
+		ThresholdPair:      TRthresholdpair,
+		HasThresholdPair:   true,
+		ThresholdTarget:    TRthresholdtarget,
+		HasThresholdTarget: true,
+		NoFill:             TRnofill,
+		DistPush:           TRdistpush,
+	})
 	if err != nil {
 		t.Error(err)
 	}
@@ -374,11 +486,27 @@ Query1,TargetSame1,TargetUp2;TargetUp1,TargetDown1,TargetSide3;TargetSide1
 	ttype = "csv"
 	out = new(bytes.Buffer)
 
-	err = TopRanking(queryList, targetList, ref, out, table,
-		qtype, ttype, ignoreArray,
-		TRsizetotal, TRsizeup, TRsizedown, TRsizeside, TRsizesame,
-		TRdistall, TRdistup, TRdistdown, TRdistside,
-		TRthresholdpair, TRthresholdtarget, TRnofill, TRdistpush)
+	err = TopRanking(queryList, targetList, ref, out, TopRankingOptions{
+		Table:              table,
+		QueryType:          qtype,
+		TargetType:         ttype,
+		Ignore:             ignoreArray,
+		SizeTotal:          TRsizetotal,
+		SizeUp:             TRsizeup,
+		SizeDown:           TRsizedown,
+		SizeSide:           TRsizeside,
+		SizeSame:           TRsizesame,
+		DistAll:            TRdistall,
+		DistUp:             TRdistup,
+		DistDown:           TRdistdown,
+		DistSide:           TRdistside,
+		ThresholdPair:      TRthresholdpair,
+		HasThresholdPair:   true,
+		ThresholdTarget:    TRthresholdtarget,
+		HasThresholdTarget: true,
+		NoFill:             TRnofill,
+		DistPush:           TRdistpush,
+	})
 	if err != nil {
 		t.Error(err)
 	}
@@ -434,11 +562,27 @@ ATNATC
 	TRnofill := false
 	TRdistpush := 0
 
-	err := TopRanking(query, target, ref, out, table,
-		qtype, ttype, ignoreArray,
-		TRsizetotal, TRsizeup, TRsizedown, TRsizeside, TRsizesame,
-		TRdistall, TRdistup, TRdistdown, TRdistside,
-		TRthresholdpair, TRthresholdtarget, TRnofill, TRdistpush)
+	err := TopRanking(query, target, ref, out, TopRankingOptions{
+		Table:              table,
+		QueryType:          qtype,
+		TargetType:         ttype,
+		Ignore:             ignoreArray,
+		SizeTotal:          TRsizetotal,
+		SizeUp:             TRsizeup,
+		SizeDown:           TRsizedown,
+		SizeSide:           TRsizeside,
+		SizeSame:           TRsizesame,
+		DistAll:            TRdistall,
+		DistUp:             TRdistup,
+		DistDown:           TRdistdown,
+		DistSide:           TRdistside,
+		ThresholdPair:      TRthresholdpair,
+		HasThresholdPair:   true,
+		ThresholdTarget:    TRthresholdtarget,
+		HasThresholdTarget: true,
+		NoFill:             TRnofill,
+		DistPush:           TRdistpush,
+	})
 	if err != nil {
 		t.Error(err)
 	}
@@ -471,11 +615,27 @@ Query1,TargetSame1,TargetUp2;TargetUp1,TargetDown1,TargetSide1
 	ttype = "csv"
 	out = new(bytes.Buffer)
 
-	err = TopRanking(queryList, targetList, ref, out, table,
-		qtype, ttype, ignoreArray,
-		TRsizetotal, TRsizeup, TRsizedown, TRsizeside, TRsizesame,
-		TRdistall, TRdistup, TRdistdown, TRdistside,
-		TRthresholdpair, TRthresholdtarget, TRnofill, TRdistpush)
+	err = TopRanking(queryList, targetList, ref, out, TopRankingOptions{
+		Table:              table,
+		QueryType:          qtype,
+		TargetType:         ttype,
+		Ignore:             ignoreArray,
+		SizeTotal:          TRsizetotal,
+		SizeUp:             TRsizeup,
+		SizeDown:           TRsizedown,
+		SizeSide:           TRsizeside,
+		SizeSame:           TRsizesame,
+		DistAll:            TRdistall,
+		DistUp:             TRdistup,
+		DistDown:           TRdistdown,
+		DistSide:           TRdistside,
+		ThresholdPair:      TRthresholdpair,
+		HasThresholdPair:   true,
+		ThresholdTarget:    TRthresholdtarget,
+		HasThresholdTarget: true,
+		NoFill:             TRnofill,
+		DistPush:           TRdistpush,
+	})
 	if err != nil {
 		t.Error(err)
 	}
@@ -529,11 +689,27 @@ ATGCTT
 	TRnofill := false
 	TRdistpush := 0
 
-	err := TopRanking(query, target, ref, out, table,
-		qtype, ttype, ignoreArray,
-		TRsizetotal, TRsizeup, TRsizedown, TRsizeside, TRsizesame,
-		TRdistall, TRdistup, TRdistdown, TRdistside,
-		TRthresholdpair, TRthresholdtarget, TRnofill, TRdistpush)
+	err := TopRanking(query, target, ref, out, TopRankingOptions{
+		Table:              table,
+		QueryType:          qtype,
+		TargetType:         ttype,
+		Ignore:             ignoreArray,
+		SizeTotal:          TRsizetotal,
+		SizeUp:             TRsizeup,
+		SizeDown:           TRsizedown,
+		SizeSide:           TRsizeside,
+		SizeSame:           TRsizesame,
+		DistAll:            TRdistall,
+		DistUp:             TRdistup,
+		DistDown:           TRdistdown,
+		DistSide:           TRdistside,
+		ThresholdPair:      TRthresholdpair,
+		HasThresholdPair:   true,
+		ThresholdTarget:    TRthresholdtarget,
+		HasThresholdTarget: true,
+		NoFill:             TRnofill,
+		DistPush:           TRdistpush,
+	})
 	if err != nil {
 		t.Error(err)
 	}
@@ -565,11 +741,27 @@ Query1,TargetSame1_dist0,TargetUp2_dist1,TargetDown1_dist1,
 	ttype = "csv"
 	out = new(bytes.Buffer)
 
-	err = TopRanking(queryList, targetList, ref, out, table,
-		qtype, ttype, ignoreArray,
-		TRsizetotal, TRsizeup, TRsizedown, TRsizeside, TRsizesame,
-		TRdistall, TRdistup, TRdistdown, TRdistside,
-		TRthresholdpair, TRthresholdtarget, TRnofill, TRdistpush)
+	err = TopRanking(queryList, targetList, ref, out, TopRankingOptions{
+		Table:              table,
+		QueryType:          qtype,
+		TargetType:         ttype,
+		Ignore:             ignoreArray,
+		SizeTotal:          TRsizetotal,
+		SizeUp:             TRsizeup,
+		SizeDown:           TRsizedown,
+		SizeSide:           TRsizeside,
+		SizeSame:           TRsizesame,
+		DistAll:            TRdistall,
+		DistUp:             TRdistup,
+		DistDown:           TRdistdown,
+		DistSide:           TRdistside,
+		ThresholdPair:      TRthresholdpair,
+		HasThresholdPair:   true,
+		ThresholdTarget:    TRthresholdtarget,
+		HasThresholdTarget: true,
+		NoFill:             TRnofill,
+		DistPush:           TRdistpush,
+	})
 	if err != nil {
 		t.Error(err)
 	}
@@ -625,11 +817,27 @@ ATGCTT
 	TRnofill := false
 	TRdistpush := 2
 
-	err := TopRanking(query, target, ref, out, table,
-		qtype, ttype, ignoreArray,
-		TRsizetotal, TRsizeup, TRsizedown, TRsizeside, TRsizesame,
-		TRdistall, TRdistup, TRdistdown, TRdistside,
-		TRthresholdpair, TRthresholdtarget, TRnofill, TRdistpush)
+	err := TopRanking(query, target, ref, out, TopRankingOptions{
+		Table:              table,
+		QueryType:          qtype,
+		TargetType:         ttype,
+		Ignore:             ignoreArray,
+		SizeTotal:          TRsizetotal,
+		SizeUp:             TRsizeup,
+		SizeDown:           TRsizedown,
+		SizeSide:           TRsizeside,
+		SizeSame:           TRsizesame,
+		DistAll:            TRdistall,
+		DistUp:             TRdistup,
+		DistDown:           TRdistdown,
+		DistSide:           TRdistside,
+		ThresholdPair:      TRthresholdpair,
+		HasThresholdPair:   true,
+		ThresholdTarget:    TRthresholdtarget,
+		HasThresholdTarget: true,
+		NoFill:             TRnofill,
+		DistPush:           TRdistpush,
+	})
 	if err != nil {
 		t.Error(err)
 	}
@@ -661,11 +869,27 @@ Query1,TargetSame1_dist0,TargetUp2_dist1;TargetUp1_dist2,TargetDown1_dist1,Targe
 	ttype = "csv"
 	out = new(bytes.Buffer)
 
-	err = TopRanking(queryList, targetList, ref, out, table,
-		qtype, ttype, ignoreArray,
-		TRsizetotal, TRsizeup, TRsizedown, TRsizeside, TRsizesame,
-		TRdistall, TRdistup, TRdistdown, TRdistside,
-		TRthresholdpair, TRthresholdtarget, TRnofill, TRdistpush)
+	err = TopRanking(queryList, targetList, ref, out, TopRankingOptions{
+		Table:              table,
+		QueryType:          qtype,
+		TargetType:         ttype,
+		Ignore:             ignoreArray,
+		SizeTotal:          TRsizetotal,
+		SizeUp:             TRsizeup,
+		SizeDown:           TRsizedown,
+		SizeSide:           TRsizeside,
+		SizeSame:           TRsizesame,
+		DistAll:            TRdistall,
+		DistUp:             TRdistup,
+		DistDown:           TRdistdown,
+		DistSide:           TRdistside,
+		ThresholdPair:      TRthresholdpair,
+		HasThresholdPair:   true,
+		ThresholdTarget:    TRthresholdtarget,
+		HasThresholdTarget: true,
+		NoFill:             TRnofill,
+		DistPush:           TRdistpush,
+	})
 	if err != nil {
 		t.Error(err)
 	}
@@ -719,11 +943,27 @@ ATGCTT
 	TRnofill := false
 	TRdistpush := 0
 
-	err := TopRanking(query, target, ref, out, table,
-		qtype, ttype, ignoreArray,
-		TRsizetotal, TRsizeup, TRsizedown, TRsizeside, TRsizesame,
-		TRdistall, TRdistup, TRdistdown, TRdistside,
-		TRthresholdpair, TRthresholdtarget, TRnofill, TRdistpush)
+	err := TopRanking(query, target, ref, out, TopRankingOptions{
+		Table:              table,
+		QueryType:          qtype,
+		TargetType:         ttype,
+		Ignore:             ignoreArray,
+		SizeTotal:          TRsizetotal,
+		SizeUp:             TRsizeup,
+		SizeDown:           TRsizedown,
+		SizeSide:           TRsizeside,
+		SizeSame:           TRsizesame,
+		DistAll:            TRdistall,
+		DistUp:             TRdistup,
+		DistDown:           TRdistdown,
+		DistSide:           TRdistside,
+		ThresholdPair:      TRthresholdpair,
+		HasThresholdPair:   true,
+		ThresholdTarget:    TRthresholdtarget,
+		HasThresholdTarget: true,
+		NoFill:             TRnofill,
+		DistPush:           TRdistpush,
+	})
 	if err != nil {
 		t.Error(err)
 	}
@@ -759,11 +999,27 @@ Query1,side,2,TargetSide2
 	ttype = "csv"
 	out = new(bytes.Buffer)
 
-	err = TopRanking(queryList, targetList, ref, out, table,
-		qtype, ttype, ignoreArray,
-		TRsizetotal, TRsizeup, TRsizedown, TRsizeside, TRsizesame,
-		TRdistall, TRdistup, TRdistdown, TRdistside,
-		TRthresholdpair, TRthresholdtarget, TRnofill, TRdistpush)
+	err = TopRanking(queryList, targetList, ref, out, TopRankingOptions{
+		Table:              table,
+		QueryType:          qtype,
+		TargetType:         ttype,
+		Ignore:             ignoreArray,
+		SizeTotal:          TRsizetotal,
+		SizeUp:             TRsizeup,
+		SizeDown:           TRsizedown,
+		SizeSide:           TRsizeside,
+		SizeSame:           TRsizesame,
+		DistAll:            TRdistall,
+		DistUp:             TRdistup,
+		DistDown:           TRdistdown,
+		DistSide:           TRdistside,
+		ThresholdPair:      TRthresholdpair,
+		HasThresholdPair:   true,
+		ThresholdTarget:    TRthresholdtarget,
+		HasThresholdTarget: true,
+		NoFill:             TRnofill,
+		DistPush:           TRdistpush,
+	})
 	if err != nil {
 		t.Error(err)
 	}
@@ -772,3 +1028,70 @@ Query1,side,2,TargetSide2
 		t.Errorf("problem in TestTopRankingTable1(csv)")
 	}
 }
+
+func TestTopRankingZeroThresholds(t *testing.T) {
+	refData := []byte(`>ref
+ATGATGATGATGATGATGATGATGATGATG
+`)
+	queryData := []byte(`>Query1
+CCCCCCCCCCCCATGATGATGATGATGATG
+`)
+	// one ambiguity among the twelve sites where the query differs from the reference
+	targetData := []byte(`>TargetSame1
+NCCCCCCCCCCCATGATGATGATGATGATG
+>TargetUp1
+ATGATGATGATGATGATGATGATGATGATG
+`)
+
+	// the zero value of the thresholds means the defaults, which let TargetSame1's ambiguity through
+	out := new(bytes.Buffer)
+	err := TopRanking(bytes.NewReader(queryData), bytes.NewReader(targetData), bytes.NewReader(refData), out, TopRankingOptions{SizeTotal: 5})
+	if err != nil {
+		t.Error(err)
+	}
+	desiredResult := `query,closestsame,closestup,closestdown,closestside
+Query1,TargetSame1,TargetUp1,,
+`
+	if out.String() != desiredResult {
+		t.Errorf("problem in TestTopRankingZeroThresholds(): zero thresholds")
+		fmt.Println(out.String())
+	}
+
+	// but thresholds of 0 can still be asked for
+	for _, o := range []TopRankingOptions{{SizeTotal: 5, HasThresholdPair: true}, {SizeTotal: 5, HasThresholdTarget: true}} {
+		out = new(bytes.Buffer)
+		err = TopRanking(bytes.NewReader(queryData), bytes.NewReader(targetData), bytes.NewReader(refData), out, o)
+		if err != nil {
+			t.Error(err)
+		}
+		desiredResult = `query,closestsame,closestup,closestdown,closestside
+Query1,,TargetUp1,,
+`
+		if out.String() != desiredResult {
+			t.Errorf("problem in TestTopRankingZeroThresholds(): %+v", o)
+			fmt.Println(out.String())
+		}
+	}
+}
+
+func TestCatchments(t *testing.T) {
+	ref := bytes.NewReader([]byte(">ref\nATGATG\n"))
+	query := bytes.NewReader([]byte(">Query1\nATTATT\n"))
+	target := bytes.NewReader([]byte(">TargetUp1\nATGATG\n>TargetSame1\nATTATT\n>TargetDown1\nATTACT\n>TargetSide2\nATGCTT\n"))
+
+	catchments, err := Catchments(query, target, ref, TopRankingOptions{SizeTotal: 4})
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(catchments) != 1 {
+		t.Fatalf("problem in TestCatchments(): %v", catchments)
+	}
+	c := catchments[0]
+	if c.Query != "Query1" || len(c.Same) != 1 || c.Same[0] != (Neighbour{Target: "TargetSame1", Distance: 0}) ||
+		len(c.Up) != 1 || c.Up[0] != (Neighbour{Target: "TargetUp1", Distance: 2}) ||
+		len(c.Down) != 1 || c.Down[0] != (Neighbour{Target: "TargetDown1", Distance: 1}) ||
+		len(c.Side) != 1 || c.Side[0].Target != "TargetSide2" {
+		t.Errorf("problem in TestCatchments(): %+v", c)
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Package variablesites implements the removal of invariant columns from an
+alignment, keeping a record of which columns were kept and how many of each
+nucleotide were constant, for ascertainment bias correction in phylogenetics
+programs.
+*/
+package variablesites
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/alignment"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+// Options controls which characters are missing data, so don't make a column variable. By default every
+// character (ignoring case) is a state of its own
+type Options struct {
+	AmbiguousAsMissing bool // IUPAC ambiguity codes, N and ? are missing
+	GapsAsMissing      bool // gaps are missing
+}
+
+// missing returns true if c is missing data under o
+func (o Options) missing(c byte) bool {
+	switch c {
+	case 'A', 'C', 'G', 'T':
+		return false
+	case '-':
+		return o.GapsAsMissing
+	}
+	return o.AmbiguousAsMissing
+}
+
+// missingEncoded returns true if nuc, an EP-encoded nucleotide, is missing data under o
+func (o Options) missingEncoded(nuc byte) bool {
+	switch {
+	case nuc&8 == 8:
+		return false
+	case nuc == 244 || nuc == 4:
+		return o.GapsAsMissing
+	}
+	return o.AmbiguousAsMissing
+}
+
+// column is the state of one alignment column so far: the first non-missing character, and whether any
+// later character was different
+type column struct {
+	first    byte
+	variable bool
+}
+
+// Result is the outcome of finding the variable columns: the 0-based indices of the columns that are kept,
+// and the number of invariant columns of each of A, C, G and T
+type Result struct {
+	Kept      []int
+	Invariant [4]int
+}
+
+// Find returns the variable columns in records, which must be aligned
+func Find(records []fastaio.FastaRecord, o Options) (Result, error) {
+
+	if len(records) == 0 {
+		return Result{}, errors.New("no records in alignment")
+	}
+
+	cols := make([]column, len(records[0].Seq))
+	for _, FR := range records {
+		if len(FR.Seq) != len(cols) {
+			return Result{}, errors.New(FR.ID + " is not the same length as the other records")
+		}
+		for i := 0; i < len(FR.Seq); i++ {
+			c := FR.Seq[i]
+			if c >= 'a' && c <= 'z' {
+				c -= 32
+			}
+			if !o.missing(c) {
+				cols[i].add(c)
+			}
+		}
+	}
+
+	return result(cols, "ACGT"), nil
+}
+
+// FindAlignment returns the variable columns in a, as Find does
+func FindAlignment(a *alignment.Alignment, o Options) Result {
+	cols := make([]column, a.Width())
+	a.EachColumn(func(i int, col []byte) error {
+		for _, nuc := range col {
+			if !o.missingEncoded(nuc) {
+				cols[i].add(nuc)
+			}
+		}
+		return nil
+	})
+	// A, C, G and T as EP encodes them
+	return result(cols, string([]byte{136, 40, 72, 24}))
+}
+
+// add records a character that isn't missing data
+func (col *column) add(c byte) {
+	if col.first == 0 {
+		col.first = c
+	} else if c != col.first {
+		col.variable = true
+	}
+}
+
+// result returns the variable columns of cols, and the invariant ones counted by which of acgt (A, C, G and T
+// as the columns' characters are coded) they are
+func result(cols []column, acgt string) Result {
+	r := Result{Kept: make([]int, 0)}
+	for i, col := range cols {
+		if col.variable {
+			r.Kept = append(r.Kept, i)
+			continue
+		}
+		if b := strings.IndexByte(acgt, col.first); col.first != 0 && b >= 0 {
+			r.Invariant[b]++
+		}
+	}
+	return r
+}
+
+// VariableSites writes the variable columns of the alignment msa to out. If they aren't nil, the 1-based
+// original index of each kept column is written to mapOut as a CSV with the columns column,original_column,
+// and the number of invariant A, C, G and T columns to ascOut, as one space-separated line
+func VariableSites(msa io.Reader, out, mapOut, ascOut io.Writer, o Options) error {
+
+	records, err := fastaio.ReadFastaToList(msa)
+	if err != nil {
+		return err
+	}
+
+	r, err := Find(records, o)
+	if err != nil {
+		return err
+	}
+
+	for _, FR := range records {
+		seq := make([]byte, len(r.Kept))
+		for k, i := range r.Kept {
+			seq[k] = FR.Seq[i]
+		}
+		if _, err := out.Write([]byte(">" + FR.ID + "\n" + string(seq) + "\n")); err != nil {
+			return err
+		}
+	}
+
+	if mapOut != nil {
+		if _, err := mapOut.Write([]byte("column,original_column\n")); err != nil {
+			return err
+		}
+		for k, i := range r.Kept {
+			if _, err := mapOut.Write([]byte(strconv.Itoa(k+1) + "," + strconv.Itoa(i+1) + "\n")); err != nil {
+				return err
+			}
+		}
+	}
+
+	if ascOut != nil {
+		counts := make([]string, 4)
+		for b, n := range r.Invariant {
+			counts[b] = strconv.Itoa(n)
+		}
+		if _, err := ascOut.Write([]byte(strings.Join(counts, " ") + "\n")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package variablesites
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/alignment"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+func TestVariableSites(t *testing.T) {
+	msa := ">a\nACGTAN-\n>b\nACGAAC-\n>c\nacgTaTA\n"
+
+	out, mapOut, ascOut := new(bytes.Buffer), new(bytes.Buffer), new(bytes.Buffer)
+	if err := VariableSites(strings.NewReader(msa), out, mapOut, ascOut, Options{}); err != nil {
+		t.Fatal(err)
+	}
+	if want := ">a\nTN-\n>b\nAC-\n>c\nTTA\n"; out.String() != want {
+		t.Errorf("problem in TestVariableSites(): got\n%s\nwant\n%s", out.String(), want)
+	}
+	if want := "column,original_column\n1,4\n2,6\n3,7\n"; mapOut.String() != want {
+		t.Errorf("problem in TestVariableSites(): got\n%s", mapOut.String())
+	}
+	if want := "2 1 1 0\n"; ascOut.String() != want {
+		t.Errorf("problem in TestVariableSites(): got %s", ascOut.String())
+	}
+
+	out.Reset()
+	ascOut.Reset()
+	if err := VariableSites(strings.NewReader(msa), out, nil, ascOut, Options{AmbiguousAsMissing: true, GapsAsMissing: true}); err != nil {
+		t.Fatal(err)
+	}
+	if want := ">a\nTN\n>b\nAC\n>c\nTT\n"; out.String() != want {
+		t.Errorf("problem in TestVariableSites(): got\n%s\nwant\n%s", out.String(), want)
+	}
+	if want := "3 1 1 0\n"; ascOut.String() != want {
+		t.Errorf("problem in TestVariableSites(): got %s", ascOut.String())
+	}
+}
+
+func TestFindAlignment(t *testing.T) {
+	msa := ">a\nACGTAN-\n>b\nACGAAC-\n>c\nacgTaTA\n"
+
+	records, err := fastaio.ReadFastaToList(strings.NewReader(msa))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := alignment.Read(strings.NewReader(msa))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, o := range []Options{{}, {AmbiguousAsMissing: true, GapsAsMissing: true}} {
+		want, err := Find(records, o)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := FindAlignment(a, o)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("problem in TestFindAlignment() with %+v: got %v, want %v", o, got, want)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package variants
+
+import (
+	"errors"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/genbank"
+	"github.com/virus-evolution/gofasta/pkg/gff"
+)
+
+// AnnotationSuffix returns "gb" or "gff" depending on the suffix of an annotation file's path
+func AnnotationSuffix(path string) (string, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".gb", ".gbk":
+		return "gb", nil
+	case ".gff", ".gff3":
+		return "gff", nil
+	}
+	return "", errors.New("couldn't tell if --annotation was a .gb or a .gff file")
+}
+
+// ReadAnnotation parses a genbank (annoSuffix == "gb") or gff (annoSuffix == "gff") annotation and returns its
+// protein-coding regions, sorted by start position, along with the reference sequence (ungapped, in the
+// annotation's coordinates). If refSeqDegapped is empty, the reference is taken from the annotation itself
+func ReadAnnotation(annoIn io.Reader, annoSuffix string, refSeqDegapped string) ([]Region, string, error) {
+
+	switch annoSuffix {
+	case "gb":
+		gb, err := genbank.ReadGenBank(annoIn)
+		if err != nil {
+			return nil, "", err
+		}
+		if refSeqDegapped == "" {
+			refSeqDegapped = strings.ToUpper(string(gb.ORIGIN))
+		}
+		if len(gb.ORIGIN) > 0 && len(gb.ORIGIN) != len(refSeqDegapped) {
+			return nil, "", errors.New("the reference sequence is not the same length as the genbank annotation")
+		}
+		cds, _, err := RegionsFromGenbank(gb, len(refSeqDegapped))
+		if err != nil {
+			return nil, "", err
+		}
+		sort.SliceStable(cds, func(j, k int) bool {
+			return cds[j].Start < cds[k].Start
+		})
+		return cds, refSeqDegapped, nil
+
+	case "gff":
+		g, err := gff.ReadGFF(annoIn)
+		if err != nil {
+			return nil, "", err
+		}
+		if refSeqDegapped == "" {
+			switch len(g.FASTA) {
+			case 0:
+				return nil, "", errors.New("couldn't find a reference sequence in the gff")
+			case 1:
+				for _, v := range g.FASTA {
+					refSeqDegapped = strings.ToUpper(v.Seq)
+				}
+			default:
+				return nil, "", errors.New("more than one sequence in gff ##FASTA section")
+			}
+		}
+		if len(g.SequenceRegions) > 1 {
+			return nil, "", errors.New("more than one sequence-region in gff header")
+		}
+		for _, region := range g.SequenceRegions {
+			if len(refSeqDegapped) != region.End {
+				return nil, "", errors.New("the reference sequence is not the same length as the gff annotation")
+			}
+		}
+		cds, _, err := RegionsFromGFF(g, refSeqDegapped)
+		if err != nil {
+			return nil, "", err
+		}
+		return cds, refSeqDegapped, nil
+	}
+
+	return nil, "", errors.New("couldn't tell if --annotation was a .gb or a .gff file")
+}
+
+// SelectRegions returns the regions whose names are in names, in their original order. It is an
+// error if any of names isn't found
+func SelectRegions(regions []Region, names []string) ([]Region, error) {
+	keep := make(map[string]bool)
+	for _, n := range names {
+		keep[n] = true
+	}
+	found := make(map[string]bool)
+	selected := make([]Region, 0)
+	for _, r := range regions {
+		if keep[r.Name] {
+			selected = append(selected, r)
+			found[r.Name] = true
+		}
+	}
+	for _, n := range names {
+		if !found[n] {
+			return nil, errors.New("couldn't find CDS " + n + " in --annotation")
+		}
+	}
+	return selected, nil
+}
@@ -0,0 +1,60 @@
+package variants
+
+import (
+	"os"
+	"testing"
+)
+
+func TestReadAnnotation(t *testing.T) {
+	for _, path := range []string{"../../resources/MN908947.gb", "../../resources/sarscov2-reduced.gff"} {
+		f, err := os.Open(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		suffix, err := AnnotationSuffix(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cds, ref, err := ReadAnnotation(f, suffix, "")
+		f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ref) != 29903 {
+			t.Errorf("problem in TestReadAnnotation(): %s reference is %d long", path, len(ref))
+		}
+		found := false
+		for _, r := range cds {
+			if r.Name == "S" {
+				found = true
+				if r.Start != 21563 || r.Stop != 25384 {
+					t.Errorf("problem in TestReadAnnotation(): %s S is at %d-%d", path, r.Start, r.Stop)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("problem in TestReadAnnotation(): no S in %s", path)
+		}
+		for i := 1; i < len(cds); i++ {
+			if cds[i].Start < cds[i-1].Start {
+				t.Errorf("problem in TestReadAnnotation(): %s regions aren't sorted", path)
+			}
+		}
+	}
+
+	_, err := AnnotationSuffix("anno.txt")
+	if err == nil {
+		t.Errorf("expected an error for an unknown suffix")
+	}
+}
+
+func TestSelectRegions(t *testing.T) {
+	regions := []Region{{Name: "ORF1ab", Start: 1}, {Name: "S", Start: 5}, {Name: "ORF1ab", Start: 2}}
+	got, err := SelectRegions(regions, []string{"ORF1ab"})
+	if err != nil || len(got) != 2 || got[1].Start != 2 {
+		t.Errorf("problem in TestSelectRegions(): %v %v", got, err)
+	}
+	if _, err = SelectRegions(regions, []string{"S", "N"}); err == nil {
+		t.Errorf("expected an error in TestSelectRegions() for a missing CDS")
+	}
+}
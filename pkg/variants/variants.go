@@ -8,15 +8,18 @@ package variants
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"os"
+	"runtime"
 	"sort"
 	"strconv"
 	"strings"
-	"sync"
+	"unicode"
 
+	"github.com/virus-evolution/gofasta/internal/pipeline"
 	"github.com/virus-evolution/gofasta/pkg/alphabet"
 	"github.com/virus-evolution/gofasta/pkg/encoding"
 	"github.com/virus-evolution/gofasta/pkg/fastaio"
@@ -38,30 +41,70 @@ type Region struct {
 // A Variant is a struct that contains information about one mutation (nuc, amino acid, indel) between
 // reference and query
 type Variant struct {
-	Queryname      string
-	RefAl          string
-	QueAl          string
-	Position       int    // (1-based) genomic location (for an amino acid change, this is the first position of the codon)
-	Residue        int    // (1-based) amino acid location
-	Changetype     string // one of {nuc,aa,ins,del}
-	Feature        string // this should be, for example, the name of the CDS that the thing is in
-	Length         int    // for indels
-	SNPs           string // if this is an amino acid change, what are the snps
-	Representation string
+	Queryname      string `json:"query,omitempty"`
+	RefAl          string `json:"ref,omitempty"`
+	QueAl          string `json:"alt,omitempty"`
+	Position       int    `json:"position"`          // (1-based) genomic location (for an amino acid change, this is the first position of the codon)
+	Residue        int    `json:"residue,omitempty"` // (1-based) amino acid location
+	Changetype     string `json:"type"`              // one of {nuc,aa,ins,del}
+	Feature        string `json:"feature,omitempty"` // this should be, for example, the name of the CDS that the thing is in
+	Length         int    `json:"length,omitempty"`  // for indels
+	SNPs           string `json:"snps,omitempty"`    // if this is an amino acid change, what are the snps
+	Representation string `json:"representation,omitempty"`
 }
 
 // AnnoStructs is for passing groups of Variant structs around with an index which is used to retain input
-// order in the output
+// order in the output. It is also the result for one query that Call passes on
 type AnnoStructs struct {
-	Queryname string
-	Vs        []Variant
-	Idx       int
+	Queryname string    `json:"query"`
+	Vs        []Variant `json:"variants"`
+	Idx       int       `json:"-"`
 }
 
-func Variants(msaIn io.Reader, stdin bool, refID string, annoIn io.Reader, annoSuffix string, out io.Writer, start int, end int, aggregate bool, threshold float64, appendSNP bool, threads int) error {
+// Options controls how Variants (and sam.Variants) report variants. If Start and End are both greater than 0,
+// only variants between those 1-based positions (inclusive) are reported. With Aggregate, the proportion of records
+// that have each variant is reported instead, for the variants with a proportion of at least Threshold. AppendSNP
+// adds the SNPs of each amino acid change after it. Threads is the number of goroutines to use (0 means all
+// available CPUs)
+type Options struct {
+	Start     int
+	End       int
+	Aggregate bool
+	Threshold float64
+	AppendSNP bool
+	Threads   int
+}
+
+// Variants annotates the mutations of every record in the alignment msaIn relative to the record refID (or,
+// if refID is empty, to the annotation's own sequence), and writes them to out, as a Writer does. It stops and
+// returns ctx's error if ctx is cancelled
+func Variants(ctx context.Context, msaIn io.Reader, stdin bool, refID string, annoIn io.Reader, annoSuffix string, out io.Writer, o Options) error {
+
+	vw, err := NewWriter(out, refID, o)
+	if err != nil {
+		return err
+	}
+
+	err = Call(ctx, msaIn, stdin, refID, annoIn, annoSuffix, o.Threads, vw.Write)
+	if err != nil {
+		return err
+	}
+
+	return vw.Close()
+}
+
+// Call annotates the mutations of every record in the alignment msaIn relative to the record refID (or, if refID
+// is empty, to the annotation's own sequence), and passes them to f in the order of the alignment, leaving out the
+// reference itself. Mutations aren't filtered by position. Threads is the number of goroutines to use (0 means all
+// available CPUs). It stops and returns ctx's error if ctx is cancelled
+func Call(ctx context.Context, msaIn io.Reader, stdin bool, refID string, annoIn io.Reader, annoSuffix string, threads int, f func(AnnoStructs) error) error {
 
 	var err error
 
+	if threads < 1 {
+		threads = runtime.NumCPU()
+	}
+
 	// Find the reference
 	var ref fastaio.EncodedFastaRecord
 
@@ -95,9 +138,18 @@ func Variants(msaIn io.Reader, stdin bool, refID string, annoIn io.Reader, annoS
 	cErr := make(chan error)
 	cMSADone := make(chan bool)
 
-	go fastaio.ReadEncodeAlignment(msaIn, false, cMSA, cErr, cMSADone)
+	// if we return before the pipeline takes over the reader, stop it and drain what it has already sent
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	started := false
+	defer func() {
+		if !started {
+			cancel()
+			go pipeline.Drain(cMSA, cErr, cMSADone)
+		}
+	}()
 
-	firstmissing := false
+	go fastaio.ReadEncodeAlignment(fastaio.ContextReader(ctx, msaIn), false, cMSA, cErr, cMSADone)
 
 	if stdin && refID != "" {
 		select {
@@ -105,10 +157,11 @@ func Variants(msaIn io.Reader, stdin bool, refID string, annoIn io.Reader, annoS
 			if ref.ID != refID {
 				return errors.New("--reference is not the first record in --msa")
 			}
-			firstmissing = true
 		case err := <-cErr:
+			started = true
 			return err
 		case <-cMSADone:
+			started = true
 			return errors.New("is the pipe to --msa empty?") // TO DO - does this work/is this necessary?
 		}
 	}
@@ -207,62 +260,20 @@ func Variants(msaIn io.Reader, stdin bool, refID string, annoIn io.Reader, annoS
 		return errors.New("couldn't tell if --annotation was a .gb or a .gff file")
 	}
 
-	cVariants := make(chan AnnoStructs, 50+threads)
-	cVariantsDone := make(chan bool)
-	cWriteDone := make(chan bool)
-
-	switch aggregate {
-	case true:
-		go AggregateWriteVariants(out, start, end, appendSNP, threshold, ref.ID, cVariants, cWriteDone, cErr)
-	case false:
-		go WriteVariants(out, start, end, firstmissing, appendSNP, ref.ID, cVariants, cWriteDone, cErr)
-	}
-
-	var wgVariants sync.WaitGroup
-	wgVariants.Add(threads)
-
-	for n := 0; n < threads; n++ {
-		go func() {
-			getVariants(ref, cdsregions, intregions, refToMSA, MSAToRef, cMSA, cVariants, cErr)
-			wgVariants.Done()
-		}()
-	}
-
-	go func() {
-		wgVariants.Wait()
-		cVariantsDone <- true
-	}()
-
-	for n := 1; n > 0; {
-		select {
-		case err := <-cErr:
-			return err
-		case <-cMSADone:
-			close(cMSA)
-			n--
+	work := func(record fastaio.EncodedFastaRecord) (AnnoStructs, error) {
+		if len(record.Seq) != len(MSAToRef) {
+			return AnnoStructs{}, errors.New("Gapped reference sequence and alignment are not the same width")
 		}
+		return GetVariantsPair(ref.Seq, record.Seq, ref.ID, record.ID, record.Idx, cdsregions, intregions, refToMSA, MSAToRef)
 	}
 
-	for n := 1; n > 0; {
-		select {
-		case err := <-cErr:
-			return err
-		case <-cVariantsDone:
-			close(cVariants)
-			n--
+	started = true
+	return pipeline.Run(ctx, threads, 0, pipeline.FromChannels(cMSA, cErr, cMSADone), work, func(AS AnnoStructs) error {
+		if AS.Queryname == ref.ID {
+			return nil
 		}
-	}
-
-	for n := 1; n > 0; {
-		select {
-		case err := <-cErr:
-			return err
-		case <-cWriteDone:
-			n--
-		}
-	}
-
-	return nil
+		return f(AS)
+	})
 }
 
 // findReference gets the reference sequence from the msa if it is in there. If it isn't, we will try get it
@@ -278,83 +289,94 @@ func findReference(msaIn io.Reader, referenceID string) (fastaio.EncodedFastaRec
 
 	first := true
 
-	var id string
 	var description string
 	var seqBuffer []byte
 	var line []byte
-	var nuc byte
 	var width int
+	var seqLen int
 
-	var refRec fastaio.EncodedFastaRecord
 	refFound := false
 
 	counter := 0
+	lineNo := 0
+	headerLine := 0
+	var id []byte
 
+	// only the reference's header and sequence are copied out of the scanner's buffer; the others are
+	// just checked
 	for s.Scan() {
 		line = s.Bytes()
+		lineNo++
 
 		if first {
 
-			if line[0] != '>' {
-				return fastaio.EncodedFastaRecord{}, errors.New("badly formatted fasta file")
-			}
-
-			description = string(line[1:])
-			id = strings.Fields(description)[0]
-
-			if id == referenceID {
-				refFound = true
+			if len(line) == 0 || line[0] != '>' {
+				return fastaio.EncodedFastaRecord{}, &fastaio.RecordError{Line: lineNo, Err: fastaio.ErrBadFormat}
 			}
 
 			first = false
 
-		} else if line[0] == '>' {
+		} else if len(line) > 0 && line[0] == '>' {
 
 			if refFound {
-				refRec = fastaio.EncodedFastaRecord{ID: id, Description: description, Seq: seqBuffer, Idx: counter}
-				return refRec, nil
+				return fastaio.EncodedFastaRecord{ID: referenceID, Description: description, Seq: seqBuffer, Idx: counter}, nil
 			}
 
 			if counter == 0 {
-				width = len(seqBuffer)
-			} else if len(seqBuffer) != width {
-				return fastaio.EncodedFastaRecord{}, errors.New("different length sequences in input file: is this an alignment?")
+				width = seqLen
+			} else if seqLen != width {
+				return fastaio.EncodedFastaRecord{}, fastaio.NotAlignment(string(id), counter, headerLine, seqLen, width)
 			}
 
 			counter++
-			description = string(line[1:])
-			id = strings.Fields(description)[0]
-			seqBuffer = make([]byte, 0)
-
-			if id == referenceID {
-				refFound = true
-			}
+			seqLen = 0
 
 		} else {
-			encodedLine := make([]byte, len(line))
 			for i := range line {
-				nuc = coding[line[i]]
-				if nuc == 0 {
-					return fastaio.EncodedFastaRecord{}, fmt.Errorf("invalid nucleotide in fasta file (%s)", string(line[i]))
+				if coding[line[i]] == 0 {
+					return fastaio.EncodedFastaRecord{}, &fastaio.RecordError{Record: string(id), Index: counter, Line: lineNo, Position: seqLen + i + 1,
+						Err: fmt.Errorf("%w (\"%s\")", fastaio.ErrInvalidNucleotide, string(line[i]))}
+				}
+			}
+			seqLen += len(line)
+			if refFound {
+				n := len(seqBuffer)
+				seqBuffer = append(seqBuffer, line...)
+				for i := n; i < len(seqBuffer); i++ {
+					seqBuffer[i] = coding[seqBuffer[i]]
 				}
-				encodedLine[i] = nuc
 			}
-			seqBuffer = append(seqBuffer, encodedLine...)
+			continue
+		}
+
+		// copied, because the scanner reuses line
+		id = append(id[:0], headerID(line)...)
+		headerLine = lineNo
+		if string(id) == referenceID {
+			refFound = true
+			description = string(line[1:])
 		}
 	}
 
 	err = s.Err()
 	if err != nil {
-		return fastaio.EncodedFastaRecord{}, err
+		return fastaio.EncodedFastaRecord{}, &fastaio.RecordError{Record: string(id), Index: counter, Line: lineNo + 1, Err: err}
 	}
 
-	if refFound {
-		refRec = fastaio.EncodedFastaRecord{ID: id, Description: description, Seq: seqBuffer, Idx: counter}
-	} else {
-		return refRec, errors.New("Couldn't find reference (" + referenceID + ") in msa")
+	if !refFound {
+		return fastaio.EncodedFastaRecord{}, errors.New("Couldn't find reference (" + referenceID + ") in msa")
 	}
 
-	return refRec, nil
+	return fastaio.EncodedFastaRecord{ID: referenceID, Description: description, Seq: seqBuffer, Idx: counter}, nil
+}
+
+// headerID returns the ID in a fasta header line, without copying it
+func headerID(line []byte) []byte {
+	id := bytes.TrimLeftFunc(line[1:], unicode.IsSpace)
+	if i := bytes.IndexFunc(id, unicode.IsSpace); i != -1 {
+		id = id[:i]
+	}
+	return id
 }
 
 func RegionsFromGFF(anno gff.GFF, refSeqDegapped string) ([]Region, []int, error) {
@@ -622,27 +644,6 @@ func GetMSAOffsets(refseq []byte) ([]int, []int) {
 	return refToMSA, MSAToRef
 }
 
-// getVariants annotates mutations between query and reference sequences, one fasta record at a time. It reads each fasta
-// record from a channel and passes all its mutations grouped together in one struct to another channel.
-func getVariants(ref fastaio.EncodedFastaRecord, cdsregions []Region, intregions []int, offsetRefCoord []int, offsetMSACoord []int, cMSA chan fastaio.EncodedFastaRecord, cVariants chan AnnoStructs, cErr chan error) {
-
-	for record := range cMSA {
-
-		if len(record.Seq) != len(offsetMSACoord) {
-			cErr <- errors.New("Gapped reference sequence and alignment are not the same width")
-			break
-		}
-
-		AS, err := GetVariantsPair(ref.Seq, record.Seq, ref.ID, record.ID, record.Idx, cdsregions, intregions, offsetRefCoord, offsetMSACoord)
-		if err != nil {
-			cErr <- err
-			break
-		}
-
-		cVariants <- AS
-	}
-}
-
 func GetVariantsPair(ref, query []byte, refID, queryID string, idx int, cdsregions []Region, intregions []int, offsetRefCoord []int, offsetMSACoord []int) (AnnoStructs, error) {
 
 	AS := AnnoStructs{}
@@ -718,133 +719,166 @@ func FormatVariant(v Variant, appendSNP bool) (string, error) {
 	return s, nil
 }
 
-// WriteVariants writes each query's mutations to file or stdout
-func WriteVariants(w io.Writer, start, end int, firstmissing bool, appendSNP bool, refID string, cVariants chan AnnoStructs, cWriteDone chan bool, cErr chan error) {
+// Writer writes each query's mutations as CSV with the columns query,mutations, or with Options.Aggregate collects
+// them, and writes the frequency of each on Close with the columns mutation,frequency. Only the mutations between
+// Options.Start and Options.End are written, if they are both greater than 0. The reference itself is left out
+type Writer struct {
+	w       io.Writer
+	refID   string
+	o       Options
+	propMap map[Variant]float64
+	counter float64
+	reps    []string
+}
 
-	outputMap := make(map[int]AnnoStructs)
+// NewWriter returns a Writer to w, having written the header
+func NewWriter(w io.Writer, refID string, o Options) (*Writer, error) {
+	vw := &Writer{w: w, refID: refID, o: o}
+	header := "query,mutations\n"
+	if o.Aggregate {
+		vw.propMap = make(map[Variant]float64)
+		header = "mutation,frequency\n"
+	}
+	if _, err := w.Write([]byte(header)); err != nil {
+		return nil, err
+	}
+	return vw, nil
+}
 
-	var counter int
-	switch firstmissing {
-	case true:
-		counter = 1
-	case false:
-		counter = 0
+// Write writes one query's mutations, or adds them to the totals
+func (vw *Writer) Write(AS AnnoStructs) error {
+
+	if AS.Queryname == vw.refID {
+		return nil
+	}
+	if vw.o.Aggregate {
+		vw.counter++
 	}
 
-	var err error
-	var sa []string
+	sa := vw.reps[:0]
+	for _, v := range AS.Vs {
+		if vw.o.Start > 0 && vw.o.End > 0 {
+			if v.Position < vw.o.Start || v.Position > vw.o.End {
+				continue
+			}
+		}
+		rep, err := FormatVariant(v, vw.o.AppendSNP)
+		if err != nil {
+			return err
+		}
+		if vw.o.Aggregate {
+			Vskinny := Variant{RefAl: v.RefAl, QueAl: v.QueAl, Position: v.Position, Residue: v.Residue, Changetype: v.Changetype, Feature: v.Feature, Length: v.Length, Representation: rep}
+			vw.propMap[Vskinny]++
+			continue
+		}
+		sa = append(sa, rep)
+	}
+	vw.reps = sa
 
-	_, err = w.Write([]byte("query,mutations\n"))
-	if err != nil {
-		cErr <- err
-		return
+	if vw.o.Aggregate {
+		return nil
 	}
+	_, err := vw.w.Write([]byte(AS.Queryname + "," + strings.Join(sa, "|") + "\n"))
+	return err
+}
 
-	for variantLine := range cVariants {
-		outputMap[variantLine.Idx] = variantLine
+// Close writes the frequency of each mutation that is present in at least Options.Threshold of the queries, with
+// Options.Aggregate. Otherwise there is nothing left to write
+func (vw *Writer) Close() error {
 
-		for {
-			if VL, ok := outputMap[counter]; ok {
+	if !vw.o.Aggregate {
+		return nil
+	}
 
-				if VL.Queryname == refID {
-					delete(outputMap, counter)
-					counter++
-					continue
-				}
+	order := make([]Variant, 0)
+	for k := range vw.propMap {
+		order = append(order, k)
+	}
 
-				_, err = w.Write([]byte(VL.Queryname + ","))
-				if err != nil {
-					cErr <- err
-					return
-				}
-				sa = make([]string, 0)
-				for _, v := range VL.Vs {
-					if start > 0 && end > 0 {
-						if v.Position < start || v.Position > end {
-							continue
-						}
-					}
-					newVar, err := FormatVariant(v, appendSNP)
-					if err != nil {
-						cErr <- err
-						return
-					}
-					sa = append(sa, newVar)
-				}
-				_, err = w.Write([]byte(strings.Join(sa, "|") + "\n"))
-				if err != nil {
-					cErr <- err
-					return
-				}
+	sort.SliceStable(order, func(i, j int) bool {
+		return order[i].Position < order[j].Position || (order[i].Position == order[j].Position && order[i].Changetype < order[j].Changetype) || (order[i].Position == order[j].Position && order[i].Changetype == order[j].Changetype && order[i].QueAl < order[j].QueAl)
+	})
 
-				delete(outputMap, counter)
-				counter++
-			} else {
-				break
-			}
+	for _, V := range order {
+		if vw.propMap[V]/vw.counter < vw.o.Threshold {
+			continue
+		}
+		_, err := vw.w.Write([]byte(V.Representation + "," + strconv.FormatFloat(vw.propMap[V]/vw.counter, 'f', 9, 64) + "\n"))
+		if err != nil {
+			return err
 		}
-
 	}
 
-	cWriteDone <- true
+	return nil
 }
 
-// AggregateWriteOutput aggregates the mutations that are present greater than or equal to threshold, and
-// writes their frequencies to file or stdout
-func AggregateWriteVariants(w io.Writer, start, end int, appendSNP bool, threshold float64, refID string, cVariants chan AnnoStructs, cWriteDone chan bool, cErr chan error) {
-
-	propMap := make(map[Variant]float64)
-
-	var err error
+// WriteVariants writes each query's mutations to file or stdout, in input order, as a Writer does
+func WriteVariants(w io.Writer, start, end int, firstmissing bool, appendSNP bool, refID string, cVariants chan AnnoStructs, cWriteDone chan bool, cErr chan error) {
 
-	_, err = w.Write([]byte("mutation,frequency\n"))
+	vw, err := NewWriter(w, refID, Options{Start: start, End: end, AppendSNP: appendSNP})
 	if err != nil {
 		cErr <- err
 		return
 	}
 
-	counter := 0.0
+	Deliver(vw.Write, firstmissing, cVariants, cWriteDone, cErr)
+}
 
-	for AS := range cVariants {
-		if AS.Queryname == refID {
-			continue
-		}
-		counter++
-		for _, v := range AS.Vs {
-			if start > 0 && end > 0 {
-				if v.Position < start || v.Position > end {
-					continue
-				}
+// Deliver passes each query's mutations from a channel to f, in input order, using a map to hold those that
+// arrive early. If firstmissing is true, the record with index 0 (the reference, say) never arrives
+func Deliver(f func(AnnoStructs) error, firstmissing bool, cVariants chan AnnoStructs, cDone chan bool, cErr chan error) {
+
+	outputMap := make(map[int]AnnoStructs)
+
+	var counter int
+	switch firstmissing {
+	case true:
+		counter = 1
+	case false:
+		counter = 0
+	}
+
+	for variantLine := range cVariants {
+		outputMap[variantLine.Idx] = variantLine
+
+		for {
+			VL, ok := outputMap[counter]
+			if !ok {
+				break
 			}
-			rep, err := FormatVariant(v, appendSNP)
-			if err != nil {
+			if err := f(VL); err != nil {
 				cErr <- err
 				return
 			}
-			Vskinny := Variant{RefAl: v.RefAl, QueAl: v.QueAl, Position: v.Position, Residue: v.Residue, Changetype: v.Changetype, Feature: v.Feature, Length: v.Length, Representation: rep}
-			propMap[Vskinny]++
+			delete(outputMap, counter)
+			counter++
 		}
 	}
 
-	order := make([]Variant, 0)
-	for k := range propMap {
-		order = append(order, k)
-	}
+	cDone <- true
+}
 
-	sort.SliceStable(order, func(i, j int) bool {
-		return order[i].Position < order[j].Position || (order[i].Position == order[j].Position && order[i].Changetype < order[j].Changetype) || (order[i].Position == order[j].Position && order[i].Changetype == order[j].Changetype && order[i].QueAl < order[j].QueAl)
-	})
+// AggregateWriteOutput aggregates the mutations that are present greater than or equal to threshold, and
+// writes their frequencies to file or stdout, as a Writer does
+func AggregateWriteVariants(w io.Writer, start, end int, appendSNP bool, threshold float64, refID string, cVariants chan AnnoStructs, cWriteDone chan bool, cErr chan error) {
 
-	for _, V := range order {
-		if propMap[V]/counter < threshold {
-			continue
-		}
-		_, err = w.Write([]byte(V.Representation + "," + strconv.FormatFloat(propMap[V]/counter, 'f', 9, 64) + "\n"))
-		if err != nil {
+	vw, err := NewWriter(w, refID, Options{Start: start, End: end, AppendSNP: appendSNP, Aggregate: true, Threshold: threshold})
+	if err != nil {
+		cErr <- err
+		return
+	}
+
+	for AS := range cVariants {
+		if err = vw.Write(AS); err != nil {
 			cErr <- err
 			return
 		}
 	}
 
+	if err = vw.Close(); err != nil {
+		cErr <- err
+		return
+	}
+
 	cWriteDone <- true
 }
@@ -0,0 +1,296 @@
+/*
+Package vcf2fasta implements the conversion of the genotypes in a VCF file to
+one sequence per sample, in the coordinates of the reference that the
+variants were called against, so that variant calls can be analysed like an
+alignment.
+*/
+package vcf2fasta
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/encoding"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/summary"
+)
+
+// Options controls which genotypes are trusted. Sites in records that fail MinQual or the FILTER check are
+// N in every sample, and sites where a sample's call fails MinGQ or MinDP, or is missing, are N in that
+// sample. A zero threshold isn't used
+type Options struct {
+	Samples   []string // the samples to write, in order (default: all of them, in the order of the VCF)
+	MinQual   float64
+	MinGQ     float64
+	MinDP     int
+	Pass      bool // only trust records whose FILTER is PASS or "."
+	AbsentAsN bool // write N, rather than the reference, at sites with no VCF record
+}
+
+var ep = encoding.MakeEncodingArray()
+var decode = encoding.MakeDecodingArray()
+
+// sample is the sequence being built for one sample, and the sample's column in the VCF
+type sample struct {
+	name   string
+	column int
+	seq    []byte
+}
+
+// record is one line of a VCF
+type record struct {
+	pos     int // 1-based
+	ref     string
+	alts    []string
+	trusted bool
+	format  []string
+	fields  []string
+}
+
+// parseRecord parses one VCF data line, and returns it with its CHROM
+func parseRecord(line string, lineN int, o Options) (record, string, error) {
+	fields := strings.Split(line, "\t")
+	if len(fields) < 8 {
+		return record{}, "", errors.New("couldn't parse VCF line " + strconv.Itoa(lineN) + ": fewer than eight tab-separated columns")
+	}
+	pos, err := strconv.Atoi(fields[1])
+	if err != nil || pos < 1 {
+		return record{}, "", errors.New("couldn't parse VCF line " + strconv.Itoa(lineN) + ": bad POS")
+	}
+
+	r := record{pos: pos, ref: strings.ToUpper(fields[3]), alts: strings.Split(strings.ToUpper(fields[4]), ","), trusted: true, fields: fields}
+	if len(fields) > 8 {
+		r.format = strings.Split(fields[8], ":")
+	}
+
+	if o.MinQual > 0 {
+		q, err := strconv.ParseFloat(fields[5], 64)
+		if err != nil || q < o.MinQual {
+			r.trusted = false
+		}
+	}
+	if o.Pass && fields[6] != "PASS" && fields[6] != "." {
+		r.trusted = false
+	}
+
+	return r, fields[0], nil
+}
+
+// value returns the FORMAT field key of the genotype in column, or "" if it isn't there
+func (r record) value(column int, key string) string {
+	values := strings.Split(r.fields[column], ":")
+	for i, f := range r.format {
+		if f == key && i < len(values) {
+			return values[i]
+		}
+	}
+	return ""
+}
+
+// allele returns the sequence of allele number a, and false if it can't be written as bases
+func (r record) allele(a int) (string, bool) {
+	if a == 0 {
+		return r.ref, true
+	}
+	if a > len(r.alts) {
+		return "", false
+	}
+	alt := r.alts[a-1]
+	if alt == "*" || strings.HasPrefix(alt, "<") || strings.ContainsAny(alt, "[]") {
+		return "", false
+	}
+	return alt, true
+}
+
+// call returns what to write over the reference allele in one sample, which is the same length as the
+// reference allele. Alleles are left-aligned with the reference allele, so a shorter allele is padded with
+// gaps and any bases beyond the length of the reference allele (i.e. an insertion) are dropped
+func (r record) call(column int, o Options) []byte {
+
+	n := make([]byte, len(r.ref))
+	for i := range n {
+		n[i] = 'N'
+	}
+
+	if !r.trusted {
+		return n
+	}
+
+	gt := r.value(column, "GT")
+	if gt == "" {
+		return n
+	}
+	if o.MinGQ > 0 {
+		gq, err := strconv.ParseFloat(r.value(column, "GQ"), 64)
+		if err != nil || gq < o.MinGQ {
+			return n
+		}
+	}
+	if o.MinDP > 0 {
+		dp, err := strconv.Atoi(r.value(column, "DP"))
+		if err != nil || dp < o.MinDP {
+			return n
+		}
+	}
+
+	alleles := make([]string, 0, 2)
+	for _, a := range strings.FieldsFunc(gt, func(c rune) bool { return c == '/' || c == '|' }) {
+		i, err := strconv.Atoi(a)
+		if err != nil {
+			return n
+		}
+		allele, ok := r.allele(i)
+		if !ok {
+			return n
+		}
+		alleles = append(alleles, allele)
+	}
+	if len(alleles) == 0 {
+		return n
+	}
+
+	// heterozygous calls are IUPAC codes if every allele is a single base, otherwise Ns
+	het := false
+	for _, a := range alleles[1:] {
+		if a != alleles[0] {
+			het = true
+		}
+	}
+	if het {
+		if len(r.ref) != 1 {
+			return n
+		}
+		var code byte
+		for _, a := range alleles {
+			if len(a) != 1 {
+				return n
+			}
+			code |= ep[a[0]]
+		}
+		n[0] = decode[code&0xF0][0]
+		return n
+	}
+
+	out := make([]byte, len(r.ref))
+	for i := range out {
+		if i < len(alleles[0]) {
+			out[i] = alleles[0][i]
+		} else {
+			out[i] = '-'
+		}
+	}
+	return out
+}
+
+// VCF2Fasta writes one sequence per sample in the VCF file vcf to out, with the genotypes applied to the
+// reference sequence ref. The VCF must describe a single chromosome
+func VCF2Fasta(vcf io.Reader, ref fastaio.FastaRecord, out io.Writer, o Options) error {
+
+	refSeq := strings.ToUpper(ref.Seq)
+
+	s := bufio.NewScanner(vcf)
+	s.Buffer(make([]byte, 0), 64*1024*1024)
+
+	var samples []*sample
+	chrom := ""
+
+	lineN := 0
+	for s.Scan() {
+		lineN++
+		line := s.Text()
+		if strings.HasPrefix(line, "##") || len(line) == 0 {
+			continue
+		}
+
+		if strings.HasPrefix(line, "#CHROM") {
+			var err error
+			samples, err = makeSamples(strings.Split(line, "\t"), refSeq, o)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if samples == nil {
+			return errors.New("no #CHROM header line before the first VCF record")
+		}
+
+		r, c, err := parseRecord(line, lineN, o)
+		if err != nil {
+			return err
+		}
+		if chrom == "" {
+			chrom = c
+		} else if c != chrom {
+			return errors.New("more than one chromosome in VCF (" + chrom + " and " + c + ")")
+		}
+		if r.pos-1+len(r.ref) > len(refSeq) {
+			return errors.New("VCF line " + strconv.Itoa(lineN) + " is beyond the end of the reference")
+		}
+		if r.ref != refSeq[r.pos-1:r.pos-1+len(r.ref)] {
+			return errors.New("REF on VCF line " + strconv.Itoa(lineN) + " (" + r.ref + ") doesn't match the reference at position " + strconv.Itoa(r.pos))
+		}
+		for _, smp := range samples {
+			if smp.column >= len(r.fields) {
+				return errors.New("VCF line " + strconv.Itoa(lineN) + " has no genotype for " + smp.name)
+			}
+			copy(smp.seq[r.pos-1:], r.call(smp.column, o))
+		}
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+	if samples == nil {
+		return errors.New("no #CHROM header line in VCF")
+	}
+
+	if chrom != "" && chrom != ref.ID {
+		summary.Warn("the VCF's chromosome (" + chrom + ") isn't called the same as the reference (" + ref.ID + ")")
+	}
+
+	for _, smp := range samples {
+		if _, err := out.Write([]byte(">" + smp.name + "\n" + string(smp.seq) + "\n")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// makeSamples finds the columns of the samples to write from the #CHROM header line, and starts each
+// sample's sequence
+func makeSamples(header []string, refSeq string, o Options) ([]*sample, error) {
+	if len(header) < 10 {
+		return nil, errors.New("no samples in VCF")
+	}
+
+	columns := make(map[string]int)
+	names := make([]string, 0, len(header)-9)
+	for i, name := range header[9:] {
+		columns[name] = i + 9
+		names = append(names, name)
+	}
+	if len(o.Samples) > 0 {
+		names = o.Samples
+	}
+
+	samples := make([]*sample, 0, len(names))
+	for _, name := range names {
+		column, ok := columns[name]
+		if !ok {
+			return nil, errors.New("couldn't find sample " + name + " in VCF")
+		}
+		seq := []byte(refSeq)
+		if o.AbsentAsN {
+			for i := range seq {
+				seq[i] = 'N'
+			}
+		}
+		samples = append(samples, &sample{name: name, column: column, seq: seq})
+	}
+
+	return samples, nil
+}
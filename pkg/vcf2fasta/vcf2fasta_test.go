@@ -0,0 +1,45 @@
+package vcf2fasta
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+var testVCF = `##fileformat=VCFv4.2
+##FORMAT=<ID=GT,Number=1,Type=String,Description="Genotype">
+#CHROM	POS	ID	REF	ALT	QUAL	FILTER	INFO	FORMAT	s1	s2	s3
+ref	2	.	C	T	50	PASS	.	GT:DP	1:20	0:20	1:3
+ref	4	.	TAC	T	50	PASS	.	GT:DP	0/1:20	1/1:20	./.:20
+ref	7	.	G	A,T	50	PASS	.	GT:DP	1/2:20	2:20	0:20
+ref	10	.	A	ATT	5	lowq	.	GT:DP	1:20	1:20	0:20
+`
+
+func TestVCF2Fasta(t *testing.T) {
+	ref := fastaio.FastaRecord{ID: "ref", Seq: "ACGTACGTAA"}
+
+	out := new(bytes.Buffer)
+	if err := VCF2Fasta(strings.NewReader(testVCF), ref, out, Options{}); err != nil {
+		t.Fatal(err)
+	}
+	want := ">s1\nATGNNNWTAA\n>s2\nACGT--TTAA\n>s3\nATGNNNGTAA\n"
+	if out.String() != want {
+		t.Errorf("problem in TestVCF2Fasta(): got\n%s\nwant\n%s", out.String(), want)
+	}
+
+	out.Reset()
+	o := Options{Samples: []string{"s3"}, MinQual: 10, MinDP: 10, Pass: true}
+	if err := VCF2Fasta(strings.NewReader(testVCF), ref, out, o); err != nil {
+		t.Fatal(err)
+	}
+	if want = ">s3\nANGNNNGTAN\n"; out.String() != want {
+		t.Errorf("problem in TestVCF2Fasta(): got\n%s\nwant\n%s", out.String(), want)
+	}
+
+	out.Reset()
+	if err := VCF2Fasta(strings.NewReader(testVCF), fastaio.FastaRecord{ID: "ref", Seq: "AAAAAAAAAA"}, out, Options{}); err == nil {
+		t.Errorf("problem in TestVCF2Fasta(): expected an error for a REF that doesn't match")
+	}
+}
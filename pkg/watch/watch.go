@@ -0,0 +1,127 @@
+/*
+Package watch implements incremental processing of a growing fasta file: a
+state file records the name and sequence hash of every record that has been
+processed, so that each run only passes on the records that are new since the
+last one, and outputs can be appended to instead of being rebuilt.
+*/
+package watch
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"errors"
+	"io"
+
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+	"github.com/virus-evolution/gofasta/pkg/seqhash"
+	"github.com/virus-evolution/gofasta/pkg/summary"
+)
+
+// State maps the name of every record that has been processed to the hash of its sequence
+type State map[string]string
+
+// Entry is one record to add to the state
+type Entry struct {
+	Name string
+	Hash string
+}
+
+// ReadState reads a state file: a CSV file with the header name,seqhash
+func ReadState(r io.Reader) (State, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = 2
+	rows, err := cr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 || rows[0][0] != "name" || rows[0][1] != "seqhash" {
+		return nil, errors.New("a state file should have the header name,seqhash")
+	}
+	s := make(State, len(rows)-1)
+	for _, row := range rows[1:] {
+		s[row[0]] = row[1]
+	}
+	return s, nil
+}
+
+// WriteState writes entries to a state file, with the header first if header is true
+func WriteState(w io.Writer, entries []Entry, header bool) error {
+	cw := csv.NewWriter(w)
+	if header {
+		if err := cw.Write([]string{"name", "seqhash"}); err != nil {
+			return err
+		}
+	}
+	for _, e := range entries {
+		if err := cw.Write([]string{e.Name, e.Hash}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// New writes the records in the fasta file in that aren't in state to out, and returns the entries to add to
+// the state for them. A record that is in the state with a different sequence isn't passed on again, since its
+// earlier results are already in the outputs, but a warning is given. The new records are kept in memory, so that
+// nothing is written to out if reading fails part of the way through
+func New(ctx context.Context, in io.Reader, state State, out io.Writer) ([]Entry, error) {
+
+	h := sha256.New()
+	entries := make([]Entry, 0)
+	seen := make(map[string]bool)
+	var buf bytes.Buffer
+
+	err := fastaio.EachRecord(ctx, in, func(FR fastaio.FastaRecord) error {
+		sum := seqhash.Sum(h, FR.Seq, 0)
+		if old, ok := state[FR.ID]; ok {
+			if old != sum {
+				summary.Warn(FR.ID + " has changed since it was processed, but its old results are kept: remove it from the state file and its outputs to process it again")
+			}
+			summary.Skipped(FR.ID, "already processed")
+			return nil
+		}
+		if seen[FR.ID] {
+			return errors.New("more than one new record is called " + FR.ID)
+		}
+		seen[FR.ID] = true
+		entries = append(entries, Entry{Name: FR.ID, Hash: sum})
+		buf.WriteString(">" + FR.Description + "\n" + FR.Seq + "\n")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = out.Write(buf.Bytes())
+	return entries, err
+}
+
+// headerSkipper passes on everything written to it after the first line
+type headerSkipper struct {
+	w    io.Writer
+	done bool
+}
+
+func (hs *headerSkipper) Write(p []byte) (int, error) {
+	if hs.done {
+		return hs.w.Write(p)
+	}
+	i := bytes.IndexByte(p, '\n')
+	if i == -1 {
+		return len(p), nil
+	}
+	hs.done = true
+	if _, err := hs.w.Write(p[i+1:]); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// SkipHeader returns a writer that drops the first line written to it, so that a CSV file's header isn't
+// repeated when more rows are appended to it
+func SkipHeader(w io.Writer) io.Writer {
+	return &headerSkipper{w: w}
+}
@@ -0,0 +1,53 @@
+package watch
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/virus-evolution/gofasta/pkg/snps"
+)
+
+func TestNew(t *testing.T) {
+	state := make(State)
+
+	// the first run processes everything
+	out := new(bytes.Buffer)
+	entries, err := New(context.Background(), strings.NewReader(">a\nACGT\n>b\nACGA\n"), state, out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != ">a\nACGT\n>b\nACGA\n" || len(entries) != 2 {
+		t.Errorf("problem in TestNew(): got %s", out.String())
+	}
+
+	stateFile := new(bytes.Buffer)
+	if err := WriteState(stateFile, entries, true); err != nil {
+		t.Fatal(err)
+	}
+	state, err = ReadState(bytes.NewReader(stateFile.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the second only processes c, and b's change is left alone
+	out.Reset()
+	entries, err = New(context.Background(), strings.NewReader(">a\nACGT\n>b\nACGG\n>c\nTCGA\n"), state, out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != ">c\nTCGA\n" || len(entries) != 1 || entries[0].Name != "c" {
+		t.Errorf("problem in TestNew(): got %s", out.String())
+	}
+}
+
+func TestSkipHeader(t *testing.T) {
+	out := new(bytes.Buffer)
+	if err := snps.SNPs(context.Background(), strings.NewReader(">ref\nACGT\n"), strings.NewReader(">c\nTCGA\n"), SkipHeader(out), snps.Options{}); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "c,A1T|T4A\n" {
+		t.Errorf("problem in TestSkipHeader(): got %s", out.String())
+	}
+}
@@ -0,0 +1,155 @@
+/*
+Package windows implements sliding-window summaries of each record in a fasta
+file: GC content, the proportions of N and gaps, and, given a reference aligned
+to the records, the divergence from it.
+*/
+package windows
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/virus-evolution/gofasta/pkg/encoding"
+	"github.com/virus-evolution/gofasta/pkg/fastaio"
+)
+
+// Options controls the windows, which are Window columns wide and start every Step columns
+type Options struct {
+	Window int
+	Step   int
+}
+
+// DefaultOptions returns options suited to viral genomes of a few tens of kilobases
+func DefaultOptions() Options {
+	return Options{Window: 500, Step: 250}
+}
+
+// Window summarises the columns [Start, End) (0-based) of one record. Bases is the number of unambiguous
+// nucleotides, and Compared the number of sites where both the record and the reference have one
+type Window struct {
+	Start    int
+	End      int
+	Bases    int
+	GC       int
+	N        int
+	Gaps     int
+	Compared int
+	Diffs    int
+}
+
+// cumulative holds running totals along a record, so that any window can be summarised by subtraction
+type cumulative struct {
+	bases, gc, n, gaps, compared, diffs []int
+}
+
+func newCumulative(seq, ref string) cumulative {
+	c := cumulative{
+		bases:    make([]int, len(seq)+1),
+		gc:       make([]int, len(seq)+1),
+		n:        make([]int, len(seq)+1),
+		gaps:     make([]int, len(seq)+1),
+		compared: make([]int, len(seq)+1),
+		diffs:    make([]int, len(seq)+1),
+	}
+	for i := 0; i < len(seq); i++ {
+		c.bases[i+1], c.gc[i+1], c.n[i+1], c.gaps[i+1] = c.bases[i], c.gc[i], c.n[i], c.gaps[i]
+		c.compared[i+1], c.diffs[i+1] = c.compared[i], c.diffs[i]
+		switch seq[i] {
+		case 'G', 'C':
+			c.gc[i+1]++
+			c.bases[i+1]++
+		case 'A', 'T':
+			c.bases[i+1]++
+		case 'N':
+			c.n[i+1]++
+		case '-':
+			c.gaps[i+1]++
+		}
+		if ref != "" && encoding.Certain(seq[i]) && encoding.Certain(ref[i]) {
+			c.compared[i+1]++
+			if seq[i] != ref[i] {
+				c.diffs[i+1]++
+			}
+		}
+	}
+	return c
+}
+
+func (c cumulative) window(start, end int) Window {
+	return Window{
+		Start:    start,
+		End:      end,
+		Bases:    c.bases[end] - c.bases[start],
+		GC:       c.gc[end] - c.gc[start],
+		N:        c.n[end] - c.n[start],
+		Gaps:     c.gaps[end] - c.gaps[start],
+		Compared: c.compared[end] - c.compared[start],
+		Diffs:    c.diffs[end] - c.diffs[start],
+	}
+}
+
+// Scan returns the windows of seq, which must be uppercase. If ref isn't empty it must be the same length as
+// seq. The last window is shortened to end at the end of seq
+func Scan(seq, ref string, o Options) []Window {
+	c := newCumulative(seq, ref)
+	ws := make([]Window, 0, len(seq)/o.Step+1)
+	for start := 0; start < len(seq); start += o.Step {
+		end := start + o.Window
+		if end > len(seq) {
+			end = len(seq)
+		}
+		ws = append(ws, c.window(start, end))
+		if end == len(seq) {
+			break
+		}
+	}
+	return ws
+}
+
+// ratio formats a/b, or returns an empty string if b is 0
+func ratio(a, b int) string {
+	if b == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(float64(a)/float64(b), 'f', 4, 64)
+}
+
+// Windows summarises every record in the fasta file in, in sliding windows, and writes a CSV file with the
+// columns record,start,end,bases,gc,n_fraction,gap_fraction,compared_sites,divergence to out. gc is the
+// proportion of unambiguous nucleotides that are G or C, n_fraction and gap_fraction are proportions of the
+// window's width, and divergence is the proportion of compared sites that differ from ref. If ref is empty the
+// last two columns are left empty. Start and end are 1-based and inclusive
+func Windows(ctx context.Context, in io.Reader, ref string, out io.Writer, o Options) error {
+
+	if o.Window < 1 || o.Step < 1 {
+		return errors.New("the window size and step must be at least 1")
+	}
+	ref = strings.ToUpper(ref)
+
+	if _, err := out.Write([]byte("record,start,end,bases,gc,n_fraction,gap_fraction,compared_sites,divergence\n")); err != nil {
+		return err
+	}
+
+	return fastaio.EachRecord(ctx, in, func(FR fastaio.FastaRecord) error {
+		seq := strings.ToUpper(FR.Seq)
+		if ref != "" && len(seq) != len(ref) {
+			return errors.New(FR.ID + " is not the same length as the reference: are they aligned?")
+		}
+		var sb strings.Builder
+		for _, w := range Scan(seq, ref, o) {
+			sb.WriteString(FR.ID + "," + strconv.Itoa(w.Start+1) + "," + strconv.Itoa(w.End) + "," + strconv.Itoa(w.Bases) + "," +
+				ratio(w.GC, w.Bases) + "," + ratio(w.N, w.End-w.Start) + "," + ratio(w.Gaps, w.End-w.Start) + ",")
+			if ref != "" {
+				sb.WriteString(strconv.Itoa(w.Compared) + "," + ratio(w.Diffs, w.Compared))
+			} else {
+				sb.WriteString(",")
+			}
+			sb.WriteString("\n")
+		}
+		_, err := out.Write([]byte(sb.String()))
+		return err
+	})
+}
@@ -0,0 +1,52 @@
+package windows
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestScan(t *testing.T) {
+	seq := "GGCCAANN--ACGT"
+	ref := "GGCCAAAAAAACGA"
+	ws := Scan(seq, ref, Options{Window: 6, Step: 4})
+	want := []Window{
+		{Start: 0, End: 6, Bases: 6, GC: 4, Compared: 6},
+		{Start: 4, End: 10, Bases: 2, N: 2, Gaps: 2, Compared: 2},
+		{Start: 8, End: 14, Bases: 4, GC: 2, Gaps: 2, Compared: 4, Diffs: 1},
+	}
+	if len(ws) != len(want) {
+		t.Fatalf("problem in TestScan(): got %+v", ws)
+	}
+	for i := range ws {
+		if ws[i] != want[i] {
+			t.Errorf("problem in TestScan(): window %d is %+v, want %+v", i, ws[i], want[i])
+		}
+	}
+}
+
+func TestWindows(t *testing.T) {
+	out := new(bytes.Buffer)
+	if err := Windows(context.Background(), strings.NewReader(">a\nGCAT\n>b\nNNAT\n"), "GCAA", out, Options{Window: 4, Step: 4}); err != nil {
+		t.Fatal(err)
+	}
+	want := "record,start,end,bases,gc,n_fraction,gap_fraction,compared_sites,divergence\n" +
+		"a,1,4,4,0.5000,0.0000,0.0000,4,0.2500\n" +
+		"b,1,4,2,0.0000,0.5000,0.0000,2,0.5000\n"
+	if out.String() != want {
+		t.Errorf("problem in TestWindows(): got\n%s", out.String())
+	}
+
+	out.Reset()
+	if err := Windows(context.Background(), strings.NewReader(">a\nNNAT\n"), "", out, Options{Window: 4, Step: 4}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(out.String(), "a,1,4,2,0.0000,0.5000,0.0000,,\n") {
+		t.Errorf("problem in TestWindows(): got\n%s", out.String())
+	}
+
+	if err := Windows(context.Background(), strings.NewReader(">a\nGCAT\n"), "GCA", out, Options{Window: 4, Step: 4}); err == nil {
+		t.Errorf("problem in TestWindows(): expected an error for a reference of a different length")
+	}
+}